@@ -93,6 +93,14 @@ type EncodeContext struct {
 	omitZeroStruct          bool
 	omitEmpty               bool
 	useJSONStructTags       bool
+
+	// float32SliceAsVector causes the Encoder to marshal Go float32 slices as
+	// BSON binary vectors instead of BSON arrays of doubles.
+	float32SliceAsVector bool
+
+	// sliceAsVector is set for struct fields with the "vector" struct tag. It
+	// causes float32 and int8 slices to be marshaled as BSON binary vectors.
+	sliceAsVector bool
 }
 
 // DecodeContext is the contextual information required for a Codec to decode a
@@ -114,6 +122,16 @@ type DecodeContext struct {
 
 	binaryAsSlice bool
 
+	// disallowUnknownFields, if true, causes the struct codec to return an error for document
+	// elements that do not map to a field of the destination struct or an inline map.
+	disallowUnknownFields bool
+
+	// integersAsInt64 and numbersAsJSONNumber control the Go type that BSON numbers are decoded
+	// into when there is no type information. By default, BSON "int32", "int64", and "double"
+	// values decode to int32, int64, and float64 respectively.
+	integersAsInt64     bool
+	numbersAsJSONNumber bool
+
 	// a false value results in a decoding error.
 	objectIDAsHexString bool
 
@@ -121,6 +139,10 @@ type DecodeContext struct {
 	useLocalTimeZone  bool
 	zeroMaps          bool
 	zeroStructs       bool
+
+	// stringInterner, if not nil, is used to intern decoded BSON "string"
+	// values.
+	stringInterner *StringInterner
 }
 
 // ValueEncoder is the interface implemented by types that can encode a provided Go type to BSON.
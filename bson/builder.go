@@ -0,0 +1,403 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// maxLinearKeys is the number of keys in a document after which a
+// DocumentBuilder indexes keys in a map to detect duplicates instead of
+// comparing each new key to all previous keys.
+const maxLinearKeys = 16
+
+// DocumentBuilder builds a BSON document by appending elements directly to a
+// byte slice, without using reflection. It is an alternative to marshaling a D
+// or a struct for performance-sensitive code:
+//
+//	doc, err := bson.NewDocumentBuilder().
+//		AppendString("name", "Alice").
+//		AppendInt32("age", 30).
+//		StartDocument("address").
+//		AppendString("city", "New York").
+//		End().
+//		StartArray("tags").
+//		AppendString("", "admin").
+//		AppendString("", "staff").
+//		End().
+//		Build()
+//
+// Embedded documents and arrays are started with StartDocument or StartArray
+// and ended with End. The elements of an array are appended with an empty key,
+// and their indexes are generated by the DocumentBuilder.
+//
+// If an element is invalid, for example because its key is already used in
+// the same document, the DocumentBuilder records the error and ignores all
+// further calls. The first error is returned by Build, so errors do not need
+// to be checked after each call.
+type DocumentBuilder struct {
+	buf    []byte
+	frames []builderFrame
+	err    error
+}
+
+// builderFrame is a document or array that has been started but not ended.
+type builderFrame struct {
+	name  string // The key of the document or array in its parent.
+	start int32  // The index of the length of the document or array.
+	array bool
+	n     int // The number of elements appended.
+
+	keys  []string
+	index map[string]struct{}
+}
+
+// NewDocumentBuilder returns a DocumentBuilder for an empty document.
+func NewDocumentBuilder() *DocumentBuilder {
+	b := &DocumentBuilder{}
+	b.push("", false)
+	return b
+}
+
+func (b *DocumentBuilder) push(name string, array bool) {
+	var start int32
+	start, b.buf = bsoncore.ReserveLength(b.buf)
+
+	// Reuse the keys of a previously ended frame at the same depth.
+	if len(b.frames) < cap(b.frames) {
+		b.frames = b.frames[:len(b.frames)+1]
+		f := &b.frames[len(b.frames)-1]
+		*f = builderFrame{name: name, start: start, array: array, keys: f.keys[:0]}
+		return
+	}
+	b.frames = append(b.frames, builderFrame{name: name, start: start, array: array})
+}
+
+// Err returns the first error recorded by the DocumentBuilder, or nil if there
+// is none.
+func (b *DocumentBuilder) Err() error {
+	return b.err
+}
+
+func (b *DocumentBuilder) setErr(format string, args ...any) {
+	if b.err == nil {
+		b.err = fmt.Errorf(format, args...)
+	}
+}
+
+// path returns the dot-separated path of key in the current document or array.
+func (b *DocumentBuilder) path(key string) string {
+	names := make([]string, 0, len(b.frames))
+	for _, f := range b.frames[1:] {
+		names = append(names, f.name)
+	}
+	if key != "" {
+		names = append(names, key)
+	}
+	return strings.Join(names, ".")
+}
+
+// header appends the type and key of an element to the current document or
+// array and reports whether the value of the element should be appended.
+func (b *DocumentBuilder) header(t Type, key string) bool {
+	if b.err != nil {
+		return false
+	}
+	if len(b.frames) == 0 {
+		b.err = errors.New("cannot append to a DocumentBuilder after Build")
+		return false
+	}
+
+	f := &b.frames[len(b.frames)-1]
+	if f.array {
+		if key != "" {
+			b.setErr("array element %s must have an empty key, got %q", b.path(strconv.Itoa(f.n)), key)
+			return false
+		}
+		b.buf = append(b.buf, byte(t))
+		b.buf = strconv.AppendInt(b.buf, int64(f.n), 10)
+		b.buf = append(b.buf, 0x00)
+		f.n++
+		return true
+	}
+
+	if strings.IndexByte(key, 0x00) != -1 {
+		b.setErr("key %q contains a null byte", b.path(key))
+		return false
+	}
+	if !f.addKey(key) {
+		b.setErr("duplicate key %q", b.path(key))
+		return false
+	}
+	b.buf = append(b.buf, byte(t))
+	b.buf = append(b.buf, key...)
+	b.buf = append(b.buf, 0x00)
+	f.n++
+	return true
+}
+
+// addKey records key as used in the document and reports whether it was not
+// used before.
+func (f *builderFrame) addKey(key string) bool {
+	if f.index != nil {
+		if _, ok := f.index[key]; ok {
+			return false
+		}
+		f.index[key] = struct{}{}
+		return true
+	}
+
+	for _, k := range f.keys {
+		if k == key {
+			return false
+		}
+	}
+	f.keys = append(f.keys, key)
+	if len(f.keys) > maxLinearKeys {
+		f.index = make(map[string]struct{}, 2*len(f.keys))
+		for _, k := range f.keys {
+			f.index[k] = struct{}{}
+		}
+	}
+	return true
+}
+
+// AppendString appends a string element.
+func (b *DocumentBuilder) AppendString(key, s string) *DocumentBuilder {
+	if b.header(TypeString, key) {
+		b.buf = bsoncore.AppendString(b.buf, s)
+	}
+	return b
+}
+
+// AppendInt32 appends a 32-bit integer element.
+func (b *DocumentBuilder) AppendInt32(key string, i int32) *DocumentBuilder {
+	if b.header(TypeInt32, key) {
+		b.buf = bsoncore.AppendInt32(b.buf, i)
+	}
+	return b
+}
+
+// AppendInt64 appends a 64-bit integer element.
+func (b *DocumentBuilder) AppendInt64(key string, i int64) *DocumentBuilder {
+	if b.header(TypeInt64, key) {
+		b.buf = bsoncore.AppendInt64(b.buf, i)
+	}
+	return b
+}
+
+// AppendDouble appends a double element.
+func (b *DocumentBuilder) AppendDouble(key string, f float64) *DocumentBuilder {
+	if b.header(TypeDouble, key) {
+		b.buf = bsoncore.AppendDouble(b.buf, f)
+	}
+	return b
+}
+
+// AppendDecimal128 appends a Decimal128 element.
+func (b *DocumentBuilder) AppendDecimal128(key string, d Decimal128) *DocumentBuilder {
+	if b.header(TypeDecimal128, key) {
+		h, l := d.GetBytes()
+		b.buf = bsoncore.AppendDecimal128(b.buf, h, l)
+	}
+	return b
+}
+
+// AppendBoolean appends a boolean element.
+func (b *DocumentBuilder) AppendBoolean(key string, v bool) *DocumentBuilder {
+	if b.header(TypeBoolean, key) {
+		b.buf = bsoncore.AppendBoolean(b.buf, v)
+	}
+	return b
+}
+
+// AppendNull appends a null element.
+func (b *DocumentBuilder) AppendNull(key string) *DocumentBuilder {
+	b.header(TypeNull, key)
+	return b
+}
+
+// AppendObjectID appends an ObjectID element.
+func (b *DocumentBuilder) AppendObjectID(key string, oid ObjectID) *DocumentBuilder {
+	if b.header(TypeObjectID, key) {
+		b.buf = bsoncore.AppendObjectID(b.buf, oid)
+	}
+	return b
+}
+
+// AppendDateTime appends a UTC datetime element.
+func (b *DocumentBuilder) AppendDateTime(key string, dt DateTime) *DocumentBuilder {
+	if b.header(TypeDateTime, key) {
+		b.buf = bsoncore.AppendDateTime(b.buf, int64(dt))
+	}
+	return b
+}
+
+// AppendTimestamp appends a timestamp element.
+func (b *DocumentBuilder) AppendTimestamp(key string, ts Timestamp) *DocumentBuilder {
+	if b.header(TypeTimestamp, key) {
+		b.buf = bsoncore.AppendTimestamp(b.buf, ts.T, ts.I)
+	}
+	return b
+}
+
+// AppendBinary appends a binary element with the given subtype.
+func (b *DocumentBuilder) AppendBinary(key string, subtype byte, data []byte) *DocumentBuilder {
+	if b.header(TypeBinary, key) {
+		b.buf = bsoncore.AppendBinary(b.buf, subtype, data)
+	}
+	return b
+}
+
+// AppendRegex appends a regular expression element. The pattern and options
+// must not contain null bytes.
+func (b *DocumentBuilder) AppendRegex(key, pattern, options string) *DocumentBuilder {
+	if b.err == nil && (strings.IndexByte(pattern, 0x00) != -1 || strings.IndexByte(options, 0x00) != -1) {
+		b.setErr("regex %q contains a null byte", b.path(key))
+		return b
+	}
+	if b.header(TypeRegex, key) {
+		b.buf = bsoncore.AppendRegex(b.buf, pattern, sortStringAlphebeticAscending(options))
+	}
+	return b
+}
+
+// AppendJavaScript appends a JavaScript code element.
+func (b *DocumentBuilder) AppendJavaScript(key, code string) *DocumentBuilder {
+	if b.header(TypeJavaScript, key) {
+		b.buf = bsoncore.AppendJavaScript(b.buf, code)
+	}
+	return b
+}
+
+// AppendMinKey appends a min key element.
+func (b *DocumentBuilder) AppendMinKey(key string) *DocumentBuilder {
+	b.header(TypeMinKey, key)
+	return b
+}
+
+// AppendMaxKey appends a max key element.
+func (b *DocumentBuilder) AppendMaxKey(key string) *DocumentBuilder {
+	b.header(TypeMaxKey, key)
+	return b
+}
+
+// AppendDocument appends an embedded document element containing a copy of
+// doc. An error is recorded if doc is not a valid BSON document.
+func (b *DocumentBuilder) AppendDocument(key string, doc Raw) *DocumentBuilder {
+	if b.err == nil {
+		if err := doc.Validate(); err != nil {
+			b.setErr("invalid document %q: %w", b.path(key), err)
+			return b
+		}
+	}
+	if b.header(TypeEmbeddedDocument, key) {
+		b.buf = append(b.buf, doc...)
+	}
+	return b
+}
+
+// AppendArray appends an array element containing a copy of arr. An error is
+// recorded if arr is not a valid BSON array.
+func (b *DocumentBuilder) AppendArray(key string, arr RawArray) *DocumentBuilder {
+	if b.err == nil {
+		if err := arr.Validate(); err != nil {
+			b.setErr("invalid array %q: %w", b.path(key), err)
+			return b
+		}
+	}
+	if b.header(TypeArray, key) {
+		b.buf = append(b.buf, arr...)
+	}
+	return b
+}
+
+// AppendValue appends an element containing a copy of val. An error is
+// recorded if val is not a valid BSON value.
+func (b *DocumentBuilder) AppendValue(key string, val RawValue) *DocumentBuilder {
+	if b.err == nil {
+		if err := convertToCoreValue(val).Validate(); err != nil {
+			b.setErr("invalid value %q: %w", b.path(key), err)
+			return b
+		}
+	}
+	if b.header(val.Type, key) {
+		b.buf = append(b.buf, val.Value...)
+	}
+	return b
+}
+
+// StartDocument starts an embedded document element. Elements are appended to
+// the embedded document until End is called.
+func (b *DocumentBuilder) StartDocument(key string) *DocumentBuilder {
+	if b.header(TypeEmbeddedDocument, key) {
+		b.push(b.frameName(key), false)
+	}
+	return b
+}
+
+// StartArray starts an array element. Elements are appended to the array,
+// with empty keys, until End is called.
+func (b *DocumentBuilder) StartArray(key string) *DocumentBuilder {
+	if b.header(TypeArray, key) {
+		b.push(b.frameName(key), true)
+	}
+	return b
+}
+
+// frameName returns the name of an embedded document or array that was just
+// appended to the current frame with key.
+func (b *DocumentBuilder) frameName(key string) string {
+	if f := b.frames[len(b.frames)-1]; f.array {
+		return strconv.Itoa(f.n - 1)
+	}
+	return key
+}
+
+// End ends the embedded document or array most recently started with
+// StartDocument or StartArray.
+func (b *DocumentBuilder) End() *DocumentBuilder {
+	if b.err != nil {
+		return b
+	}
+	if len(b.frames) < 2 {
+		b.err = errors.New("End called without a matching StartDocument or StartArray")
+		return b
+	}
+	b.end()
+	return b
+}
+
+func (b *DocumentBuilder) end() {
+	f := b.frames[len(b.frames)-1]
+	b.buf = append(b.buf, 0x00)
+	b.buf = bsoncore.UpdateLength(b.buf, f.start, int32(len(b.buf))-f.start)
+	b.frames = b.frames[:len(b.frames)-1]
+}
+
+// Build ends the document and returns it, or returns the first error recorded
+// by the DocumentBuilder. It is an error if an embedded document or array has
+// not been ended. The DocumentBuilder cannot be used after Build is called.
+func (b *DocumentBuilder) Build() (Raw, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	switch len(b.frames) {
+	case 0:
+		return nil, errors.New("Build called more than once")
+	case 1:
+	default:
+		return nil, fmt.Errorf("%q was not ended", b.path(""))
+	}
+	b.end()
+	return b.buf, nil
+}
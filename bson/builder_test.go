@@ -0,0 +1,171 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"strconv"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestDocumentBuilder(t *testing.T) {
+	t.Run("matches Marshal", func(t *testing.T) {
+		oid := NewObjectID()
+		dec := NewDecimal128(1, 2)
+		embedded, err := Marshal(D{{Key: "e", Value: int32(1)}})
+		require.NoError(t, err)
+
+		got, err := NewDocumentBuilder().
+			AppendString("string", "hello").
+			AppendInt32("int32", 1).
+			AppendInt64("int64", 2).
+			AppendDouble("double", 3.5).
+			AppendDecimal128("decimal", dec).
+			AppendBoolean("bool", true).
+			AppendNull("null").
+			AppendObjectID("oid", oid).
+			AppendDateTime("date", DateTime(1000)).
+			AppendTimestamp("ts", Timestamp{T: 1, I: 2}).
+			AppendBinary("bin", 0x80, []byte{1, 2}).
+			AppendRegex("regex", "^a", "xi").
+			AppendJavaScript("js", "x").
+			AppendMinKey("min").
+			AppendMaxKey("max").
+			AppendDocument("raw", embedded).
+			AppendValue("value", RawValue{Type: TypeInt32, Value: []byte{7, 0, 0, 0}}).
+			StartDocument("doc").
+			AppendString("a", "b").
+			StartArray("arr").
+			AppendInt32("", 1).
+			StartDocument("").
+			AppendString("a", "nested").
+			End().
+			StartArray("").
+			End().
+			End().
+			End().
+			Build()
+		require.NoError(t, err)
+		require.NoError(t, got.Validate())
+
+		want, err := Marshal(D{
+			{Key: "string", Value: "hello"},
+			{Key: "int32", Value: int32(1)},
+			{Key: "int64", Value: int64(2)},
+			{Key: "double", Value: 3.5},
+			{Key: "decimal", Value: dec},
+			{Key: "bool", Value: true},
+			{Key: "null", Value: nil},
+			{Key: "oid", Value: oid},
+			{Key: "date", Value: DateTime(1000)},
+			{Key: "ts", Value: Timestamp{T: 1, I: 2}},
+			{Key: "bin", Value: Binary{Subtype: 0x80, Data: []byte{1, 2}}},
+			{Key: "regex", Value: Regex{Pattern: "^a", Options: "xi"}},
+			{Key: "js", Value: JavaScript("x")},
+			{Key: "min", Value: MinKey{}},
+			{Key: "max", Value: MaxKey{}},
+			{Key: "raw", Value: Raw(embedded)},
+			{Key: "value", Value: int32(7)},
+			{Key: "doc", Value: D{
+				{Key: "a", Value: "b"},
+				{Key: "arr", Value: A{int32(1), D{{Key: "a", Value: "nested"}}, A{}}},
+			}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, Raw(want), got)
+	})
+	t.Run("empty", func(t *testing.T) {
+		got, err := NewDocumentBuilder().Build()
+		require.NoError(t, err)
+		assert.Equal(t, Raw{5, 0, 0, 0, 0}, got)
+	})
+	t.Run("duplicate keys", func(t *testing.T) {
+		b := NewDocumentBuilder().
+			AppendInt32("a", 1).
+			StartDocument("b").
+			AppendInt32("a", 1).
+			AppendInt32("a", 2)
+		assert.EqualError(t, b.Err(), `duplicate key "b.a"`)
+
+		// Errors are sticky, so later calls are ignored.
+		_, err := b.End().AppendInt32("c", 1).Build()
+		assert.EqualError(t, err, `duplicate key "b.a"`)
+	})
+	t.Run("duplicate keys in large document", func(t *testing.T) {
+		b := NewDocumentBuilder()
+		for i := 0; i < 3*maxLinearKeys; i++ {
+			b.AppendInt32(strconv.Itoa(i), int32(i))
+		}
+		require.NoError(t, b.Err())
+		b.AppendInt32("5", 1)
+		assert.EqualError(t, b.Err(), `duplicate key "5"`)
+	})
+	t.Run("keys are scoped to documents", func(t *testing.T) {
+		_, err := NewDocumentBuilder().
+			StartDocument("a").AppendInt32("x", 1).End().
+			StartDocument("b").AppendInt32("x", 1).End().
+			Build()
+		assert.NoError(t, err)
+	})
+	t.Run("errors", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			build func(*DocumentBuilder) *DocumentBuilder
+			err   string
+		}{
+			{
+				name: "key in array",
+				build: func(b *DocumentBuilder) *DocumentBuilder {
+					return b.StartArray("a").AppendInt32("", 1).AppendInt32("x", 2)
+				},
+				err: `array element a.1 must have an empty key, got "x"`,
+			},
+			{
+				name:  "null byte in key",
+				build: func(b *DocumentBuilder) *DocumentBuilder { return b.AppendInt32("a\x00", 1) },
+				err:   `key "a\x00" contains a null byte`,
+			},
+			{
+				name:  "null byte in regex",
+				build: func(b *DocumentBuilder) *DocumentBuilder { return b.AppendRegex("r", "a\x00", "") },
+				err:   `regex "r" contains a null byte`,
+			},
+			{
+				name:  "unmatched End",
+				build: func(b *DocumentBuilder) *DocumentBuilder { return b.End() },
+				err:   "End called without a matching StartDocument or StartArray",
+			},
+			{
+				name:  "not ended",
+				build: func(b *DocumentBuilder) *DocumentBuilder { return b.StartDocument("a").StartArray("b") },
+				err:   `"a.b" was not ended`,
+			},
+			{
+				name:  "invalid document",
+				build: func(b *DocumentBuilder) *DocumentBuilder { return b.AppendDocument("d", Raw{1, 2}) },
+				err:   `invalid document "d"`,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.build(NewDocumentBuilder()).Build()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+			})
+		}
+	})
+	t.Run("Build twice", func(t *testing.T) {
+		b := NewDocumentBuilder()
+		_, err := b.Build()
+		require.NoError(t, err)
+		_, err = b.Build()
+		assert.Error(t, err)
+		assert.EqualError(t, b.AppendInt32("a", 1).Err(), "cannot append to a DocumentBuilder after Build")
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+	"sync"
+)
+
+// maxPooledBufferSize is the capacity above which buffers are not returned to
+// a CodecPool, so that a single large document does not pin a large buffer
+// for the lifetime of the pool. It matches the maximum BSON document size
+// supported by any current MongoDB server.
+const maxPooledBufferSize = 16 * 1024 * 1024
+
+// pooledEncoder is an Encoder together with the ValueWriter it writes to, so
+// that both are reused by a CodecPool.
+type pooledEncoder struct {
+	enc Encoder
+	vw  valueWriter
+}
+
+// CodecPool marshals and unmarshals BSON documents with a fixed Registry and
+// set of Encoder and Decoder options, reusing Encoders, Decoders, ValueWriters,
+// and buffers across calls. Unlike NewEncoder and NewDecoder, which allocate a
+// new Encoder or Decoder for each use, a CodecPool allocates very little once
+// it is warmed up, which makes it a good fit for workloads that marshal many
+// small documents, such as inserts.
+//
+// A CodecPool is safe for concurrent use and should be shared rather than
+// created per call.
+type CodecPool struct {
+	ec EncodeContext
+	dc DecodeContext
+
+	encoders sync.Pool
+	decoders sync.Pool
+	buffers  sync.Pool
+}
+
+// NewCodecPool returns a CodecPool that uses r to look up codecs. If r is nil,
+// the default registry created by NewRegistry is used.
+//
+// If encOpts or decOpts is not nil, it is called once with an Encoder or
+// Decoder so that options can be set with its methods, and every Encoder or
+// Decoder used by the pool has the same options. For example:
+//
+//	pool := bson.NewCodecPool(nil, func(enc *bson.Encoder) {
+//		enc.IntMinSize()
+//		enc.NilSliceAsEmpty()
+//	}, nil)
+//
+// The Encoder and Decoder passed to encOpts and decOpts must not be retained,
+// and calling SetRegistry on them has no effect.
+func NewCodecPool(r *Registry, encOpts func(*Encoder), decOpts func(*Decoder)) *CodecPool {
+	if r == nil {
+		r = defaultRegistry
+	}
+
+	enc := Encoder{ec: EncodeContext{Registry: r}}
+	if encOpts != nil {
+		encOpts(&enc)
+	}
+	enc.ec.Registry = r
+
+	dec := Decoder{dc: DecodeContext{Registry: r}}
+	if decOpts != nil {
+		decOpts(&dec)
+	}
+	dec.dc.Registry = r
+
+	return &CodecPool{
+		ec: enc.ec,
+		dc: dec.dc,
+	}
+}
+
+// Marshal returns the BSON encoding of val as a BSON document. It is equivalent
+// to Marshal but uses the registry and options of the pool.
+//
+// The returned slice is not shared with the pool. To avoid allocating it,
+// use MarshalAppend.
+func (p *CodecPool) Marshal(val any) ([]byte, error) {
+	bufp, _ := p.buffers.Get().(*[]byte)
+	if bufp == nil {
+		bufp = new([]byte)
+	}
+	buf, err := p.MarshalAppend((*bufp)[:0], val)
+	var out []byte
+	if err == nil {
+		out = append(make([]byte, 0, len(buf)), buf...)
+	}
+	if cap(buf) <= maxPooledBufferSize {
+		*bufp = buf
+		p.buffers.Put(bufp)
+	}
+	return out, err
+}
+
+// MarshalAppend appends the BSON encoding of val as a BSON document to dst and
+// returns the extended buffer. If an error occurs, the returned slice holds dst
+// followed by the partially written document, so callers that reuse dst should
+// truncate it to its original length.
+//
+// MarshalAppend writes directly into dst, so a caller that reuses dst across
+// calls, such as by truncating it to zero length, does not allocate a buffer
+// once dst has grown to the size of the largest document.
+func (p *CodecPool) MarshalAppend(dst []byte, val any) ([]byte, error) {
+	pe, _ := p.encoders.Get().(*pooledEncoder)
+	if pe == nil {
+		pe = new(pooledEncoder)
+	}
+	pe.vw.reset(dst)
+	pe.enc.ec = p.ec
+	pe.enc.vw = &pe.vw
+
+	err := pe.enc.Encode(val)
+	buf := pe.vw.buf
+
+	// Don't keep references to the caller's buffer or value.
+	pe.vw.buf = nil
+	pe.enc.vw = nil
+	p.encoders.Put(pe)
+
+	return buf, err
+}
+
+// Unmarshal parses the BSON document in data and stores the result in the
+// value pointed to by val. It is equivalent to Unmarshal but uses the registry
+// and options of the pool.
+func (p *CodecPool) Unmarshal(data []byte, val any) error {
+	vr := getBufferedDocumentReader(data)
+	defer putBufferedDocumentReader(vr)
+
+	if l, err := vr.peekLength(); err != nil {
+		return err
+	} else if int(l) != len(data) {
+		return fmt.Errorf("invalid document length")
+	}
+
+	dec, _ := p.decoders.Get().(*Decoder)
+	if dec == nil {
+		dec = new(Decoder)
+	}
+	dec.dc = p.dc
+	dec.vr = vr
+
+	err := dec.Decode(val)
+
+	dec.vr = nil
+	p.decoders.Put(dec)
+
+	return err
+}
@@ -0,0 +1,135 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"sync"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type codecPoolTest struct {
+	Name  string
+	Count int
+	Tags  []string
+}
+
+func TestCodecPool(t *testing.T) {
+	val := codecPoolTest{Name: "a", Count: 1, Tags: []string{"x", "y"}}
+
+	t.Run("Marshal matches Marshal", func(t *testing.T) {
+		want, err := Marshal(val)
+		require.NoError(t, err)
+
+		pool := NewCodecPool(nil, nil, nil)
+		for i := 0; i < 3; i++ {
+			got, err := pool.Marshal(val)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+	})
+	t.Run("MarshalAppend", func(t *testing.T) {
+		want, err := Marshal(val)
+		require.NoError(t, err)
+
+		got, err := NewCodecPool(nil, nil, nil).MarshalAppend([]byte("prefix"), val)
+		require.NoError(t, err)
+		assert.Equal(t, append([]byte("prefix"), want...), got)
+	})
+	t.Run("encoder options", func(t *testing.T) {
+		pool := NewCodecPool(nil, func(enc *Encoder) {
+			enc.IntMinSize()
+			enc.NilSliceAsEmpty()
+		}, nil)
+		got, err := pool.Marshal(codecPoolTest{Count: 1})
+		require.NoError(t, err)
+
+		assert.Equal(t, TypeInt32, Raw(got).Lookup("count").Type)
+		assert.Equal(t, TypeArray, Raw(got).Lookup("tags").Type)
+	})
+	t.Run("Unmarshal", func(t *testing.T) {
+		b, err := Marshal(D{{"count", int32(1)}, {"extra", true}})
+		require.NoError(t, err)
+
+		var got codecPoolTest
+		err = NewCodecPool(nil, nil, nil).Unmarshal(b, &got)
+		require.NoError(t, err)
+		assert.Equal(t, codecPoolTest{Count: 1}, got)
+
+		pool := NewCodecPool(nil, nil, func(dec *Decoder) { dec.DisallowUnknownFields() })
+		err = pool.Unmarshal(b, &got)
+		assert.ErrorIs(t, err, ErrUnknownField)
+
+		err = pool.Unmarshal(b[:len(b)-1], &got)
+		assert.Error(t, err)
+	})
+	t.Run("registry", func(t *testing.T) {
+		reg := NewMgoRegistry()
+		pool := NewCodecPool(reg, func(enc *Encoder) { enc.SetRegistry(nil) }, nil)
+		assert.Equal(t, reg, pool.ec.Registry)
+	})
+	t.Run("concurrent use", func(t *testing.T) {
+		pool := NewCodecPool(nil, nil, nil)
+		want, err := Marshal(val)
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					b, err := pool.Marshal(val)
+					assert.NoError(t, err)
+					assert.Equal(t, want, b)
+
+					var got codecPoolTest
+					assert.NoError(t, pool.Unmarshal(b, &got))
+					assert.Equal(t, val, got)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestCodecPoolMarshalAppendAllocs(t *testing.T) {
+	pool := NewCodecPool(nil, nil, nil)
+	val := D{{"a", int32(1)}, {"b", "c"}}
+	buf := make([]byte, 0, 256)
+
+	// Warm up the pool.
+	_, err := pool.MarshalAppend(buf, val)
+	require.NoError(t, err)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, err = pool.MarshalAppend(buf[:0], val)
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, allocs, 1.0)
+}
+
+func BenchmarkCodecPool(b *testing.B) {
+	val := codecPoolTest{Name: "a", Count: 1, Tags: []string{"x", "y"}}
+
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = Marshal(val)
+		}
+	})
+	b.Run("CodecPool.MarshalAppend", func(b *testing.B) {
+		pool := NewCodecPool(nil, nil, nil)
+		var buf []byte
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf, _ = pool.MarshalAppend(buf[:0], val)
+		}
+	})
+}
@@ -106,6 +106,35 @@ func (d *Decoder) BinaryAsSlice() {
 	d.dc.binaryAsSlice = true
 }
 
+// DisallowUnknownFields causes the Decoder to return an error when a BSON document contains an
+// element that does not map to a field of the destination Go struct. Elements are still collected
+// into an ",inline" map field if the struct has one. The returned error wraps ErrUnknownField.
+func (d *Decoder) DisallowUnknownFields() {
+	d.dc.disallowUnknownFields = true
+}
+
+// IntegersAsInt64 causes the Decoder to unmarshal BSON "int32" values as Go int64 values when
+// there is no type information (e.g. when unmarshaling into an "any" value or a bson.M), so that
+// all BSON integers decode to the same Go type. BSON "int64" and "double" values are unaffected.
+func (d *Decoder) IntegersAsInt64() {
+	d.dc.integersAsInt64 = true
+}
+
+// InternStrings causes the Decoder to intern the BSON "string" values that it unmarshals into Go
+// string types using si, so that repeated values share memory. The same StringInterner can be
+// passed to the Decoders of several documents, such as the documents of a large result set, to
+// share the interned strings across them. Passing nil disables interning.
+func (d *Decoder) InternStrings(si *StringInterner) {
+	d.dc.stringInterner = si
+}
+
+// NumbersAsJSONNumber causes the Decoder to unmarshal BSON "int32", "int64", and "double" values
+// as json.Number values when there is no type information (e.g. when unmarshaling into an "any"
+// value or a bson.M). This takes precedence over IntegersAsInt64.
+func (d *Decoder) NumbersAsJSONNumber() {
+	d.dc.numbersAsJSONNumber = true
+}
+
 // ObjectIDAsHexString causes the Decoder to decode object IDs to their hex representation.
 func (d *Decoder) ObjectIDAsHexString() {
 	d.dc.objectIDAsHexString = true
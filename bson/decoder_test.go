@@ -8,6 +8,7 @@ package bson
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"reflect"
 	"testing"
@@ -617,6 +618,49 @@ func TestDecoderConfiguration(t *testing.T) {
 			},
 			want: &zeroMapsTest{MyMap: map[string]string{"myString": "test value"}},
 		},
+		// Test that IntegersAsInt64 causes the Decoder to unmarshal BSON int32 values into Go int64
+		// values when there is no type information.
+		{
+			description: "IntegersAsInt64",
+			configure: func(dec *Decoder) {
+				dec.IntegersAsInt64()
+			},
+			input: bsoncore.NewDocumentBuilder().
+				AppendInt32("myInt32", 1).
+				AppendInt64("myInt64", 2).
+				AppendDouble("myDouble", 3.5).
+				AppendArray("myArray", bsoncore.NewArrayBuilder().AppendInt32(4).Build()).
+				Build(),
+			decodeInto: func() any { return &M{} },
+			want: &M{
+				"myInt32":  int64(1),
+				"myInt64":  int64(2),
+				"myDouble": 3.5,
+				"myArray":  A{int64(4)},
+			},
+		},
+		// Test that NumbersAsJSONNumber causes the Decoder to unmarshal BSON numbers into
+		// json.Number values when there is no type information.
+		{
+			description: "NumbersAsJSONNumber",
+			configure: func(dec *Decoder) {
+				dec.IntegersAsInt64()
+				dec.NumbersAsJSONNumber()
+			},
+			input: bsoncore.NewDocumentBuilder().
+				AppendInt32("myInt32", 1).
+				AppendInt64("myInt64", 2).
+				AppendDouble("myDouble", 3.5).
+				AppendString("myString", "4").
+				Build(),
+			decodeInto: func() any { return &D{} },
+			want: &D{
+				{Key: "myInt32", Value: json.Number("1")},
+				{Key: "myInt64", Value: json.Number("2")},
+				{Key: "myDouble", Value: json.Number("3.5")},
+				{Key: "myString", Value: "4"},
+			},
+		},
 		// Test that ZeroStructs causes the Decoder to empty any Go struct values before decoding
 		// BSON documents into them.
 		{
@@ -719,4 +763,49 @@ func TestDecoderConfiguration(t *testing.T) {
 		}
 		assert.Equal(t, want, got, "expected and actual decode results do not match")
 	})
+	t.Run("DisallowUnknownFields", func(t *testing.T) {
+		t.Parallel()
+
+		type inner struct {
+			A int32
+		}
+		type outer struct {
+			Inner inner
+		}
+		type withInlineMap struct {
+			Inner inner
+			Extra M `bson:",inline"`
+		}
+
+		input := bsoncore.NewDocumentBuilder().
+			AppendDocument("inner", bsoncore.NewDocumentBuilder().
+				AppendInt32("a", 1).
+				AppendInt32("b", 2).
+				Build()).
+			Build()
+
+		var got outer
+		err := Unmarshal(input, &got)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Equal(t, outer{Inner: inner{A: 1}}, got, "expected unknown fields to be skipped by default")
+
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(input)))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&got)
+		assert.ErrorIs(t, err, ErrUnknownField)
+		assert.EqualError(t, err, "error decoding key inner.b: unknown field in bson.inner")
+
+		input = bsoncore.NewDocumentBuilder().
+			AppendDocument("inner", bsoncore.NewDocumentBuilder().
+				AppendInt32("a", 1).
+				Build()).
+			AppendInt32("c", 3).
+			Build()
+		dec = NewDecoder(NewDocumentReader(bytes.NewReader(input)))
+		dec.DisallowUnknownFields()
+		var gotInline withInlineMap
+		err = dec.Decode(&gotInline)
+		require.NoError(t, err, "Decode error")
+		assert.Equal(t, M{"c": int32(3)}, gotInline.Extra, "expected unknown fields to be collected in the inline map")
+	})
 }
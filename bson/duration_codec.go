@@ -0,0 +1,157 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+var tDuration = reflect.TypeOf(time.Duration(0))
+
+// DurationFormat specifies the BSON representation of time.Duration values used by a DurationCodec.
+type DurationFormat int
+
+// These constants specify the supported DurationFormat values.
+const (
+	// DurationNanoseconds represents durations as BSON int64 values holding the number of
+	// nanoseconds. This matches how time.Duration values are encoded by the default registry.
+	DurationNanoseconds DurationFormat = iota
+
+	// DurationMilliseconds represents durations as BSON int64 values holding the number of
+	// milliseconds. Durations are truncated to millisecond precision when they are encoded.
+	DurationMilliseconds
+
+	// DurationString represents durations as BSON strings in the format returned by
+	// time.Duration.String (e.g. "1h30m0s").
+	DurationString
+)
+
+// DurationCodec is the ValueEncoder and ValueDecoder for time.Duration values with a configurable
+// BSON representation. To use it, register it for the time.Duration type in a Registry:
+//
+//	codec := bson.NewDurationCodec(bson.DurationString)
+//	reg := bson.NewRegistry()
+//	reg.RegisterTypeEncoder(reflect.TypeOf(time.Duration(0)), codec)
+//	reg.RegisterTypeDecoder(reflect.TypeOf(time.Duration(0)), codec)
+//
+// Regardless of the format, a DurationCodec decodes BSON strings as parsed by time.ParseDuration,
+// and BSON int32, int64, and double values as a number of nanoseconds, or milliseconds if the
+// format is DurationMilliseconds. This allows data to be migrated from one format to another.
+type DurationCodec struct {
+	format DurationFormat
+}
+
+// Assert that DurationCodec satisfies the typeDecoder interface, which allows it to be used by
+// collection type decoders (e.g. map, slice, etc) to set individual values in a collection.
+var _ typeDecoder = &DurationCodec{}
+
+// NewDurationCodec returns a DurationCodec that encodes time.Duration values using format.
+func NewDurationCodec(format DurationFormat) *DurationCodec {
+	return &DurationCodec{format: format}
+}
+
+func (dc *DurationCodec) unit() time.Duration {
+	if dc.format == DurationMilliseconds {
+		return time.Millisecond
+	}
+	return time.Nanosecond
+}
+
+// EncodeValue is the ValueEncoderFunc for time.Duration.
+func (dc *DurationCodec) EncodeValue(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tDuration {
+		return ValueEncoderError{Name: "DurationEncodeValue", Types: []reflect.Type{tDuration}, Received: val}
+	}
+
+	d := time.Duration(val.Int())
+	switch dc.format {
+	case DurationNanoseconds, DurationMilliseconds:
+		return vw.WriteInt64(int64(d / dc.unit()))
+	case DurationString:
+		return vw.WriteString(d.String())
+	default:
+		return fmt.Errorf("unknown duration format %d", dc.format)
+	}
+}
+
+func (dc *DurationCodec) decodeType(_ DecodeContext, vr ValueReader, t reflect.Type) (reflect.Value, error) {
+	if t != tDuration {
+		return emptyValue, ValueDecoderError{
+			Name:     "DurationDecodeValue",
+			Types:    []reflect.Type{tDuration},
+			Received: reflect.Zero(t),
+		}
+	}
+
+	var d time.Duration
+	switch vrType := vr.Type(); vrType {
+	case TypeInt32:
+		i32, err := vr.ReadInt32()
+		if err != nil {
+			return emptyValue, err
+		}
+		d = time.Duration(i32) * dc.unit()
+	case TypeInt64:
+		i64, err := vr.ReadInt64()
+		if err != nil {
+			return emptyValue, err
+		}
+		if i64 > math.MaxInt64/int64(dc.unit()) || i64 < math.MinInt64/int64(dc.unit()) {
+			return emptyValue, fmt.Errorf("%d overflows time.Duration", i64)
+		}
+		d = time.Duration(i64) * dc.unit()
+	case TypeDouble:
+		f64, err := vr.ReadDouble()
+		if err != nil {
+			return emptyValue, err
+		}
+		f64 *= float64(dc.unit())
+		if math.IsNaN(f64) || f64 > math.MaxInt64 || f64 < math.MinInt64 {
+			return emptyValue, fmt.Errorf("%g overflows time.Duration", f64)
+		}
+		d = time.Duration(f64)
+	case TypeString:
+		s, err := vr.ReadString()
+		if err != nil {
+			return emptyValue, err
+		}
+		d, err = time.ParseDuration(s)
+		if err != nil {
+			return emptyValue, err
+		}
+	case TypeNull:
+		if err := vr.ReadNull(); err != nil {
+			return emptyValue, err
+		}
+	case TypeUndefined:
+		if err := vr.ReadUndefined(); err != nil {
+			return emptyValue, err
+		}
+	default:
+		return emptyValue, fmt.Errorf("cannot decode %v into a time.Duration", vrType)
+	}
+
+	return reflect.ValueOf(d), nil
+}
+
+// DecodeValue is the ValueDecoderFunc for time.Duration.
+func (dc *DurationCodec) DecodeValue(dctx DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tDuration {
+		return ValueDecoderError{Name: "DurationDecodeValue", Types: []reflect.Type{tDuration}, Received: val}
+	}
+
+	elem, err := dc.decodeType(dctx, vr, tDuration)
+	if err != nil {
+		return err
+	}
+
+	val.Set(elem)
+	return nil
+}
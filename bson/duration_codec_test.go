@@ -0,0 +1,107 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestDurationCodec(t *testing.T) {
+	type timeout struct {
+		Timeout time.Duration
+		Retries []time.Duration
+	}
+	want := timeout{Timeout: 90 * time.Minute, Retries: []time.Duration{time.Second, 1500 * time.Millisecond}}
+
+	testCases := []struct {
+		name   string
+		format DurationFormat
+		want   D
+	}{
+		{"nanoseconds", DurationNanoseconds, D{
+			{Key: "timeout", Value: int64(90 * time.Minute)},
+			{Key: "retries", Value: A{int64(time.Second), int64(1500 * time.Millisecond)}},
+		}},
+		{"milliseconds", DurationMilliseconds, D{
+			{Key: "timeout", Value: int64(5400000)},
+			{Key: "retries", Value: A{int64(1000), int64(1500)}},
+		}},
+		{"string", DurationString, D{
+			{Key: "timeout", Value: "1h30m0s"},
+			{Key: "retries", Value: A{"1s", "1.5s"}},
+		}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			codec := NewDurationCodec(tc.format)
+			reg := NewRegistry()
+			reg.RegisterTypeEncoder(tDuration, codec)
+			reg.RegisterTypeDecoder(tDuration, codec)
+
+			buf := new(bytes.Buffer)
+			enc := NewEncoder(NewDocumentWriter(buf))
+			enc.SetRegistry(reg)
+			require.NoError(t, enc.Encode(want), "Encode error")
+			b := buf.Bytes()
+
+			var got D
+			require.NoError(t, Unmarshal(b, &got), "Unmarshal error")
+			assert.Equal(t, tc.want, got, "expected and actual encoded documents do not match")
+
+			var decoded timeout
+			require.NoError(t, unmarshalWithRegistry(t, reg, b, &decoded), "Unmarshal error")
+			assert.Equal(t, want, decoded, "expected and actual decoded values do not match")
+		})
+	}
+
+	t.Run("decode other representations", func(t *testing.T) {
+		t.Parallel()
+
+		codec := NewDurationCodec(DurationMilliseconds)
+		reg := NewRegistry()
+		reg.RegisterTypeDecoder(tDuration, codec)
+
+		b, err := Marshal(D{
+			{Key: "a", Value: int32(2)},
+			{Key: "b", Value: 2.5},
+			{Key: "c", Value: "2m"},
+			{Key: "d", Value: nil},
+		})
+		require.NoError(t, err, "Marshal error")
+
+		var got struct{ A, B, C, D time.Duration }
+		require.NoError(t, unmarshalWithRegistry(t, reg, b, &got), "Unmarshal error")
+		assert.Equal(t, 2*time.Millisecond, got.A)
+		assert.Equal(t, 2500*time.Microsecond, got.B)
+		assert.Equal(t, 2*time.Minute, got.C)
+		assert.Equal(t, time.Duration(0), got.D)
+	})
+	t.Run("decode errors", func(t *testing.T) {
+		t.Parallel()
+
+		reg := NewRegistry()
+		reg.RegisterTypeDecoder(tDuration, NewDurationCodec(DurationMilliseconds))
+
+		for _, v := range []any{"soon", true, int64(1) << 62} {
+			b, err := Marshal(D{{Key: "d", Value: v}})
+			require.NoError(t, err, "Marshal error")
+
+			var got struct{ D time.Duration }
+			err = unmarshalWithRegistry(t, reg, b, &got)
+			assert.Error(t, err, "expected error decoding %v", v)
+		}
+	})
+}
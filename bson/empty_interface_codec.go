@@ -91,6 +91,15 @@ func (eic *emptyInterfaceCodec) decodeType(dc DecodeContext, vr ValueReader, t r
 		}
 	}
 
+	switch vr.Type() {
+	case TypeInt32, TypeInt64, TypeDouble:
+		if dc.numbersAsJSONNumber {
+			rtype = tJSONNumber
+		} else if dc.integersAsInt64 && vr.Type() == TypeInt32 {
+			rtype = tInt64
+		}
+	}
+
 	decoder, err := dc.LookupDecoder(rtype)
 	if err != nil {
 		return emptyValue, err
@@ -104,6 +104,14 @@ func (e *Encoder) NilByteSliceAsEmpty() {
 	e.ec.nilByteSliceAsEmpty = true
 }
 
+// Float32SliceAsVector causes the Encoder to marshal Go float32 slices as BSON binary vectors
+// (subtype 9) instead of BSON arrays of doubles. Vectors take about a quarter of the space of
+// arrays and are the representation Atlas Vector Search indexes most efficiently. Use the
+// "vector" struct tag option to marshal individual fields as vectors.
+func (e *Encoder) Float32SliceAsVector() {
+	e.ec.float32SliceAsVector = true
+}
+
 // TODO(GODRIVER-2820): Update the description to remove the note about only examining exported
 // TODO struct fields once the logic is updated to also inspect private struct fields.
 
@@ -226,6 +226,21 @@ func TestEncoderConfiguration(t *testing.T) {
 				AppendArray("mySlice", bsoncore.NewArrayBuilder().Build()).
 				Build(),
 		},
+		// Test that Float32SliceAsVector encodes Go float32 slices as BSON binary vectors.
+		{
+			description: "Float32SliceAsVector",
+			configure: func(enc *Encoder) {
+				enc.Float32SliceAsVector()
+			},
+			input: D{
+				{Key: "myFloats", Value: []float32{1, 2}},
+				{Key: "myInts", Value: []int8{1, 2}},
+			},
+			want: bsoncore.NewDocumentBuilder().
+				AppendBinary("myFloats", TypeBinaryVector, NewVector([]float32{1, 2}).Binary().Data).
+				AppendArray("myInts", bsoncore.NewArrayBuilder().AppendInt32(1).AppendInt32(2).Build()).
+				Build(),
+		},
 		// Test that NilByteSliceAsEmpty encodes nil Go byte slices as empty BSON binary elements.
 		{
 			description: "NilByteSliceAsEmpty",
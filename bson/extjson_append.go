@@ -0,0 +1,233 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// AppendExtJSON appends the Extended JSON representation of the BSON document
+// raw to dst and returns the extended buffer. HTML characters are not escaped.
+// The output is the same as that of MarshalExtJSON(raw, canonical, false).
+//
+// AppendExtJSON converts raw directly, without decoding it into Go values, and
+// does not allocate for documents that contain only the common BSON types
+// (documents, arrays, strings, numbers, booleans, nulls, ObjectIDs, dates,
+// timestamps, and binary data) if dst has enough capacity. It is intended for
+// services that proxy documents to HTTP clients at high throughput:
+//
+//	buf = buf[:0]
+//	for cursor.Next(ctx) {
+//		buf, err = bson.AppendExtJSON(buf, bson.Raw(cursor.Current), false)
+//		if err != nil {
+//			return err
+//		}
+//		buf = append(buf, '\n')
+//	}
+//
+// If raw is not a valid BSON document, AppendExtJSON returns dst unmodified and
+// an error.
+func AppendExtJSON(dst []byte, raw Raw, canonical bool) ([]byte, error) {
+	ext := extJSONAppender{canonical: canonical}
+	out, err := ext.appendDocument(dst, raw)
+	if err != nil {
+		return dst, err
+	}
+	return out, nil
+}
+
+type extJSONAppender struct {
+	canonical bool
+}
+
+func (ext extJSONAppender) appendDocument(dst []byte, doc []byte) ([]byte, error) {
+	return ext.appendElements(dst, doc, '{', '}', true)
+}
+
+func (ext extJSONAppender) appendArray(dst []byte, arr []byte) ([]byte, error) {
+	return ext.appendElements(dst, arr, '[', ']', false)
+}
+
+// appendElements appends the elements of the BSON document or array src to dst
+// between the open and close characters. Keys are only written if withKeys is
+// true.
+func (ext extJSONAppender) appendElements(dst []byte, src []byte, open, close byte, withKeys bool) ([]byte, error) {
+	length, rem, ok := bsoncore.ReadLength(src)
+	if !ok || length < 5 || int(length) > len(src) {
+		return dst, bsoncore.NewInsufficientBytesError(src, rem)
+	}
+	rem = rem[:length-4]
+
+	dst = append(dst, open)
+	for i := 0; ; i++ {
+		var t bsoncore.Type
+		t, rem, ok = bsoncore.ReadType(rem)
+		if !ok {
+			return dst, bsoncore.NewInsufficientBytesError(src, rem)
+		}
+		if t == bsoncore.Type(0) {
+			if len(rem) != 0 {
+				return dst, fmt.Errorf("document end byte found before end of document. remaining bytes=%v", rem)
+			}
+			break
+		}
+
+		var key []byte
+		key, rem, ok = bsoncore.ReadKeyBytes(rem)
+		if !ok {
+			return dst, fmt.Errorf("invalid key found. remaining bytes=%v", rem)
+		}
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if withKeys {
+			dst = appendBytesWithEscapes(dst, key, false)
+			dst = append(dst, ':')
+		}
+
+		var val bsoncore.Value
+		val, rem, ok = bsoncore.ReadValue(rem, t)
+		if !ok {
+			return dst, fmt.Errorf("not enough bytes available to read type. bytes=%d type=%s", len(rem), t)
+		}
+		var err error
+		dst, err = ext.appendValue(dst, val)
+		if err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, close), nil
+}
+
+func (ext extJSONAppender) appendValue(dst []byte, val bsoncore.Value) ([]byte, error) {
+	var ok bool
+	switch val.Type {
+	case bsoncore.TypeDouble:
+		var f float64
+		if f, _, ok = bsoncore.ReadDouble(val.Data); ok {
+			dst = appendExtJSONDouble(dst, f, ext.canonical)
+		}
+	case bsoncore.TypeString:
+		var s []byte
+		if s, ok = readStringBytes(val.Data); ok {
+			dst = appendBytesWithEscapes(dst, s, false)
+		}
+	case bsoncore.TypeEmbeddedDocument:
+		return ext.appendDocument(dst, val.Data)
+	case bsoncore.TypeArray:
+		return ext.appendArray(dst, val.Data)
+	case bsoncore.TypeBinary:
+		var subtype byte
+		var b []byte
+		if subtype, b, _, ok = bsoncore.ReadBinary(val.Data); ok {
+			dst = appendExtJSONBinary(dst, b, subtype)
+		}
+	case bsoncore.TypeUndefined:
+		dst, ok = appendExtendedSingleValue(dst, "undefined", "true", false), true
+	case bsoncore.TypeObjectID:
+		var oid [12]byte
+		if oid, _, ok = bsoncore.ReadObjectID(val.Data); ok {
+			dst = appendExtJSONObjectID(dst, oid)
+		}
+	case bsoncore.TypeBoolean:
+		var b bool
+		if b, _, ok = bsoncore.ReadBoolean(val.Data); ok {
+			if b {
+				dst = append(dst, "true"...)
+			} else {
+				dst = append(dst, "false"...)
+			}
+		}
+	case bsoncore.TypeDateTime:
+		var dt int64
+		if dt, _, ok = bsoncore.ReadDateTime(val.Data); ok {
+			dst = appendExtJSONDateTime(dst, dt, ext.canonical)
+		}
+	case bsoncore.TypeNull:
+		dst, ok = append(dst, "null"...), true
+	case bsoncore.TypeRegex:
+		var pattern, options string
+		if pattern, options, _, ok = bsoncore.ReadRegex(val.Data); ok {
+			dst = appendExtJSONRegex(dst, pattern, options, false)
+		}
+	case bsoncore.TypeDBPointer:
+		var ns string
+		var oid [12]byte
+		if ns, oid, _, ok = bsoncore.ReadDBPointer(val.Data); ok {
+			dst = appendExtJSONDBPointer(dst, ns, oid)
+		}
+	case bsoncore.TypeJavaScript:
+		var code []byte
+		if code, ok = readStringBytes(val.Data); ok {
+			dst = append(dst, `{"$code":`...)
+			dst = appendBytesWithEscapes(dst, code, false)
+			dst = append(dst, '}')
+		}
+	case bsoncore.TypeSymbol:
+		var symbol []byte
+		if symbol, ok = readStringBytes(val.Data); ok {
+			dst = append(dst, `{"$symbol":`...)
+			dst = appendBytesWithEscapes(dst, symbol, false)
+			dst = append(dst, '}')
+		}
+	case bsoncore.TypeCodeWithScope:
+		var code string
+		var scope []byte
+		if code, scope, _, ok = bsoncore.ReadCodeWithScope(val.Data); ok {
+			dst = append(dst, `{"$code":`...)
+			dst = appendStringWithEscapes(dst, code, false)
+			dst = append(dst, `,"$scope":`...)
+			var err error
+			if dst, err = ext.appendDocument(dst, scope); err != nil {
+				return dst, err
+			}
+			dst = append(dst, '}')
+		}
+	case bsoncore.TypeInt32:
+		var i int32
+		if i, _, ok = bsoncore.ReadInt32(val.Data); ok {
+			dst = appendExtJSONInt(dst, "numberInt", int64(i), ext.canonical)
+		}
+	case bsoncore.TypeTimestamp:
+		var t, i uint32
+		if t, i, _, ok = bsoncore.ReadTimestamp(val.Data); ok {
+			dst = appendExtJSONTimestamp(dst, t, i)
+		}
+	case bsoncore.TypeInt64:
+		var i int64
+		if i, _, ok = bsoncore.ReadInt64(val.Data); ok {
+			dst = appendExtJSONInt(dst, "numberLong", i, ext.canonical)
+		}
+	case bsoncore.TypeDecimal128:
+		var h, l uint64
+		if h, l, _, ok = bsoncore.ReadDecimal128(val.Data); ok {
+			dst = appendExtendedSingleValue(dst, "numberDecimal", NewDecimal128(h, l).String(), true)
+		}
+	case bsoncore.TypeMinKey:
+		dst, ok = appendExtendedSingleValue(dst, "minKey", "1", false), true
+	case bsoncore.TypeMaxKey:
+		dst, ok = appendExtendedSingleValue(dst, "maxKey", "1", false), true
+	default:
+		return dst, fmt.Errorf("cannot convert unknown BSON type %s to Extended JSON", val.Type)
+	}
+	if !ok {
+		return dst, fmt.Errorf("invalid %s value", val.Type)
+	}
+	return dst, nil
+}
+
+// readStringBytes reads a BSON string from src without converting it to a Go
+// string.
+func readStringBytes(src []byte) ([]byte, bool) {
+	l, rem, ok := bsoncore.ReadLength(src)
+	if !ok || l <= 0 || int(l) > len(rem) {
+		return nil, false
+	}
+	return rem[:l-1], true
+}
@@ -0,0 +1,96 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestAppendExtJSON(t *testing.T) {
+	oid, err := ObjectIDFromHex("5f1e3c1a9d3b2a0012345678")
+	require.NoError(t, err)
+	dec, err := ParseDecimal128("1.5E+3")
+	require.NoError(t, err)
+
+	doc := D{
+		{Key: "double", Value: 1.0},
+		{Key: "fraction", Value: 0.25},
+		{Key: "inf", Value: math.Inf(-1)},
+		{Key: "string", Value: "a \"quoted\" <string>\n\u2028\xff"},
+		{Key: "doc", Value: D{{Key: "a", Value: int32(1)}, {Key: "b", Value: D{}}}},
+		{Key: "array", Value: A{int32(1), "two", A{}, D{{Key: "x", Value: nil}}}},
+		{Key: "binary", Value: Binary{Subtype: 0x80, Data: []byte{1, 2, 3}}},
+		{Key: "undefined", Value: Undefined{}},
+		{Key: "oid", Value: oid},
+		{Key: "true", Value: true},
+		{Key: "false", Value: false},
+		{Key: "date", Value: NewDateTimeFromTime(time.Date(2020, 1, 2, 3, 4, 5, 6e6, time.UTC))},
+		{Key: "oldDate", Value: DateTime(-62135596800001)},
+		{Key: "null", Value: nil},
+		{Key: "regex", Value: Regex{Pattern: "^a/b", Options: "xi"}},
+		{Key: "dbPointer", Value: DBPointer{DB: "db.coll", Pointer: oid}},
+		{Key: "js", Value: JavaScript("function() {}")},
+		{Key: "symbol", Value: Symbol("sym")},
+		{Key: "codeWithScope", Value: CodeWithScope{Code: "x", Scope: D{{Key: "x", Value: int64(1)}}}},
+		{Key: "int32", Value: int32(-42)},
+		{Key: "timestamp", Value: Timestamp{T: 1, I: 2}},
+		{Key: "int64", Value: int64(math.MaxInt64)},
+		{Key: "decimal", Value: dec},
+		{Key: "minKey", Value: MinKey{}},
+		{Key: "maxKey", Value: MaxKey{}},
+		{Key: "k\"ey", Value: ""},
+	}
+	raw, err := Marshal(doc)
+	require.NoError(t, err)
+
+	for _, canonical := range []bool{false, true} {
+		want, err := MarshalExtJSON(Raw(raw), canonical, false)
+		require.NoError(t, err)
+
+		got, err := AppendExtJSON([]byte("prefix"), raw, canonical)
+		require.NoError(t, err)
+		assert.Equal(t, "prefix"+string(want), string(got), "canonical=%v", canonical)
+	}
+
+	t.Run("empty document", func(t *testing.T) {
+		got, err := AppendExtJSON(nil, Raw{5, 0, 0, 0, 0}, true)
+		require.NoError(t, err)
+		assert.Equal(t, "{}", string(got))
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		dst := []byte("prefix")
+		got, err := AppendExtJSON(dst, raw[:len(raw)-3], false)
+		assert.Error(t, err)
+		assert.Equal(t, "prefix", string(got))
+	})
+	t.Run("allocations", func(t *testing.T) {
+		raw, err := Marshal(D{
+			{Key: "_id", Value: oid},
+			{Key: "name", Value: "caf\u00e9"},
+			{Key: "n", Value: int64(123456789)},
+			{Key: "score", Value: 98.6},
+			{Key: "at", Value: DateTime(1577934245006)},
+			{Key: "tags", Value: A{"a", "b"}},
+			{Key: "nested", Value: D{{Key: "ok", Value: true}, {Key: "ts", Value: Timestamp{T: 1, I: 2}}}},
+			{Key: "bin", Value: Binary{Data: []byte("data")}},
+		})
+		require.NoError(t, err)
+
+		buf := make([]byte, 0, 1024)
+		for _, canonical := range []bool{false, true} {
+			allocs := testing.AllocsPerRun(100, func() {
+				buf, _ = AppendExtJSON(buf[:0], raw, canonical)
+			})
+			assert.Equal(t, 0.0, allocs, "canonical=%v", canonical)
+		}
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// An ExtJSONEncoder writes a stream of values as newline-delimited Extended
+// JSON documents, such as the output of mongoexport. Each document is written
+// to the underlying io.Writer as soon as it has been encoded, and the buffer
+// used to encode documents is reused, so encoding a stream does not allocate a
+// string for each document.
+//
+// ExtJSONEncoder embeds an Encoder, so the options of an Encoder, such as
+// IntMinSize, can be used to configure how values are marshaled.
+type ExtJSONEncoder struct {
+	*Encoder
+	ejvw *extJSONValueWriter
+}
+
+// NewExtJSONEncoder returns an ExtJSONEncoder that writes relaxed Extended JSON
+// to w without escaping HTML characters.
+func NewExtJSONEncoder(w io.Writer) *ExtJSONEncoder {
+	ejvw := newExtJSONWriter(w, false, false, true)
+	return &ExtJSONEncoder{
+		Encoder: NewEncoder(ejvw),
+		ejvw:    ejvw,
+	}
+}
+
+// SetCanonical sets whether the ExtJSONEncoder writes canonical Extended JSON,
+// which preserves the BSON types of all values, instead of relaxed Extended
+// JSON.
+func (e *ExtJSONEncoder) SetCanonical(canonical bool) {
+	e.ejvw.canonical = canonical
+}
+
+// SetEscapeHTML sets whether the ExtJSONEncoder escapes the characters <, >,
+// and & in strings, like encoding/json does by default.
+func (e *ExtJSONEncoder) SetEscapeHTML(escapeHTML bool) {
+	e.ejvw.escapeHTML = escapeHTML
+}
+
+// Encode writes the Extended JSON encoding of val, which must marshal to a
+// document, followed by a newline. If an error occurs, nothing is written for
+// val and the ExtJSONEncoder can continue to be used.
+//
+// See [MarshalExtJSON] for details about Extended JSON marshaling behavior.
+func (e *ExtJSONEncoder) Encode(val any) error {
+	if err := e.Encoder.Encode(val); err != nil {
+		e.reset(e.ejvw.w)
+		return err
+	}
+	return nil
+}
+
+// Reset discards any state of the ExtJSONEncoder and makes it write to w. The
+// options of the ExtJSONEncoder are retained.
+func (e *ExtJSONEncoder) Reset(w io.Writer) {
+	e.reset(w)
+}
+
+func (e *ExtJSONEncoder) reset(w io.Writer) {
+	e.ejvw.reset(e.ejvw.buf[:0], e.ejvw.canonical, e.ejvw.escapeHTML)
+	e.ejvw.w = w
+}
+
+// An ExtJSONDecoder reads a stream of Extended JSON documents, such as the
+// output of mongoexport. The documents can be separated by whitespace, such as
+// newlines, or be the elements of a single top-level array. The input is read
+// incrementally, so the whole stream and the text of each document are never
+// held in memory at once.
+//
+// ExtJSONDecoder embeds a Decoder, so the options of a Decoder, such as
+// DefaultDocumentM, can be used to configure how documents are unmarshaled.
+type ExtJSONDecoder struct {
+	*Decoder
+	r             io.Reader
+	canonicalOnly bool
+
+	ejvr *extJSONValueReader
+	ar   ArrayReader
+	err  error
+}
+
+// NewExtJSONDecoder returns an ExtJSONDecoder that reads canonical or relaxed
+// Extended JSON documents from r. It does not read from r until Decode is
+// called.
+func NewExtJSONDecoder(r io.Reader) *ExtJSONDecoder {
+	return &ExtJSONDecoder{
+		Decoder: NewDecoder(nil),
+		r:       r,
+	}
+}
+
+// SetCanonicalOnly sets whether the ExtJSONDecoder returns an error if the
+// Extended JSON was not marshaled in canonical mode.
+func (d *ExtJSONDecoder) SetCanonicalOnly(canonicalOnly bool) {
+	d.canonicalOnly = canonicalOnly
+	if d.ejvr != nil {
+		d.ejvr.p.canonicalOnly = canonicalOnly
+	}
+}
+
+// Decode reads the next Extended JSON document from the stream and decodes it
+// into the value pointed to by val. It returns io.EOF at the end of the stream.
+// Once Decode returns an error, the position in the stream is unknown, so all
+// subsequent calls return the same error.
+//
+// See [UnmarshalExtJSON] for details about Extended JSON unmarshaling behavior.
+func (d *ExtJSONDecoder) Decode(val any) error {
+	if d.err != nil {
+		return d.err
+	}
+	if err := d.decode(val); err != nil {
+		d.err = err
+		return err
+	}
+	return nil
+}
+
+func (d *ExtJSONDecoder) decode(val any) error {
+	if d.ejvr == nil {
+		ejvr, err := newExtJSONValueReader(d.r, d.canonicalOnly)
+		if err != nil {
+			return err
+		}
+		d.ejvr = ejvr
+
+		switch t := ejvr.Type(); t {
+		case Type(0):
+			// The input is empty or contains only whitespace.
+			return io.EOF
+		case TypeEmbeddedDocument:
+			d.Decoder.Reset(ejvr)
+		case TypeArray:
+			if d.ar, err = ejvr.ReadArray(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("cannot decode Extended JSON stream starting with a value of type %v: "+
+				"expected a document or an array of documents", t)
+		}
+	}
+
+	if d.ar != nil {
+		vr, err := d.ar.ReadValue()
+		if errors.Is(err, ErrEOA) {
+			return io.EOF
+		}
+		if err != nil {
+			return err
+		}
+		d.Decoder.Reset(vr)
+	}
+	return d.Decoder.Decode(val)
+}
+
+// Reset discards any state of the ExtJSONDecoder and makes it read from r. The
+// options of the ExtJSONDecoder are retained.
+func (d *ExtJSONDecoder) Reset(r io.Reader) {
+	d.r = r
+	d.ejvr = nil
+	d.ar = nil
+	d.err = nil
+	d.Decoder.Reset(nil)
+}
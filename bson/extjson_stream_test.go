@@ -0,0 +1,151 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestExtJSONEncoder(t *testing.T) {
+	type doc struct {
+		X int64  `bson:"x"`
+		S string `bson:"s"`
+	}
+
+	t.Run("relaxed", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := NewExtJSONEncoder(&buf)
+		require.NoError(t, enc.Encode(doc{X: 1, S: "<a>"}))
+		require.NoError(t, enc.Encode(D{{Key: "x", Value: int32(2)}}))
+		assert.Equal(t, "{\"x\":1,\"s\":\"<a>\"}\n{\"x\":2}\n", buf.String())
+	})
+	t.Run("canonical and escaped", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := NewExtJSONEncoder(&buf)
+		enc.SetCanonical(true)
+		enc.SetEscapeHTML(true)
+		require.NoError(t, enc.Encode(doc{X: 1, S: "<a>"}))
+		assert.Equal(t, "{\"x\":{\"$numberLong\":\"1\"},\"s\":\"\\u003ca\\u003e\"}\n", buf.String())
+	})
+	t.Run("encoder options", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := NewExtJSONEncoder(&buf)
+		enc.SetCanonical(true)
+		enc.IntMinSize()
+		require.NoError(t, enc.Encode(D{{Key: "x", Value: int64(1)}}))
+		assert.Equal(t, "{\"x\":{\"$numberInt\":\"1\"}}\n", buf.String())
+	})
+	t.Run("error", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := NewExtJSONEncoder(&buf)
+		err := enc.Encode(D{{Key: "a", Value: 1}, {Key: "b", Value: make(chan int)}})
+		assert.Error(t, err)
+		assert.Equal(t, 0, buf.Len())
+
+		require.NoError(t, enc.Encode(D{{Key: "a", Value: 1}}))
+		assert.Equal(t, "{\"a\":1}\n", buf.String())
+	})
+	t.Run("reset", func(t *testing.T) {
+		var buf1, buf2 bytes.Buffer
+		enc := NewExtJSONEncoder(&buf1)
+		enc.SetCanonical(true)
+		require.NoError(t, enc.Encode(D{{Key: "a", Value: int32(1)}}))
+		enc.Reset(&buf2)
+		require.NoError(t, enc.Encode(D{{Key: "a", Value: int32(2)}}))
+		assert.Equal(t, "{\"a\":{\"$numberInt\":\"1\"}}\n", buf1.String())
+		assert.Equal(t, "{\"a\":{\"$numberInt\":\"2\"}}\n", buf2.String())
+	})
+}
+
+func TestExtJSONDecoder(t *testing.T) {
+	decodeAll := func(t *testing.T, dec *ExtJSONDecoder) []D {
+		t.Helper()
+
+		var docs []D
+		for {
+			var d D
+			err := dec.Decode(&d)
+			if errors.Is(err, io.EOF) {
+				return docs
+			}
+			require.NoError(t, err)
+			docs = append(docs, d)
+		}
+	}
+	want := []D{
+		{{Key: "x", Value: int32(1)}},
+		{{Key: "x", Value: int64(2)}},
+	}
+
+	testCases := []struct {
+		name  string
+		input string
+		want  []D
+	}{
+		{"empty", "", nil},
+		{"whitespace", " \n\t", nil},
+		{"newline-delimited", "{\"x\":1}\n{\"x\":{\"$numberLong\":\"2\"}}\n", want},
+		{"concatenated", "{\"x\":1}{\"x\":{\"$numberLong\":\"2\"}}", want},
+		{"array", "[{\"x\":1},\n{\"x\":{\"$numberLong\":\"2\"}}]\n", want},
+		{"empty array", "[]", nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dec := NewExtJSONDecoder(strings.NewReader(tc.input))
+			assert.Equal(t, tc.want, decodeAll(t, dec))
+
+			var d D
+			assert.ErrorIs(t, dec.Decode(&d), io.EOF)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := NewExtJSONEncoder(&buf)
+		enc.SetCanonical(true)
+		for _, d := range want {
+			require.NoError(t, enc.Encode(d))
+		}
+
+		dec := NewExtJSONDecoder(&buf)
+		dec.SetCanonicalOnly(true)
+		assert.Equal(t, want, decodeAll(t, dec))
+	})
+	t.Run("canonical only", func(t *testing.T) {
+		dec := NewExtJSONDecoder(strings.NewReader("{\"d\":{\"$date\":\"2020-01-01T00:00:00Z\"}}"))
+		dec.SetCanonicalOnly(true)
+		var d D
+		assert.Error(t, dec.Decode(&d))
+	})
+	t.Run("decoder options", func(t *testing.T) {
+		dec := NewExtJSONDecoder(strings.NewReader("{\"x\":{\"a\":1}}"))
+		dec.DefaultDocumentM()
+		var d D
+		require.NoError(t, dec.Decode(&d))
+		assert.Equal(t, D{{Key: "x", Value: M{"a": int32(1)}}}, d)
+	})
+	t.Run("scalar", func(t *testing.T) {
+		dec := NewExtJSONDecoder(strings.NewReader("1"))
+		var d D
+		err := dec.Decode(&d)
+		assert.ErrorContains(t, err, "expected a document or an array of documents")
+		assert.Equal(t, err, dec.Decode(&d))
+	})
+	t.Run("reset", func(t *testing.T) {
+		dec := NewExtJSONDecoder(strings.NewReader("{\"x\":1}"))
+		assert.Equal(t, want[:1], decodeAll(t, dec))
+		dec.Reset(strings.NewReader("[{\"x\":1}]"))
+		assert.Equal(t, want[:1], decodeAll(t, dec))
+	})
+}
@@ -14,7 +14,6 @@ import (
 	"math"
 	"sort"
 	"strconv"
-	"strings"
 	"time"
 	"unicode/utf8"
 )
@@ -140,14 +139,7 @@ func (ejvw *extJSONValueWriter) ensureElementValue(destination mode, callerName
 }
 
 func (ejvw *extJSONValueWriter) writeExtendedSingleValue(key string, value string, quotes bool) {
-	var s string
-	if quotes {
-		s = fmt.Sprintf(`{"$%s":"%s"}`, key, value)
-	} else {
-		s = fmt.Sprintf(`{"$%s":%s}`, key, value)
-	}
-
-	ejvw.buf = append(ejvw.buf, []byte(s)...)
+	ejvw.buf = appendExtendedSingleValue(ejvw.buf, key, value, quotes)
 }
 
 func (ejvw *extJSONValueWriter) WriteArray() (ArrayWriter, error) {
@@ -170,12 +162,8 @@ func (ejvw *extJSONValueWriter) WriteBinaryWithSubtype(b []byte, btype byte) err
 		return err
 	}
 
-	var buf bytes.Buffer
-	buf.WriteString(`{"$binary":{"base64":"`)
-	buf.WriteString(base64.StdEncoding.EncodeToString(b))
-	buf.WriteString(fmt.Sprintf(`","subType":"%02x"}},`, btype))
-
-	ejvw.buf = append(ejvw.buf, buf.Bytes()...)
+	ejvw.buf = appendExtJSONBinary(ejvw.buf, b, btype)
+	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
 	return nil
@@ -186,7 +174,7 @@ func (ejvw *extJSONValueWriter) WriteBoolean(b bool) error {
 		return err
 	}
 
-	ejvw.buf = append(ejvw.buf, []byte(strconv.FormatBool(b))...)
+	ejvw.buf = strconv.AppendBool(ejvw.buf, b)
 	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
@@ -198,12 +186,9 @@ func (ejvw *extJSONValueWriter) WriteCodeWithScope(code string) (DocumentWriter,
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	buf.WriteString(`{"$code":`)
-	writeStringWithEscapes(code, &buf, ejvw.escapeHTML)
-	buf.WriteString(`,"$scope":{`)
-
-	ejvw.buf = append(ejvw.buf, buf.Bytes()...)
+	ejvw.buf = append(ejvw.buf, `{"$code":`...)
+	ejvw.buf = appendStringWithEscapes(ejvw.buf, code, ejvw.escapeHTML)
+	ejvw.buf = append(ejvw.buf, `,"$scope":{`...)
 
 	ejvw.push(mCodeWithScope)
 	return ejvw, nil
@@ -214,14 +199,8 @@ func (ejvw *extJSONValueWriter) WriteDBPointer(ns string, oid ObjectID) error {
 		return err
 	}
 
-	var buf bytes.Buffer
-	buf.WriteString(`{"$dbPointer":{"$ref":"`)
-	buf.WriteString(ns)
-	buf.WriteString(`","$id":{"$oid":"`)
-	buf.WriteString(oid.Hex())
-	buf.WriteString(`"}}},`)
-
-	ejvw.buf = append(ejvw.buf, buf.Bytes()...)
+	ejvw.buf = appendExtJSONDBPointer(ejvw.buf, ns, oid)
+	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
 	return nil
@@ -232,15 +211,7 @@ func (ejvw *extJSONValueWriter) WriteDateTime(dt int64) error {
 		return err
 	}
 
-	t := time.Unix(dt/1e3, dt%1e3*1e6).UTC()
-
-	if ejvw.canonical || t.Year() < 1970 || t.Year() > 9999 {
-		s := fmt.Sprintf(`{"$numberLong":"%d"}`, dt)
-		ejvw.writeExtendedSingleValue("date", s, false)
-	} else {
-		ejvw.writeExtendedSingleValue("date", t.Format(rfc3339Milli), true)
-	}
-
+	ejvw.buf = appendExtJSONDateTime(ejvw.buf, dt, ejvw.canonical)
 	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
@@ -279,22 +250,7 @@ func (ejvw *extJSONValueWriter) WriteDouble(f float64) error {
 		return err
 	}
 
-	s := formatDouble(f)
-
-	if ejvw.canonical {
-		ejvw.writeExtendedSingleValue("numberDouble", s, true)
-	} else {
-		switch s {
-		case "Infinity":
-			fallthrough
-		case "-Infinity":
-			fallthrough
-		case "NaN":
-			s = fmt.Sprintf(`{"$numberDouble":"%s"}`, s)
-		}
-		ejvw.buf = append(ejvw.buf, []byte(s)...)
-	}
-
+	ejvw.buf = appendExtJSONDouble(ejvw.buf, f, ejvw.canonical)
 	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
@@ -306,14 +262,7 @@ func (ejvw *extJSONValueWriter) WriteInt32(i int32) error {
 		return err
 	}
 
-	s := strconv.FormatInt(int64(i), 10)
-
-	if ejvw.canonical {
-		ejvw.writeExtendedSingleValue("numberInt", s, true)
-	} else {
-		ejvw.buf = append(ejvw.buf, []byte(s)...)
-	}
-
+	ejvw.buf = appendExtJSONInt(ejvw.buf, "numberInt", int64(i), ejvw.canonical)
 	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
@@ -325,14 +274,7 @@ func (ejvw *extJSONValueWriter) WriteInt64(i int64) error {
 		return err
 	}
 
-	s := strconv.FormatInt(i, 10)
-
-	if ejvw.canonical {
-		ejvw.writeExtendedSingleValue("numberLong", s, true)
-	} else {
-		ejvw.buf = append(ejvw.buf, []byte(s)...)
-	}
-
+	ejvw.buf = appendExtJSONInt(ejvw.buf, "numberLong", i, ejvw.canonical)
 	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
@@ -344,11 +286,9 @@ func (ejvw *extJSONValueWriter) WriteJavascript(code string) error {
 		return err
 	}
 
-	var buf bytes.Buffer
-	writeStringWithEscapes(code, &buf, ejvw.escapeHTML)
-
-	ejvw.writeExtendedSingleValue("code", buf.String(), false)
-	ejvw.buf = append(ejvw.buf, ',')
+	ejvw.buf = append(ejvw.buf, `{"$code":`...)
+	ejvw.buf = appendStringWithEscapes(ejvw.buf, code, ejvw.escapeHTML)
+	ejvw.buf = append(ejvw.buf, "},"...)
 
 	ejvw.pop()
 	return nil
@@ -383,7 +323,7 @@ func (ejvw *extJSONValueWriter) WriteNull() error {
 		return err
 	}
 
-	ejvw.buf = append(ejvw.buf, []byte("null")...)
+	ejvw.buf = append(ejvw.buf, "null"...)
 	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
@@ -395,7 +335,7 @@ func (ejvw *extJSONValueWriter) WriteObjectID(oid ObjectID) error {
 		return err
 	}
 
-	ejvw.writeExtendedSingleValue("oid", oid.Hex(), true)
+	ejvw.buf = appendExtJSONObjectID(ejvw.buf, oid)
 	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
@@ -407,15 +347,8 @@ func (ejvw *extJSONValueWriter) WriteRegex(pattern string, options string) error
 		return err
 	}
 
-	options = sortStringAlphebeticAscending(options)
-	var buf bytes.Buffer
-	buf.WriteString(`{"$regularExpression":{"pattern":`)
-	writeStringWithEscapes(pattern, &buf, ejvw.escapeHTML)
-	buf.WriteString(`,"options":`)
-	writeStringWithEscapes(options, &buf, ejvw.escapeHTML)
-	buf.WriteString(`}},`)
-
-	ejvw.buf = append(ejvw.buf, buf.Bytes()...)
+	ejvw.buf = appendExtJSONRegex(ejvw.buf, pattern, options, ejvw.escapeHTML)
+	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
 	return nil
@@ -426,10 +359,7 @@ func (ejvw *extJSONValueWriter) WriteString(s string) error {
 		return err
 	}
 
-	var buf bytes.Buffer
-	writeStringWithEscapes(s, &buf, ejvw.escapeHTML)
-
-	ejvw.buf = append(ejvw.buf, buf.Bytes()...)
+	ejvw.buf = appendStringWithEscapes(ejvw.buf, s, ejvw.escapeHTML)
 	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
@@ -441,11 +371,9 @@ func (ejvw *extJSONValueWriter) WriteSymbol(symbol string) error {
 		return err
 	}
 
-	var buf bytes.Buffer
-	writeStringWithEscapes(symbol, &buf, ejvw.escapeHTML)
-
-	ejvw.writeExtendedSingleValue("symbol", buf.String(), false)
-	ejvw.buf = append(ejvw.buf, ',')
+	ejvw.buf = append(ejvw.buf, `{"$symbol":`...)
+	ejvw.buf = appendStringWithEscapes(ejvw.buf, symbol, ejvw.escapeHTML)
+	ejvw.buf = append(ejvw.buf, "},"...)
 
 	ejvw.pop()
 	return nil
@@ -456,14 +384,8 @@ func (ejvw *extJSONValueWriter) WriteTimestamp(t uint32, i uint32) error {
 		return err
 	}
 
-	var buf bytes.Buffer
-	buf.WriteString(`{"$timestamp":{"t":`)
-	buf.WriteString(strconv.FormatUint(uint64(t), 10))
-	buf.WriteString(`,"i":`)
-	buf.WriteString(strconv.FormatUint(uint64(i), 10))
-	buf.WriteString(`}},`)
-
-	ejvw.buf = append(ejvw.buf, buf.Bytes()...)
+	ejvw.buf = appendExtJSONTimestamp(ejvw.buf, t, i)
+	ejvw.buf = append(ejvw.buf, ',')
 
 	ejvw.pop()
 	return nil
@@ -484,10 +406,8 @@ func (ejvw *extJSONValueWriter) WriteUndefined() error {
 func (ejvw *extJSONValueWriter) WriteDocumentElement(key string) (ValueWriter, error) {
 	switch ejvw.stack[ejvw.frame].mode {
 	case mDocument, mTopLevel, mCodeWithScope:
-		var buf bytes.Buffer
-		writeStringWithEscapes(key, &buf, ejvw.escapeHTML)
-
-		ejvw.buf = append(ejvw.buf, []byte(fmt.Sprintf(`%s:`, buf.String()))...)
+		ejvw.buf = appendStringWithEscapes(ejvw.buf, key, ejvw.escapeHTML)
+		ejvw.buf = append(ejvw.buf, ':')
 		ejvw.push(mElement)
 	default:
 		return nil, ejvw.invalidTransitionErr(mElement, "WriteDocumentElement", []mode{mDocument, mTopLevel, mCodeWithScope})
@@ -567,30 +487,136 @@ func (ejvw *extJSONValueWriter) WriteArrayEnd() error {
 }
 
 func formatDouble(f float64) string {
-	var s string
+	return string(appendDouble(nil, f))
+}
+
+// appendDouble appends the Extended JSON representation of f, without the
+// $numberDouble wrapper, to dst.
+func appendDouble(dst []byte, f float64) []byte {
 	switch {
 	case math.IsInf(f, 1):
-		s = "Infinity"
+		return append(dst, "Infinity"...)
 	case math.IsInf(f, -1):
-		s = "-Infinity"
+		return append(dst, "-Infinity"...)
 	case math.IsNaN(f):
-		s = "NaN"
-	default:
-		// Print exactly one decimalType place for integers; otherwise, print as many are necessary to
-		// perfectly represent it.
-		s = strconv.FormatFloat(f, 'G', -1, 64)
-		if !strings.ContainsRune(s, 'E') && !strings.ContainsRune(s, '.') {
-			s += ".0"
-		}
+		return append(dst, "NaN"...)
+	}
+
+	// Print exactly one decimalType place for integers; otherwise, print as many are necessary to
+	// perfectly represent it.
+	start := len(dst)
+	dst = strconv.AppendFloat(dst, f, 'G', -1, 64)
+	if !bytes.ContainsAny(dst[start:], "E.") {
+		dst = append(dst, ".0"...)
+	}
+	return dst
+}
+
+func appendExtendedSingleValue(dst []byte, key, value string, quotes bool) []byte {
+	dst = append(dst, `{"$`...)
+	dst = append(dst, key...)
+	if quotes {
+		dst = append(dst, `":"`...)
+		dst = append(dst, value...)
+		return append(dst, `"}`...)
+	}
+	dst = append(dst, `":`...)
+	dst = append(dst, value...)
+	return append(dst, '}')
+}
+
+func appendExtJSONBinary(dst []byte, b []byte, btype byte) []byte {
+	dst = append(dst, `{"$binary":{"base64":"`...)
+	start := len(dst)
+	dst = append(dst, make([]byte, base64.StdEncoding.EncodedLen(len(b)))...)
+	base64.StdEncoding.Encode(dst[start:], b)
+	dst = append(dst, `","subType":"`...)
+	dst = append(dst, hexChars[btype>>4], hexChars[btype&0xF])
+	return append(dst, `"}}`...)
+}
+
+func appendExtJSONDateTime(dst []byte, dt int64, canonical bool) []byte {
+	t := time.Unix(dt/1e3, dt%1e3*1e6).UTC()
+
+	if canonical || t.Year() < 1970 || t.Year() > 9999 {
+		dst = append(dst, `{"$date":{"$numberLong":"`...)
+		dst = strconv.AppendInt(dst, dt, 10)
+		return append(dst, `"}}`...)
 	}
+	dst = append(dst, `{"$date":"`...)
+	dst = t.AppendFormat(dst, rfc3339Milli)
+	return append(dst, `"}`...)
+}
+
+func appendExtJSONDBPointer(dst []byte, ns string, oid ObjectID) []byte {
+	dst = append(dst, `{"$dbPointer":{"$ref":"`...)
+	dst = append(dst, ns...)
+	dst = append(dst, `","$id":`...)
+	dst = appendExtJSONObjectID(dst, oid)
+	return append(dst, "}}"...)
+}
+
+func appendExtJSONDouble(dst []byte, f float64, canonical bool) []byte {
+	if canonical || math.IsInf(f, 0) || math.IsNaN(f) {
+		dst = append(dst, `{"$numberDouble":"`...)
+		dst = appendDouble(dst, f)
+		return append(dst, `"}`...)
+	}
+	return appendDouble(dst, f)
+}
+
+// appendExtJSONInt appends i to dst, wrapped in an object with the given
+// Extended JSON key if canonical is true.
+func appendExtJSONInt(dst []byte, key string, i int64, canonical bool) []byte {
+	if !canonical {
+		return strconv.AppendInt(dst, i, 10)
+	}
+	dst = append(dst, `{"$`...)
+	dst = append(dst, key...)
+	dst = append(dst, `":"`...)
+	dst = strconv.AppendInt(dst, i, 10)
+	return append(dst, `"}`...)
+}
 
-	return s
+func appendExtJSONObjectID(dst []byte, oid ObjectID) []byte {
+	dst = append(dst, `{"$oid":"`...)
+	for _, b := range oid {
+		dst = append(dst, hexChars[b>>4], hexChars[b&0xF])
+	}
+	return append(dst, `"}`...)
+}
+
+func appendExtJSONRegex(dst []byte, pattern, options string, escapeHTML bool) []byte {
+	dst = append(dst, `{"$regularExpression":{"pattern":`...)
+	dst = appendStringWithEscapes(dst, pattern, escapeHTML)
+	dst = append(dst, `,"options":`...)
+	dst = appendStringWithEscapes(dst, sortStringAlphebeticAscending(options), escapeHTML)
+	return append(dst, "}}"...)
+}
+
+func appendExtJSONTimestamp(dst []byte, t, i uint32) []byte {
+	dst = append(dst, `{"$timestamp":{"t":`...)
+	dst = strconv.AppendUint(dst, uint64(t), 10)
+	dst = append(dst, `,"i":`...)
+	dst = strconv.AppendUint(dst, uint64(i), 10)
+	return append(dst, "}}"...)
 }
 
 var hexChars = "0123456789abcdef"
 
-func writeStringWithEscapes(s string, buf *bytes.Buffer, escapeHTML bool) {
-	buf.WriteByte('"')
+// appendStringWithEscapes appends s to dst as a quoted JSON string.
+func appendStringWithEscapes(dst []byte, s string, escapeHTML bool) []byte {
+	return appendEscaped(dst, s, escapeHTML, utf8.DecodeRuneInString)
+}
+
+// appendBytesWithEscapes is like appendStringWithEscapes, but reads the string
+// from a byte slice so that it does not need to be converted to a string.
+func appendBytesWithEscapes(dst []byte, s []byte, escapeHTML bool) []byte {
+	return appendEscaped(dst, s, escapeHTML, utf8.DecodeRune)
+}
+
+func appendEscaped[T string | []byte](dst []byte, s T, escapeHTML bool, decodeRune func(T) (rune, int)) []byte {
+	dst = append(dst, '"')
 	start := 0
 	for i := 0; i < len(s); {
 		if b := s[i]; b < utf8.RuneSelf {
@@ -599,47 +625,40 @@ func writeStringWithEscapes(s string, buf *bytes.Buffer, escapeHTML bool) {
 				continue
 			}
 			if start < i {
-				buf.WriteString(s[start:i])
+				dst = append(dst, s[start:i]...)
 			}
 			switch b {
 			case '\\', '"':
-				buf.WriteByte('\\')
-				buf.WriteByte(b)
+				dst = append(dst, '\\', b)
 			case '\n':
-				buf.WriteByte('\\')
-				buf.WriteByte('n')
+				dst = append(dst, '\\', 'n')
 			case '\r':
-				buf.WriteByte('\\')
-				buf.WriteByte('r')
+				dst = append(dst, '\\', 'r')
 			case '\t':
-				buf.WriteByte('\\')
-				buf.WriteByte('t')
+				dst = append(dst, '\\', 't')
 			case '\b':
-				buf.WriteByte('\\')
-				buf.WriteByte('b')
+				dst = append(dst, '\\', 'b')
 			case '\f':
-				buf.WriteByte('\\')
-				buf.WriteByte('f')
+				dst = append(dst, '\\', 'f')
 			default:
 				// This encodes bytes < 0x20 except for \t, \n and \r.
 				// If escapeHTML is set, it also escapes <, >, and &
 				// because they can lead to security holes when
 				// user-controlled strings are rendered into JSON
 				// and served to some browsers.
-				buf.WriteString(`\u00`)
-				buf.WriteByte(hexChars[b>>4])
-				buf.WriteByte(hexChars[b&0xF])
+				dst = append(dst, `\u00`...)
+				dst = append(dst, hexChars[b>>4], hexChars[b&0xF])
 			}
 			i++
 			start = i
 			continue
 		}
-		c, size := utf8.DecodeRuneInString(s[i:])
+		c, size := decodeRune(s[i:])
 		if c == utf8.RuneError && size == 1 {
 			if start < i {
-				buf.WriteString(s[start:i])
+				dst = append(dst, s[start:i]...)
 			}
-			buf.WriteString(`\ufffd`)
+			dst = append(dst, `\ufffd`...)
 			i += size
 			start = i
 			continue
@@ -653,10 +672,10 @@ func writeStringWithEscapes(s string, buf *bytes.Buffer, escapeHTML bool) {
 		// See http://timelessrepo.com/json-isnt-a-javascript-subset for discussion.
 		if c == '\u2028' || c == '\u2029' {
 			if start < i {
-				buf.WriteString(s[start:i])
+				dst = append(dst, s[start:i]...)
 			}
-			buf.WriteString(`\u202`)
-			buf.WriteByte(hexChars[c&0xF])
+			dst = append(dst, `\u202`...)
+			dst = append(dst, hexChars[c&0xF])
 			i += size
 			start = i
 			continue
@@ -664,9 +683,9 @@ func writeStringWithEscapes(s string, buf *bytes.Buffer, escapeHTML bool) {
 		i += size
 	}
 	if start < len(s) {
-		buf.WriteString(s[start:])
+		dst = append(dst, s[start:]...)
 	}
-	buf.WriteByte('"')
+	return append(dst, '"')
 }
 
 type sortableString []rune
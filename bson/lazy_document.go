@@ -0,0 +1,122 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// LazyDocument is a BSON document that decodes individual fields when they are
+// accessed instead of decoding the whole document up front. Decoded values are
+// cached, so accessing the same field again does not decode it again. This is
+// useful for code that only reads a few fields of large documents:
+//
+//	var doc bson.LazyDocument
+//	if err := cursor.Decode(&doc); err != nil {
+//		return err
+//	}
+//	var status string
+//	if err := doc.Decode(&status, "status"); err != nil {
+//		return err
+//	}
+//
+// LazyDocument implements Marshaler and Unmarshaler, so it can be used as the
+// destination of a Decode or as a struct field. The methods of a LazyDocument
+// are safe for concurrent use.
+type LazyDocument struct {
+	raw Raw
+
+	mu    sync.Mutex
+	cache map[lazyCacheKey]reflect.Value
+}
+
+type lazyCacheKey struct {
+	path string
+	typ  reflect.Type
+}
+
+var (
+	_ Marshaler   = &LazyDocument{}
+	_ Unmarshaler = &LazyDocument{}
+)
+
+// NewLazyDocument returns a LazyDocument that decodes fields from raw. The
+// LazyDocument does not copy raw, so raw must not be modified afterwards.
+func NewLazyDocument(raw Raw) *LazyDocument {
+	return &LazyDocument{raw: raw}
+}
+
+// Raw returns the underlying BSON document.
+func (ld *LazyDocument) Raw() Raw {
+	return ld.raw
+}
+
+// Lookup returns the value at the given key path without decoding it. See
+// Raw.Lookup for details.
+func (ld *LazyDocument) Lookup(key ...string) RawValue {
+	return ld.raw.Lookup(key...)
+}
+
+// Decode decodes the value at the given key path into val, which must be a
+// non-nil pointer. If a value of the same type was decoded from the same key
+// path before, the cached value is assigned to val instead. Cached values are
+// shallow copies, so maps, slices, and pointers decoded from the same key path
+// share their underlying data.
+func (ld *LazyDocument) Decode(val any, key ...string) error {
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("argument to Decode must be a non-nil pointer, got %T", val)
+	}
+	ck := lazyCacheKey{path: strings.Join(key, "\x00"), typ: rv.Type().Elem()}
+
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+
+	if cached, ok := ld.cache[ck]; ok {
+		rv.Elem().Set(cached)
+		return nil
+	}
+
+	rawVal, err := ld.raw.LookupErr(key...)
+	if err != nil {
+		return err
+	}
+	decoded := reflect.New(ck.typ)
+	if err := rawVal.Unmarshal(decoded.Interface()); err != nil {
+		return err
+	}
+
+	if ld.cache == nil {
+		ld.cache = make(map[lazyCacheKey]reflect.Value)
+	}
+	ld.cache[ck] = decoded.Elem()
+	rv.Elem().Set(decoded.Elem())
+	return nil
+}
+
+// MarshalBSON implements the Marshaler interface. It returns the underlying
+// BSON document.
+func (ld *LazyDocument) MarshalBSON() ([]byte, error) {
+	return ld.raw, nil
+}
+
+// UnmarshalBSON implements the Unmarshaler interface. It copies data and
+// discards any cached values.
+func (ld *LazyDocument) UnmarshalBSON(data []byte) error {
+	raw := make(Raw, len(data))
+	copy(raw, data)
+
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+
+	ld.raw = raw
+	ld.cache = nil
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+func TestLazyDocument(t *testing.T) {
+	t.Parallel()
+
+	raw, err := Marshal(D{
+		{Key: "status", Value: "active"},
+		{Key: "count", Value: int32(3)},
+		{Key: "tags", Value: A{"a", "b"}},
+		{Key: "meta", Value: D{{Key: "owner", Value: "alice"}}},
+	})
+	require.NoError(t, err, "Marshal error")
+
+	t.Run("decode fields", func(t *testing.T) {
+		t.Parallel()
+
+		doc := NewLazyDocument(raw)
+
+		var status string
+		require.NoError(t, doc.Decode(&status, "status"), "Decode error")
+		assert.Equal(t, "active", status)
+
+		var count int64
+		require.NoError(t, doc.Decode(&count, "count"), "Decode error")
+		assert.Equal(t, int64(3), count)
+
+		var owner string
+		require.NoError(t, doc.Decode(&owner, "meta", "owner"), "Decode error")
+		assert.Equal(t, "alice", owner)
+
+		var tags []string
+		require.NoError(t, doc.Decode(&tags, "tags"), "Decode error")
+		assert.Equal(t, []string{"a", "b"}, tags)
+
+		assert.Equal(t, "active", doc.Lookup("status").StringValue())
+		assert.Len(t, doc.cache, 4, "expected each decoded value to be cached")
+	})
+	t.Run("cached values", func(t *testing.T) {
+		t.Parallel()
+
+		doc := NewLazyDocument(raw)
+
+		var count int32
+		require.NoError(t, doc.Decode(&count, "count"), "Decode error")
+		doc.cache[lazyCacheKey{path: "count", typ: tInt32}].Set(reflect.ValueOf(int32(42)))
+
+		require.NoError(t, doc.Decode(&count, "count"), "Decode error")
+		assert.Equal(t, int32(42), count, "expected the cached value to be used")
+
+		var countStr string
+		err := doc.Decode(&countStr, "count")
+		assert.Error(t, err, "expected decoding an int32 into a string to fail")
+	})
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		doc := NewLazyDocument(raw)
+
+		var s string
+		assert.ErrorIs(t, doc.Decode(&s, "missing"), bsoncore.ErrElementNotFound)
+		assert.Error(t, doc.Decode(s, "status"), "expected an error for a non-pointer argument")
+	})
+	t.Run("marshal and unmarshal", func(t *testing.T) {
+		t.Parallel()
+
+		type wrapper struct {
+			ID   int32         `bson:"_id"`
+			Body *LazyDocument `bson:"body"`
+		}
+
+		b, err := Marshal(wrapper{ID: 1, Body: NewLazyDocument(raw)})
+		require.NoError(t, err, "Marshal error")
+
+		var got wrapper
+		require.NoError(t, Unmarshal(b, &got), "Unmarshal error")
+		assert.Equal(t, Raw(raw), got.Body.Raw())
+
+		var status string
+		require.NoError(t, got.Body.Decode(&status, "status"), "Decode error")
+		assert.Equal(t, "active", status)
+
+		var top LazyDocument
+		require.NoError(t, Unmarshal(raw, &top), "Unmarshal error")
+		assert.Equal(t, "active", top.Lookup("status").StringValue())
+	})
+}
@@ -20,6 +20,8 @@ import (
 	"io"
 	"sync/atomic"
 	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/replay"
 )
 
 // ErrInvalidHex indicates that a hex string cannot be converted to an ObjectID.
@@ -39,7 +41,9 @@ var _ encoding.TextUnmarshaler = &ObjectID{}
 
 // NewObjectID generates a new ObjectID.
 func NewObjectID() ObjectID {
-	return NewObjectIDFromTimestamp(time.Now())
+	return replay.Bytes12(func() [12]byte {
+		return NewObjectIDFromTimestamp(time.Now())
+	})
 }
 
 // NewObjectIDFromTimestamp generates a new ObjectID based on the given time.
@@ -8,7 +8,9 @@ package bson
 
 import (
 	"errors"
+	"fmt"
 	"io"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
@@ -48,6 +50,76 @@ func (r Raw) LookupErr(key ...string) (RawValue, error) {
 	return convertFromCoreValue(val), err
 }
 
+// LookupPathError is returned by Raw.LookupPath if the path cannot be resolved.
+type LookupPathError struct {
+	// Path is the path passed to LookupPath.
+	Path string
+
+	// Key is the prefix of Path that could not be resolved: the path of the
+	// missing element, or of the element that is not a document or array.
+	Key string
+
+	// Type is the BSON type of the element at Key if it exists but is not a
+	// document or array. It is zero if the element at Key is missing.
+	Type Type
+}
+
+// Missing reports whether the lookup failed because the element at Key does
+// not exist, rather than because it is not a document or array.
+func (e *LookupPathError) Missing() bool {
+	return e.Type == 0
+}
+
+// Error implements the error interface.
+func (e *LookupPathError) Error() string {
+	if e.Missing() {
+		return fmt.Sprintf("lookup of path %q failed: element %q does not exist", e.Path, e.Key)
+	}
+	return fmt.Sprintf("lookup of path %q failed: element %q is of type %v, not a document or array", e.Path, e.Key, e.Type)
+}
+
+// LookupPath searches the document for the value at the given dot-separated
+// path, such as "a.b.2.c". Each component of the path is the key of an element
+// of a document or the index of an element of an array. If the value does not
+// exist or an intermediate value is neither a document nor an array, a
+// *LookupPathError is returned. Keys that contain "." cannot be looked up with
+// LookupPath; use LookupErr instead.
+func (r Raw) LookupPath(path string) (RawValue, error) {
+	if path == "" {
+		return RawValue{}, bsoncore.ErrEmptyKey
+	}
+
+	doc := bsoncore.Document(r)
+	rest := path
+	var end int
+	for {
+		key, remaining, more := strings.Cut(rest, ".")
+		end += len(key)
+
+		val, err := doc.LookupErr(key)
+		if errors.Is(err, bsoncore.ErrElementNotFound) {
+			return RawValue{}, &LookupPathError{Path: path, Key: path[:end]}
+		}
+		if err != nil {
+			return RawValue{}, err
+		}
+		if !more {
+			return convertFromCoreValue(val), nil
+		}
+
+		switch val.Type {
+		case bsoncore.TypeEmbeddedDocument:
+			doc = val.Document()
+		case bsoncore.TypeArray:
+			doc = bsoncore.Document(val.Array())
+		default:
+			return RawValue{}, &LookupPathError{Path: path, Key: path[:end], Type: Type(val.Type)}
+		}
+		rest = remaining
+		end++
+	}
+}
+
 // Elements returns this document as a slice of elements. The returned slice will contain valid
 // elements. If the document is not valid, the elements up to the invalid point will be returned
 // along with an error.
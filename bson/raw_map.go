@@ -0,0 +1,159 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MapValueFormat specifies how Raw.AsMap represents a BSON value that has no
+// equivalent in JSON.
+type MapValueFormat int
+
+// These constants specify the supported MapValueFormat values.
+const (
+	// MapNative represents values as Go types: ObjectID values as ObjectID,
+	// datetimes as time.Time in UTC, Decimal128 values as Decimal128, and
+	// binary values as []byte if they have the generic subtype and as Binary
+	// otherwise.
+	MapNative MapValueFormat = iota
+
+	// MapString represents values as strings: ObjectID values as hexadecimal
+	// strings, datetimes in the time.RFC3339Nano format in UTC, Decimal128
+	// values as returned by Decimal128.String, and binary values as standard
+	// base64. The binary subtype is not preserved.
+	MapString
+
+	// MapExtJSON represents values as maps with the shape of canonical
+	// Extended JSON, e.g. map[string]any{"$oid": "5ef7fdd91c19e3222b41b839"}.
+	// Encoding the map to JSON produces Extended JSON that can be parsed back
+	// into the original BSON value.
+	MapExtJSON
+)
+
+// MapOptions configures the conversion of a document by Raw.AsMap. The zero
+// value represents all values with MapNative.
+type MapOptions struct {
+	// ObjectID is the format for ObjectID values.
+	ObjectID MapValueFormat
+
+	// DateTime is the format for datetime values.
+	DateTime MapValueFormat
+
+	// Decimal128 is the format for Decimal128 values.
+	Decimal128 MapValueFormat
+
+	// Binary is the format for binary values.
+	Binary MapValueFormat
+}
+
+// AsMap converts the document to a map[string]any, which can be passed to APIs
+// that accept arbitrary JSON-like data, such as encoding/json. Embedded
+// documents are converted to map[string]any and arrays to []any, recursively.
+//
+// The representation of ObjectID, datetime, Decimal128, and binary values is
+// controlled by opts (see MapOptions). Other values are converted to the type
+// they are decoded into when unmarshaled into an empty interface: BSON
+// doubles, strings, booleans, int32s, and int64s to the corresponding Go
+// types, null to nil, and the remaining BSON types to the corresponding types
+// in this package, such as Timestamp and Regex.
+//
+// If a key occurs more than once in a document, the last value is used.
+func (r Raw) AsMap(opts MapOptions) (map[string]any, error) {
+	elems, err := r.Elements()
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]any, len(elems))
+	for _, elem := range elems {
+		key := elem.Key()
+		val, err := rawValueAsAny(elem.Value(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("error converting field %q: %w", key, err)
+		}
+		m[key] = val
+	}
+	return m, nil
+}
+
+func rawValueAsAny(rv RawValue, opts MapOptions) (any, error) {
+	switch rv.Type {
+	case TypeEmbeddedDocument:
+		return rv.Document().AsMap(opts)
+	case TypeArray:
+		vals, err := rv.Array().Values()
+		if err != nil {
+			return nil, err
+		}
+		arr := make([]any, len(vals))
+		for i, val := range vals {
+			if arr[i], err = rawValueAsAny(val, opts); err != nil {
+				return nil, fmt.Errorf("error converting array index %d: %w", i, err)
+			}
+		}
+		return arr, nil
+	case TypeObjectID:
+		oid := rv.ObjectID()
+		switch opts.ObjectID {
+		case MapString:
+			return oid.Hex(), nil
+		case MapExtJSON:
+			return map[string]any{"$oid": oid.Hex()}, nil
+		}
+		return oid, nil
+	case TypeDateTime:
+		ms := rv.DateTime()
+		switch opts.DateTime {
+		case MapString:
+			return time.UnixMilli(ms).UTC().Format(time.RFC3339Nano), nil
+		case MapExtJSON:
+			return map[string]any{
+				"$date": map[string]any{"$numberLong": strconv.FormatInt(ms, 10)},
+			}, nil
+		}
+		return time.UnixMilli(ms).UTC(), nil
+	case TypeDecimal128:
+		d := rv.Decimal128()
+		switch opts.Decimal128 {
+		case MapString:
+			return d.String(), nil
+		case MapExtJSON:
+			return map[string]any{"$numberDecimal": d.String()}, nil
+		}
+		return d, nil
+	case TypeBinary:
+		subtype, data := rv.Binary()
+		switch opts.Binary {
+		case MapString:
+			return base64.StdEncoding.EncodeToString(data), nil
+		case MapExtJSON:
+			return map[string]any{
+				"$binary": map[string]any{
+					"base64":  base64.StdEncoding.EncodeToString(data),
+					"subType": fmt.Sprintf("%02x", subtype),
+				},
+			}, nil
+		}
+		// Copy the data so that the map does not share memory with the
+		// document.
+		data = append([]byte(nil), data...)
+		if subtype == TypeBinaryGeneric {
+			return data, nil
+		}
+		return Binary{Subtype: subtype, Data: data}, nil
+	}
+
+	var val any
+	if err := rv.Unmarshal(&val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
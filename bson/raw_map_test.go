@@ -0,0 +1,129 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestRawAsMap(t *testing.T) {
+	oid, err := ObjectIDFromHex("5ef7fdd91c19e3222b41b839")
+	require.NoError(t, err)
+	dt := time.Date(2020, 6, 28, 1, 2, 3, 4e6, time.UTC)
+	dec, err := ParseDecimal128("1.5")
+	require.NoError(t, err)
+
+	doc, err := Marshal(D{
+		{"oid", oid},
+		{"date", NewDateTimeFromTime(dt)},
+		{"dec", dec},
+		{"bin", Binary{Data: []byte{1, 2}}},
+		{"uuid", Binary{Subtype: TypeBinaryUUID, Data: []byte{3, 4}}},
+		{"str", "a"},
+		{"int", int32(1)},
+		{"null", nil},
+		{"ts", Timestamp{T: 1, I: 2}},
+		{"doc", D{{"oid", oid}}},
+		{"arr", A{dec, "b"}},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		opts MapOptions
+		want map[string]any
+	}{
+		{
+			name: "native",
+			opts: MapOptions{},
+			want: map[string]any{
+				"oid":  oid,
+				"date": dt,
+				"dec":  dec,
+				"bin":  []byte{1, 2},
+				"uuid": Binary{Subtype: TypeBinaryUUID, Data: []byte{3, 4}},
+				"str":  "a",
+				"int":  int32(1),
+				"null": nil,
+				"ts":   Timestamp{T: 1, I: 2},
+				"doc":  map[string]any{"oid": oid},
+				"arr":  []any{dec, "b"},
+			},
+		},
+		{
+			name: "string",
+			opts: MapOptions{ObjectID: MapString, DateTime: MapString, Decimal128: MapString, Binary: MapString},
+			want: map[string]any{
+				"oid":  "5ef7fdd91c19e3222b41b839",
+				"date": "2020-06-28T01:02:03.004Z",
+				"dec":  "1.5",
+				"bin":  "AQI=",
+				"uuid": "AwQ=",
+				"str":  "a",
+				"int":  int32(1),
+				"null": nil,
+				"ts":   Timestamp{T: 1, I: 2},
+				"doc":  map[string]any{"oid": "5ef7fdd91c19e3222b41b839"},
+				"arr":  []any{"1.5", "b"},
+			},
+		},
+		{
+			name: "extended JSON",
+			opts: MapOptions{ObjectID: MapExtJSON, DateTime: MapExtJSON, Decimal128: MapExtJSON, Binary: MapExtJSON},
+			want: map[string]any{
+				"oid":  map[string]any{"$oid": "5ef7fdd91c19e3222b41b839"},
+				"date": map[string]any{"$date": map[string]any{"$numberLong": "1593306123004"}},
+				"dec":  map[string]any{"$numberDecimal": "1.5"},
+				"bin":  map[string]any{"$binary": map[string]any{"base64": "AQI=", "subType": "00"}},
+				"uuid": map[string]any{"$binary": map[string]any{"base64": "AwQ=", "subType": "04"}},
+				"str":  "a",
+				"int":  int32(1),
+				"null": nil,
+				"ts":   Timestamp{T: 1, I: 2},
+				"doc":  map[string]any{"oid": map[string]any{"$oid": "5ef7fdd91c19e3222b41b839"}},
+				"arr":  []any{map[string]any{"$numberDecimal": "1.5"}, "b"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Raw(doc).AsMap(tc.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("extended JSON round trip", func(t *testing.T) {
+		m, err := Raw(doc).AsMap(MapOptions{
+			ObjectID:   MapExtJSON,
+			DateTime:   MapExtJSON,
+			Decimal128: MapExtJSON,
+			Binary:     MapExtJSON,
+		})
+		require.NoError(t, err)
+		delete(m, "ts")
+
+		js, err := json.Marshal(m)
+		require.NoError(t, err)
+
+		var got Raw
+		require.NoError(t, UnmarshalExtJSON(js, true, &got))
+		for _, key := range []string{"oid", "date", "dec", "bin", "uuid"} {
+			assert.True(t, Raw(doc).Lookup(key).Equal(got.Lookup(key)), "expected %q to round trip, got %v", key, got.Lookup(key))
+		}
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := Raw{0x05, 0x00}.AsMap(MapOptions{})
+		assert.Error(t, err)
+	})
+}
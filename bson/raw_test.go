@@ -577,3 +577,60 @@ func createVoluminousArrayDocuments(t *testing.T, size int) bsoncore.Document {
 
 	return bsoncore.Document(bsonData)
 }
+
+func TestRawLookupPath(t *testing.T) {
+	t.Parallel()
+
+	doc, err := Marshal(D{
+		{Key: "a", Value: D{
+			{Key: "b", Value: A{
+				int32(0),
+				"one",
+				D{{Key: "c", Value: "found"}},
+			}},
+			{Key: "s", Value: "str"},
+		}},
+	})
+	require.NoError(t, err, "Marshal error")
+	r := Raw(doc)
+
+	testCases := []struct {
+		path    string
+		want    RawValue
+		wantErr *LookupPathError
+	}{
+		{path: "a.b.2.c", want: r.Lookup("a", "b", "2", "c")},
+		{path: "a.b.1", want: r.Lookup("a", "b", "1")},
+		{path: "a.s", want: r.Lookup("a", "s")},
+		{path: "x", wantErr: &LookupPathError{Path: "x", Key: "x"}},
+		{path: "a.b.3.c", wantErr: &LookupPathError{Path: "a.b.3.c", Key: "a.b.3"}},
+		{path: "a.b.2.d", wantErr: &LookupPathError{Path: "a.b.2.d", Key: "a.b.2.d"}},
+		{path: "a.s.x", wantErr: &LookupPathError{Path: "a.s.x", Key: "a.s", Type: TypeString}},
+		{path: "a.b.0.c", wantErr: &LookupPathError{Path: "a.b.0.c", Key: "a.b.0", Type: TypeInt32}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.path, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := r.LookupPath(tc.path)
+			if tc.wantErr == nil {
+				require.NoError(t, err, "LookupPath error")
+				assert.Equal(t, tc.want, got)
+				return
+			}
+
+			var lpErr *LookupPathError
+			require.True(t, errors.As(err, &lpErr), "expected a *LookupPathError, got %v", err)
+			assert.Equal(t, tc.wantErr, lpErr)
+			assert.Equal(t, tc.wantErr.Type == 0, lpErr.Missing())
+		})
+	}
+
+	_, err = r.LookupPath("")
+	assert.ErrorIs(t, err, bsoncore.ErrEmptyKey)
+
+	_, err = r.LookupPath("a.s.x")
+	assert.EqualError(t, err, `lookup of path "a.s.x" failed: element "a.s" is of type string, not a document or array`)
+}
@@ -79,6 +79,14 @@ func (entme errNoTypeMapEntry) Error() string {
 // match a registered type or interface encoder/decoder first. These methods should be used to change the
 // behavior for all values for a specific kind.
 //
+// When more than one codec applies to a type, type codecs take precedence over interface codecs, and
+// interface codecs take precedence over kind codecs. For example, if an interface encoder is
+// registered for fmt.Stringer, it is used for a named string type with a String method, but not for
+// time.Time, which has a type encoder in registries constructed using NewRegistry, and not for types
+// that also implement bson.Marshaler, which is registered as an interface before any user-defined
+// interfaces. Interface codecs are useful for sharing encoding logic between all types that implement
+// a domain interface, without registering a codec for each concrete type.
+//
 // Read [Registry.LookupDecoder] and [Registry.LookupEncoder] for Registry lookup procedure.
 type Registry struct {
 	interfaceEncoders []interfaceValueEncoder
@@ -88,6 +96,8 @@ type Registry struct {
 	kindEncoders      *kindEncoderCache
 	kindDecoders      *kindDecoderCache
 	typeMap           sync.Map // map[Type]reflect.Type
+	structTagKey      string
+	fieldNameFunc     func(string) string
 }
 
 // NewRegistry creates a new empty Registry.
@@ -104,6 +114,33 @@ func NewRegistry() *Registry {
 	return reg
 }
 
+// SetStructTagKey specifies the struct tag key that the struct codecs of the Registry read field
+// names and options from, instead of "bson". For example, the following code causes structs to be
+// encoded and decoded using their "json" struct tags:
+//
+//	reg := bson.NewRegistry()
+//	reg.SetStructTagKey("json")
+//
+// SetStructTagKey must be called before the Registry is used to encode or decode structs, and
+// should not be called concurrently with any other Registry method.
+func (r *Registry) SetStructTagKey(key string) {
+	r.structTagKey = key
+}
+
+// SetFieldNameFunc specifies a function that the struct codecs of the Registry use to convert Go
+// struct field names to BSON field names when the struct tag does not specify a name. By default,
+// field names are lowercased. For example, the following code causes a field named "UserID" to be
+// encoded as "user_id":
+//
+//	reg := bson.NewRegistry()
+//	reg.SetFieldNameFunc(bson.SnakeCaseFieldName)
+//
+// SetFieldNameFunc must be called before the Registry is used to encode or decode structs, and
+// should not be called concurrently with any other Registry method.
+func (r *Registry) SetFieldNameFunc(fn func(string) string) {
+	r.fieldNameFunc = fn
+}
+
 // RegisterTypeEncoder registers the provided ValueEncoder for the provided type.
 //
 // The type will be used as provided, so an encoder can be registered for a type and a different
@@ -111,7 +148,7 @@ func NewRegistry() *Registry {
 //
 // If the given type is an interface, the encoder will be called when marshaling a type that is
 // that interface. It will not be called when marshaling a non-interface type that implements the
-// interface. To get the latter behavior, call RegisterHookEncoder instead.
+// interface. To get the latter behavior, call RegisterInterfaceEncoder instead.
 //
 // RegisterTypeEncoder should not be called concurrently with any other Registry method.
 func (r *Registry) RegisterTypeEncoder(valueType reflect.Type, enc ValueEncoder) {
@@ -125,7 +162,7 @@ func (r *Registry) RegisterTypeEncoder(valueType reflect.Type, enc ValueEncoder)
 //
 // If the given type is an interface, the decoder will be called when unmarshaling into a type that
 // is that interface. It will not be called when unmarshaling into a non-interface type that
-// implements the interface. To get the latter behavior, call RegisterHookDecoder instead.
+// implements the interface. To get the latter behavior, call RegisterInterfaceDecoder instead.
 //
 // RegisterTypeDecoder should not be called concurrently with any other Registry method.
 func (r *Registry) RegisterTypeDecoder(valueType reflect.Type, dec ValueDecoder) {
@@ -169,7 +206,12 @@ func (r *Registry) RegisterKindDecoder(kind reflect.Kind, dec ValueDecoder) {
 // implements iface. If the provided type is not an interface
 // (i.e. iface.Kind() != reflect.Interface), this method will panic.
 //
-// RegisterInterfaceEncoder should not be called concurrently with any other Registry method.
+// The encoder is not called for types that have an encoder registered with RegisterTypeEncoder, or
+// that implement an interface registered earlier. Registering an encoder for an interface that is
+// already registered replaces the encoder but keeps the precedence of the interface.
+//
+// RegisterInterfaceEncoder should not be called concurrently with any other Registry method, or
+// after the Registry has been used to encode values, because the result of each lookup is cached.
 func (r *Registry) RegisterInterfaceEncoder(iface reflect.Type, enc ValueEncoder) {
 	if iface.Kind() != reflect.Interface {
 		panicStr := fmt.Errorf("RegisterInterfaceEncoder expects a type with kind reflect.Interface, "+
@@ -192,7 +234,12 @@ func (r *Registry) RegisterInterfaceEncoder(iface reflect.Type, enc ValueEncoder
 // implements iface. If the provided type is not an interface (i.e. iface.Kind() != reflect.Interface),
 // this method will panic.
 //
-// RegisterInterfaceDecoder should not be called concurrently with any other Registry method.
+// The decoder is not called for types that have a decoder registered with RegisterTypeDecoder, or
+// that implement an interface registered earlier. Registering a decoder for an interface that is
+// already registered replaces the decoder but keeps the precedence of the interface.
+//
+// RegisterInterfaceDecoder should not be called concurrently with any other Registry method, or
+// after the Registry has been used to decode values, because the result of each lookup is cached.
 func (r *Registry) RegisterInterfaceDecoder(iface reflect.Type, dec ValueDecoder) {
 	if iface.Kind() != reflect.Interface {
 		panicStr := fmt.Errorf("RegisterInterfaceDecoder expects a type with kind reflect.Interface, "+
@@ -301,3 +301,71 @@ func ExampleRegistry_RegisterKindDecoder() {
 	fmt.Printf("%+v\n", doc)
 	// Output: {MyInt:8 Int64:9}
 }
+
+// status is a user-defined type that implements fmt.Stringer.
+type status int
+
+func (s status) String() string {
+	switch s {
+	case 0:
+		return "pending"
+	case 1:
+		return "active"
+	}
+	return "unknown"
+}
+
+func ExampleRegistry_RegisterInterfaceEncoder() {
+	// Create a custom encoder that writes any value that implements
+	// fmt.Stringer as a BSON string. To do that, we register the encoder as an
+	// "interface" encoder for fmt.Stringer. That way, the encoder is used for
+	// every type that implements fmt.Stringer without registering it for each
+	// type.
+	stringerType := reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	stringerEncoder := func(
+		_ bson.EncodeContext,
+		vw bson.ValueWriter,
+		val reflect.Value,
+	) error {
+		// All encoder implementations should check that val is valid and is of
+		// the correct type before proceeding.
+		if !val.IsValid() || !val.Type().Implements(stringerType) {
+			return bson.ValueEncoderError{
+				Name:     "stringerEncoder",
+				Types:    []reflect.Type{stringerType},
+				Received: val,
+			}
+		}
+
+		return vw.WriteString(val.Interface().(fmt.Stringer).String())
+	}
+
+	reg := bson.NewRegistry()
+	reg.RegisterInterfaceEncoder(
+		stringerType,
+		bson.ValueEncoderFunc(stringerEncoder))
+
+	// Define a document that includes a status, which implements fmt.Stringer,
+	// and an int, which does not.
+	type myDocument struct {
+		Status status
+		Count  int
+	}
+	doc := myDocument{
+		Status: 1,
+		Count:  1,
+	}
+
+	// Marshal the document as BSON. Expect that the status field is encoded as
+	// a string and that the int field is encoded as an int32.
+	buf := new(bytes.Buffer)
+	vw := bson.NewDocumentWriter(buf)
+	enc := bson.NewEncoder(vw)
+	enc.SetRegistry(reg)
+	err := enc.Encode(doc)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(bson.Raw(buf.Bytes()).String())
+	// Output: {"status": "active","count": {"$numberInt":"1"}}
+}
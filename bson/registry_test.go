@@ -483,6 +483,61 @@ func TestRegistry(t *testing.T) {
 	})
 }
 
+func TestRegistryPrecedence(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ti1      = reflect.TypeOf((*testInterface1)(nil)).Elem()
+		ti2      = reflect.TypeOf((*testInterface2)(nil)).Elem()
+		typeC    = &fakeCodec{num: 1}
+		iface1C  = &fakeCodec{num: 2}
+		iface2C  = &fakeCodec{num: 3}
+		iface2C2 = &fakeCodec{num: 4}
+		kindC    = &fakeCodec{num: 5}
+	)
+
+	reg := newTestRegistry()
+	reg.RegisterTypeEncoder(reflect.TypeOf(testInterface1Impl{}), typeC)
+	reg.RegisterTypeDecoder(reflect.TypeOf(testInterface1Impl{}), typeC)
+	reg.RegisterInterfaceEncoder(ti2, iface2C)
+	reg.RegisterInterfaceDecoder(ti2, iface2C)
+	reg.RegisterInterfaceEncoder(ti1, iface1C)
+	reg.RegisterInterfaceDecoder(ti1, iface1C)
+	reg.RegisterKindEncoder(reflect.Int, kindC)
+	reg.RegisterKindDecoder(reflect.Int, kindC)
+
+	// Re-registering an interface replaces its codec but keeps its precedence.
+	reg.RegisterInterfaceEncoder(ti2, iface2C2)
+	reg.RegisterInterfaceDecoder(ti2, iface2C2)
+
+	testCases := []struct {
+		name string
+		typ  reflect.Type
+		want *fakeCodec
+	}{
+		{"type codec before interface codec", reflect.TypeOf(testInterface1Impl{}), typeC},
+		{"first registered interface", reflect.TypeOf(testInterface12Impl{}), iface2C2},
+		{"interface codec before kind codec", reflect.TypeOf(testInterface1Int(0)), iface1C},
+		{"interface type", ti1, iface1C},
+		{"kind codec", reflect.TypeOf(0), kindC},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			enc, err := reg.LookupEncoder(tc.typ)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, enc)
+
+			dec, err := reg.LookupDecoder(tc.typ)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, dec)
+		})
+	}
+}
+
 // get is only for testing as it does return if the value was found
 func (c *kindEncoderCache) get(rt reflect.Kind) ValueEncoder {
 	e, _ := c.Load(rt)
@@ -557,6 +612,17 @@ type testInterface2 interface{ test2() }
 type testInterface3 interface{ test3() }
 type testInterface4 interface{ test4() }
 
+// testInterface12Impl implements both testInterface1 and testInterface2.
+type testInterface12Impl struct{}
+
+func (testInterface12Impl) test1() {}
+func (testInterface12Impl) test2() {}
+
+// testInterface1Int is an int kind that implements testInterface1.
+type testInterface1Int int
+
+func (testInterface1Int) test1() {}
+
 type testInterface1Impl struct{}
 
 var _ testInterface1 = testInterface1Impl{}
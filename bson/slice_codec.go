@@ -36,6 +36,13 @@ func (sc *sliceCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect.
 		return vw.WriteBinary(byteSlice)
 	}
 
+	if ec.sliceAsVector || ec.float32SliceAsVector {
+		if v, ok := vectorFromSlice(val, ec.sliceAsVector); ok {
+			b := v.Binary()
+			return vw.WriteBinaryWithSubtype(b.Data, b.Subtype)
+		}
+	}
+
 	// If we have a []E we want to treat it as a document instead of as an array.
 	if val.Type() == tD || val.Type().ConvertibleTo(tD) {
 		d := val.Convert(tD).Interface().(D)
@@ -112,6 +119,9 @@ func (sc *sliceCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect.
 			return fmt.Errorf("cannot decode document into %s", val.Type())
 		}
 	case TypeBinary:
+		if kind := val.Type().Elem().Kind(); kind == reflect.Float32 || kind == reflect.Int8 {
+			return decodeVectorIntoSlice(vr, val)
+		}
 		if val.Type().Elem() != tByte {
 			return fmt.Errorf("SliceDecodeValue can only decode a binary into a byte array, got %v", vrType)
 		}
@@ -171,3 +181,60 @@ func (sc *sliceCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect.
 
 	return nil
 }
+
+// vectorFromSlice converts val to a float32 Vector if it is a slice of float32
+// values. If int8s is true, slices of int8 values are converted to an int8
+// Vector.
+func vectorFromSlice(val reflect.Value, int8s bool) (Vector, bool) {
+	switch kind := val.Type().Elem().Kind(); {
+	case kind == reflect.Float32:
+		data := make([]float32, val.Len())
+		for i := range data {
+			data[i] = float32(val.Index(i).Float())
+		}
+		return NewVector(data), true
+	case kind == reflect.Int8 && int8s:
+		data := make([]int8, val.Len())
+		for i := range data {
+			data[i] = int8(val.Index(i).Int())
+		}
+		return NewVector(data), true
+	default:
+		return Vector{}, false
+	}
+}
+
+// decodeVectorIntoSlice decodes a BSON binary vector into val, which must be a
+// slice of float32 or int8 values matching the vector type.
+func decodeVectorIntoSlice(vr ValueReader, val reflect.Value) error {
+	data, subtype, err := vr.ReadBinary()
+	if err != nil {
+		return err
+	}
+	if subtype != TypeBinaryVector {
+		return fmt.Errorf("SliceDecodeValue can only decode a binary of subtype 0x09 into %s, got %v", val.Type(), subtype)
+	}
+	v, err := NewVectorFromBinary(Binary{Subtype: subtype, Data: data})
+	if err != nil {
+		return err
+	}
+
+	var elems reflect.Value
+	switch kind := val.Type().Elem().Kind(); {
+	case kind == reflect.Float32 && v.Type() == Float32Vector:
+		elems = reflect.ValueOf(v.Float32())
+	case kind == reflect.Int8 && v.Type() == Int8Vector:
+		elems = reflect.ValueOf(v.Int8())
+	default:
+		return fmt.Errorf("cannot decode %v into %s", vectorTypeName(v.Type()), val.Type())
+	}
+
+	if val.IsNil() {
+		val.Set(reflect.MakeSlice(val.Type(), 0, elems.Len()))
+	}
+	val.SetLen(0)
+	for i := 0; i < elems.Len(); i++ {
+		val.Set(reflect.Append(val, elems.Index(i).Convert(val.Type().Elem())))
+	}
+	return nil
+}
@@ -50,6 +50,9 @@ func (sc *stringCodec) decodeType(dc DecodeContext, vr ValueReader, t reflect.Ty
 		if err != nil {
 			return emptyValue, err
 		}
+		if dc.stringInterner != nil {
+			str = dc.stringInterner.intern(str)
+		}
 	case TypeObjectID:
 		if dc.objectIDAsHexString {
 			oid, err := vr.ReadObjectID()
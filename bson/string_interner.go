@@ -0,0 +1,70 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import "sync"
+
+// maxInternedStringLen is the maximum length of the strings that a
+// StringInterner interns. Longer strings are rarely repeated, so they are not
+// worth the cost of a lookup.
+const maxInternedStringLen = 256
+
+// StringInterner is a bounded cache of string values that lets a Decoder reuse
+// a single copy of each distinct string it decodes, instead of allocating a
+// new copy for every occurrence. It reduces the memory held by decoded values
+// that contain many repeated strings, such as the values of a low-cardinality
+// field in a large result set. The same StringInterner can be used by several
+// Decoders to share the cache across documents. It is safe for concurrent use.
+//
+// A StringInterner holds at most a fixed number of distinct strings. Once it
+// is full, strings that it does not hold are decoded as usual. Strings longer
+// than 256 bytes are never interned.
+type StringInterner struct {
+	mu      sync.Mutex
+	strings map[string]string
+	max     int
+}
+
+// NewStringInterner returns a new StringInterner that holds at most
+// maxStrings distinct strings. If maxStrings is not positive, the
+// StringInterner does not intern any string.
+func NewStringInterner(maxStrings int) *StringInterner {
+	if maxStrings < 0 {
+		maxStrings = 0
+	}
+	return &StringInterner{
+		strings: make(map[string]string),
+		max:     maxStrings,
+	}
+}
+
+// Len returns the number of distinct strings held by si.
+func (si *StringInterner) Len() int {
+	si.mu.Lock()
+	defer si.mu.Unlock()
+
+	return len(si.strings)
+}
+
+// intern returns the copy of s held by si, adding s to si if it does not hold
+// it and is not full.
+func (si *StringInterner) intern(s string) string {
+	if len(s) > maxInternedStringLen {
+		return s
+	}
+
+	si.mu.Lock()
+	defer si.mu.Unlock()
+
+	if interned, ok := si.strings[s]; ok {
+		return interned
+	}
+	if len(si.strings) < si.max {
+		si.strings[s] = s
+	}
+	return s
+}
@@ -0,0 +1,118 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// sameString reports whether a and b share the same memory.
+func sameString(a, b string) bool {
+	ha := (*reflect.StringHeader)(unsafe.Pointer(&a))
+	hb := (*reflect.StringHeader)(unsafe.Pointer(&b))
+	return ha.Data == hb.Data && ha.Len == hb.Len
+}
+
+func TestStringInterner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bounded", func(t *testing.T) {
+		t.Parallel()
+
+		si := NewStringInterner(2)
+		a := si.intern(strings.Repeat("a", 2))
+		assert.True(t, sameString(a, si.intern(strings.Repeat("a", 2))), "expected interned string to be reused")
+		si.intern("b")
+		c := si.intern(strings.Repeat("c", 3))
+		assert.Equal(t, 2, si.Len())
+		assert.False(t, sameString(c, si.intern(strings.Repeat("c", 3))), "expected no string to be added to a full interner")
+	})
+	t.Run("long strings", func(t *testing.T) {
+		t.Parallel()
+
+		si := NewStringInterner(10)
+		si.intern(strings.Repeat("a", maxInternedStringLen+1))
+		assert.Equal(t, 0, si.Len())
+	})
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		si := NewStringInterner(-1)
+		si.intern("a")
+		assert.Equal(t, 0, si.Len())
+	})
+}
+
+func TestDecoderInternStrings(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		Status string
+		Tags   []string
+	}
+
+	docs := []bsoncore.Document{
+		bsoncore.NewDocumentBuilder().
+			AppendString("status", "active").
+			AppendArray("tags", bsoncore.NewArrayBuilder().AppendString("x").AppendString("y").Build()).
+			Build(),
+		bsoncore.NewDocumentBuilder().
+			AppendString("status", "active").
+			AppendArray("tags", bsoncore.NewArrayBuilder().AppendString("y").Build()).
+			Build(),
+	}
+
+	decode := func(t *testing.T, si *StringInterner, doc bsoncore.Document, val any) {
+		t.Helper()
+
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(doc)))
+		dec.InternStrings(si)
+		require.NoError(t, dec.Decode(val))
+	}
+
+	t.Run("structs", func(t *testing.T) {
+		t.Parallel()
+
+		si := NewStringInterner(10)
+		var first, second record
+		decode(t, si, docs[0], &first)
+		decode(t, si, docs[1], &second)
+
+		assert.Equal(t, record{Status: "active", Tags: []string{"y"}}, second)
+		assert.True(t, sameString(first.Status, second.Status), "expected status to be interned")
+		assert.True(t, sameString(first.Tags[1], second.Tags[0]), "expected tags to be interned")
+		assert.Equal(t, 3, si.Len())
+	})
+	t.Run("documents", func(t *testing.T) {
+		t.Parallel()
+
+		si := NewStringInterner(10)
+		var first, second M
+		decode(t, si, docs[0], &first)
+		decode(t, si, docs[1], &second)
+
+		assert.True(t, sameString(first["status"].(string), second["status"].(string)),
+			"expected status to be interned")
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		var first, second record
+		decode(t, nil, docs[0], &first)
+		decode(t, nil, docs[1], &second)
+
+		assert.False(t, sameString(first.Status, second.Status), "expected status not to be interned")
+	})
+}
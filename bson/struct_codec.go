@@ -16,6 +16,11 @@ import (
 	"time"
 )
 
+// ErrUnknownField is wrapped by the error returned when a BSON document contains an element that
+// does not map to a field of the destination struct and the Decoder was configured with
+// DisallowUnknownFields.
+var ErrUnknownField = errors.New("unknown field")
+
 // DecodeError represents an error that occurs when unmarshalling BSON bytes into a native Go type.
 type DecodeError struct {
 	keys    []string
@@ -176,6 +181,8 @@ func (sc *structCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect
 			nilByteSliceAsEmpty:     ec.nilByteSliceAsEmpty,
 			omitZeroStruct:          ec.omitZeroStruct,
 			useJSONStructTags:       ec.useJSONStructTags,
+			float32SliceAsVector:    ec.float32SliceAsVector,
+			sliceAsVector:           desc.vector,
 		}
 		err = encoder.EncodeValue(ectx, vw2, rv)
 		if err != nil {
@@ -286,8 +293,9 @@ func (sc *structCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect
 
 		if !exists {
 			if sd.inlineMap < 0 {
-				// The encoding/json package requires a flag to return on error for non-existent fields.
-				// This functionality seems appropriate for the struct codec.
+				if dc.disallowUnknownFields {
+					return newDecodeError(name, fmt.Errorf("%w in %v", ErrUnknownField, val.Type()))
+				}
 				err = vr.Skip()
 				if err != nil {
 					return err
@@ -341,15 +349,19 @@ func (sc *structCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect
 		field = field.Addr()
 
 		dctx := DecodeContext{
-			Registry:            dc.Registry,
-			truncate:            fd.truncate || dc.truncate,
-			defaultDocumentType: dc.defaultDocumentType,
-			binaryAsSlice:       dc.binaryAsSlice,
-			objectIDAsHexString: dc.objectIDAsHexString,
-			useJSONStructTags:   dc.useJSONStructTags,
-			useLocalTimeZone:    dc.useLocalTimeZone,
-			zeroMaps:            dc.zeroMaps,
-			zeroStructs:         dc.zeroStructs,
+			Registry:              dc.Registry,
+			truncate:              fd.truncate || dc.truncate,
+			defaultDocumentType:   dc.defaultDocumentType,
+			binaryAsSlice:         dc.binaryAsSlice,
+			disallowUnknownFields: dc.disallowUnknownFields,
+			integersAsInt64:       dc.integersAsInt64,
+			numbersAsJSONNumber:   dc.numbersAsJSONNumber,
+			objectIDAsHexString:   dc.objectIDAsHexString,
+			useJSONStructTags:     dc.useJSONStructTags,
+			useLocalTimeZone:      dc.useLocalTimeZone,
+			zeroMaps:              dc.zeroMaps,
+			zeroStructs:           dc.zeroStructs,
+			stringInterner:        dc.stringInterner,
 		}
 
 		if fd.decoder == nil {
@@ -410,6 +422,7 @@ type fieldDescription struct {
 	omitEmpty bool
 	minSize   bool
 	truncate  bool
+	vector    bool
 	inline    []int
 	encoder   ValueEncoder
 	decoder   ValueDecoder
@@ -508,11 +521,11 @@ func (sc *structCodec) describeStructSlow(
 		var stags *structTags
 		// If the caller requested that we use JSON struct tags, use the JSONFallbackStructTagParser
 		// instead of the parser defined on the codec.
-		if useJSONStructTags {
-			stags, err = parseJSONStructTags(sf)
-		} else {
-			stags, err = parseStructTags(sf)
+		tagKey := "bson"
+		if r.structTagKey != "" {
+			tagKey = r.structTagKey
 		}
+		stags, err = parseStructTagsWithKey(sf, tagKey, useJSONStructTags, r.fieldNameFunc)
 		if err != nil {
 			return nil, err
 		}
@@ -523,6 +536,7 @@ func (sc *structCodec) describeStructSlow(
 		description.omitEmpty = stags.OmitEmpty
 		description.minSize = stags.MinSize
 		description.truncate = stags.Truncate
+		description.vector = stags.Vector
 
 		if stags.Inline {
 			sd.inline = true
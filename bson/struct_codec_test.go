@@ -7,11 +7,13 @@
 package bson
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 )
 
 func TestIsZero(t *testing.T) {
@@ -158,3 +160,87 @@ func TestIsZero(t *testing.T) {
 		})
 	}
 }
+
+func TestStructCodecRegistryNaming(t *testing.T) {
+	t.Parallel()
+
+	type account struct {
+		UserID    string `json:"uid"`
+		CreatedAt int64
+		Secret    string `json:"-" bson:"secret"`
+	}
+
+	testCases := []struct {
+		description string
+		configure   func(*Registry)
+		want        D
+	}{
+		{
+			description: "default",
+			configure:   func(*Registry) {},
+			want: D{
+				{Key: "userid", Value: "alice"},
+				{Key: "createdat", Value: int64(1)},
+				{Key: "secret", Value: "s"},
+			},
+		},
+		{
+			description: "json tag key",
+			configure:   func(r *Registry) { r.SetStructTagKey("json") },
+			want: D{
+				{Key: "uid", Value: "alice"},
+				{Key: "createdat", Value: int64(1)},
+			},
+		},
+		{
+			description: "json tag key and snake case",
+			configure: func(r *Registry) {
+				r.SetStructTagKey("json")
+				r.SetFieldNameFunc(SnakeCaseFieldName)
+			},
+			want: D{
+				{Key: "uid", Value: "alice"},
+				{Key: "created_at", Value: int64(1)},
+			},
+		},
+		{
+			description: "camel case",
+			configure:   func(r *Registry) { r.SetFieldNameFunc(CamelCaseFieldName) },
+			want: D{
+				{Key: "userID", Value: "alice"},
+				{Key: "createdAt", Value: int64(1)},
+				{Key: "secret", Value: "s"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			reg := NewRegistry()
+			tc.configure(reg)
+
+			buf := new(bytes.Buffer)
+			enc := NewEncoder(NewDocumentWriter(buf))
+			enc.SetRegistry(reg)
+			in := account{UserID: "alice", CreatedAt: 1, Secret: "s"}
+			require.NoError(t, enc.Encode(in), "Encode error")
+
+			var got D
+			require.NoError(t, Unmarshal(buf.Bytes(), &got), "Unmarshal error")
+			assert.Equal(t, tc.want, got, "expected and actual encoded documents do not match")
+
+			dec := NewDecoder(NewDocumentReader(bytes.NewReader(buf.Bytes())))
+			dec.SetRegistry(reg)
+			var out account
+			require.NoError(t, dec.Decode(&out), "Decode error")
+			want := in
+			if len(tc.want) == 2 {
+				want.Secret = ""
+			}
+			assert.Equal(t, want, out, "expected and actual decoded values do not match")
+		})
+	}
+}
@@ -9,6 +9,7 @@ package bson
 import (
 	"reflect"
 	"strings"
+	"unicode"
 )
 
 // structTags represents the struct tag fields that the StructCodec uses during
@@ -29,6 +30,9 @@ import (
 //	Truncate   When unmarshaling a BSON double, it is permitted to lose precision to fit within
 //	           a float32.
 //
+//	Vector     Marshal a float32 or int8 slice as a BSON binary vector (subtype 9) instead
+//	           of a BSON array.
+//
 //	Inline     Inline the field, which must be a struct or a map, causing all of its fields
 //	           or keys to be processed as if they were part of the outer struct. For maps,
 //	           keys must not conflict with the bson keys of other struct fields.
@@ -40,6 +44,7 @@ type structTags struct {
 	OmitEmpty bool
 	MinSize   bool
 	Truncate  bool
+	Vector    bool
 	Inline    bool
 	Skip      bool
 }
@@ -59,32 +64,43 @@ type structTags struct {
 //
 //	type T struct {
 //	    A bool
-//	    B int    "myb"
-//	    C string "myc,omitempty"
-//	    D string `bson:",omitempty" json:"jsonkey"`
-//	    E int64  ",minsize"
-//	    F int64  "myf,omitempty,minsize"
+//	    B int       "myb"
+//	    C string    "myc,omitempty"
+//	    D string    `bson:",omitempty" json:"jsonkey"`
+//	    E int64     ",minsize"
+//	    F int64     "myf,omitempty,minsize"
+//	    G []float32 "embedding,vector"
 //	}
 //
 // A struct tag either consisting entirely of '-' or with a bson key with a
 // value consisting entirely of '-' will return a StructTags with Skip true and
 // the remaining fields will be their default values.
 func parseStructTags(sf reflect.StructField) (*structTags, error) {
-	key := strings.ToLower(sf.Name)
-	tag, ok := sf.Tag.Lookup("bson")
-	if !ok && !strings.Contains(string(sf.Tag), ":") && len(sf.Tag) > 0 {
-		tag = string(sf.Tag)
-	}
-	return parseTags(key, tag)
+	return parseStructTagsWithKey(sf, "bson", false, nil)
 }
 
 // jsonStructTagParser has the same behavior as DefaultStructTagParser
 // but will also fallback to parsing the json tag instead on a field where the
 // bson tag isn't available.
 func parseJSONStructTags(sf reflect.StructField) (*structTags, error) {
-	key := strings.ToLower(sf.Name)
-	tag, ok := sf.Tag.Lookup("bson")
-	if !ok {
+	return parseStructTagsWithKey(sf, "bson", true, nil)
+}
+
+// parseStructTagsWithKey parses the struct tag of sf with the given key. If useJSON is true, the
+// "json" struct tag is used if sf does not have a tag with the given key. If the tag does not
+// specify a name, nameFunc is applied to the Go field name, which is lowercased if nameFunc is nil.
+func parseStructTagsWithKey(
+	sf reflect.StructField,
+	tagKey string,
+	useJSON bool,
+	nameFunc func(string) string,
+) (*structTags, error) {
+	if nameFunc == nil {
+		nameFunc = strings.ToLower
+	}
+	key := nameFunc(sf.Name)
+	tag, ok := sf.Tag.Lookup(tagKey)
+	if !ok && useJSON {
 		tag, ok = sf.Tag.Lookup("json")
 	}
 	if !ok && !strings.Contains(string(sf.Tag), ":") && len(sf.Tag) > 0 {
@@ -114,6 +130,8 @@ func parseTags(key string, tag string) (*structTags, error) {
 			st.Truncate = true
 		case "inline":
 			st.Inline = true
+		case "vector":
+			st.Vector = true
 		}
 	}
 
@@ -121,3 +139,41 @@ func parseTags(key string, tag string) (*structTags, error) {
 
 	return &st, nil
 }
+
+// CamelCaseFieldName converts a Go field name to camelCase by lowercasing its leading word, e.g.
+// "UserID" becomes "userID" and "HTTPServer" becomes "httpServer". It can be passed to
+// Registry.SetFieldNameFunc.
+func CamelCaseFieldName(name string) string {
+	runes := []rune(name)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	// Keep the last upper case letter of an acronym that is followed by another word, e.g. the
+	// "S" of "HTTPServer".
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// SnakeCaseFieldName converts a Go field name to snake_case, e.g. "UserID" becomes "user_id" and
+// "HTTPServer" becomes "http_server". It can be passed to Registry.SetFieldNameFunc.
+func SnakeCaseFieldName(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
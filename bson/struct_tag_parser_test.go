@@ -68,6 +68,12 @@ func TestStructTagParsers(t *testing.T) {
 			&structTags{Name: "foo", OmitEmpty: true, MinSize: true, Truncate: true, Inline: true},
 			parseStructTags,
 		},
+		{
+			"default vector",
+			reflect.StructField{Name: "foo", Tag: reflect.StructTag(`bson:"embedding,vector"`)},
+			&structTags{Name: "embedding", Vector: true},
+			parseStructTags,
+		},
 		{
 			"default ignore xml",
 			reflect.StructField{Name: "foo", Tag: reflect.StructTag(`xml:"bar"`)},
@@ -158,3 +164,26 @@ func TestStructTagParsers(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldNameFuncs(t *testing.T) {
+	testCases := []struct {
+		name  string
+		camel string
+		snake string
+	}{
+		{"Name", "name", "name"},
+		{"UserID", "userID", "user_id"},
+		{"HTTPServer", "httpServer", "http_server"},
+		{"ID", "id", "id"},
+		{"Field1Value", "field1Value", "field1_value"},
+		{"already", "already", "already"},
+	}
+	for _, tc := range testCases {
+		if got := CamelCaseFieldName(tc.name); got != tc.camel {
+			t.Errorf("CamelCaseFieldName(%q) = %q; want %q", tc.name, got, tc.camel)
+		}
+		if got := SnakeCaseFieldName(tc.name); got != tc.snake {
+			t.Errorf("SnakeCaseFieldName(%q) = %q; want %q", tc.name, got, tc.snake)
+		}
+	}
+}
@@ -34,16 +34,21 @@ type vectorTypeError struct {
 
 // Error implements the error interface.
 func (vte vectorTypeError) Error() string {
-	t := "invalid"
-	switch vte.Type {
+	return fmt.Sprintf("cannot call %s, on a type %s", vte.Method, vectorTypeName(vte.Type))
+}
+
+// vectorTypeName describes the vector type t, e.g. "float32 vector".
+func vectorTypeName(t byte) string {
+	switch t {
 	case Int8Vector:
-		t = "int8"
+		return "int8 vector"
 	case Float32Vector:
-		t = "float32"
+		return "float32 vector"
 	case PackedBitVector:
-		t = "packed bit"
+		return "packed bit vector"
+	default:
+		return "invalid vector"
 	}
-	return fmt.Sprintf("cannot call %s, on a type %s vector", vte.Method, t)
 }
 
 // Vector represents a densely packed array of numbers / bits.
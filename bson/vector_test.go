@@ -0,0 +1,122 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+type embedding float32
+
+func TestVectorStructTag(t *testing.T) {
+	t.Parallel()
+
+	type document struct {
+		Floats   []float32   `bson:"floats,vector"`
+		Ints     []int8      `bson:"ints,vector"`
+		Named    []embedding `bson:"named,vector"`
+		Array    []float32   `bson:"array"`
+		Nil      []float32   `bson:"nil,vector"`
+		Pointers *[]float32  `bson:"pointers,vector"`
+	}
+
+	floats := []float32{0.5, -1}
+	in := document{
+		Floats:   floats,
+		Ints:     []int8{-128, 127},
+		Named:    []embedding{0.25},
+		Array:    []float32{1},
+		Pointers: &floats,
+	}
+
+	got, err := Marshal(in)
+	require.NoError(t, err, "Marshal error")
+
+	want := bsoncore.NewDocumentBuilder().
+		AppendBinary("floats", TypeBinaryVector, NewVector(floats).Binary().Data).
+		AppendBinary("ints", TypeBinaryVector, NewVector([]int8{-128, 127}).Binary().Data).
+		AppendBinary("named", TypeBinaryVector, NewVector([]float32{0.25}).Binary().Data).
+		AppendArray("array", bsoncore.NewArrayBuilder().AppendDouble(1).Build()).
+		AppendNull("nil").
+		AppendBinary("pointers", TypeBinaryVector, NewVector(floats).Binary().Data).
+		Build()
+	assert.Equal(t, bsoncore.Document(want).String(), Raw(got).String(), "unexpected marshaled document")
+
+	var out document
+	err = Unmarshal(got, &out)
+	require.NoError(t, err, "Unmarshal error")
+	assert.Equal(t, in, out, "expected the document to round trip")
+}
+
+func TestDecodeVectorIntoSlice(t *testing.T) {
+	t.Parallel()
+
+	bitVector, err := NewPackedBitVector([]byte{0xff}, 0)
+	require.NoError(t, err, "NewPackedBitVector error")
+
+	testCases := []struct {
+		name    string
+		value   any
+		dst     any
+		want    any
+		wantErr string
+	}{
+		{
+			name:  "float32",
+			value: NewVector([]float32{1.5, 2}),
+			dst:   new([]float32),
+			want:  []float32{1.5, 2},
+		},
+		{
+			name:  "int8",
+			value: NewVector([]int8{-1, 1}),
+			dst:   new([]int8),
+			want:  []int8{-1, 1},
+		},
+		{
+			name:    "mismatched type",
+			value:   NewVector([]int8{1}),
+			dst:     new([]float32),
+			wantErr: "cannot decode int8 vector into []float32",
+		},
+		{
+			name:    "packed bit",
+			value:   bitVector,
+			dst:     new([]int8),
+			wantErr: "cannot decode packed bit vector into []int8",
+		},
+		{
+			name:    "generic binary",
+			value:   Binary{Subtype: TypeBinaryGeneric, Data: []byte{1}},
+			dst:     new([]float32),
+			wantErr: "can only decode a binary of subtype 0x09",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := Marshal(D{{Key: "v", Value: tc.value}})
+			require.NoError(t, err, "Marshal error")
+
+			err = Raw(b).Lookup("v").Unmarshal(tc.dst)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err, "Unmarshal error")
+			assert.Equal(t, tc.want, reflect.ValueOf(tc.dst).Elem().Interface(), "unexpected decoded slice")
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 type ServerDescription struct {
 	Addr                     address.Address
 	Arbiters                 []string
+	AverageRTT               time.Duration
 	Compression              []string // compression methods returned by server
 	CanonicalAddr            address.Address
 	ElectionID               bson.ObjectID
@@ -28,6 +29,8 @@ type ServerDescription struct {
 	HelloOK                  bool
 	Hosts                    []string
 	Kind                     string
+	LastError                error // The error that caused the server to be marked Unknown, if any.
+	LastUpdateTime           time.Time
 	LastWriteTime            time.Time
 	MaxBatchCount            uint32
 	MaxDocumentSize          uint32
@@ -39,6 +42,7 @@ type ServerDescription struct {
 	Passive                  bool
 	Primary                  address.Address
 	ReadOnly                 bool
+	RTTStats                 RTTStats
 	ServiceID                *bson.ObjectID // Only set for servers that are deployed behind a load balancer.
 	SessionTimeoutMinutes    *int64
 	SetName                  string
@@ -48,6 +52,28 @@ type ServerDescription struct {
 	TopologyVersionCounter   int64
 }
 
+// RTTStats contains statistics about the round-trip times measured by the
+// monitor of a server, in addition to the moving average in AverageRTT.
+type RTTStats struct {
+	// Samples are the most recent round-trip times, oldest first.
+	Samples []time.Duration
+
+	// P90 is the 90th percentile of Samples.
+	P90 time.Duration
+
+	// Histogram counts all round-trip times measured since the server was
+	// last marked unknown.
+	Histogram []RTTBucket
+}
+
+// RTTBucket is a bucket of an RTT histogram. It counts the round-trip times
+// that are greater than the UpperBound of the previous bucket and at most
+// UpperBound. The UpperBound of the last bucket is the maximum time.Duration.
+type RTTBucket struct {
+	UpperBound time.Duration
+	Count      int64
+}
+
 // TopologyDescription contains information about a MongoDB cluster.
 type TopologyDescription struct {
 	Servers               []ServerDescription
@@ -27,6 +27,10 @@ type CommandStartedEvent struct {
 	// ServiceID contains the ID of the server to which the command was sent if it is running behind a load balancer.
 	// Otherwise, it is unset.
 	ServiceID *bson.ObjectID
+	// Time is the time at which the command was sent. It is obtained from time.Now, so it contains both a wall clock
+	// and a monotonic clock reading, and durations computed between event times with Time.Sub are not affected by
+	// changes to the system clock.
+	Time time.Time
 }
 
 // CommandFinishedEvent represents a generic command finishing.
@@ -42,6 +46,16 @@ type CommandFinishedEvent struct {
 	// ServiceID contains the ID of the server to which the command was sent if it is running behind a load balancer.
 	// Otherwise, it is unset.
 	ServiceID *bson.ObjectID
+	// Time is the time at which the reply was received or the command failed. Like CommandStartedEvent.Time, it
+	// contains both a wall clock and a monotonic clock reading.
+	Time time.Time
+	// OperationTime is the operationTime reported by the server in the reply. It is unset if the reply does not
+	// contain an operationTime or the command is sensitive.
+	OperationTime *bson.Timestamp
+	// ClusterTime is the $clusterTime document reported by the server in the reply. It is unset if the reply does not
+	// contain a $clusterTime or the command is sensitive. OperationTime and ClusterTime can be used to correlate
+	// events from different hosts with the server's logical clock.
+	ClusterTime bson.Raw
 }
 
 // CommandSucceededEvent represents an event generated when a command's execution succeeds.
@@ -63,6 +77,37 @@ type CommandMonitor struct {
 	Failed    func(context.Context, *CommandFailedEvent)
 }
 
+// WireMessageEvent represents a raw wire message sent or received on a connection. OP_COMPRESSED messages are
+// decompressed, so Message is the OP_MSG, or legacy OP_QUERY or OP_REPLY, message that was compressed.
+type WireMessageEvent struct {
+	// ConnectionID is the address of the server and the driver ID of the connection, in the form of the ConnectionID
+	// of command events.
+	ConnectionID string
+	// ServerConnectionID is the server's ID for the connection. It is unset for messages sent before the server
+	// reported it in the handshake.
+	ServerConnectionID *int64
+	RequestID          int32
+	ResponseTo         int32
+	// OpCode is the opcode of Message.
+	OpCode int32
+	// Compressed is true if the message was sent or received as an OP_COMPRESSED message.
+	Compressed bool
+	// Message is a copy of the wire message, including its header.
+	Message []byte
+	// Time is the time at which the message was sent or received. It contains both a wall clock and a monotonic clock
+	// reading.
+	Time time.Time
+}
+
+// WireMonitor represents a monitor that receives the raw wire messages sent and received on the connections of a
+// client, including the connection handshakes, authentication conversations, and server heartbeats. The messages
+// contain the documents of commands and replies without redaction, including credentials, so they should only be
+// collected for debugging.
+type WireMonitor struct {
+	Sent     func(context.Context, *WireMessageEvent)
+	Received func(context.Context, *WireMessageEvent)
+}
+
 // strings for pool command monitoring reasons
 const (
 	ReasonIdle              = "idle"
@@ -108,6 +153,8 @@ type PoolEvent struct {
 	ServiceID    *bson.ObjectID `json:"serviceId"`
 	Interruption bool           `json:"interruptInUseConnections"`
 	Error        error          `json:"error"`
+	// Time is the time at which the event occurred. It contains both a wall clock and a monotonic clock reading.
+	Time time.Time `json:"time"`
 }
 
 // PoolMonitor is a function that allows the user to gain access to events occurring in the pool
@@ -135,6 +182,31 @@ type ServerClosedEvent struct {
 	TopologyID bson.ObjectID // A unique identifier for the topology this server is a part of
 }
 
+// ServerTrippedEvent is an event generated when the circuit breaker configured with
+// options.ClientOptions.SetCircuitBreaker trips a server because of its error rate or latency.
+type ServerTrippedEvent struct {
+	Address    address.Address
+	TopologyID bson.ObjectID // A unique identifier for the topology this server is a part of
+
+	// Operations and Failures are the number of operations and failed operations recorded for the
+	// server in the window that tripped it.
+	Operations int
+	Failures   int
+
+	// AverageLatency is the average latency of the operations recorded in the window.
+	AverageLatency time.Duration
+
+	// Until is the time at which the server will be restored.
+	Until time.Time
+}
+
+// ServerRestoredEvent is an event generated when the cooldown of a tripped server expires. It is
+// published the next time the server is considered by server selection or runs an operation.
+type ServerRestoredEvent struct {
+	Address    address.Address
+	TopologyID bson.ObjectID // A unique identifier for the topology this server is a part of
+}
+
 // TopologyDescriptionChangedEvent represents a topology description change.
 type TopologyDescriptionChangedEvent struct {
 	TopologyID          bson.ObjectID // A unique identifier for the topology this server is a part of
@@ -162,8 +234,9 @@ type ServerHeartbeatStartedEvent struct {
 type ServerHeartbeatSucceededEvent struct {
 	Duration     time.Duration
 	Reply        ServerDescription
-	ConnectionID string // The address this heartbeat was sent to with a unique identifier
-	Awaited      bool   // If this heartbeat was awaitable
+	ConnectionID string   // The address this heartbeat was sent to with a unique identifier
+	Awaited      bool     // If this heartbeat was awaitable
+	RTTStats     RTTStats // The round-trip time statistics of the server when the heartbeat succeeded
 }
 
 // ServerHeartbeatFailedEvent is an event generated when the heartbeat fails.
@@ -190,4 +263,6 @@ type ServerMonitor struct {
 	ServerHeartbeatStarted     func(*ServerHeartbeatStartedEvent)
 	ServerHeartbeatSucceeded   func(*ServerHeartbeatSucceededEvent)
 	ServerHeartbeatFailed      func(*ServerHeartbeatFailedEvent)
+	ServerTripped              func(*ServerTrippedEvent)
+	ServerRestored             func(*ServerRestoredEvent)
 }
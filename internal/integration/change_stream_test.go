@@ -810,6 +810,62 @@ func TestChangeStream_ReplicaSet(t *testing.T) {
 
 		wg.Wait()
 	})
+
+	mt.RunOpts("pre- and post-images", mtest.NewOptions().MinServerVersion("6.0"), func(mt *mtest.T) {
+		_, err := mt.Coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+		require.NoError(mt, err, "InsertOne error")
+
+		opts := options.ChangeStream().SetFullDocumentBeforeChange(options.Required)
+		err = mt.Coll.ValidateWatchOptions(context.Background(), opts)
+		assert.ErrorIs(mt, err, mongo.ErrPreAndPostImagesDisabled)
+
+		err = mt.Coll.EnableChangeStreamPreAndPostImages(context.Background(), true)
+		require.NoError(mt, err, "EnableChangeStreamPreAndPostImages error")
+
+		enabled, err := mt.Coll.ChangeStreamPreAndPostImagesEnabled(context.Background())
+		require.NoError(mt, err, "ChangeStreamPreAndPostImagesEnabled error")
+		assert.True(mt, enabled, "expected pre- and post-images to be enabled")
+
+		err = mt.Coll.ValidateWatchOptions(context.Background(), opts)
+		assert.NoError(mt, err, "ValidateWatchOptions error")
+
+		cs, err := mt.Coll.Watch(context.Background(), mongo.Pipeline{}, opts)
+		require.NoError(mt, err, "Watch error")
+		defer closeStream(cs)
+
+		_, err = mt.Coll.UpdateOne(context.Background(), bson.D{{"x", 1}}, bson.D{{"$set", bson.D{{"x", 2}}}})
+		require.NoError(mt, err, "UpdateOne error")
+
+		require.True(mt, cs.Next(context.Background()), "Next error: %v", cs.Err())
+		before := cs.Current.Lookup("fullDocumentBeforeChange", "x").Int32()
+		assert.Equal(mt, int32(1), before, "expected pre-image x 1, got %v", before)
+
+		err = mt.Coll.EnableChangeStreamPreAndPostImages(context.Background(), false)
+		require.NoError(mt, err, "EnableChangeStreamPreAndPostImages error")
+
+		enabled, err = mt.Coll.ChangeStreamPreAndPostImagesEnabled(context.Background())
+		require.NoError(mt, err, "ChangeStreamPreAndPostImagesEnabled error")
+		assert.False(mt, enabled, "expected pre- and post-images to be disabled")
+	})
+
+	mt.RunOpts("start after write", mtest.NewOptions().MinServerVersion("4.0"), func(mt *mtest.T) {
+		res, err := mt.Coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+		require.NoError(mt, err, "InsertOne error")
+
+		opts, err := mongo.StartAfterWrite(res)
+		require.NoError(mt, err, "StartAfterWrite error")
+
+		_, err = mt.Coll.InsertOne(context.Background(), bson.D{{"x", 2}})
+		require.NoError(mt, err, "InsertOne error")
+
+		cs, err := mt.Coll.Watch(context.Background(), mongo.Pipeline{}, opts)
+		require.NoError(mt, err, "Watch error")
+		defer closeStream(cs)
+
+		require.True(mt, cs.Next(context.Background()), "Next error: %v", cs.Err())
+		x := cs.Current.Lookup("fullDocument", "x").Int32()
+		assert.Equal(mt, int32(2), x, "expected the first change after the write, got x %v", x)
+	})
 }
 
 func closeStream(cs *mongo.ChangeStream) {
@@ -8,11 +8,13 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -327,6 +329,17 @@ func TestClient(t *testing.T) {
 			err := mt.Client.Ping(context.Background(), nil)
 			assert.Nil(mt, err, "Ping error: %v", err)
 		})
+		mt.Run("with result", func(mt *mtest.T) {
+			res, err := mt.Client.PingWithResult(context.Background(), mtest.PrimaryRp)
+			require.NoError(mt, err, "PingWithResult error")
+			assert.Greater(mt, res.RTT, time.Duration(0), "expected a positive RTT")
+			assert.NotEqual(mt, "", res.Address, "expected the address of the server")
+			assert.NotEqual(mt, "Unknown", res.ServerKind, "expected a known server kind")
+
+			evt := mt.GetStartedEvent()
+			require.NotNil(mt, evt, "expected a started event")
+			assert.Equal(mt, "ping", evt.CommandName, "expected ping command")
+		})
 		mt.Run("invalid host", func(mt *mtest.T) {
 			// manually create client rather than using RunOpts with ClientOptions because the testing lib will
 			// apply the correct URI.
@@ -341,6 +354,240 @@ func TestClient(t *testing.T) {
 			_ = client.Disconnect(context.Background())
 		})
 	})
+	mt.RunOpts("build info", noClientOpts, func(mt *mtest.T) {
+		first, err := mt.Client.BuildInfo(context.Background())
+		assert.Nil(mt, err, "BuildInfo error: %v", err)
+		assert.NotEqual(mt, "", first.Version, "expected a server version")
+		assert.True(mt, len(first.VersionArray) > 0, "expected a version array")
+
+		mt.ClearEvents()
+		second, err := mt.Client.BuildInfo(context.Background())
+		assert.Nil(mt, err, "BuildInfo error: %v", err)
+		assert.Equal(mt, first, second, "expected the cached build info")
+		evt := mt.GetStartedEvent()
+		assert.Nil(mt, evt, "expected no command for cached build info, got %v", evt)
+	})
+	mt.RunOpts("ensure indexes for all", noClientOpts, func(mt *mtest.T) {
+		dbNames := []string{"ensureIndexesDb1", "ensureIndexesDb2"}
+		for _, name := range dbNames {
+			defer func(name string) { _ = mt.Client.Database(name).Drop(context.Background()) }(name)
+		}
+
+		// Create a conflicting index in the second database so that it fails.
+		_, err := mt.Client.Database(dbNames[1]).Collection("orders").Indexes().CreateOne(context.Background(),
+			mongo.IndexModel{Keys: bson.D{{"x", 1}}, Options: options.Index().SetName("x_1").SetUnique(true)})
+		require.NoError(mt, err, "CreateOne error")
+
+		models := []mongo.IndexModel{{Keys: bson.D{{"x", 1}}}, {Keys: bson.D{{"y", -1}}}}
+		indexes := map[mongo.Namespace][]mongo.IndexModel{
+			{Database: dbNames[0], Collection: "orders"}: models,
+			{Database: dbNames[1], Collection: "orders"}: models,
+		}
+		results, err := mt.Client.EnsureIndexesForAll(context.Background(), indexes,
+			options.EnsureIndexes().SetConcurrency(2))
+
+		var ensureErr mongo.EnsureIndexesError
+		require.True(mt, errors.As(err, &ensureErr), "expected EnsureIndexesError, got %v", err)
+		assert.Equal(mt, []mongo.Namespace{{Database: dbNames[1], Collection: "orders"}}, ensureErr.Failed,
+			"expected second namespace to fail, got %v", ensureErr.Failed)
+
+		ok := results[mongo.Namespace{Database: dbNames[0], Collection: "orders"}]
+		assert.NoError(mt, ok.Err, "unexpected error for first namespace")
+		assert.Equal(mt, []string{"x_1", "y_-1"}, ok.Names, "expected index names, got %v", ok.Names)
+
+		failed := results[mongo.Namespace{Database: dbNames[1], Collection: "orders"}]
+		assert.Error(mt, failed.Err, "expected error for second namespace")
+
+		// Ensuring the same indexes again is a no-op.
+		delete(indexes, mongo.Namespace{Database: dbNames[1], Collection: "orders"})
+		_, err = mt.Client.EnsureIndexesForAll(context.Background(), indexes)
+		assert.NoError(mt, err, "EnsureIndexesForAll error")
+	})
+	mt.RunOpts("zones", mtest.NewOptions().Topologies(mtest.Sharded).MinServerVersion("4.4"), func(mt *mtest.T) {
+		var shard struct {
+			ID string `bson:"_id"`
+		}
+		err := mt.Client.Database("config").Collection("shards").FindOne(context.Background(), bson.D{}).Decode(&shard)
+		require.NoError(mt, err, "FindOne error")
+
+		const zone = "zoneHelpersTest"
+		err = mt.Client.AddShardToZone(context.Background(), shard.ID, zone)
+		require.NoError(mt, err, "AddShardToZone error")
+		defer func() { _ = mt.Client.RemoveShardFromZone(context.Background(), shard.ID, zone) }()
+
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		err = mt.Client.Database("admin").RunCommand(context.Background(),
+			bson.D{{"shardCollection", ns}, {"key", bson.D{{"x", 1}}}}).Err()
+		require.NoError(mt, err, "shardCollection error")
+
+		err = mt.Client.UpdateZoneKeyRange(context.Background(), ns, bson.D{{"x", 10}}, bson.D{{"x", 0}}, zone)
+		assert.ErrorContains(mt, err, "must be less than max")
+
+		err = mt.Client.UpdateZoneKeyRange(context.Background(), ns, bson.D{{"x", 0}}, bson.D{{"x", 10}}, zone)
+		require.NoError(mt, err, "UpdateZoneKeyRange error")
+
+		zones, err := mt.Client.ListZones(context.Background())
+		require.NoError(mt, err, "ListZones error")
+		var found *mongo.Zone
+		for i := range zones {
+			if zones[i].Name == zone {
+				found = &zones[i]
+			}
+		}
+		require.NotNil(mt, found, "expected zone %q in %v", zone, zones)
+		assert.Equal(mt, []string{shard.ID}, found.Shards, "expected shard in zone")
+		require.Len(mt, found.Ranges, 1, "expected one range in zone")
+		assert.Equal(mt, ns, found.Ranges[0].Namespace, "expected range namespace")
+
+		err = mt.Client.UpdateZoneKeyRange(context.Background(), ns, bson.D{{"x", 0}}, bson.D{{"x", 10}}, "")
+		assert.NoError(mt, err, "UpdateZoneKeyRange error")
+	})
+	var replies []string
+	interceptor := &options.CommandInterceptor{
+		Command: func(_ context.Context, cmd *options.InterceptedCommand) error {
+			if cmd.Name != "find" {
+				return nil
+			}
+			var doc bson.D
+			if err := bson.Unmarshal(cmd.Command, &doc); err != nil {
+				return err
+			}
+			tagged, err := bson.Marshal(append(doc, bson.E{"comment", "intercepted"}))
+			if err != nil {
+				return err
+			}
+			cmd.Command = tagged
+			return nil
+		},
+		Reply: func(_ context.Context, reply options.InterceptedReply) error {
+			if reply.Name == "find" {
+				replies = append(replies, reply.Reply.Lookup("ok").String())
+			}
+			return nil
+		},
+	}
+	interceptorOpts := mtest.NewOptions().ClientOptions(options.Client().SetCommandInterceptors(interceptor))
+	mt.RunOpts("command interceptors", interceptorOpts, func(mt *mtest.T) {
+		replies = nil
+		err := mt.Coll.FindOne(context.Background(), bson.D{}).Err()
+		assert.ErrorIs(mt, err, mongo.ErrNoDocuments, "FindOne error")
+
+		started := mt.GetStartedEvent()
+		require.NotNil(mt, started, "expected a started event")
+		assert.Equal(mt, "intercepted", started.Command.Lookup("comment").StringValue(), "expected comment to be set")
+		assert.Len(mt, replies, 1, "expected the find reply to be intercepted")
+	})
+	var wireMu sync.Mutex
+	wireSent := make(map[int32]*event.WireMessageEvent)
+	var wireReceived []*event.WireMessageEvent
+	wireMonitor := &event.WireMonitor{
+		Sent: func(_ context.Context, evt *event.WireMessageEvent) {
+			wireMu.Lock()
+			defer wireMu.Unlock()
+			wireSent[evt.RequestID] = evt
+		},
+		Received: func(_ context.Context, evt *event.WireMessageEvent) {
+			wireMu.Lock()
+			defer wireMu.Unlock()
+			wireReceived = append(wireReceived, evt)
+		},
+	}
+	wireMonitorOpts := mtest.NewOptions().ClientOptions(options.Client().SetWireMonitor(wireMonitor))
+	mt.RunOpts("wire monitor", wireMonitorOpts, func(mt *mtest.T) {
+		err := mt.Client.Ping(context.Background(), nil)
+		require.NoError(mt, err, "Ping error")
+
+		wireMu.Lock()
+		defer wireMu.Unlock()
+		var found bool
+		for _, reply := range wireReceived {
+			req, ok := wireSent[reply.ResponseTo]
+			if !ok || !strings.Contains(string(req.Message), "ping") {
+				continue
+			}
+			found = true
+			assert.Equal(mt, req.ConnectionID, reply.ConnectionID, "expected the reply on the request's connection")
+			assert.Equal(mt, int32(wiremessage.OpMsg), reply.OpCode, "expected an OP_MSG reply")
+		}
+		assert.True(mt, found, "expected the ping request and reply to be monitored")
+	})
+	mt.Run("connect and wait", func(mt *mtest.T) {
+		err := mt.Client.ConnectAndWait(context.Background())
+		assert.NoError(mt, err, "ConnectAndWait error")
+	})
+	mt.Run("health", func(mt *mtest.T) {
+		err := mt.Client.ConnectAndWait(context.Background())
+		require.NoError(mt, err, "ConnectAndWait error")
+
+		status, err := mt.Client.Health(context.Background())
+		require.NoError(mt, err, "Health error")
+		assert.True(mt, status.Ready(), "expected status to be ready, got %+v", status)
+		assert.NotEqual(mt, 0, len(status.Servers), "expected at least one server")
+	})
+	memoryLimitOpts := options.Client().SetMemoryLimit(options.MemoryLimit().SetSoftLimit(1 << 20))
+	mt.RunOpts("memory limit", mtest.NewOptions().ClientOptions(memoryLimitOpts), func(mt *mtest.T) {
+		docs := []any{bson.D{{"x", 1}}, bson.D{{"x", 2}}, bson.D{{"x", 3}}}
+		_, err := mt.Coll.InsertMany(context.Background(), docs)
+		require.NoError(mt, err, "InsertMany error")
+
+		cursor, err := mt.Coll.Find(context.Background(), bson.D{}, options.Find().SetBatchSize(2))
+		require.NoError(mt, err, "Find error")
+		require.True(mt, cursor.Next(context.Background()), "expected a document, got error %v", cursor.Err())
+		stats := mt.Client.MemoryStats()
+		assert.Greater(mt, stats.InUse, int64(0), "expected the cursor batch to be accounted")
+		assert.Equal(mt, int64(1<<20), stats.Limit, "expected the soft limit to be reported")
+
+		require.NoError(mt, cursor.Close(context.Background()), "Close error")
+		stats = mt.Client.MemoryStats()
+		assert.Equal(mt, int64(0), stats.InUse, "expected all memory to be released")
+		assert.Greater(mt, stats.Peak, int64(0), "expected a peak to be recorded")
+	})
+	mt.Run("with connection", func(mt *mtest.T) {
+		docs := []any{bson.D{{"x", 1}}, bson.D{{"x", 2}}, bson.D{{"x", 3}}}
+		_, err := mt.Coll.InsertMany(context.Background(), docs)
+		require.NoError(mt, err, "InsertMany error")
+
+		mt.ClearEvents()
+		err = mt.Client.WithConnection(context.Background(), func(ctx context.Context) error {
+			cursor, err := mt.Coll.Find(ctx, bson.D{}, options.Find().SetBatchSize(1))
+			if err != nil {
+				return err
+			}
+			var res []bson.Raw
+			if err := cursor.All(ctx, &res); err != nil {
+				return err
+			}
+			assert.Len(mt, res, 3, "expected all documents")
+			_, err = mt.Coll.CountDocuments(ctx, bson.D{})
+			return err
+		})
+		require.NoError(mt, err, "WithConnection error")
+
+		started := mt.GetAllStartedEvents()
+		require.True(mt, len(started) > 2, "expected find, getMore, and aggregate commands, got %v", started)
+		for _, evt := range started {
+			assert.Equal(mt, started[0].ConnectionID, evt.ConnectionID,
+				"expected %q to run on the pinned connection", evt.CommandName)
+		}
+	})
+	mt.Run("with connection invalidates open cursors", func(mt *mtest.T) {
+		docs := []any{bson.D{{"x", 1}}, bson.D{{"x", 2}}, bson.D{{"x", 3}}}
+		_, err := mt.Coll.InsertMany(context.Background(), docs)
+		require.NoError(mt, err, "InsertMany error")
+
+		var cursor *mongo.Cursor
+		err = mt.Client.WithConnection(context.Background(), func(ctx context.Context) error {
+			cursor, err = mt.Coll.Find(ctx, bson.D{}, options.Find().SetBatchSize(1))
+			return err
+		})
+		require.NoError(mt, err, "WithConnection error")
+
+		assert.True(mt, cursor.Next(context.Background()), "expected the first batch to be readable")
+		assert.False(mt, cursor.Next(context.Background()), "expected the cursor to be invalidated")
+		assert.True(mt, errors.Is(cursor.Err(), driver.ErrConnectionDeploymentClosed),
+			"expected error %v, got %v", driver.ErrConnectionDeploymentClosed, cursor.Err())
+		assert.NoError(mt, cursor.Close(context.Background()), "Close error")
+	})
 	mt.RunOpts("disconnect", noClientOpts, func(mt *mtest.T) {
 		mt.Run("nil context", func(mt *mtest.T) {
 			err := mt.Client.Disconnect(nil)
@@ -10,7 +10,9 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/event"
@@ -19,6 +21,8 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/pipeline"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
@@ -431,6 +435,54 @@ func TestCollection(t *testing.T) {
 			assert.Equal(mt, mongo.ErrMapForOrderedArgument{"hint"}, err, "expected error %v, got %v", mongo.ErrMapForOrderedArgument{"hint"}, err)
 		})
 	})
+	mt.RunOpts("delete by ids", noClientOpts, func(mt *mtest.T) {
+		mt.Run("deletes in batches", func(mt *mtest.T) {
+			var docs []any
+			var ids []any
+			for i := 0; i < 5; i++ {
+				docs = append(docs, bson.D{{"_id", i}})
+				ids = append(ids, i)
+			}
+			_, err := mt.Coll.InsertMany(context.Background(), docs)
+			require.NoError(mt, err, "InsertMany error: %v", err)
+			ids = append(ids, 100)
+
+			var progress []mongo.DeleteByIDsProgress
+			mt.ClearEvents()
+			res, err := mt.Coll.DeleteByIDs(context.Background(), ids, 4, func(p mongo.DeleteByIDsProgress) {
+				progress = append(progress, p)
+			})
+			require.NoError(mt, err, "DeleteByIDs error: %v", err)
+			assert.Equal(mt, int64(5), res.DeletedCount, "expected DeletedCount 5, got %v", res.DeletedCount)
+
+			mt.FilterStartedEvents(func(evt *event.CommandStartedEvent) bool {
+				return evt.CommandName == "delete"
+			})
+			deletes := len(mt.GetAllStartedEvents())
+			assert.Equal(mt, 2, deletes, "expected 2 delete commands, got %v", deletes)
+
+			want := []mongo.DeleteByIDsProgress{
+				{Processed: 4, Total: 6, Deleted: 4},
+				{Processed: 6, Total: 6, Deleted: 5},
+			}
+			assert.Equal(mt, want, progress, "expected progress %v, got %v", want, progress)
+		})
+		mt.RunOpts("stops at error", mtest.NewOptions().ClientType(mtest.Mock), func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateSuccessResponse(bson.E{"n", 2}),
+				mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Name: "InternalError", Message: "failed"}),
+			)
+
+			var calls int
+			res, err := mt.Coll.DeleteByIDs(context.Background(), []any{1, 2, 3, 4}, 2, func(mongo.DeleteByIDsProgress) {
+				calls++
+			})
+			assert.Error(mt, err, "expected DeleteByIDs error")
+			require.NotNil(mt, res, "expected partial result")
+			assert.Equal(mt, int64(2), res.DeletedCount, "expected DeletedCount 2, got %v", res.DeletedCount)
+			assert.Equal(mt, 1, calls, "expected 1 progress call, got %v", calls)
+		})
+	})
 	mt.RunOpts("update one", noClientOpts, func(mt *mtest.T) {
 		mt.Run("empty update", func(mt *mtest.T) {
 			_, err := mt.Coll.UpdateOne(context.Background(), bson.D{}, bson.D{})
@@ -827,6 +879,222 @@ func TestCollection(t *testing.T) {
 			assert.True(mt, adu, "expected field 'allowDiskUse' to be true, got false")
 		})
 	})
+	mt.RunOpts("aggregate each", noClientOpts, func(mt *mtest.T) {
+		pipeline := mongo.Pipeline{bson.D{{"$sort", bson.D{{"x", 1}}}}}
+
+		mt.Run("sequential", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+
+			var got []int32
+			err := mt.Coll.AggregateEach(context.Background(), pipeline, 0, func(doc bson.Raw) error {
+				got = append(got, doc.Lookup("x").Int32())
+				return nil
+			}, options.Aggregate().SetBatchSize(2))
+			require.NoError(mt, err, "AggregateEach error: %v", err)
+			assert.Equal(mt, []int32{1, 2, 3, 4, 5}, got, "expected documents in order, got %v", got)
+		})
+		mt.Run("workers", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+
+			var sum atomic.Int32
+			err := mt.Coll.AggregateEach(context.Background(), pipeline, 3, func(doc bson.Raw) error {
+				sum.Add(doc.Lookup("x").Int32())
+				return nil
+			}, options.Aggregate().SetBatchSize(2))
+			require.NoError(mt, err, "AggregateEach error: %v", err)
+			assert.Equal(mt, int32(15), sum.Load(), "expected sum 15, got %v", sum.Load())
+		})
+		mt.Run("callback error stops iteration", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+
+			errStop := errors.New("stop")
+			var calls int
+			mt.ClearEvents()
+			err := mt.Coll.AggregateEach(context.Background(), pipeline, 0, func(bson.Raw) error {
+				calls++
+				return errStop
+			}, options.Aggregate().SetBatchSize(2))
+			assert.ErrorIs(mt, err, errStop, "expected error %v, got %v", errStop, err)
+			assert.Equal(mt, 1, calls, "expected 1 callback call, got %v", calls)
+
+			evt := mt.GetStartedEvent()
+			for evt != nil && evt.CommandName != "killCursors" {
+				evt = mt.GetStartedEvent()
+			}
+			assert.NotNil(mt, evt, "expected cursor to be killed")
+		})
+		mt.Run("callback error with workers", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+
+			errStop := errors.New("stop")
+			err := mt.Coll.AggregateEach(context.Background(), pipeline, 2, func(doc bson.Raw) error {
+				if doc.Lookup("x").Int32() == 3 {
+					return errStop
+				}
+				return nil
+			}, options.Aggregate().SetBatchSize(2))
+			assert.ErrorIs(mt, err, errStop, "expected error %v, got %v", errStop, err)
+		})
+	})
+	mt.RunOpts("materialized view", mtest.NewOptions().MinServerVersion("4.2"), func(mt *mtest.T) {
+		initCollection(mt, mt.Coll)
+		target := mt.CreateCollection(mtest.Collection{Name: "materialized_view_target"}, false)
+
+		var runs []mongo.MaterializedViewRun
+		view, err := mongo.NewMaterializedView(mt.Coll, mongo.MaterializedViewConfig{
+			Pipeline: mongo.Pipeline{
+				{{"$group", bson.D{{"_id", nil}, {"total", bson.D{{"$sum", "$x"}}}}}},
+				{{"$merge", bson.D{
+					{"into", target.Name()},
+					{"whenMatched", bson.A{bson.D{{"$set", bson.D{
+						{"total", bson.D{{"$add", bson.A{"$total", "$$new.total"}}}},
+					}}}}},
+				}}},
+			},
+			WatermarkField: "_id",
+			OnRun: func(run mongo.MaterializedViewRun) {
+				runs = append(runs, run)
+			},
+		})
+		require.NoError(mt, err, "NewMaterializedView error: %v", err)
+
+		total := func() int32 {
+			res, err := target.FindOne(context.Background(), bson.D{}).Raw()
+			require.NoError(mt, err, "FindOne error: %v", err)
+			return res.Lookup("total").Int32()
+		}
+
+		_, err = view.RunOnce(context.Background())
+		require.NoError(mt, err, "RunOnce error: %v", err)
+		assert.Equal(mt, int32(15), total(), "expected total of initial documents")
+
+		run, err := view.RunOnce(context.Background())
+		require.NoError(mt, err, "RunOnce error: %v", err)
+		assert.True(mt, run.Skipped, "expected run without new documents to be skipped")
+
+		_, err = mt.Coll.InsertOne(context.Background(), bson.D{{"x", 6}})
+		require.NoError(mt, err, "InsertOne error: %v", err)
+		_, err = view.RunOnce(context.Background())
+		require.NoError(mt, err, "RunOnce error: %v", err)
+		assert.Equal(mt, int32(21), total(), "expected only the new document to be added")
+
+		stats := view.Stats()
+		assert.Equal(mt, int64(3), stats.Runs, "expected 3 runs, got %v", stats.Runs)
+		assert.Equal(mt, int64(1), stats.Skipped, "expected 1 skipped run, got %v", stats.Skipped)
+		assert.Len(mt, runs, 3, "expected OnRun to be called for each run")
+	})
+	mt.RunOpts("time series", mtest.NewOptions().MinServerVersion("5.0"), func(mt *mtest.T) {
+		tsOpts := options.TimeSeries().
+			SetTimeField("ts").
+			SetMetaField("sensor").
+			SetGranularity(options.TimeSeriesGranularitySeconds)
+		coll := mt.CreateCollection(mtest.Collection{
+			Name:       "time_series",
+			CreateOpts: options.CreateCollection().SetTimeSeriesOptions(tsOpts),
+		}, true)
+
+		start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		var docs []any
+		for i := 0; i < 4; i++ {
+			docs = append(docs, bson.D{
+				{"ts", start.Add(time.Duration(i) * 30 * time.Minute)},
+				{"sensor", "a"},
+				{"temp", int32(10 * (i + 1))},
+			})
+		}
+		_, err := coll.InsertMany(context.Background(), docs)
+		require.NoError(mt, err, "InsertMany error: %v", err)
+
+		cursor, err := coll.Aggregate(context.Background(), pipeline.Downsample(pipeline.DownsampleArgs{
+			TimeField: "ts",
+			MetaField: "sensor",
+			Unit:      pipeline.Hour,
+			Output:    []pipeline.DownsampleOutput{{Field: "temp", Accumulator: pipeline.Avg("$temp")}},
+		}))
+		require.NoError(mt, err, "Aggregate error: %v", err)
+		var got []bson.Raw
+		require.NoError(mt, cursor.All(context.Background(), &got), "All error")
+		require.Len(mt, got, 2, "expected one document per hour")
+		assert.Equal(mt, 15.0, got[0].Lookup("temp").Double(), "expected average of the first hour")
+		assert.Equal(mt, 35.0, got[1].Lookup("temp").Double(), "expected average of the second hour")
+
+		err = coll.ModifyTimeSeries(context.Background(), options.ModifyTimeSeries().
+			SetGranularity(options.TimeSeriesGranularityMinutes))
+		require.NoError(mt, err, "ModifyTimeSeries error: %v", err)
+
+		specs, err := mt.DB.ListCollectionSpecifications(context.Background(), bson.D{{"name", coll.Name()}})
+		require.NoError(mt, err, "ListCollectionSpecifications error: %v", err)
+		require.Len(mt, specs, 1, "expected one collection")
+		granularity := specs[0].Options.Lookup("timeseries", "granularity").StringValue()
+		assert.Equal(mt, options.TimeSeriesGranularityMinutes, granularity, "expected granularity to be modified")
+	})
+	mt.RunOpts("operation concerns", noClientOpts, func(mt *mtest.T) {
+		mt.Run("write concern", func(mt *mtest.T) {
+			coll := mt.Coll.Database().Collection(mt.Coll.Name(),
+				options.Collection().SetWriteConcern(writeconcern.Majority()))
+
+			mt.ClearEvents()
+			_, err := coll.InsertOne(context.Background(), bson.D{{"x", 1}},
+				options.InsertOne().SetWriteConcern(&writeconcern.WriteConcern{W: 1}))
+			require.NoError(mt, err, "InsertOne error: %v", err)
+
+			evt := mt.GetStartedEvent()
+			require.NotNil(mt, evt, "expected insert event")
+			w := evt.Command.Lookup("writeConcern", "w")
+			assert.Equal(mt, int32(1), w.Int32(), "expected operation write concern, got %v", w)
+		})
+		mt.RunOpts("read concern", mtest.NewOptions().MinServerVersion("4.0"), func(mt *mtest.T) {
+			coll := mt.Coll.Database().Collection(mt.Coll.Name(),
+				options.Collection().SetReadConcern(readconcern.Majority()))
+
+			mt.ClearEvents()
+			_, err := coll.CountDocuments(context.Background(), bson.D{},
+				options.Count().SetReadConcern(readconcern.Local()))
+			require.NoError(mt, err, "CountDocuments error: %v", err)
+
+			evt := mt.GetStartedEvent()
+			require.NotNil(mt, evt, "expected aggregate event")
+			level := evt.Command.Lookup("readConcern", "level")
+			assert.Equal(mt, "local", level.StringValue(), "expected operation read concern, got %v", level)
+		})
+	})
+	renameOpts := mtest.NewOptions().Topologies(mtest.Single, mtest.ReplicaSet)
+	mt.RunOpts("rename", renameOpts, func(mt *mtest.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			_, err := mt.Coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+			require.NoError(mt, err, "InsertOne error")
+
+			renamed := mt.Coll.Name() + "_renamed"
+			err = mt.Coll.Rename(context.Background(), renamed, false)
+			require.NoError(mt, err, "Rename error")
+			defer func() { _ = mt.DB.Collection(renamed).Drop(context.Background()) }()
+
+			names, err := mt.DB.ListCollectionNames(context.Background(), mongo.CollectionFilter{
+				Names: []string{mt.Coll.Name(), renamed},
+			})
+			require.NoError(mt, err, "ListCollectionNames error")
+			assert.Equal(mt, []string{renamed}, names, "expected only the renamed collection, got %v", names)
+
+			n, err := mt.DB.Collection(renamed).CountDocuments(context.Background(), bson.D{})
+			require.NoError(mt, err, "CountDocuments error")
+			assert.Equal(mt, int64(1), n, "expected 1 document in renamed collection, got %d", n)
+		})
+		mt.Run("target exists", func(mt *mtest.T) {
+			target := mt.CreateCollection(mtest.Collection{Name: mt.Coll.Name() + "_target"}, true)
+			_, err := mt.Coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+			require.NoError(mt, err, "InsertOne error")
+
+			err = mt.Coll.Rename(context.Background(), target.Name(), false)
+			assert.Error(mt, err, "expected Rename error when target exists")
+
+			err = mt.Coll.Rename(context.Background(), target.Name(), true)
+			require.NoError(mt, err, "Rename error with dropTarget")
+
+			n, err := target.CountDocuments(context.Background(), bson.D{})
+			require.NoError(mt, err, "CountDocuments error")
+			assert.Equal(mt, int64(1), n, "expected 1 document in target collection, got %d", n)
+		})
+	})
 	mt.RunOpts("count documents", noClientOpts, func(mt *mtest.T) {
 		mt.Run("success", func(mt *mtest.T) {
 			testCases := []struct {
@@ -926,6 +1194,13 @@ func TestCollection(t *testing.T) {
 
 			assert.EqualValues(mt, []int32{1}, got)
 		})
+		mt.Run("DistinctAs", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+
+			got, err := mongo.DistinctAs[int64](context.Background(), mt.Coll, "x", bson.D{{"x", bson.D{{"$gt", 2}}}})
+			require.NoError(mt, err, "DistinctAs error: %v", err)
+			assert.Equal(mt, []int64{3, 4, 5}, got, "expected result %v, got %v", []int64{3, 4, 5}, got)
+		})
 	})
 	mt.RunOpts("find", noClientOpts, func(mt *mtest.T) {
 		mt.Run("found", func(mt *mtest.T) {
@@ -1485,6 +1760,43 @@ func TestCollection(t *testing.T) {
 			assert.NotNil(mt, we.WriteConcernError, "expected write concern error, got %v", err)
 		})
 	})
+	mt.RunOpts("get or create", noClientOpts, func(mt *mtest.T) {
+		mt.Run("creates then gets", func(mt *mtest.T) {
+			filter := bson.D{{"name", "a"}}
+
+			doc, created, err := mongo.GetOrCreate[bson.M](context.Background(), mt.Coll, filter, bson.D{{"x", 1}})
+			require.NoError(mt, err, "GetOrCreate error: %v", err)
+			assert.True(mt, created, "expected document to be created")
+			assert.Equal(mt, "a", doc["name"], "expected name a, got %v", doc["name"])
+			assert.Equal(mt, int32(1), doc["x"], "expected x 1, got %v", doc["x"])
+
+			doc, created, err = mongo.GetOrCreate[bson.M](context.Background(), mt.Coll, filter, bson.D{{"x", 2}})
+			require.NoError(mt, err, "GetOrCreate error: %v", err)
+			assert.False(mt, created, "expected existing document")
+			assert.Equal(mt, int32(1), doc["x"], "expected x 1, got %v", doc["x"])
+		})
+		mt.Run("nil document", func(mt *mtest.T) {
+			_, _, err := mongo.GetOrCreate[bson.M](context.Background(), mt.Coll, bson.D{}, nil)
+			assert.ErrorIs(mt, err, mongo.ErrNilDocument)
+		})
+		mt.RunOpts("retries duplicate key error", mtest.NewOptions().ClientType(mtest.Mock), func(mt *mtest.T) {
+			dupKey := mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Name:    "DuplicateKey",
+				Message: "E11000 duplicate key error",
+			})
+			existing := mtest.CreateSuccessResponse(
+				bson.E{"value", bson.D{{"_id", 1}, {"name", "a"}}},
+				bson.E{"lastErrorObject", bson.D{{"n", 1}, {"updatedExisting", true}}},
+			)
+			mt.AddMockResponses(dupKey, existing)
+
+			doc, created, err := mongo.GetOrCreate[bson.M](context.Background(), mt.Coll, bson.D{{"name", "a"}}, bson.D{})
+			require.NoError(mt, err, "GetOrCreate error: %v", err)
+			assert.False(mt, created, "expected existing document")
+			assert.Equal(mt, "a", doc["name"], "expected name a, got %v", doc["name"])
+		})
+	})
 
 	unackClientOpts := options.Client().
 		SetWriteConcern(writeconcern.Unacknowledged())
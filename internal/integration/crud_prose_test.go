@@ -292,6 +292,27 @@ func TestWriteErrorsDetails(t *testing.T) {
 				assert.Equal(mt, details, errInfo, "want %v, got %v", details, errInfo)
 			})
 		}
+		mt.RunOpts("schema validation errors can be parsed", validatorOpts, func(mt *mtest.T) {
+			// "a" has the wrong type and "b" is missing.
+			_, err := mt.Coll.InsertOne(context.Background(), bson.D{{"a", 1}})
+			var we mongo.WriteException
+			require.True(mt, errors.As(err, &we), "expected a WriteException, got %v", err)
+
+			failures := we.ValidationFailures()
+			require.Len(mt, failures, 1, "expected one validation failure")
+			assert.Equal(mt, "$jsonSchema", failures[0].Rule.Operator, "expected a $jsonSchema failure")
+
+			violations := map[string]mongo.ValidationRuleFailure{}
+			for _, v := range failures[0].Violations() {
+				violations[v.Rule.Operator] = v.Rule
+				if v.Rule.Operator == "bsonType" {
+					assert.Equal(mt, "a", v.Field, "expected the bsonType violation to be for field a")
+				}
+			}
+			assert.Equal(mt, []string{"b"}, violations["required"].MissingProperties)
+			_, ok := violations["bsonType"]
+			assert.True(mt, ok, "expected a bsonType violation, got %v", violations)
+		})
 	})
 }
 
@@ -552,6 +552,95 @@ type tryNextCursor interface {
 	Err() error
 }
 
+func TestTailableCursor(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().CreateClient(false))
+
+	cappedOpts := options.CreateCollection().SetCapped(true).SetSizeInBytes(64 * 1024)
+	mtOpts := mtest.NewOptions().
+		MinServerVersion("4.4").
+		Topologies(mtest.ReplicaSet, mtest.Single).
+		CollectionCreateOptions(cappedOpts)
+
+	mt.RunOpts("resumes after CursorNotFound", mtOpts, func(mt *mtest.T) {
+		_, err := mt.Coll.InsertMany(context.Background(), []any{
+			bson.D{{"_id", 1}},
+			bson.D{{"_id", 2}},
+		})
+		require.NoError(mt, err, "InsertMany error")
+
+		tc, err := mt.Coll.Tail(context.Background(), bson.D{}, options.Tail().SetBatchSize(1))
+		require.NoError(mt, err, "Tail error")
+		defer tc.Close(context.Background())
+
+		require.True(mt, tc.Next(context.Background()), "Next error: %v", tc.Err())
+		assert.Equal(mt, int32(1), tc.Current.Lookup("_id").Int32())
+
+		mt.SetFailPoint(failpoint.FailPoint{
+			ConfigureFailPoint: "failCommand",
+			Mode:               failpoint.Mode{Times: 1},
+			Data: failpoint.Data{
+				FailCommands: []string{"getMore"},
+				ErrorCode:    errorCursorNotFound,
+			},
+		})
+		mt.ClearEvents()
+
+		require.True(mt, tc.Next(context.Background()), "Next error: %v", tc.Err())
+		assert.Equal(mt, int32(2), tc.Current.Lookup("_id").Int32())
+		assert.Equal(mt, int32(2), tc.ResumeValue().Int32())
+
+		mt.FilterStartedEvents(func(evt *event.CommandStartedEvent) bool {
+			return evt.CommandName == "find"
+		})
+		evt := mt.GetStartedEvent()
+		require.NotNil(mt, evt, "expected find to be sent to resume the cursor")
+		_, err = evt.Command.LookupErr("filter", "$and")
+		assert.NoError(mt, err, "expected resumed filter to exclude seen documents, got %v", evt.Command)
+	})
+	mt.RunOpts("start after", mtOpts, func(mt *mtest.T) {
+		initCollection(mt, mt.Coll)
+
+		var first bson.D
+		err := mt.Coll.FindOne(context.Background(), bson.D{{"x", 3}}).Decode(&first)
+		require.NoError(mt, err, "FindOne error")
+
+		opts := options.Tail().SetStartAfter(first[0].Value)
+		tc, err := mt.Coll.Tail(context.Background(), bson.D{}, opts)
+		require.NoError(mt, err, "Tail error")
+		defer tc.Close(context.Background())
+
+		require.True(mt, tc.Next(context.Background()), "Next error: %v", tc.Err())
+		assert.Equal(mt, int32(4), tc.Current.Lookup("x").Int32())
+	})
+	mt.RunOpts("non-resumable error", mtest.NewOptions().ClientType(mtest.Mock), func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{{"_id", 1}}),
+			mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 2, Name: "BadValue", Message: "bad value"}),
+		)
+
+		tc, err := mt.Coll.Tail(context.Background(), bson.D{})
+		require.NoError(mt, err, "Tail error")
+		defer tc.Close(context.Background())
+
+		require.True(mt, tc.Next(context.Background()), "Next error: %v", tc.Err())
+		assert.False(mt, tc.Next(context.Background()), "expected Next to return false")
+
+		var ce mongo.CommandError
+		require.True(mt, errors.As(tc.Err(), &ce), "expected CommandError, got %v", tc.Err())
+		assert.Equal(mt, int32(2), ce.Code, "expected code 2, got %v", ce.Code)
+	})
+	mt.RunOpts("missing resume field", mtest.NewOptions().ClientType(mtest.Mock), func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{{"x", 1}}))
+
+		tc, err := mt.Coll.Tail(context.Background(), bson.D{})
+		require.NoError(mt, err, "Tail error")
+		defer tc.Close(context.Background())
+
+		assert.False(mt, tc.Next(context.Background()), "expected Next to return false")
+		assert.ErrorIs(mt, tc.Err(), mongo.ErrMissingResumeField)
+	})
+}
+
 func tryNextExistingBatchTest(mt *mtest.T, cursor tryNextCursor) {
 	mt.Helper()
 
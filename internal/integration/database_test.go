@@ -19,6 +19,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/failpoint"
 	"go.mongodb.org/mongo-driver/v2/internal/handshake"
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -118,12 +119,88 @@ func TestDatabase(t *testing.T) {
 			assert.NotNil(mt, writeExcept.WriteConcernError, "expected WriteConcernError to be non-nil")
 			assert.Equal(mt, writeExcept.WriteConcernError.Code, 100, "expected error code 100, got %v", writeExcept.WriteConcernError.Code)
 		})
+		mt.RunOpts("retryable", failpointOpts, func(mt *mtest.T) {
+			testCases := []struct {
+				name        string
+				opts        *options.RunCmdOptionsBuilder
+				wantErr     bool
+				wantStarted int
+			}{
+				{"not retried by default", options.RunCmd(), true, 1},
+				{"retried if retryable", options.RunCmd().SetRetryable(true), false, 2},
+			}
+			for _, tc := range testCases {
+				mt.Run(tc.name, func(mt *mtest.T) {
+					mt.SetFailPoint(failpoint.FailPoint{
+						ConfigureFailPoint: "failCommand",
+						Mode: failpoint.Mode{
+							Times: 1,
+						},
+						Data: failpoint.Data{
+							FailCommands: []string{"ping"},
+							ErrorCode:    11600,
+						},
+					})
+					mt.ClearEvents()
+
+					err := mt.DB.RunCommand(context.Background(), bson.D{{"ping", 1}}, tc.opts).Err()
+					if tc.wantErr {
+						assert.Error(mt, err, "expected RunCommand error")
+					} else {
+						assert.NoError(mt, err, "RunCommand error")
+					}
+
+					var started int
+					for _, evt := range mt.GetAllStartedEvents() {
+						if evt.CommandName == "ping" {
+							started++
+						}
+					}
+					assert.Equal(mt, tc.wantStarted, started, "expected %d ping commands, got %d", tc.wantStarted, started)
+				})
+			}
+		})
 		mt.Run("multi key map command", func(mt *mtest.T) {
 			err := mt.DB.RunCommand(context.Background(), bson.M{"insert": "test", "documents": bson.A{bson.D{{"a", 1}}}}).Err()
 			assert.Equal(mt, mongo.ErrMapForOrderedArgument{"cmd"}, err, "expected error %v, got %v", mongo.ErrMapForOrderedArgument{"cmd"}, err)
 		})
 	})
 
+	mt.RunOpts("run commands", noClientOpts, func(mt *mtest.T) {
+		cmds := []bson.D{
+			{{"ping", 1}},
+			{{"notACommand", 1}},
+			{{"insert", mt.Coll.Name()}, {"documents", bson.A{bson.D{{"x", 1}}}}},
+		}
+
+		mt.Run("single connection", func(mt *mtest.T) {
+			results, err := mt.DB.RunCommands(context.Background(), cmds)
+			require.NoError(mt, err, "RunCommands error")
+			require.Len(mt, results, 3, "expected a result for each command")
+
+			assert.Equal(mt, "ping", results[0].Name, "expected command name")
+			assert.NoError(mt, results[0].Err, "ping error")
+			assert.Error(mt, results[1].Err, "expected error for unknown command")
+			assert.NoError(mt, results[2].Err, "insert error")
+			n, _ := results[2].Result.Lookup("n").AsInt64OK()
+			assert.Equal(mt, int64(1), n, "expected 1 inserted document")
+
+			var connIDs []string
+			for _, evt := range mt.GetAllStartedEvents() {
+				connIDs = append(connIDs, evt.ConnectionID)
+			}
+			require.Len(mt, connIDs, 3, "expected 3 started events")
+			assert.Equal(mt, connIDs[0], connIDs[1], "expected commands to use the same connection")
+			assert.Equal(mt, connIDs[0], connIDs[2], "expected commands to use the same connection")
+		})
+		mt.Run("stop on error", func(mt *mtest.T) {
+			results, err := mt.DB.RunCommands(context.Background(), cmds, options.RunCommands().SetStopOnError(true))
+			require.NoError(mt, err, "RunCommands error")
+			require.Len(mt, results, 2, "expected no results after the failed command")
+			assert.Error(mt, results[1].Err, "expected error for unknown command")
+		})
+	})
+
 	dropOpts := mtest.NewOptions().DatabaseName("dropDb")
 	mt.RunOpts("drop", dropOpts, func(mt *mtest.T) {
 		err := mt.DB.Drop(context.Background())
@@ -138,6 +215,64 @@ func TestDatabase(t *testing.T) {
 		}
 	})
 
+	mt.RunOpts("clone collection structure", noClientOpts, func(mt *mtest.T) {
+		mt.Run("collection", func(mt *mtest.T) {
+			src := mt.CreateCollection(mtest.Collection{
+				Name:       "cloneSrc",
+				CreateOpts: options.CreateCollection().SetCapped(true).SetSizeInBytes(4096),
+			}, true)
+			_, err := src.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+				Keys:    bson.D{{"x", 1}},
+				Options: options.Index().SetUnique(true),
+			})
+			require.NoError(mt, err, "CreateOne error")
+			_, err = src.InsertOne(context.Background(), bson.D{{"x", 1}})
+			require.NoError(mt, err, "InsertOne error")
+
+			dst := mt.CreateCollection(mtest.Collection{Name: "cloneDst"}, false)
+			err = mt.DB.CloneCollectionStructure(context.Background(), src.Name(), dst.Name())
+			require.NoError(mt, err, "CloneCollectionStructure error")
+
+			specs, err := mt.DB.ListCollectionSpecifications(context.Background(), mongo.CollectionFilter{
+				Names: []string{dst.Name()},
+			})
+			require.NoError(mt, err, "ListCollectionSpecifications error")
+			require.Len(mt, specs, 1, "expected 1 collection specification")
+			assert.True(mt, specs[0].Capped, "expected cloned collection to be capped")
+
+			indexes, err := dst.Indexes().ListSpecifications(context.Background())
+			require.NoError(mt, err, "ListSpecifications error")
+			var names []string
+			for _, index := range indexes {
+				names = append(names, index.Name)
+			}
+			assert.ElementsMatch(mt, []string{"_id_", "x_1"}, names, "expected cloned indexes, got %v", names)
+
+			n, err := dst.CountDocuments(context.Background(), bson.D{})
+			require.NoError(mt, err, "CountDocuments error")
+			assert.Equal(mt, int64(0), n, "expected no documents in cloned collection, got %d", n)
+		})
+		mt.Run("view", func(mt *mtest.T) {
+			mt.CreateCollection(mtest.Collection{Name: "cloneViewSrc", ViewOn: mt.Coll.Name(), ViewPipeline: bson.A{}}, true)
+			dst := mt.CreateCollection(mtest.Collection{Name: "cloneViewDst"}, false)
+
+			err := mt.DB.CloneCollectionStructure(context.Background(), "cloneViewSrc", dst.Name())
+			require.NoError(mt, err, "CloneCollectionStructure error")
+
+			specs, err := mt.DB.ListCollectionSpecifications(context.Background(), mongo.CollectionFilter{
+				Names: []string{dst.Name()},
+			})
+			require.NoError(mt, err, "ListCollectionSpecifications error")
+			require.Len(mt, specs, 1, "expected 1 collection specification")
+			assert.Equal(mt, mongo.CollectionTypeView, specs[0].Type, "expected view, got %q", specs[0].Type)
+			assert.Equal(mt, mt.Coll.Name(), specs[0].ViewOn, "expected view on %q, got %q", mt.Coll.Name(), specs[0].ViewOn)
+		})
+		mt.Run("source not found", func(mt *mtest.T) {
+			err := mt.DB.CloneCollectionStructure(context.Background(), "cloneMissing", "cloneMissingDst")
+			assert.ErrorContains(mt, err, `collection "cloneMissing" not found`)
+		})
+	})
+
 	lcNamesOpts := mtest.NewOptions().MinServerVersion("4.0")
 	mt.RunOpts("list collection names", lcNamesOpts, func(mt *mtest.T) {
 		collName := "lcNamesCollection"
@@ -300,11 +435,14 @@ func TestDatabase(t *testing.T) {
 				AppendInt32("size", 4096).
 				Build()
 
+			size := int64(4096)
 			expectedSpec := mongo.CollectionSpecification{
-				Name:     cappedName,
-				Type:     "collection",
-				ReadOnly: false,
-				Options:  bson.Raw(optionsDoc),
+				Name:        cappedName,
+				Type:        "collection",
+				ReadOnly:    false,
+				Options:     bson.Raw(optionsDoc),
+				Capped:      true,
+				SizeInBytes: &size,
 			}
 			if mtest.CompareServerVersions(mtest.ServerVersion(), "3.6") >= 0 {
 				uuidSubtype, uuidData := cursor.Current.Lookup("info", "uuid").Binary()
@@ -328,6 +466,21 @@ func TestDatabase(t *testing.T) {
 			assert.Equal(mt, expectedSpec, specs[0], "expected specification %v, got %v", expectedSpec, specs[0])
 		})
 
+		mt.Run("collection filter", func(mt *mtest.T) {
+			viewName := "list-collection-specs-view"
+			err := mt.DB.CreateView(context.Background(), viewName, mt.Coll.Name(), mongo.Pipeline{})
+			require.NoError(mt, err, "CreateView error: %v", err)
+			defer func() { _ = mt.DB.Collection(viewName).Drop(context.Background()) }()
+
+			specs, err := mt.DB.ListCollectionSpecifications(context.Background(), mongo.CollectionFilter{
+				NamePrefix: "list-collection-specs-",
+				Types:      []string{mongo.CollectionTypeView},
+			})
+			require.NoError(mt, err, "ListCollectionSpecifications error: %v", err)
+			require.Len(mt, specs, 1, "expected one view")
+			assert.Equal(mt, viewName, specs[0].Name, "expected view name")
+			assert.Equal(mt, mt.Coll.Name(), specs[0].ViewOn, "expected view source")
+		})
 		mt.RunOpts("options passed to listCollections", mtest.NewOptions().MinServerVersion("3.0"), func(mt *mtest.T) {
 			// Test that ListCollectionSpecifications correctly uses the supplied options.
 
@@ -421,6 +574,31 @@ func TestDatabase(t *testing.T) {
 				return mt.DB.RunCommandCursor(context.Background(), findCmd)
 			})
 		})
+		// getMore supports a comment on server versions 4.4 and above.
+		mt.RunOpts("getMore options", mtest.NewOptions().MinServerVersion("4.4"), func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			findCmd := bson.D{
+				{"find", mt.Coll.Name()},
+				{"batchSize", 1},
+			}
+			opts := options.RunCmd().SetBatchSize(3).SetComment("run command cursor")
+			cursor, err := mt.DB.RunCommandCursor(context.Background(), findCmd, opts)
+			require.NoError(mt, err, "RunCommandCursor error")
+			defer cursor.Close(context.Background())
+
+			mt.ClearEvents()
+			for cursor.Next(context.Background()) {
+			}
+			require.NoError(mt, cursor.Err(), "cursor error")
+
+			evt := mt.GetStartedEvent()
+			require.NotNil(mt, evt, "expected a getMore event")
+			assert.Equal(mt, "getMore", evt.CommandName, "expected getMore, got %v", evt.CommandName)
+			batchSize := evt.Command.Lookup("batchSize").Int32()
+			assert.Equal(mt, int32(3), batchSize, "expected batchSize 3, got %v", batchSize)
+			comment := evt.Command.Lookup("comment").StringValue()
+			assert.Equal(mt, "run command cursor", comment, "expected comment, got %q", comment)
+		})
 	})
 
 	mt.RunOpts("create collection", noClientOpts, func(mt *mtest.T) {
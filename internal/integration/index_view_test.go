@@ -685,6 +685,19 @@ func TestIndexView(t *testing.T) {
 		}
 		assert.Nil(mt, cursor.Err(), "cursor error: %v", cursor.Err())
 	})
+	mt.RunOpts("interrupted builds", mtest.NewOptions().MinServerVersion("4.4"), func(mt *mtest.T) {
+		iv := mt.Coll.Indexes()
+		_, err := iv.CreateOne(context.Background(), mongo.IndexModel{Keys: bson.D{{"foo", 1}}})
+		assert.Nil(mt, err, "CreateOne error: %v", err)
+
+		interrupted, err := iv.InterruptedBuilds(context.Background())
+		assert.Nil(mt, err, "InterruptedBuilds error: %v", err)
+		assert.Len(mt, interrupted, 0, "expected no interrupted builds, got %v", interrupted)
+
+		restarted, err := iv.RestartInterruptedBuilds(context.Background())
+		assert.Nil(mt, err, "RestartInterruptedBuilds error: %v", err)
+		assert.Len(mt, restarted, 0, "expected no restarted builds, got %v", restarted)
+	})
 	mt.RunOpts("clustered indexes", mtest.NewOptions().MinServerVersion("5.3"), func(mt *mtest.T) {
 		const name = "clustered"
 		clustered := mt.CreateCollection(mtest.Collection{
@@ -0,0 +1,103 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func TestVectorSearchIndex(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	definition := mongo.VectorSearchIndexDefinition{
+		Fields: []mongo.VectorSearchField{
+			{
+				Type:          mongo.VectorSearchFieldVector,
+				Path:          "embedding",
+				NumDimensions: 3,
+				Similarity:    mongo.VectorSimilarityCosine,
+			},
+			{Type: mongo.VectorSearchFieldFilter, Path: "genre"},
+		},
+	}
+
+	mt.Run("create", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(
+			bson.E{"indexesCreated", bson.A{bson.D{{"id", "1"}, {"name", "vector"}}}},
+		))
+
+		name, err := mt.Coll.SearchIndexes().CreateVectorSearchIndex(context.Background(), "vector", definition)
+		require.NoError(mt, err, "CreateVectorSearchIndex error: %v", err)
+		assert.Equal(mt, "vector", name, "expected name %q, got %q", "vector", name)
+
+		evt := mt.GetStartedEvent()
+		require.NotNil(mt, evt, "expected a createSearchIndexes event")
+		index := evt.Command.Lookup("indexes", "0").Document()
+		assert.Equal(mt, "vectorSearch", index.Lookup("type").StringValue(), "unexpected index type")
+		assert.Equal(mt, "embedding", index.Lookup("definition", "fields", "0", "path").StringValue(),
+			"unexpected vector field path")
+	})
+	mt.Run("create invalid definition", func(mt *mtest.T) {
+		_, err := mt.Coll.SearchIndexes().CreateVectorSearchIndex(context.Background(), "vector",
+			mongo.VectorSearchIndexDefinition{})
+		assert.Error(mt, err, "expected a validation error")
+		assert.Nil(mt, mt.GetStartedEvent(), "expected no command to be sent")
+	})
+	mt.Run("update", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		err := mt.Coll.SearchIndexes().UpdateVectorSearchIndex(context.Background(), "vector", definition)
+		require.NoError(mt, err, "UpdateVectorSearchIndex error: %v", err)
+
+		evt := mt.GetStartedEvent()
+		require.NotNil(mt, evt, "expected an updateSearchIndex event")
+		assert.Equal(mt, "updateSearchIndex", evt.CommandName, "unexpected command")
+		assert.Equal(mt, "genre", evt.Command.Lookup("definition", "fields", "1", "path").StringValue(),
+			"unexpected filter field path")
+	})
+	mt.Run("wait for queryable", func(mt *mtest.T) {
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, bson.D{
+			{"name", "vector"},
+			{"type", "vectorSearch"},
+			{"status", "READY"},
+			{"queryable", true},
+			{"latestDefinition", bson.D{{"fields", bson.A{}}}},
+		}))
+
+		spec, err := mt.Coll.SearchIndexes().WaitForQueryable(context.Background(), "vector")
+		require.NoError(mt, err, "WaitForQueryable error: %v", err)
+		assert.Equal(mt, "vector", spec.Name, "unexpected name")
+		assert.Equal(mt, "vectorSearch", spec.Type, "unexpected type")
+		assert.True(mt, spec.Queryable, "expected index to be queryable")
+	})
+	mt.Run("wait for failed index", func(mt *mtest.T) {
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, bson.D{
+			{"name", "vector"},
+			{"status", "FAILED"},
+			{"queryable", false},
+		}))
+
+		_, err := mt.Coll.SearchIndexes().WaitForQueryable(context.Background(), "vector")
+		assert.ErrorContains(mt, err, "FAILED", "expected an error with the index status")
+	})
+	mt.Run("wait for missing index", func(mt *mtest.T) {
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch))
+
+		_, err := mt.Coll.SearchIndexes().WaitForQueryable(context.Background(), "vector")
+		assert.ErrorIs(mt, err, mongo.ErrSearchIndexNotFound, "expected ErrSearchIndexNotFound")
+	})
+}
@@ -0,0 +1,179 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package replay records and replays the nondeterministic choices made by the
+// driver. It is the implementation of the mongo/replay package; the driver
+// calls the hooks in this package wherever it makes a random choice.
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Kind identifies a source of nondeterministic input.
+type Kind int
+
+// The sources of nondeterministic input that are recorded.
+const (
+	ServerSelection Kind = iota
+	RetryBackoff
+	ObjectID
+	numKinds
+)
+
+var kindNames = [numKinds]string{"server selection", "retry backoff", "ObjectID"}
+
+// String returns the name of k.
+func (k Kind) String() string {
+	if k < 0 || k >= numKinds {
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+	return kindNames[k]
+}
+
+// ErrActive is returned when a recording or replay is started while another
+// one is active.
+var ErrActive = errors.New("a recording or replay is already active")
+
+// Log contains the recorded inputs of each Kind in the order they were used.
+type Log struct {
+	Ints      [numKinds][]int64
+	ObjectIDs [][12]byte
+}
+
+// Session is an active recording or replay.
+type Session struct {
+	replaying bool
+
+	// mu guards the fields below.
+	mu   sync.Mutex
+	log  Log
+	pos  [numKinds]int
+	err  error
+	done bool
+}
+
+// active is the active Session. It is accessed atomically so that the hooks
+// are cheap when no Session is active.
+var active atomic.Pointer[Session]
+
+func start(s *Session) (*Session, error) {
+	if !active.CompareAndSwap(nil, s) {
+		return nil, ErrActive
+	}
+	return s, nil
+}
+
+// Record starts recording.
+func Record() (*Session, error) {
+	return start(&Session{})
+}
+
+// Replay starts replaying log. A copy of log is made.
+func Replay(log Log) (*Session, error) {
+	s := &Session{replaying: true}
+	for k := range log.Ints {
+		s.log.Ints[k] = append([]int64(nil), log.Ints[k]...)
+	}
+	s.log.ObjectIDs = append([][12]byte(nil), log.ObjectIDs...)
+	return start(s)
+}
+
+// Stop stops s and returns the inputs it recorded or replayed. The returned
+// error reports the first divergence from the replayed log, if any. Inputs
+// that were recorded but not used during the replay are not reported as a
+// divergence, because the run may have stopped early.
+func (s *Session) Stop() (Log, error) {
+	active.CompareAndSwap(s, nil)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.done = true
+	return s.log, s.err
+}
+
+// diverged records that the replay diverged from the log. It must be called
+// with s.mu held.
+func (s *Session) diverged(k Kind, format string, args ...any) {
+	if s.err == nil {
+		s.err = fmt.Errorf("%v input %d: %s", k, s.pos[k], fmt.Sprintf(format, args...))
+	}
+}
+
+// Int63n returns a value in [0, n) of the given kind. If no session is active,
+// it returns gen(n). While recording, the value returned by gen is recorded;
+// while replaying, the next recorded value is returned instead. If the
+// recorded value is missing or not in [0, n), the replay has diverged and
+// gen(n) is returned.
+func Int63n(k Kind, n int64, gen func(int64) int64) int64 {
+	s := active.Load()
+	if s == nil {
+		return gen(n)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.done {
+		return gen(n)
+	}
+	if !s.replaying {
+		v := gen(n)
+		s.log.Ints[k] = append(s.log.Ints[k], v)
+		return v
+	}
+
+	recorded := s.log.Ints[k]
+	if s.pos[k] >= len(recorded) {
+		s.diverged(k, "no more recorded values")
+		return gen(n)
+	}
+	v := recorded[s.pos[k]]
+	if v < 0 || v >= n {
+		s.diverged(k, "recorded value %d is not in [0, %d)", v, n)
+		return gen(n)
+	}
+	s.pos[k]++
+	return v
+}
+
+// Intn is like Int63n for int values.
+func Intn(k Kind, n int, gen func(int) int) int {
+	return int(Int63n(k, int64(n), func(n int64) int64 { return int64(gen(int(n))) }))
+}
+
+// Bytes12 returns a 12-byte value such as an ObjectID. It records and replays
+// the values returned by gen like Int63n.
+func Bytes12(gen func() [12]byte) [12]byte {
+	s := active.Load()
+	if s == nil {
+		return gen()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.done {
+		return gen()
+	}
+	if !s.replaying {
+		v := gen()
+		s.log.ObjectIDs = append(s.log.ObjectIDs, v)
+		return v
+	}
+
+	if s.pos[ObjectID] >= len(s.log.ObjectIDs) {
+		s.diverged(ObjectID, "no more recorded values")
+		return gen()
+	}
+	v := s.log.ObjectIDs[s.pos[ObjectID]]
+	s.pos[ObjectID]++
+	return v
+}
@@ -244,32 +244,11 @@ func selectSecondaries(rp *readpref.ReadPref, candidates []description.Server) [
 	}
 	if maxStaleness, set := rp.MaxStaleness(); set {
 		primaries := selectByKind(candidates, description.ServerKindRSPrimary)
-		if len(primaries) == 0 {
-			baseTime := secondaries[0].LastWriteTime
-			for i := 1; i < len(secondaries); i++ {
-				if secondaries[i].LastWriteTime.After(baseTime) {
-					baseTime = secondaries[i].LastWriteTime
-				}
-			}
-
-			var selected []description.Server
-			for _, secondary := range secondaries {
-				estimatedStaleness := baseTime.Sub(secondary.LastWriteTime) + secondary.HeartbeatInterval
-				if estimatedStaleness <= maxStaleness {
-					selected = append(selected, secondary)
-				}
-			}
-
-			return selected
-		}
-
-		primary := primaries[0]
+		estimates := estimateStaleness(secondaries, primaries)
 
 		var selected []description.Server
-		for _, secondary := range secondaries {
-			estimatedStaleness := secondary.LastUpdateTime.Sub(secondary.LastWriteTime) -
-				primary.LastUpdateTime.Sub(primary.LastWriteTime) + secondary.HeartbeatInterval
-			if estimatedStaleness <= maxStaleness {
+		for i, secondary := range secondaries {
+			if estimates[i] <= maxStaleness {
 				selected = append(selected, secondary)
 			}
 		}
@@ -279,6 +258,53 @@ func selectSecondaries(rp *readpref.ReadPref, candidates []description.Server) [
 	return secondaries
 }
 
+// SecondaryStaleness returns the estimated staleness of each secondary in
+// servers, keyed by address. Staleness is estimated as described in the Max
+// Staleness specification, which is the value compared against a read
+// preference's maxStalenessSeconds during server selection.
+func SecondaryStaleness(servers []description.Server) map[string]time.Duration {
+	secondaries := selectByKind(servers, description.ServerKindRSSecondary)
+	primaries := selectByKind(servers, description.ServerKindRSPrimary)
+	estimates := estimateStaleness(secondaries, primaries)
+
+	staleness := make(map[string]time.Duration, len(secondaries))
+	for i, secondary := range secondaries {
+		staleness[secondary.Addr.String()] = estimates[i]
+	}
+	return staleness
+}
+
+// estimateStaleness returns the estimated staleness of each secondary. If
+// there is a primary, staleness is measured relative to it. Otherwise, it is
+// measured relative to the secondary with the most recent write.
+func estimateStaleness(secondaries, primaries []description.Server) []time.Duration {
+	estimates := make([]time.Duration, len(secondaries))
+	if len(secondaries) == 0 {
+		return estimates
+	}
+
+	if len(primaries) == 0 {
+		baseTime := secondaries[0].LastWriteTime
+		for i := 1; i < len(secondaries); i++ {
+			if secondaries[i].LastWriteTime.After(baseTime) {
+				baseTime = secondaries[i].LastWriteTime
+			}
+		}
+
+		for i, secondary := range secondaries {
+			estimates[i] = baseTime.Sub(secondary.LastWriteTime) + secondary.HeartbeatInterval
+		}
+		return estimates
+	}
+
+	primary := primaries[0]
+	for i, secondary := range secondaries {
+		estimates[i] = secondary.LastUpdateTime.Sub(secondary.LastWriteTime) -
+			primary.LastUpdateTime.Sub(primary.LastWriteTime) + secondary.HeartbeatInterval
+	}
+	return estimates
+}
+
 func selectByTagSet(candidates []description.Server, tagSets []tag.Set) []description.Server {
 	if len(tagSets) == 0 {
 		return candidates
@@ -938,6 +938,40 @@ func TestSelector_SecondaryPreferred_with_maxStaleness_and_no_primary(t *testing
 	require.Equal(t, []description.Server{readPrefTestSecondary2}, result)
 }
 
+func TestSecondaryStaleness(t *testing.T) {
+	t.Parallel()
+
+	secondary2 := readPrefTestSecondary2
+	secondary2.Addr = address.Address("localhost:27019")
+
+	t.Run("with primary", func(t *testing.T) {
+		t.Parallel()
+
+		got := SecondaryStaleness([]description.Server{readPrefTestPrimary, readPrefTestSecondary1, secondary2})
+		want := map[string]time.Duration{
+			"localhost:27018": 130 * time.Second,
+			"localhost:27019": 10 * time.Second,
+		}
+		assert.Equal(t, want, got)
+	})
+	t.Run("no primary", func(t *testing.T) {
+		t.Parallel()
+
+		got := SecondaryStaleness([]description.Server{readPrefTestSecondary1, secondary2})
+		want := map[string]time.Duration{
+			"localhost:27018": 130 * time.Second,
+			"localhost:27019": 10 * time.Second,
+		}
+		assert.Equal(t, want, got)
+	})
+	t.Run("no secondaries", func(t *testing.T) {
+		t.Parallel()
+
+		got := SecondaryStaleness([]description.Server{readPrefTestPrimary})
+		assert.Len(t, got, 0)
+	})
+}
+
 func TestSelector_Secondary(t *testing.T) {
 	t.Parallel()
 
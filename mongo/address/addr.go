@@ -18,9 +18,12 @@ const defaultPort = "27017"
 type Address string
 
 // Network is the network protocol for this address. In most cases this will be
-// "tcp" or "unix".
+// "tcp" or "unix". An address is a Unix domain socket if it ends with "sock",
+// is an absolute path, or starts with "@", which denotes a socket in the Linux
+// abstract namespace.
 func (a Address) Network() string {
-	if strings.HasSuffix(string(a), "sock") {
+	s := string(a)
+	if strings.HasSuffix(s, "sock") || strings.HasPrefix(s, "/") || strings.HasPrefix(s, "@") {
 		return "unix"
 	}
 	return "tcp"
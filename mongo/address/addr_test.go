@@ -23,6 +23,8 @@ func TestAddress_String(t *testing.T) {
 		{"a:27017", "a:27017"},
 		{"a.sock", "a.sock"},
 		{"A.sock", "A.sock"},
+		{"/tmp/Mongo", "/tmp/Mongo"},
+		{"@Mongo", "@Mongo"},
 	}
 
 	for _, test := range tests {
@@ -42,6 +44,8 @@ func TestAddress_Canonicalize(t *testing.T) {
 		{"A:27017", "a:27017"},
 		{"a:27017", "a:27017"},
 		{"a.sock", "a.sock"},
+		{"/tmp/mongodb-27017.sock", "/tmp/mongodb-27017.sock"},
+		{"@mongodb", "@mongodb"},
 	}
 
 	for _, test := range tests {
@@ -50,3 +54,24 @@ func TestAddress_Canonicalize(t *testing.T) {
 		})
 	}
 }
+
+func TestAddress_Network(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"localhost", "tcp"},
+		{"localhost:27017", "tcp"},
+		{"[::1]:27017", "tcp"},
+		{"mongodb-27017.sock", "unix"},
+		{"/tmp/mongodb-27017.sock", "unix"},
+		{"/var/run/mongodb/socket", "unix"},
+		{"@mongodb", "unix"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			require.Equal(t, test.expected, Address(test.in).Network())
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"golang.org/x/sync/errgroup"
+)
+
+// AggregateEach executes an aggregate command against the collection and calls
+// fn with each resulting document. The cursor is always closed before
+// AggregateEach returns. For example:
+//
+//	err := coll.AggregateEach(ctx, pipeline, 0, func(doc bson.Raw) error {
+//		return process(doc)
+//	})
+//
+// If workers is less than or equal to 1, fn is called sequentially from the
+// calling goroutine, and the document passed to fn is only valid until fn
+// returns, so fn must copy it to retain it. Otherwise, fn is called
+// concurrently from workers goroutines, each with its own copy of the
+// document, and the order in which documents are processed is unspecified.
+//
+// Documents are only read from the cursor as fast as fn processes them, so at
+// most one batch of documents is held in memory in addition to the documents
+// being processed, and a slow callback does not cause unbounded buffering.
+//
+// If fn returns an error, no further documents are passed to fn and
+// AggregateEach returns the first error returned by fn once the calls in
+// progress have returned. Otherwise, it returns any error from the aggregate
+// command or from iterating the cursor.
+//
+// The pipeline and opts parameters are the same as for Aggregate (see the
+// Aggregate documentation).
+func (coll *Collection) AggregateEach(
+	ctx context.Context,
+	pipeline any,
+	workers int,
+	fn func(doc bson.Raw) error,
+	opts ...options.Lister[options.AggregateOptions],
+) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline, opts...)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	if workers <= 1 {
+		for cursor.Next(ctx) {
+			if err := fn(cursor.Current); err != nil {
+				return err
+			}
+		}
+		return cursor.Err()
+	}
+
+	// The documents channel is unbuffered so that the cursor is only advanced
+	// once a worker is ready for the next document.
+	group, groupCtx := errgroup.WithContext(ctx)
+	docs := make(chan bson.Raw)
+	for i := 0; i < workers; i++ {
+		group.Go(func() error {
+			for doc := range docs {
+				if err := fn(doc); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+feed:
+	for cursor.Next(groupCtx) {
+		select {
+		case docs <- cursor.CurrentCopy():
+		case <-groupCtx.Done():
+			break feed
+		}
+	}
+	close(docs)
+
+	// An error from fn cancels groupCtx, which also causes the cursor to fail,
+	// so the error from fn takes precedence.
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,231 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package archive moves documents that match a filter from a collection to an
+// archive collection in batches, for example to keep a frequently accessed
+// collection small by moving old documents to cheaper storage:
+//
+//	cutoff := time.Now().AddDate(0, -6, 0)
+//	res, err := archive.Move(ctx, archive.Config{
+//		Source:                orders,
+//		Destination:           archivedOrders,
+//		Filter:                bson.D{{"createdAt", bson.D{{"$lt", cutoff}}}},
+//		BatchSize:             500,
+//		MaxDocumentsPerSecond: 2000,
+//		Progress: func(p archive.Progress) {
+//			log.Printf("archived %d documents", p.Moved)
+//		},
+//	})
+//
+// If the source and destination collections belong to the same Client, each
+// batch is moved in a transaction, so a document is never present in both or
+// neither collection. Otherwise, each batch is first written to the
+// destination with upserts and then deleted from the source, so an
+// interrupted run can be resumed by calling Move again.
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const defaultBatchSize = 1000
+
+// Config configures a Move.
+type Config struct {
+	// Source is the collection documents are moved from. It is required.
+	Source *mongo.Collection
+
+	// Destination is the collection documents are moved to. It is required.
+	Destination *mongo.Collection
+
+	// Filter selects the documents to move. It is required, so that all
+	// documents are not moved by accident. Use bson.D{} to move every
+	// document.
+	Filter any
+
+	// BatchSize is the maximum number of documents moved in a single batch.
+	// The default is 1000.
+	BatchSize int
+
+	// MaxDocumentsPerSecond limits the rate at which documents are moved to
+	// reduce the impact on other workloads. If zero, batches are moved as fast
+	// as possible.
+	MaxDocumentsPerSecond float64
+
+	// Progress, if set, is called after each batch is moved.
+	Progress func(Progress)
+}
+
+func (c *Config) validate() error {
+	if c.Source == nil || c.Destination == nil {
+		return errors.New("source and destination collections must be set")
+	}
+	if c.Filter == nil {
+		return errors.New("filter must be set")
+	}
+	if c.BatchSize < 0 || c.MaxDocumentsPerSecond < 0 {
+		return errors.New("batch size and maximum documents per second must not be negative")
+	}
+	return nil
+}
+
+// Progress reports the state of a Move after a batch has been moved.
+type Progress struct {
+	// Batches is the number of batches moved so far.
+	Batches int
+
+	// Moved is the number of documents moved so far.
+	Moved int64
+
+	// Elapsed is the time since the Move started.
+	Elapsed time.Duration
+}
+
+// Result is returned by Move.
+type Result struct {
+	Progress
+
+	// Transactional is true if the batches were moved in transactions.
+	Transactional bool
+}
+
+// Move moves the documents that match the filter of cfg from the source
+// collection to the destination collection, in batches ordered by _id. It
+// returns once no documents match the filter, ctx is done, or an error occurs.
+// The returned Result reflects the batches that were moved even if an error is
+// returned.
+func Move(ctx context.Context, cfg Config) (Result, error) {
+	if err := cfg.validate(); err != nil {
+		return Result{}, err
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+
+	client := cfg.Source.Database().Client()
+	res := Result{Transactional: client == cfg.Destination.Database().Client()}
+
+	var sess *mongo.Session
+	if res.Transactional {
+		var err error
+		if sess, err = client.StartSession(); err != nil {
+			return res, err
+		}
+		defer sess.EndSession(ctx)
+	}
+
+	start := time.Now()
+	for {
+		var n int
+		var err error
+		if res.Transactional {
+			n, err = moveBatchInTransaction(ctx, sess, &cfg)
+		} else {
+			n, err = moveBatch(ctx, &cfg)
+		}
+		if err != nil {
+			return res, fmt.Errorf("error moving batch %d: %w", res.Batches+1, err)
+		}
+		if n == 0 {
+			return res, nil
+		}
+
+		res.Batches++
+		res.Moved += int64(n)
+		res.Elapsed = time.Since(start)
+		if cfg.Progress != nil {
+			cfg.Progress(res.Progress)
+		}
+
+		if d := pace(res.Moved, time.Since(start), cfg.MaxDocumentsPerSecond); d > 0 {
+			timer := time.NewTimer(d)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return res, ctx.Err()
+			}
+		}
+	}
+}
+
+// pace returns how long to wait so that moved documents in elapsed time does
+// not exceed rate documents per second.
+func pace(moved int64, elapsed time.Duration, rate float64) time.Duration {
+	if rate <= 0 {
+		return 0
+	}
+	target := time.Duration(float64(moved) / rate * float64(time.Second))
+	return target - elapsed
+}
+
+// nextBatch returns the next batch of documents that match the filter.
+func nextBatch(ctx context.Context, cfg *Config) ([]bson.Raw, []any, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(cfg.BatchSize))
+	cursor, err := cfg.Source.Find(ctx, cfg.Filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var docs []bson.Raw
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, nil, err
+	}
+	ids := make([]any, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.Lookup("_id"))
+	}
+	return docs, ids, nil
+}
+
+func moveBatchInTransaction(ctx context.Context, sess *mongo.Session, cfg *Config) (int, error) {
+	n, err := sess.WithTransaction(ctx, func(ctx context.Context) (any, error) {
+		docs, ids, err := nextBatch(ctx, cfg)
+		if err != nil || len(docs) == 0 {
+			return 0, err
+		}
+		if _, err := cfg.Destination.InsertMany(ctx, docs); err != nil {
+			return 0, err
+		}
+		if _, err := cfg.Source.DeleteMany(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}); err != nil {
+			return 0, err
+		}
+		return len(docs), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n.(int), nil
+}
+
+func moveBatch(ctx context.Context, cfg *Config) (int, error) {
+	docs, ids, err := nextBatch(ctx, cfg)
+	if err != nil || len(docs) == 0 {
+		return 0, err
+	}
+
+	models := make([]mongo.WriteModel, 0, len(docs))
+	for i, doc := range docs {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: ids[i]}}).
+			SetReplacement(doc).
+			SetUpsert(true))
+	}
+	if _, err := cfg.Destination.BulkWrite(ctx, models); err != nil {
+		return 0, err
+	}
+	if _, err := cfg.Source.DeleteMany(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}); err != nil {
+		return 0, err
+	}
+	return len(docs), nil
+}
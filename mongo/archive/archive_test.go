@@ -0,0 +1,51 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func TestMoveValidation(t *testing.T) {
+	t.Parallel()
+
+	coll := &mongo.Collection{}
+	testCases := []struct {
+		name string
+		cfg  Config
+	}{
+		{"no source", Config{Destination: coll, Filter: bson.D{}}},
+		{"no destination", Config{Source: coll, Filter: bson.D{}}},
+		{"no filter", Config{Source: coll, Destination: coll}},
+		{"negative batch size", Config{Source: coll, Destination: coll, Filter: bson.D{}, BatchSize: -1}},
+		{"negative rate", Config{Source: coll, Destination: coll, Filter: bson.D{}, MaxDocumentsPerSecond: -1}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Move(context.Background(), tc.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPace(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Duration(0), pace(1000, time.Millisecond, 0))
+	assert.Equal(t, 900*time.Millisecond, pace(1000, 100*time.Millisecond, 1000))
+	assert.True(t, pace(1000, 2*time.Second, 1000) < 0, "expected no wait when below the rate")
+}
@@ -0,0 +1,136 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/audit"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// emitAudit completes rec with the time, the principal from ctx, and err, and
+// emits it to the audit sink of the Client, if one is configured.
+func (c *Client) emitAudit(ctx context.Context, rec audit.Record, err error) {
+	if c.auditSink == nil {
+		return
+	}
+
+	rec.Time = time.Now()
+	rec.Principal = audit.PrincipalFromContext(ctx)
+	rec.Err = err
+	c.auditSink.Emit(ctx, rec)
+}
+
+// auditCommand emits an audit record for cmd, run on db with RunCommand,
+// RunCommandCursor, or RunCommands, unless it is a known read command.
+func (c *Client) auditCommand(ctx context.Context, db string, cmd bsoncore.Document, err error) {
+	if c.auditSink == nil || isReadCommand(cmd) {
+		return
+	}
+
+	name := commandName(cmd)
+	ns := runCommandNamespace(db, cmd)
+	if pipeline, ok := cmd.Lookup("pipeline").ArrayOK(); ok && name == "aggregate" {
+		ns = outputNamespace(db, pipeline, ns)
+	}
+	c.emitAudit(ctx, audit.Record{Namespace: ns, Operation: name}, err)
+}
+
+// outputNamespace returns the namespace written to by the $out or $merge stage
+// that ends pipeline, run on db, or def if there is no such stage.
+func outputNamespace(db string, pipeline bsoncore.Array, def string) string {
+	stages, _ := pipeline.Values()
+	if len(stages) == 0 {
+		return def
+	}
+	last := bsoncore.BuildArray(nil, stages[len(stages)-1])
+	if namespaces := pipelineNamespaces(db, last); len(namespaces) > 0 {
+		return namespaces[0]
+	}
+	return def
+}
+
+// auditClientBulkWrite emits an audit record for each namespace written to by a
+// client-level bulk write. Document IDs and affected counts are only known per
+// namespace if verbose results were requested.
+func (c *Client) auditClientBulkWrite(ctx context.Context, writes []ClientBulkWrite, res *ClientBulkWriteResult, err error) {
+	if c.auditSink == nil {
+		return
+	}
+
+	var namespaces []string
+	recs := make(map[string]*audit.Record)
+	for i, w := range writes {
+		ns := w.Database + "." + w.Collection
+		rec, ok := recs[ns]
+		if !ok {
+			rec = &audit.Record{Namespace: ns, Operation: "bulkWrite"}
+			recs[ns] = rec
+			namespaces = append(namespaces, ns)
+		}
+
+		if ir, ok := res.InsertResults[i]; ok {
+			rec.DocumentIDs = append(rec.DocumentIDs, ir.InsertedID)
+			rec.Affected++
+		}
+		if ur, ok := res.UpdateResults[i]; ok {
+			rec.Affected += ur.ModifiedCount
+			if ur.UpsertedID != nil {
+				rec.DocumentIDs = append(rec.DocumentIDs, ur.UpsertedID)
+				rec.Affected++
+			}
+		}
+		if dr, ok := res.DeleteResults[i]; ok {
+			rec.Affected += dr.DeletedCount
+		}
+	}
+	for _, ns := range namespaces {
+		c.emitAudit(ctx, *recs[ns], err)
+	}
+}
+
+// filterHash returns the hex-encoded SHA-256 hash of filter.
+func filterHash(filter bsoncore.Document) string {
+	sum := sha256.Sum256(filter)
+	return hex.EncodeToString(sum[:])
+}
+
+// documentID returns the _id of doc as a Go value, or nil if doc does not have
+// an _id.
+func documentID(doc bsoncore.Document) any {
+	val, err := doc.LookupErr("_id")
+	if err != nil {
+		return nil
+	}
+	var id any
+	if err := bson.UnmarshalValue(bson.Type(val.Type), val.Data, &id); err != nil {
+		return nil
+	}
+	return id
+}
+
+// upsertedIDs returns the values of ids ordered by the index of their write
+// model.
+func upsertedIDs(ids map[int64]any) []any {
+	indexes := make([]int64, 0, len(ids))
+	for i := range ids {
+		indexes = append(indexes, i)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	res := make([]any, 0, len(indexes))
+	for _, i := range indexes {
+		res = append(res, ids[i])
+	}
+	return res
+}
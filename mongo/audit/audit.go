@@ -0,0 +1,140 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package audit defines the records emitted by a mongo.Client for every write
+// operation when an audit Sink is configured, for example to satisfy
+// compliance requirements without enabling auditing on the server:
+//
+//	sink := audit.NewJSONSink(auditLog)
+//	client, err := mongo.Connect(options.Client().ApplyURI(uri).SetAuditSink(sink))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	// Attribute the writes of a request to the user that made it.
+//	ctx = audit.NewContext(ctx, user.Name)
+//	_, err = client.Database("app").Collection("orders").InsertOne(ctx, order)
+//
+// Records are emitted after the operation completes, including when it fails.
+// Filters are recorded as a hash rather than their contents, so audit records
+// do not contain the values of queried fields.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Record describes a write operation run through a mongo.Client.
+type Record struct {
+	// Time is the time the operation completed.
+	Time time.Time `json:"time"`
+
+	// Namespace is the "database.collection" namespace that was written to,
+	// or the database name for operations on a whole database, such as
+	// "dropDatabase".
+	Namespace string `json:"namespace"`
+
+	// Operation is the name of the write command, such as "insert", "update",
+	// "delete", "findAndModify", "bulkWrite", "aggregate" (for pipelines that
+	// end with $out or $merge), "drop", "dropDatabase", "create",
+	// "createIndexes", "dropIndexes", "createSearchIndexes",
+	// "updateSearchIndex", or "dropSearchIndex". For commands run with
+	// Database.RunCommand, RunCommandCursor, or RunCommands, it is the name of
+	// the command, such as "collMod" or "renameCollection"; a record is emitted
+	// for every command that is not a known read command.
+	Operation string `json:"operation"`
+
+	// FilterHash is the hex-encoded SHA-256 hash of the BSON filter of the
+	// operation. It can be used to correlate operations with the same filter
+	// without recording the filter. It is empty for operations without a
+	// filter, such as inserts.
+	FilterHash string `json:"filterHash,omitempty"`
+
+	// DocumentIDs contains the _id values of the documents that are known to be
+	// affected: inserted and upserted documents, and the documents returned by
+	// findAndModify operations. For Collection.BulkWrite, only upserted
+	// documents are included. For Client.BulkWrite, documents are only included
+	// if verbose results are requested.
+	DocumentIDs []any `json:"documentIds,omitempty"`
+
+	// Affected is the number of documents inserted, modified, upserted, or
+	// deleted, as reported by the server. It is zero for unacknowledged
+	// writes, and for Client.BulkWrite if verbose results are not requested.
+	Affected int64 `json:"affected"`
+
+	// Principal is the principal attached to the context of the operation with
+	// NewContext.
+	Principal string `json:"principal,omitempty"`
+
+	// Err is the error returned by the operation, if any.
+	Err error `json:"-"`
+}
+
+// Sink receives audit records. Emit is called synchronously after each write
+// operation completes, so implementations that do I/O should buffer records or
+// hand them off to another goroutine. Emit must be safe to call concurrently.
+type Sink interface {
+	Emit(context.Context, Record)
+}
+
+// SinkFunc is an adapter that allows a function to be used as a Sink.
+type SinkFunc func(context.Context, Record)
+
+var _ Sink = SinkFunc(nil)
+
+// Emit implements the Sink interface.
+func (f SinkFunc) Emit(ctx context.Context, r Record) {
+	f(ctx, r)
+}
+
+// principalKey is the context key for the principal of an operation.
+type principalKey struct{}
+
+// NewContext returns a copy of ctx that attributes operations run with it to
+// principal.
+func NewContext(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal attached to ctx with NewContext,
+// or an empty string if there is none.
+func PrincipalFromContext(ctx context.Context) string {
+	p, _ := ctx.Value(principalKey{}).(string)
+	return p
+}
+
+// jsonSink is a Sink that writes each record as a line of JSON.
+type jsonSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// jsonRecord adds the error message to the JSON form of a Record.
+type jsonRecord struct {
+	Record
+	Error string `json:"error,omitempty"`
+}
+
+// NewJSONSink returns a Sink that writes each record to w as a line of JSON.
+// Errors writing to w are ignored.
+func NewJSONSink(w io.Writer) Sink {
+	return &jsonSink{enc: json.NewEncoder(w)}
+}
+
+func (s *jsonSink) Emit(_ context.Context, r Record) {
+	jr := jsonRecord{Record: r}
+	if r.Err != nil {
+		jr.Error = r.Err.Error()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.enc.Encode(jr)
+}
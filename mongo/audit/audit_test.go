@@ -0,0 +1,48 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+)
+
+func TestPrincipalFromContext(t *testing.T) {
+	assert.Equal(t, "", PrincipalFromContext(context.Background()))
+	assert.Equal(t, "alice", PrincipalFromContext(NewContext(context.Background(), "alice")))
+}
+
+func TestJSONSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewJSONSink(&buf)
+
+	sink.Emit(context.Background(), Record{
+		Time:        time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Namespace:   "app.orders",
+		Operation:   "insert",
+		DocumentIDs: []any{int32(1)},
+		Affected:    1,
+		Principal:   "alice",
+	})
+	sink.Emit(context.Background(), Record{
+		Time:       time.Date(2025, 1, 2, 3, 4, 6, 0, time.UTC),
+		Namespace:  "app.orders",
+		Operation:  "delete",
+		FilterHash: "abc",
+		Err:        errors.New("network error"),
+	})
+
+	want := `{"time":"2025-01-02T03:04:05Z","namespace":"app.orders","operation":"insert","documentIds":[1],"affected":1,"principal":"alice"}
+{"time":"2025-01-02T03:04:06Z","namespace":"app.orders","operation":"delete","filterHash":"abc","affected":0,"error":"network error"}
+`
+	assert.Equal(t, want, buf.String())
+}
@@ -0,0 +1,132 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/audit"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+func TestAuditRecords(t *testing.T) {
+	var mu sync.Mutex
+	var records []audit.Record
+	sink := audit.SinkFunc(func(_ context.Context, r audit.Record) {
+		mu.Lock()
+		defer mu.Unlock()
+		records = append(records, r)
+	})
+
+	// Use an unreachable server so that operations fail quickly after the
+	// audit record is built.
+	client := setupClient(options.Client().
+		ApplyURI("mongodb://localhost:1").
+		SetServerSelectionTimeout(10 * time.Millisecond).
+		SetAuditSink(sink))
+	coll := client.Database("app").Collection("orders")
+	ctx := audit.NewContext(context.Background(), "alice")
+
+	_, err := coll.InsertOne(ctx, bson.D{{Key: "_id", Value: 1}})
+	assert.Error(t, err)
+	_, err = coll.DeleteMany(ctx, bson.D{{Key: "status", Value: "done"}})
+	assert.Error(t, err)
+	_, err = coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: 2}}, bson.D{{Key: "$set", Value: bson.D{{Key: "x", Value: 1}}}})
+	assert.Error(t, err)
+	err = coll.FindOneAndDelete(ctx, bson.D{{Key: "_id", Value: 3}}).Err()
+	assert.Error(t, err)
+	_, err = coll.Find(ctx, bson.D{})
+	assert.Error(t, err)
+
+	require.Len(t, records, 4)
+	wantOps := []string{"insert", "delete", "update", "findAndModify"}
+	for i, rec := range records {
+		assert.Equal(t, wantOps[i], rec.Operation)
+		assert.Equal(t, "app.orders", rec.Namespace)
+		assert.Equal(t, "alice", rec.Principal)
+		assert.Error(t, rec.Err)
+		assert.False(t, rec.Time.IsZero(), "expected record time to be set")
+	}
+	assert.Equal(t, "", records[0].FilterHash)
+	wantFilter := bsoncore.NewDocumentBuilder().AppendString("status", "done").Build()
+	assert.Equal(t, filterHash(wantFilter), records[1].FilterHash)
+}
+
+func TestAuditCommandRecords(t *testing.T) {
+	var mu sync.Mutex
+	var records []audit.Record
+	sink := audit.SinkFunc(func(_ context.Context, r audit.Record) {
+		mu.Lock()
+		defer mu.Unlock()
+		records = append(records, r)
+	})
+
+	client := setupClient(options.Client().
+		ApplyURI("mongodb://localhost:1").
+		SetServerSelectionTimeout(10 * time.Millisecond).
+		SetAuditSink(sink))
+	db := client.Database("app")
+	coll := db.Collection("orders")
+	ctx := audit.NewContext(context.Background(), "alice")
+
+	_, err := coll.Aggregate(ctx, Pipeline{{{Key: "$out", Value: "archive"}}})
+	assert.Error(t, err)
+	_, err = coll.Aggregate(ctx, Pipeline{{{Key: "$match", Value: bson.D{}}}})
+	assert.Error(t, err)
+	err = db.RunCommand(ctx, bson.D{{Key: "delete", Value: "orders"}, {Key: "deletes", Value: bson.A{}}}).Err()
+	assert.Error(t, err)
+	err = db.RunCommand(ctx, bson.D{{Key: "ping", Value: 1}}).Err()
+	assert.Error(t, err)
+	err = db.RunCommand(ctx, bson.D{
+		{Key: "aggregate", Value: "orders"},
+		{Key: "pipeline", Value: bson.A{bson.D{{Key: "$merge", Value: bson.D{{Key: "into", Value: bson.D{
+			{Key: "db", Value: "reports"}, {Key: "coll", Value: "totals"},
+		}}}}}}},
+		{Key: "cursor", Value: bson.D{}},
+	}).Err()
+	assert.Error(t, err)
+	err = coll.EnableChangeStreamPreAndPostImages(ctx, true)
+	assert.Error(t, err)
+	err = coll.Rename(ctx, "orders_old", false)
+	assert.Error(t, err)
+	_, err = coll.Indexes().CreateOne(ctx, IndexModel{Keys: bson.D{{Key: "status", Value: 1}}})
+	assert.Error(t, err)
+	err = db.CreateCollection(ctx, "invoices")
+	assert.Error(t, err)
+	err = db.Drop(ctx)
+	assert.Error(t, err)
+
+	want := []audit.Record{
+		{Namespace: "app.archive", Operation: "aggregate"},
+		{Namespace: "app.orders", Operation: "delete"},
+		{Namespace: "reports.totals", Operation: "aggregate"},
+		{Namespace: "app.orders", Operation: "collMod"},
+		{Namespace: "app.orders", Operation: "renameCollection"},
+		{Namespace: "app.orders", Operation: "createIndexes"},
+		{Namespace: "app.invoices", Operation: "create"},
+		{Namespace: "app", Operation: "dropDatabase"},
+	}
+	require.Len(t, records, len(want))
+	for i, rec := range records {
+		assert.Equal(t, want[i].Operation, rec.Operation)
+		assert.Equal(t, want[i].Namespace, rec.Namespace)
+		assert.Equal(t, "alice", rec.Principal)
+		assert.Error(t, rec.Err)
+	}
+}
+
+func TestUpsertedIDs(t *testing.T) {
+	got := upsertedIDs(map[int64]any{3: "c", 0: "a", 1: "b"})
+	assert.Equal(t, []any{"a", "b", "c"}, got)
+}
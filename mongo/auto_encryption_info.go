@@ -0,0 +1,93 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// QueryAnalysisMode describes how a Client with automatic encryption configured
+// analyzes commands to determine which fields to encrypt.
+type QueryAnalysisMode string
+
+// These constants are the possible values of AutoEncryptionInfo.QueryAnalysis.
+const (
+	// QueryAnalysisNone indicates that commands are not analyzed, either because
+	// automatic encryption is not configured or because BypassAutoEncryption or
+	// BypassQueryAnalysis is set.
+	QueryAnalysisNone QueryAnalysisMode = "none"
+
+	// QueryAnalysisCryptShared indicates that commands are analyzed in-process by
+	// the crypt_shared library.
+	QueryAnalysisCryptShared QueryAnalysisMode = "crypt_shared"
+
+	// QueryAnalysisMongocryptd indicates that commands are analyzed by a
+	// mongocryptd process because the crypt_shared library was not loaded.
+	QueryAnalysisMongocryptd QueryAnalysisMode = "mongocryptd"
+)
+
+// AutoEncryptionInfo describes the automatic encryption configuration that was
+// loaded when a Client was created.
+type AutoEncryptionInfo struct {
+	// Enabled is true if AutoEncryptionOptions were set on the Client.
+	Enabled bool
+
+	// QueryAnalysis is the mode used to analyze commands for automatic
+	// encryption.
+	QueryAnalysis QueryAnalysisMode
+
+	// CryptSharedLibVersion is the version of the loaded crypt_shared library
+	// in the form "major.minor.patch", or an empty string if it was not loaded.
+	CryptSharedLibVersion string
+
+	// CryptSharedLibVersionString is the full version string reported by the
+	// loaded crypt_shared library, or an empty string if it was not loaded.
+	CryptSharedLibVersionString string
+}
+
+// AutoEncryptionInfo returns information about the automatic encryption
+// configuration loaded by the Client, including whether the crypt_shared
+// library or mongocryptd is used for query analysis.
+func (c *Client) AutoEncryptionInfo() AutoEncryptionInfo {
+	if !c.autoEncryptionInfo.Enabled {
+		return AutoEncryptionInfo{QueryAnalysis: QueryAnalysisNone}
+	}
+	return c.autoEncryptionInfo
+}
+
+// parseCryptSharedLibVersion parses a "major.minor.patch" version string into
+// the encoding used by libmongocrypt, which stores the major, minor, and patch
+// versions as the three most significant 16-bit words of a uint64. Omitted
+// minor and patch versions default to zero.
+func parseCryptSharedLibVersion(version string) (uint64, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("expected a version of the form \"major.minor.patch\", got %q", version)
+	}
+
+	var v uint64
+	for i := 0; i < 3; i++ {
+		var n uint64
+		if i < len(parts) {
+			var err error
+			n, err = strconv.ParseUint(parts[i], 10, 16)
+			if err != nil {
+				return 0, fmt.Errorf("expected a version of the form \"major.minor.patch\", got %q", version)
+			}
+		}
+		v |= n << (48 - 16*i)
+	}
+	return v, nil
+}
+
+// formatCryptSharedLibVersion formats a version encoded by libmongocrypt as a
+// "major.minor.patch" string.
+func formatCryptSharedLibVersion(v uint64) string {
+	return fmt.Sprintf("%d.%d.%d", (v>>48)&0xffff, (v>>32)&0xffff, (v>>16)&0xffff)
+}
@@ -0,0 +1,55 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestCryptSharedLibVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		version string
+		want    uint64
+		format  string
+	}{
+		{"7.0.2", 0x0007_0000_0002_0000, "7.0.2"},
+		{"8.1", 0x0008_0001_0000_0000, "8.1.0"},
+		{"6", 0x0006_0000_0000_0000, "6.0.0"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.version, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseCryptSharedLibVersion(tc.version)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.format, formatCryptSharedLibVersion(got))
+		})
+	}
+
+	for _, invalid := range []string{"", "7.x", "1.2.3.4", "70000.0.0"} {
+		_, err := parseCryptSharedLibVersion(invalid)
+		assert.Error(t, err, "expected error parsing %q", invalid)
+	}
+}
+
+func TestClientAutoEncryptionInfo(t *testing.T) {
+	t.Parallel()
+
+	client, err := newClient()
+	require.NoError(t, err)
+
+	want := AutoEncryptionInfo{QueryAnalysis: QueryAnalysisNone}
+	assert.Equal(t, want, client.AutoEncryptionInfo())
+}
@@ -0,0 +1,324 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package bench runs configurable read/write workloads against a collection
+// and reports latency percentiles. It is intended for reproducing performance
+// problems consistently, for example when filing a bug report, so a workload
+// is fully described by its configuration and random seed:
+//
+//	report, err := bench.Run(ctx, bench.Workload{
+//		Collection:   client.Database("bench").Collection("docs"),
+//		DocumentSize: bench.UniformSize{Min: 512, Max: 16 * 1024},
+//		ReadRatio:    0.8,
+//		Concurrency:  32,
+//		Duration:     time.Minute,
+//		Seed:         1,
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(report)
+//
+// Writes replace or insert a document with a random _id from the workload's
+// key space, and reads look up a document by a random _id, so the collection
+// should be dedicated to the benchmark.
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	defaultDocumentSize = 1024
+	defaultKeySpace     = 10000
+)
+
+// SizeDistribution returns the payload sizes, in bytes, of documents written
+// by a workload.
+type SizeDistribution interface {
+	Size(r *rand.Rand) int
+}
+
+// FixedSize is a SizeDistribution that always returns the same size.
+type FixedSize int
+
+// Size implements the SizeDistribution interface.
+func (s FixedSize) Size(*rand.Rand) int {
+	return int(s)
+}
+
+// UniformSize is a SizeDistribution that returns sizes uniformly distributed
+// between Min and Max, inclusive.
+type UniformSize struct {
+	Min, Max int
+}
+
+// Size implements the SizeDistribution interface.
+func (s UniformSize) Size(r *rand.Rand) int {
+	if s.Max <= s.Min {
+		return s.Min
+	}
+	return s.Min + r.Intn(s.Max-s.Min+1)
+}
+
+// Workload describes a benchmark run. Either Operations or Duration must be
+// set.
+type Workload struct {
+	// Collection is the collection the workload runs against. It is required.
+	Collection *mongo.Collection
+
+	// DocumentSize is the distribution of payload sizes of written documents.
+	// The default is FixedSize(1024).
+	DocumentSize SizeDistribution
+
+	// ReadRatio is the fraction of operations that are reads, between 0 and 1.
+	// The remaining operations are writes.
+	ReadRatio float64
+
+	// Concurrency is the number of goroutines running operations. The default
+	// is 1.
+	Concurrency int
+
+	// Operations is the total number of operations to run. If zero, operations
+	// run until Duration elapses.
+	Operations int
+
+	// Duration bounds the run time. If zero, Operations operations are run.
+	Duration time.Duration
+
+	// KeySpace is the number of distinct _id values used by reads and writes.
+	// The default is 10000.
+	KeySpace int
+
+	// Seed seeds the random choices of the workload. Runs with the same seed
+	// and Concurrency of 1 perform the same sequence of operations.
+	Seed int64
+}
+
+func (w *Workload) validate() error {
+	if w.Collection == nil {
+		return errors.New("collection must be set")
+	}
+	if w.ReadRatio < 0 || w.ReadRatio > 1 {
+		return fmt.Errorf("read ratio must be between 0 and 1, got %v", w.ReadRatio)
+	}
+	if w.Concurrency < 0 || w.Operations < 0 || w.Duration < 0 || w.KeySpace < 0 {
+		return errors.New("concurrency, operations, duration, and key space must not be negative")
+	}
+	if w.Operations == 0 && w.Duration == 0 {
+		return errors.New("operations or duration must be set")
+	}
+	return nil
+}
+
+// LatencySummary summarizes the latencies of one kind of operation.
+type LatencySummary struct {
+	Count int
+	Min   time.Duration
+	Mean  time.Duration
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	P999  time.Duration
+	Max   time.Duration
+}
+
+func summarize(latencies []time.Duration) LatencySummary {
+	if len(latencies) == 0 {
+		return LatencySummary{}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	var total time.Duration
+	for _, l := range latencies {
+		total += l
+	}
+	return LatencySummary{
+		Count: len(latencies),
+		Min:   latencies[0],
+		Mean:  total / time.Duration(len(latencies)),
+		P50:   percentile(latencies, 50),
+		P90:   percentile(latencies, 90),
+		P99:   percentile(latencies, 99),
+		P999:  percentile(latencies, 99.9),
+		Max:   latencies[len(latencies)-1],
+	}
+}
+
+// percentile returns the p-th percentile of sorted using the nearest-rank
+// method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// Report is the result of a benchmark run.
+type Report struct {
+	// Elapsed is the wall time of the run.
+	Elapsed time.Duration
+
+	// Reads and Writes summarize the latencies of successful operations.
+	Reads  LatencySummary
+	Writes LatencySummary
+
+	// Errors is the number of failed operations, and FirstError the first
+	// error that occurred.
+	Errors     int
+	FirstError error
+}
+
+// Throughput returns the number of successful operations per second.
+func (r *Report) Throughput() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Reads.Count+r.Writes.Count) / r.Elapsed.Seconds()
+}
+
+// String formats the report as a table suitable for pasting into a bug report.
+func (r *Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "elapsed %v, %.1f ops/s, %d errors\n", r.Elapsed.Round(time.Millisecond), r.Throughput(), r.Errors)
+	fmt.Fprintf(&sb, "%-6s %8s %10s %10s %10s %10s %10s %10s %10s\n",
+		"op", "count", "min", "mean", "p50", "p90", "p99", "p99.9", "max")
+	for _, row := range []struct {
+		name string
+		s    LatencySummary
+	}{{"read", r.Reads}, {"write", r.Writes}} {
+		fmt.Fprintf(&sb, "%-6s %8d %10v %10v %10v %10v %10v %10v %10v\n",
+			row.name, row.s.Count, row.s.Min, row.s.Mean, row.s.P50, row.s.P90, row.s.P99, row.s.P999, row.s.Max)
+	}
+	if r.FirstError != nil {
+		fmt.Fprintf(&sb, "first error: %v\n", r.FirstError)
+	}
+	return sb.String()
+}
+
+// worker accumulates the results of a single goroutine of a run.
+type worker struct {
+	rng    *rand.Rand
+	reads  []time.Duration
+	writes []time.Duration
+	errors int
+	err    error
+}
+
+// Run runs the workload and returns a report of the operation latencies. An
+// error is only returned if the workload is invalid; failed operations are
+// counted in the report. Run stops early if ctx is canceled.
+func Run(ctx context.Context, w Workload) (*Report, error) {
+	if err := w.validate(); err != nil {
+		return nil, err
+	}
+	if w.DocumentSize == nil {
+		w.DocumentSize = FixedSize(defaultDocumentSize)
+	}
+	if w.Concurrency == 0 {
+		w.Concurrency = 1
+	}
+	if w.KeySpace == 0 {
+		w.KeySpace = defaultKeySpace
+	}
+	if w.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Duration)
+		defer cancel()
+	}
+
+	remaining := int64(w.Operations)
+	workers := make([]*worker, w.Concurrency)
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := range workers {
+		wk := &worker{rng: rand.New(rand.NewSource(w.Seed + int64(i)))}
+		workers[i] = wk
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for ctx.Err() == nil {
+				if w.Operations > 0 && atomic.AddInt64(&remaining, -1) < 0 {
+					return
+				}
+				w.runOne(ctx, wk)
+			}
+		}()
+	}
+	wg.Wait()
+
+	report := &Report{Elapsed: time.Since(start)}
+	var reads, writes []time.Duration
+	for _, wk := range workers {
+		reads = append(reads, wk.reads...)
+		writes = append(writes, wk.writes...)
+		report.Errors += wk.errors
+		if report.FirstError == nil {
+			report.FirstError = wk.err
+		}
+	}
+	report.Reads = summarize(reads)
+	report.Writes = summarize(writes)
+	return report, nil
+}
+
+func (w *Workload) runOne(ctx context.Context, wk *worker) {
+	id := int64(wk.rng.Intn(w.KeySpace))
+	read := wk.rng.Float64() < w.ReadRatio
+
+	var err error
+	var payload []byte
+	if !read {
+		payload = make([]byte, w.DocumentSize.Size(wk.rng))
+		wk.rng.Read(payload)
+	}
+
+	opStart := time.Now()
+	if read {
+		err = w.Collection.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Err()
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = nil
+		}
+	} else {
+		_, err = w.Collection.ReplaceOne(ctx,
+			bson.D{{Key: "_id", Value: id}},
+			bson.D{{Key: "_id", Value: id}, {Key: "payload", Value: payload}},
+			options.Replace().SetUpsert(true))
+	}
+	latency := time.Since(opStart)
+
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// The run ended while the operation was in progress.
+	case err != nil:
+		wk.errors++
+		if wk.err == nil {
+			wk.err = err
+		}
+	case read:
+		wk.reads = append(wk.reads, latency)
+	default:
+		wk.writes = append(wk.writes, latency)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bench
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	latencies := make([]time.Duration, 0, 1000)
+	for i := 1000; i >= 1; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	got := summarize(latencies)
+	want := LatencySummary{
+		Count: 1000,
+		Min:   time.Millisecond,
+		Mean:  500500 * time.Microsecond,
+		P50:   500 * time.Millisecond,
+		P90:   900 * time.Millisecond,
+		P99:   990 * time.Millisecond,
+		P999:  999 * time.Millisecond,
+		Max:   1000 * time.Millisecond,
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, LatencySummary{}, summarize(nil))
+}
+
+func TestUniformSize(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	dist := UniformSize{Min: 10, Max: 20}
+	for i := 0; i < 100; i++ {
+		size := dist.Size(rng)
+		assert.True(t, size >= 10 && size <= 20, "expected size in [10, 20], got %d", size)
+	}
+	assert.Equal(t, 10, UniformSize{Min: 10, Max: 5}.Size(rng))
+	assert.Equal(t, 7, FixedSize(7).Size(rng))
+}
+
+func TestRunValidation(t *testing.T) {
+	t.Parallel()
+
+	coll := &mongo.Collection{}
+	testCases := []struct {
+		name string
+		w    Workload
+	}{
+		{"no collection", Workload{Operations: 1}},
+		{"no bound", Workload{Collection: coll}},
+		{"read ratio", Workload{Collection: coll, Operations: 1, ReadRatio: 1.5}},
+		{"negative concurrency", Workload{Collection: coll, Operations: 1, Concurrency: -1}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Run(context.Background(), tc.w)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReportString(t *testing.T) {
+	t.Parallel()
+
+	r := &Report{
+		Elapsed: 2 * time.Second,
+		Reads:   LatencySummary{Count: 30},
+		Writes:  LatencySummary{Count: 10},
+	}
+	assert.Equal(t, 20.0, r.Throughput())
+	assert.True(t, strings.HasPrefix(r.String(), "elapsed 2s, 20.0 ops/s, 0 errors\n"), "unexpected report: %s", r)
+}
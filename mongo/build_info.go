@@ -0,0 +1,264 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
+)
+
+const (
+	// buildInfoTTL is the maximum time build info is cached. Build info is
+	// refreshed sooner if a server restarts or changes its wire version.
+	buildInfoTTL = 10 * time.Minute
+
+	// buildInfoRefreshTimeout bounds the buildInfo commands sent in the
+	// background for subscribers.
+	buildInfoRefreshTimeout = 30 * time.Second
+)
+
+// ServerBuildInfo is the build information of a server, as reported by the buildInfo command.
+type ServerBuildInfo struct {
+	// Version is the server version, e.g. "8.0.4".
+	Version string `bson:"version"`
+
+	// VersionArray is the server version as [major, minor, patch, extra].
+	VersionArray []int32 `bson:"versionArray"`
+
+	// GitVersion is the commit the server was built from.
+	GitVersion string `bson:"gitVersion"`
+
+	// Modules are the optional modules included in the build, e.g. "enterprise".
+	Modules []string `bson:"modules"`
+
+	// StorageEngines are the storage engines supported by the build.
+	StorageEngines []string `bson:"storageEngines"`
+
+	// Bits is the address size of the build, e.g. 64.
+	Bits int32 `bson:"bits"`
+
+	// Debug is whether the build is a debug build.
+	Debug bool `bson:"debug"`
+
+	// MaxBSONObjectSize is the maximum size of a BSON document in bytes.
+	MaxBSONObjectSize int32 `bson:"maxBsonObjectSize"`
+
+	// FetchedAt is the time the buildInfo command was run.
+	FetchedAt time.Time `bson:"-"`
+}
+
+// Enterprise returns whether the server is MongoDB Enterprise.
+func (bi *ServerBuildInfo) Enterprise() bool {
+	for _, m := range bi.Modules {
+		if m == "enterprise" {
+			return true
+		}
+	}
+	return false
+}
+
+// sameBuild returns whether bi and other describe the same build, ignoring
+// when they were fetched.
+func (bi *ServerBuildInfo) sameBuild(other *ServerBuildInfo) bool {
+	a, b := *bi, *other
+	a.FetchedAt, b.FetchedAt = time.Time{}, time.Time{}
+	return reflect.DeepEqual(a, b)
+}
+
+func (bi *ServerBuildInfo) clone() *ServerBuildInfo {
+	cp := *bi
+	cp.VersionArray = append([]int32(nil), bi.VersionArray...)
+	cp.Modules = append([]string(nil), bi.Modules...)
+	cp.StorageEngines = append([]string(nil), bi.StorageEngines...)
+	return &cp
+}
+
+// buildInfoCache holds the most recent ServerBuildInfo of a Client and the
+// functions subscribed to changes of it.
+type buildInfoCache struct {
+	mu          sync.Mutex
+	info        *ServerBuildInfo
+	fingerprint string
+
+	subsMu      sync.Mutex
+	subs        map[uint64]func(ServerBuildInfo)
+	nextSubID   uint64
+	stopWatcher chan struct{}
+}
+
+// BuildInfo returns the build information of the deployment the Client is connected to, as reported by the buildInfo
+// admin command of the primary, or of another server if there is no primary.
+//
+// The result is cached until a server of the deployment restarts or changes its wire version, as observed by the
+// Client's background monitoring, and for at most 10 minutes. BuildInfo can therefore be called on every health check
+// without sending a command each time. Concurrent calls share a single command. The returned value may be modified by
+// the caller.
+func (c *Client) BuildInfo(ctx context.Context) (*ServerBuildInfo, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	c.buildInfo.mu.Lock()
+	fingerprint := c.deploymentFingerprint()
+	if bi := c.buildInfo.info; bi != nil && c.buildInfo.fingerprint == fingerprint &&
+		time.Since(bi.FetchedAt) < buildInfoTTL {
+		c.buildInfo.mu.Unlock()
+		return bi.clone(), nil
+	}
+
+	opts := options.RunCmd().SetReadPreference(readpref.PrimaryPreferred())
+	res := c.Database("admin").RunCommand(ctx, bson.D{{"buildInfo", 1}}, opts)
+	bi := &ServerBuildInfo{}
+	if err := res.Decode(bi); err != nil {
+		c.buildInfo.mu.Unlock()
+		return nil, err
+	}
+	bi.FetchedAt = time.Now()
+
+	prev := c.buildInfo.info
+	c.buildInfo.info = bi
+	c.buildInfo.fingerprint = fingerprint
+	c.buildInfo.mu.Unlock()
+
+	if prev != nil && !prev.sameBuild(bi) {
+		c.notifyBuildInfo(bi)
+	}
+	return bi.clone(), nil
+}
+
+// SubscribeBuildInfo registers fn to be called with the new build information whenever the build information returned
+// by BuildInfo changes, e.g. after the deployment is upgraded. While fn is subscribed, the Client refreshes the build
+// information in the background whenever a server restarts or changes its wire version, so changes are reported
+// without calling BuildInfo. fn is not called for the first build information fetched.
+//
+// fn is called from a background goroutine and must not block. Call the returned function to unsubscribe.
+func (c *Client) SubscribeBuildInfo(fn func(ServerBuildInfo)) (unsubscribe func()) {
+	c.buildInfo.subsMu.Lock()
+	defer c.buildInfo.subsMu.Unlock()
+
+	if c.buildInfo.subs == nil {
+		c.buildInfo.subs = make(map[uint64]func(ServerBuildInfo))
+	}
+	id := c.buildInfo.nextSubID
+	c.buildInfo.nextSubID++
+	c.buildInfo.subs[id] = fn
+
+	if c.buildInfo.stopWatcher == nil {
+		if subscriber, ok := c.deployment.(driver.Subscriber); ok {
+			if sub, err := subscriber.Subscribe(); err == nil {
+				stop := make(chan struct{})
+				c.buildInfo.stopWatcher = stop
+				go c.watchBuildInfo(subscriber, sub, stop)
+			}
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.buildInfo.subsMu.Lock()
+			defer c.buildInfo.subsMu.Unlock()
+
+			delete(c.buildInfo.subs, id)
+			if len(c.buildInfo.subs) == 0 && c.buildInfo.stopWatcher != nil {
+				close(c.buildInfo.stopWatcher)
+				c.buildInfo.stopWatcher = nil
+			}
+		})
+	}
+}
+
+// notifyBuildInfo calls the subscribed functions with bi.
+func (c *Client) notifyBuildInfo(bi *ServerBuildInfo) {
+	c.buildInfo.subsMu.Lock()
+	fns := make([]func(ServerBuildInfo), 0, len(c.buildInfo.subs))
+	for _, fn := range c.buildInfo.subs {
+		fns = append(fns, fn)
+	}
+	c.buildInfo.subsMu.Unlock()
+
+	for _, fn := range fns {
+		fn(*bi.clone())
+	}
+}
+
+// watchBuildInfo refreshes the cached build info whenever the servers of the
+// deployment change until stop is closed or the subscription ends, which
+// happens when the Client is disconnected.
+func (c *Client) watchBuildInfo(subscriber driver.Subscriber, sub *driver.Subscription, stop <-chan struct{}) {
+	defer func() { _ = subscriber.Unsubscribe(sub) }()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case td, ok := <-sub.Updates:
+			if !ok {
+				return
+			}
+
+			c.buildInfo.mu.Lock()
+			changed := c.buildInfo.info == nil || c.buildInfo.fingerprint != fingerprintOf(td.Servers)
+			c.buildInfo.mu.Unlock()
+			if !changed {
+				continue
+			}
+
+			// Errors are ignored because the next change of the deployment
+			// triggers another refresh.
+			ctx, cancel := context.WithTimeout(context.Background(), buildInfoRefreshTimeout)
+			_, _ = c.BuildInfo(ctx)
+			cancel()
+		}
+	}
+}
+
+// deploymentFingerprint identifies the server processes of the deployment and
+// their wire versions. It is empty if the deployment does not expose its
+// servers, e.g. if it is not a *topology.Topology.
+func (c *Client) deploymentFingerprint() string {
+	topo, ok := c.deployment.(*topology.Topology)
+	if !ok {
+		return ""
+	}
+	return fingerprintOf(topo.Description().Servers)
+}
+
+// fingerprintOf returns a string that changes if any of servers restarts or
+// changes its wire version. Servers that are not connected are ignored, so
+// that the fingerprint does not change while a server is unreachable.
+func fingerprintOf(servers []description.Server) string {
+	parts := make([]string, 0, len(servers))
+	for _, s := range servers {
+		if s.Kind == description.Unknown {
+			continue
+		}
+		var processID string
+		if s.TopologyVersion != nil {
+			processID = s.TopologyVersion.ProcessID.Hex()
+		}
+		var wireVersion int32
+		if s.WireVersion != nil {
+			wireVersion = s.WireVersion.Max
+		}
+		parts = append(parts, fmt.Sprintf("%s/%s/%d", s.Addr, processID, wireVersion))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,112 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+)
+
+func TestServerBuildInfo(t *testing.T) {
+	bi := &ServerBuildInfo{
+		Version:      "8.0.4",
+		VersionArray: []int32{8, 0, 4, 0},
+		Modules:      []string{"enterprise"},
+		FetchedAt:    time.Now(),
+	}
+
+	t.Run("Enterprise", func(t *testing.T) {
+		assert.True(t, bi.Enterprise(), "expected an enterprise build")
+		assert.False(t, (&ServerBuildInfo{}).Enterprise(), "expected a community build")
+	})
+	t.Run("sameBuild", func(t *testing.T) {
+		later := bi.clone()
+		later.FetchedAt = bi.FetchedAt.Add(time.Minute)
+		assert.True(t, bi.sameBuild(later), "expected fetch time to be ignored")
+
+		upgraded := bi.clone()
+		upgraded.Version = "8.0.5"
+		assert.False(t, bi.sameBuild(upgraded), "expected different versions to differ")
+	})
+	t.Run("clone", func(t *testing.T) {
+		cp := bi.clone()
+		cp.Modules[0] = "changed"
+		assert.Equal(t, "enterprise", bi.Modules[0], "expected clone not to share slices")
+	})
+}
+
+func TestFingerprintOf(t *testing.T) {
+	pid1, pid2 := bson.NewObjectID(), bson.NewObjectID()
+	server := func(addr string, pid bson.ObjectID, wireVersion int32) description.Server {
+		return description.Server{
+			Addr:            address.Address(addr),
+			Kind:            description.ServerKindRSSecondary,
+			TopologyVersion: &description.TopologyVersion{ProcessID: pid},
+			WireVersion:     &description.VersionRange{Max: wireVersion},
+		}
+	}
+
+	base := fingerprintOf([]description.Server{server("a:27017", pid1, 21), server("b:27017", pid1, 21)})
+
+	testCases := []struct {
+		name    string
+		servers []description.Server
+		same    bool
+	}{
+		{
+			name:    "reordered",
+			servers: []description.Server{server("b:27017", pid1, 21), server("a:27017", pid1, 21)},
+			same:    true,
+		},
+		{
+			name: "unreachable server",
+			servers: []description.Server{
+				server("a:27017", pid1, 21),
+				server("b:27017", pid1, 21),
+				{Addr: "c:27017", Kind: description.Unknown},
+			},
+			same: true,
+		},
+		{
+			name:    "restarted server",
+			servers: []description.Server{server("a:27017", pid1, 21), server("b:27017", pid2, 21)},
+			same:    false,
+		},
+		{
+			name:    "upgraded server",
+			servers: []description.Server{server("a:27017", pid1, 21), server("b:27017", pid1, 25)},
+			same:    false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := fingerprintOf(tc.servers)
+			assert.Equal(t, tc.same, got == base, "expected fingerprint %q to equal %q: %v", got, base, tc.same)
+		})
+	}
+}
+
+func TestSubscribeBuildInfo(t *testing.T) {
+	c := &Client{}
+
+	var got []string
+	unsubscribe := c.SubscribeBuildInfo(func(bi ServerBuildInfo) {
+		got = append(got, bi.Version)
+	})
+
+	c.notifyBuildInfo(&ServerBuildInfo{Version: "8.0.4"})
+	unsubscribe()
+	unsubscribe()
+	c.notifyBuildInfo(&ServerBuildInfo{Version: "8.0.5"})
+
+	assert.Equal(t, []string{"8.0.4"}, got, "expected notifications until unsubscribed")
+}
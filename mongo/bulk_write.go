@@ -72,6 +72,7 @@ func (bw *bulkWrite) execute(ctx context.Context) error {
 		bwErr.Labels = append(bwErr.Labels, batchErr.Labels...)
 
 		bwErr.WriteErrors = append(bwErr.WriteErrors, batchErr.WriteErrors...)
+		bwErr.Responses = append(bwErr.Responses, batchErr.Responses...)
 
 		commandErrorOccurred := err != nil && !errors.Is(err, driver.ErrUnacknowledgedWrite)
 		writeErrorOccurred := len(batchErr.WriteErrors) > 0 || batchErr.WriteConcernError != nil
@@ -121,6 +122,7 @@ func (bw *bulkWrite) runBatch(ctx context.Context, batch bulkWriteBatch) (BulkWr
 			writeErrors = writeErr.WriteErrors
 			batchErr.Labels = writeErr.Labels
 			batchErr.WriteConcernError = convertDriverWriteConcernError(writeErr.WriteConcernError)
+			batchErr.Responses = responsesFromDriverWriteCommandError(writeErr)
 		}
 		batchRes.InsertedCount = res.N
 	case *DeleteOneModel, *DeleteManyModel:
@@ -133,6 +135,7 @@ func (bw *bulkWrite) runBatch(ctx context.Context, batch bulkWriteBatch) (BulkWr
 			writeErrors = writeErr.WriteErrors
 			batchErr.Labels = writeErr.Labels
 			batchErr.WriteConcernError = convertDriverWriteConcernError(writeErr.WriteConcernError)
+			batchErr.Responses = responsesFromDriverWriteCommandError(writeErr)
 		}
 		batchRes.DeletedCount = res.N
 	case *ReplaceOneModel, *UpdateOneModel, *UpdateManyModel:
@@ -145,6 +148,7 @@ func (bw *bulkWrite) runBatch(ctx context.Context, batch bulkWriteBatch) (BulkWr
 			writeErrors = writeErr.WriteErrors
 			batchErr.Labels = writeErr.Labels
 			batchErr.WriteConcernError = convertDriverWriteConcernError(writeErr.WriteConcernError)
+			batchErr.Responses = responsesFromDriverWriteCommandError(writeErr)
 		}
 		batchRes.MatchedCount = res.N
 		batchRes.ModifiedCount = res.NModified
@@ -187,7 +191,9 @@ func (bw *bulkWrite) runInsert(ctx context.Context, batch bulkWriteBatch) (opera
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
 		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
-		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.cryptFLE).
+		Deployment(bw.collection.client.deploymentFor(ctx)).RetryPolicy(bw.collection.client.retryPolicy).
+		CommandInterceptors(bw.collection.client.interceptors).MemoryAccountant(bw.collection.client.memoryAccountant()).
+		Crypt(bw.collection.client.cryptFLE).
 		ServerAPI(bw.collection.client.serverAPI).Timeout(bw.collection.client.timeout).
 		Logger(bw.collection.client.logger).Authenticator(bw.collection.client.authenticator)
 	if bw.comment != nil {
@@ -214,7 +220,8 @@ func (bw *bulkWrite) runInsert(ctx context.Context, batch bulkWriteBatch) (opera
 		op.RawData(*bw.rawData)
 	}
 
-	err := op.Execute(ctx)
+	execute := bw.collection.client.memory.accountDocuments(docs, op.Execute)
+	err := bw.collection.client.runOperation(ctx, "insert", bw.collection.namespace(), execute)
 
 	return op.Result(), err
 }
@@ -261,7 +268,9 @@ func (bw *bulkWrite) runDelete(ctx context.Context, batch bulkWriteBatch) (opera
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
 		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
-		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.cryptFLE).Hint(hasHint).
+		Deployment(bw.collection.client.deploymentFor(ctx)).RetryPolicy(bw.collection.client.retryPolicy).
+		CommandInterceptors(bw.collection.client.interceptors).MemoryAccountant(bw.collection.client.memoryAccountant()).
+		Crypt(bw.collection.client.cryptFLE).Hint(hasHint).
 		ServerAPI(bw.collection.client.serverAPI).Timeout(bw.collection.client.timeout).
 		Logger(bw.collection.client.logger).Authenticator(bw.collection.client.authenticator)
 	if bw.comment != nil {
@@ -291,7 +300,7 @@ func (bw *bulkWrite) runDelete(ctx context.Context, batch bulkWriteBatch) (opera
 		op.RawData(*bw.rawData)
 	}
 
-	err := op.Execute(ctx)
+	err := bw.collection.client.runOperation(ctx, "delete", bw.collection.namespace(), op.Execute)
 
 	return op.Result(), err
 }
@@ -394,7 +403,9 @@ func (bw *bulkWrite) runUpdate(ctx context.Context, batch bulkWriteBatch) (opera
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
 		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
-		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.cryptFLE).Hint(hasHint).
+		Deployment(bw.collection.client.deploymentFor(ctx)).RetryPolicy(bw.collection.client.retryPolicy).
+		CommandInterceptors(bw.collection.client.interceptors).MemoryAccountant(bw.collection.client.memoryAccountant()).
+		Crypt(bw.collection.client.cryptFLE).Hint(hasHint).
 		ArrayFilters(hasArrayFilters).ServerAPI(bw.collection.client.serverAPI).
 		Timeout(bw.collection.client.timeout).Logger(bw.collection.client.logger).
 		Authenticator(bw.collection.client.authenticator)
@@ -428,7 +439,7 @@ func (bw *bulkWrite) runUpdate(ctx context.Context, batch bulkWriteBatch) (opera
 		op.RawData(*bw.rawData)
 	}
 
-	err := op.Execute(ctx)
+	err := bw.collection.client.runOperation(ctx, "update", bw.collection.namespace(), op.Execute)
 
 	return op.Result(), err
 }
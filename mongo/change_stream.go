@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/csot"
@@ -84,6 +85,7 @@ type ChangeStream struct {
 	bsonOpts        *options.BSONOptions
 	registry        *bson.Registry
 	streamType      StreamType
+	namespace       string
 	options         *options.ChangeStreamOptions
 	selector        description.ServerSelector
 	operationTime   *bson.Timestamp
@@ -140,10 +142,21 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline an
 		closeImplicitSession(cs.sess)
 		return nil, cs.Err()
 	}
+	ns := config.databaseName
+	if config.collectionName != "" {
+		ns += "." + config.collectionName
+	}
+	if cs.err = cs.client.checkReadPolicy(cs.sess, ns, config.readConcern); cs.err != nil {
+		closeImplicitSession(cs.sess)
+		return nil, cs.Err()
+	}
+	cs.namespace = ns
 
 	cs.aggregate = operation.NewAggregate(nil).
 		ReadPreference(config.readPreference).ReadConcern(config.readConcern).
-		Deployment(cs.client.deployment).ClusterClock(cs.client.clock).
+		Deployment(cs.client.deploymentFor(ctx)).RetryPolicy(cs.client.retryPolicy).
+		CommandInterceptors(cs.client.interceptors).MemoryAccountant(cs.client.memoryAccountant()).
+		ClusterClock(cs.client.clock).
 		CommandMonitor(cs.client.monitor).Session(cs.sess).ServerSelector(cs.selector).Retry(driver.RetryNone).
 		ServerAPI(cs.client.serverAPI).Crypt(config.crypt).Timeout(cs.client.timeout).
 		Authenticator(cs.client.authenticator)
@@ -305,7 +318,7 @@ func (cs *ChangeStream) executeOperation(ctx context.Context, resuming bool) err
 	var err error
 AggregateExecuteLoop:
 	for {
-		err = cs.aggregate.Execute(ctx)
+		err = cs.client.runOperation(ctx, "aggregate", cs.namespace, cs.aggregate.Execute)
 		// If no error or no retries remain, do not retry.
 		if err == nil || retries == 0 {
 			break AggregateExecuteLoop
@@ -562,7 +575,7 @@ func (cs *ChangeStream) Decode(val any) error {
 		return ErrNilCursor
 	}
 
-	dec := getDecoder(cs.Current, cs.bsonOpts, cs.registry)
+	dec := getDecoder(cs.Current, cs.bsonOpts, cs.registry, nil)
 	return dec.Decode(val)
 }
 
@@ -724,6 +737,13 @@ func (cs *ChangeStream) loopNext(ctx context.Context, nonBlocking bool) {
 			// If a getMore was done but the batch was empty, the batch cursor will return false with no error.
 			// Update the tracked resume token to catch the post batch resume token from the server response.
 			cs.updatePbrtFromCommand()
+			if cs.options.Heartbeat != nil {
+				cs.options.Heartbeat(options.AwaitHeartbeat{
+					CursorID:    cs.ID(),
+					Time:        time.Now(),
+					ResumeToken: cs.resumeToken,
+				})
+			}
 			if nonBlocking {
 				// stop after a successful getMore, even though the batch was empty
 				return
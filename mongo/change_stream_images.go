@@ -0,0 +1,101 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrPreAndPostImagesDisabled is returned by Collection.ValidateWatchOptions if the options request pre- or
+// post-images but they are not enabled for the collection.
+var ErrPreAndPostImagesDisabled = errors.New("change stream pre- and post-images are not enabled for the collection")
+
+// EnableChangeStreamPreAndPostImages enables or disables recording pre- and post-images for change streams opened
+// against the collection by running a collMod command. Pre- and post-images are required for change streams that set
+// the FullDocumentBeforeChange option, or the FullDocument option to options.Required or options.WhenAvailable, to
+// return the document before or after each change. Only changes made while pre- and post-images are enabled have
+// images.
+//
+// This method requires MongoDB 6.0 or later.
+//
+// For more information about the command, see https://www.mongodb.com/docs/manual/reference/command/collMod/.
+func (coll *Collection) EnableChangeStreamPreAndPostImages(ctx context.Context, enabled bool) error {
+	cmd := bson.D{
+		{"collMod", coll.name},
+		{"changeStreamPreAndPostImages", bson.D{{"enabled", enabled}}},
+	}
+	return coll.db.RunCommand(ctx, cmd).Err()
+}
+
+// ChangeStreamPreAndPostImagesEnabled returns whether pre- and post-images are enabled for change streams opened
+// against the collection. It returns false if the collection does not exist.
+func (coll *Collection) ChangeStreamPreAndPostImagesEnabled(ctx context.Context) (bool, error) {
+	specs, err := coll.db.ListCollectionSpecifications(ctx, bson.D{{"name", coll.name}})
+	if err != nil {
+		return false, err
+	}
+	if len(specs) == 0 {
+		return false, nil
+	}
+
+	enabled, ok := specs[0].Options.Lookup("changeStreamPreAndPostImages", "enabled").BooleanOK()
+	return ok && enabled, nil
+}
+
+// ValidateWatchOptions checks that change streams opened by Watch with opts can return the pre- and post-images they
+// request. If opts set the FullDocumentBeforeChange option to options.Required or options.WhenAvailable, or the
+// FullDocument option to options.Required or options.WhenAvailable, and pre- and post-images are not enabled for the
+// collection, an error wrapping ErrPreAndPostImagesDisabled is returned. With options.Required, such a change stream
+// would fail when it encounters a change, and with options.WhenAvailable, it would never return the documents.
+//
+// Watch does not run this validation because it requires an additional command. It can be called once before opening
+// change streams, for example when an application starts.
+func (coll *Collection) ValidateWatchOptions(
+	ctx context.Context,
+	opts ...options.Lister[options.ChangeStreamOptions],
+) error {
+	args, err := mongoutil.NewOptions(opts...)
+	if err != nil {
+		return err
+	}
+
+	name, fd, ok := requestedImages(args)
+	if !ok {
+		return nil
+	}
+
+	enabled, err := coll.ChangeStreamPreAndPostImagesEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return fmt.Errorf("%w: %s is %q", ErrPreAndPostImagesDisabled, name, fd)
+	}
+	return nil
+}
+
+// requestedImages returns the name and value of the first option in args that
+// requires pre- or post-images, if any.
+func requestedImages(args *options.ChangeStreamOptions) (string, options.FullDocument, bool) {
+	needsImages := func(fd *options.FullDocument) bool {
+		return fd != nil && (*fd == options.Required || *fd == options.WhenAvailable)
+	}
+
+	if needsImages(args.FullDocumentBeforeChange) {
+		return "fullDocumentBeforeChange", *args.FullDocumentBeforeChange, true
+	}
+	if needsImages(args.FullDocument) {
+		return "fullDocument", *args.FullDocument, true
+	}
+	return "", "", false
+}
@@ -0,0 +1,66 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestRequestedImages(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     *options.ChangeStreamOptionsBuilder
+		wantName string
+		wantFD   options.FullDocument
+		wantOK   bool
+	}{
+		{
+			name: "none",
+			opts: options.ChangeStream(),
+		},
+		{
+			name: "update lookup",
+			opts: options.ChangeStream().SetFullDocument(options.UpdateLookup),
+		},
+		{
+			name: "before change off",
+			opts: options.ChangeStream().SetFullDocumentBeforeChange(options.Off),
+		},
+		{
+			name:     "post-image required",
+			opts:     options.ChangeStream().SetFullDocument(options.Required),
+			wantName: "fullDocument",
+			wantFD:   options.Required,
+			wantOK:   true,
+		},
+		{
+			name: "pre-image when available",
+			opts: options.ChangeStream().
+				SetFullDocument(options.UpdateLookup).
+				SetFullDocumentBeforeChange(options.WhenAvailable),
+			wantName: "fullDocumentBeforeChange",
+			wantFD:   options.WhenAvailable,
+			wantOK:   true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := mongoutil.NewOptions[options.ChangeStreamOptions](tc.opts)
+			require.NoError(t, err)
+
+			name, fd, ok := requestedImages(args)
+			assert.Equal(t, tc.wantName, name)
+			assert.Equal(t, tc.wantFD, fd)
+			assert.Equal(t, tc.wantOK, ok)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+	"math"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrNoOperationTime is returned by StartAfterWrite and StartAfterSession if there is no operation time to start a
+// change stream after. Operation times are not reported for unacknowledged writes or by standalone servers.
+var ErrNoOperationTime = errors.New("no operation time is available to start the change stream after")
+
+// WriteResult is implemented by the results of write operations: *InsertOneResult, *InsertManyResult, *UpdateResult,
+// *DeleteResult, and *BulkWriteResult.
+type WriteResult interface {
+	writeOperationTime() *bson.Timestamp
+}
+
+var (
+	_ WriteResult = (*InsertOneResult)(nil)
+	_ WriteResult = (*InsertManyResult)(nil)
+	_ WriteResult = (*UpdateResult)(nil)
+	_ WriteResult = (*DeleteResult)(nil)
+	_ WriteResult = (*BulkWriteResult)(nil)
+)
+
+func (r *InsertOneResult) writeOperationTime() *bson.Timestamp {
+	if r == nil {
+		return nil
+	}
+	return r.OperationTime
+}
+
+func (r *InsertManyResult) writeOperationTime() *bson.Timestamp {
+	if r == nil {
+		return nil
+	}
+	return r.OperationTime
+}
+
+func (r *UpdateResult) writeOperationTime() *bson.Timestamp {
+	if r == nil {
+		return nil
+	}
+	return r.OperationTime
+}
+
+func (r *DeleteResult) writeOperationTime() *bson.Timestamp {
+	if r == nil {
+		return nil
+	}
+	return r.OperationTime
+}
+
+func (r *BulkWriteResult) writeOperationTime() *bson.Timestamp {
+	if r == nil {
+		return nil
+	}
+	return r.OperationTime
+}
+
+// StartAfterWrite returns change stream options that start the change stream immediately after the write that
+// produced result, so that the stream reports every change made after the write but not the write itself:
+//
+//	res, err := coll.InsertOne(ctx, doc)
+//	if err != nil {
+//		return err
+//	}
+//	opts, err := mongo.StartAfterWrite(res)
+//	if err != nil {
+//		return err
+//	}
+//	cs, err := coll.Watch(ctx, mongo.Pipeline{}, opts)
+//
+// The returned options only set StartAtOperationTime and can be combined with other options by passing both to Watch.
+// An error wrapping ErrNoOperationTime is returned if result has no operation time.
+func StartAfterWrite(result WriteResult) (*options.ChangeStreamOptionsBuilder, error) {
+	if result == nil {
+		return nil, ErrNoOperationTime
+	}
+	return startAfter(result.writeOperationTime())
+}
+
+// StartAfterSession returns change stream options that start the change stream immediately after the latest operation
+// run with sess, such as the last write of a transaction. See StartAfterWrite for details.
+func StartAfterSession(sess *Session) (*options.ChangeStreamOptionsBuilder, error) {
+	if sess == nil {
+		return nil, ErrNoOperationTime
+	}
+	return startAfter(sess.OperationTime())
+}
+
+func startAfter(ts *bson.Timestamp) (*options.ChangeStreamOptionsBuilder, error) {
+	if ts == nil {
+		return nil, ErrNoOperationTime
+	}
+	next := nextTimestamp(*ts)
+	return options.ChangeStream().SetStartAtOperationTime(&next), nil
+}
+
+// nextTimestamp returns the smallest timestamp after ts. Change streams start
+// at the given operation time inclusively, so starting at the next timestamp
+// excludes the operation at ts.
+func nextTimestamp(ts bson.Timestamp) bson.Timestamp {
+	if ts.I == math.MaxUint32 {
+		return bson.Timestamp{T: ts.T + 1}
+	}
+	return bson.Timestamp{T: ts.T, I: ts.I + 1}
+}
@@ -0,0 +1,68 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"math"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestStartAfterWrite(t *testing.T) {
+	ts := &bson.Timestamp{T: 1700000000, I: 3}
+
+	testCases := []struct {
+		name    string
+		result  WriteResult
+		want    *bson.Timestamp
+		wantErr error
+	}{
+		{"InsertOneResult", &InsertOneResult{OperationTime: ts}, &bson.Timestamp{T: 1700000000, I: 4}, nil},
+		{"InsertManyResult", &InsertManyResult{OperationTime: ts}, &bson.Timestamp{T: 1700000000, I: 4}, nil},
+		{"UpdateResult", &UpdateResult{OperationTime: ts}, &bson.Timestamp{T: 1700000000, I: 4}, nil},
+		{"DeleteResult", &DeleteResult{OperationTime: ts}, &bson.Timestamp{T: 1700000000, I: 4}, nil},
+		{"BulkWriteResult", &BulkWriteResult{OperationTime: ts}, &bson.Timestamp{T: 1700000000, I: 4}, nil},
+		{"no operation time", &InsertOneResult{Acknowledged: false}, nil, ErrNoOperationTime},
+		{"nil result pointer", (*UpdateResult)(nil), nil, ErrNoOperationTime},
+		{"nil result", nil, nil, ErrNoOperationTime},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder, err := StartAfterWrite(tc.result)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err, "StartAfterWrite error")
+
+			var opts options.ChangeStreamOptions
+			for _, set := range builder.List() {
+				require.NoError(t, set(&opts), "setter error")
+			}
+			assert.Equal(t, tc.want, opts.StartAtOperationTime, "unexpected startAtOperationTime")
+		})
+	}
+}
+
+func TestNextTimestamp(t *testing.T) {
+	testCases := []struct {
+		ts   bson.Timestamp
+		want bson.Timestamp
+	}{
+		{bson.Timestamp{T: 10, I: 0}, bson.Timestamp{T: 10, I: 1}},
+		{bson.Timestamp{T: 10, I: 7}, bson.Timestamp{T: 10, I: 8}},
+		{bson.Timestamp{T: 10, I: math.MaxUint32}, bson.Timestamp{T: 11, I: 0}},
+	}
+	for _, tc := range testCases {
+		got := nextTimestamp(tc.ts)
+		assert.Equal(t, tc.want, got, "expected next timestamp of %v to be %v, got %v", tc.ts, tc.want, got)
+	}
+}
@@ -9,7 +9,11 @@ package mongo
 import (
 	"testing"
 
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
 
 func TestChangeStream(t *testing.T) {
@@ -27,3 +31,26 @@ func TestChangeStream(t *testing.T) {
 		assert.Nil(t, err, "Close error: %v", err)
 	})
 }
+
+func TestChangeStreamHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	pbrt := bsoncore.NewDocumentBuilder().AppendString("_data", "pbrt").Build()
+	var heartbeats []options.AwaitHeartbeat
+	cs := &ChangeStream{
+		client: &Client{},
+		cursor: &emptyBatchCursor{testBatchCursor: newTestBatchCursor(1, 1), empty: 1, pbrt: pbrt},
+		options: &options.ChangeStreamOptions{
+			Heartbeat: func(hb options.AwaitHeartbeat) {
+				heartbeats = append(heartbeats, hb)
+			},
+		},
+	}
+
+	assert.False(t, cs.TryNext(bgCtx), "expected TryNext to return false for an empty batch")
+	require.NoError(t, cs.Err())
+	require.Len(t, heartbeats, 1)
+	assert.Equal(t, int64(10), heartbeats[0].CursorID)
+	assert.Equal(t, bson.Raw(pbrt), heartbeats[0].ResumeToken)
+	assert.Equal(t, bson.Raw(pbrt), cs.ResumeToken())
+}
@@ -0,0 +1,345 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package changefeed merges several change streams, such as streams on
+// different collections or with different pipelines, into a single feed of
+// events ordered by cluster time:
+//
+//	feed, err := changefeed.Open(ctx, changefeed.Config{
+//		Sources: []changefeed.Source{
+//			{Name: "orders", Watcher: db.Collection("orders")},
+//			{Name: "payments", Watcher: db.Collection("payments"), Pipeline: paymentsPipeline},
+//		},
+//		ResumeTokens: savedTokens,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer feed.Close(context.Background())
+//
+//	for feed.Next(ctx) {
+//		handle(feed.Current.Source, feed.Current.Document)
+//		savedTokens = feed.ResumeTokens()
+//	}
+//	return feed.Err()
+//
+// Each source has its own resume token, so a feed can be reopened after a
+// restart with the tokens returned by Feed.ResumeTokens without skipping or
+// repeating events of any source.
+//
+// Events of different sources are ordered by their clusterTime field. An event
+// is only returned once every other source has either buffered a later event
+// or reported that it has no more events at the time the event was received,
+// so the order is correct for sources that watch the same deployment. Events
+// with the same cluster time, such as the writes of a multi-document
+// transaction, are returned in the order of the sources in Config.Sources.
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/errutil"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Watcher opens change streams. It is implemented by *mongo.Client,
+// *mongo.Database, and *mongo.Collection.
+type Watcher interface {
+	Watch(ctx context.Context, pipeline any, opts ...options.Lister[options.ChangeStreamOptions]) (*mongo.ChangeStream, error)
+}
+
+// stream is the part of *mongo.ChangeStream used by a Feed.
+type stream interface {
+	TryNext(ctx context.Context) bool
+	Err() error
+	ID() int64
+	ResumeToken() bson.Raw
+	Close(ctx context.Context) error
+	current() bson.Raw
+}
+
+type changeStream struct {
+	*mongo.ChangeStream
+}
+
+func (cs changeStream) current() bson.Raw {
+	return cs.Current
+}
+
+// Source is a change stream merged into a Feed.
+type Source struct {
+	// Name identifies the source in events and resume tokens. It is required
+	// and must be unique within a Config.
+	Name string
+
+	// Watcher opens the change stream. It is required.
+	Watcher Watcher
+
+	// Pipeline is the aggregation pipeline of the change stream. If nil, an
+	// empty pipeline is used.
+	Pipeline any
+
+	// Options are the options of the change stream. If the Config has a
+	// resume token for the source, the resume options are replaced by
+	// StartAfter with the token.
+	Options *options.ChangeStreamOptionsBuilder
+}
+
+// Event is a change event returned by a Feed.
+type Event struct {
+	// Source is the name of the source the event was received from.
+	Source string
+
+	// ClusterTime is the clusterTime field of the event.
+	ClusterTime bson.Timestamp
+
+	// Document is the change event document.
+	Document bson.Raw
+}
+
+// Config configures a Feed.
+type Config struct {
+	// Sources are the change streams to merge. At least one is required.
+	Sources []Source
+
+	// ResumeTokens are the resume tokens of the sources, keyed by source
+	// name, as returned by Feed.ResumeTokens. Sources without a token start
+	// according to their options.
+	ResumeTokens map[string]bson.Raw
+}
+
+// Feed is a merged stream of change events. A Feed is not safe for
+// concurrent use.
+type Feed struct {
+	// Current is the event returned by the last successful call to Next.
+	Current Event
+
+	sources []*source
+	polls   uint64
+	err     error
+}
+
+// source is the state of a Source in a Feed.
+type source struct {
+	name string
+	cs   stream
+
+	// pending is a received event that has not been returned yet, and
+	// pendingPoll is the poll it was received by.
+	pending     *Event
+	pendingPoll uint64
+
+	// idleSince is the latest poll that found no events, or 0.
+	idleSince uint64
+
+	// closed is set when the server closes the change stream, e.g. after an
+	// invalidate event.
+	closed bool
+
+	// token is the resume token after the last returned event.
+	token bson.Raw
+}
+
+// Open opens the change streams of cfg and returns a Feed that merges them.
+// If a change stream cannot be opened, the streams that were opened are
+// closed and the error is returned.
+func Open(ctx context.Context, cfg Config) (*Feed, error) {
+	if len(cfg.Sources) == 0 {
+		return nil, errors.New("changefeed: at least one source is required")
+	}
+
+	f := &Feed{}
+	names := make(map[string]bool, len(cfg.Sources))
+	for _, src := range cfg.Sources {
+		switch {
+		case src.Name == "":
+			return nil, errors.New("changefeed: source name is required")
+		case names[src.Name]:
+			return nil, fmt.Errorf("changefeed: duplicate source name %q", src.Name)
+		case src.Watcher == nil:
+			return nil, fmt.Errorf("changefeed: source %q has no Watcher", src.Name)
+		}
+		names[src.Name] = true
+	}
+
+	for _, src := range cfg.Sources {
+		pipeline := src.Pipeline
+		if pipeline == nil {
+			pipeline = mongo.Pipeline{}
+		}
+		var opts []options.Lister[options.ChangeStreamOptions]
+		if src.Options != nil {
+			opts = append(opts, src.Options)
+		}
+		token := cfg.ResumeTokens[src.Name]
+		if token != nil {
+			opts = append(opts, startAfter(token))
+		}
+
+		cs, err := src.Watcher.Watch(ctx, pipeline, opts...)
+		if err != nil {
+			_ = f.Close(ctx)
+			return nil, fmt.Errorf("changefeed: error opening source %q: %w", src.Name, err)
+		}
+		f.sources = append(f.sources, &source{name: src.Name, cs: changeStream{cs}, token: token})
+	}
+	return f, nil
+}
+
+// startAfter returns options that start a change stream after token,
+// replacing any other resume options.
+func startAfter(token bson.Raw) *options.ChangeStreamOptionsBuilder {
+	return &options.ChangeStreamOptionsBuilder{
+		Opts: []func(*options.ChangeStreamOptions) error{
+			func(opts *options.ChangeStreamOptions) error {
+				opts.StartAfter = token
+				opts.ResumeAfter = nil
+				opts.StartAtOperationTime = nil
+				return nil
+			},
+		},
+	}
+}
+
+// Next sets Current to the next event of the feed. It blocks until an event
+// can be returned in order, an error occurs, or ctx is done, in which case it
+// returns false and Err returns the error. If Next returns false, subsequent
+// calls also return false.
+func (f *Feed) Next(ctx context.Context) bool {
+	if f.err != nil {
+		return false
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for {
+		if next := f.ready(); next != nil {
+			f.Current = *next.pending
+			next.token = next.pending.Document.Lookup("_id").Document()
+			next.pending = nil
+			return true
+		}
+
+		if err := ctx.Err(); err != nil {
+			f.err = err
+			return false
+		}
+		if err := f.poll(ctx); err != nil {
+			f.err = err
+			return false
+		}
+	}
+}
+
+// ready returns the source with the earliest pending event if the event can
+// be returned, or nil.
+func (f *Feed) ready() *source {
+	var next *source
+	for _, s := range f.sources {
+		if s.pending != nil && (next == nil || s.pending.ClusterTime.Before(next.pending.ClusterTime)) {
+			next = s
+		}
+	}
+	if next == nil {
+		return nil
+	}
+
+	// Sources without a pending event must have found no events after the
+	// earliest event was received, otherwise they may still return an
+	// earlier event.
+	for _, s := range f.sources {
+		if s.pending == nil && !s.closed && s.idleSince <= next.pendingPoll {
+			return nil
+		}
+	}
+	return next
+}
+
+// poll tries to receive an event from each source that has no pending event.
+func (f *Feed) poll(ctx context.Context) error {
+	for _, s := range f.sources {
+		if s.pending != nil || s.closed {
+			continue
+		}
+
+		f.polls++
+		if !s.cs.TryNext(ctx) {
+			if err := s.cs.Err(); err != nil {
+				return fmt.Errorf("changefeed: source %q: %w", s.name, err)
+			}
+			if s.cs.ID() == 0 {
+				s.closed = true
+			}
+			s.idleSince = f.polls
+			continue
+		}
+
+		current := s.cs.current()
+		doc := make(bson.Raw, len(current))
+		copy(doc, current)
+		var ts bson.Timestamp
+		if t, i, ok := doc.Lookup("clusterTime").TimestampOK(); ok {
+			ts = bson.Timestamp{T: t, I: i}
+		}
+		s.pending = &Event{Source: s.name, ClusterTime: ts, Document: doc}
+		s.pendingPoll = f.polls
+	}
+
+	if f.closed() {
+		return errors.New("changefeed: all change streams were closed by the server")
+	}
+	return nil
+}
+
+func (f *Feed) closed() bool {
+	for _, s := range f.sources {
+		if !s.closed || s.pending != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ResumeTokens returns the resume token of each source, keyed by source name,
+// that resumes the source after the last event returned by Next. Pass them as
+// Config.ResumeTokens to continue the feed later. Sources without a resume
+// token are omitted.
+func (f *Feed) ResumeTokens() map[string]bson.Raw {
+	tokens := make(map[string]bson.Raw, len(f.sources))
+	for _, s := range f.sources {
+		token := s.token
+		// The change stream's resume token is only after the last returned
+		// event if no event is pending.
+		if s.pending == nil && s.cs.ResumeToken() != nil {
+			token = s.cs.ResumeToken()
+		}
+		if token != nil {
+			tokens[s.name] = token
+		}
+	}
+	return tokens
+}
+
+// Err returns the error that caused Next to return false, or nil.
+func (f *Feed) Err() error {
+	return f.err
+}
+
+// Close closes the change streams of the feed.
+func (f *Feed) Close(ctx context.Context) error {
+	var errs []error
+	for _, s := range f.sources {
+		if err := s.cs.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("source %q: %w", s.name, err))
+		}
+	}
+	return errutil.Join(errs...)
+}
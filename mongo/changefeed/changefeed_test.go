@@ -0,0 +1,252 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// fakeStream returns one batch per TryNext call. A nil batch entry means the
+// call finds no events.
+type fakeStream struct {
+	batches []bson.Raw
+	cur     bson.Raw
+	token   bson.Raw
+	err     error
+	id      int64
+	closed  bool
+}
+
+func (fs *fakeStream) TryNext(context.Context) bool {
+	if fs.err != nil || len(fs.batches) == 0 {
+		return false
+	}
+	doc := fs.batches[0]
+	fs.batches = fs.batches[1:]
+	if doc == nil {
+		return false
+	}
+	fs.cur = doc
+	fs.token = doc.Lookup("_id").Document()
+	return true
+}
+
+func (fs *fakeStream) Err() error                  { return fs.err }
+func (fs *fakeStream) ID() int64                   { return fs.id }
+func (fs *fakeStream) ResumeToken() bson.Raw       { return fs.token }
+func (fs *fakeStream) Close(context.Context) error { fs.closed = true; return nil }
+func (fs *fakeStream) current() bson.Raw           { return fs.cur }
+
+func event(t *testing.T, token string, ts uint32) bson.Raw {
+	t.Helper()
+
+	doc, err := bson.Marshal(bson.D{
+		{"_id", bson.D{{"_data", token}}},
+		{"clusterTime", bson.Timestamp{T: ts, I: 1}},
+	})
+	require.NoError(t, err)
+	return doc
+}
+
+func newFeed(streams map[string]*fakeStream, names ...string) *Feed {
+	f := &Feed{}
+	for _, name := range names {
+		f.sources = append(f.sources, &source{name: name, cs: streams[name]})
+	}
+	return f
+}
+
+func collect(t *testing.T, f *Feed, n int) []string {
+	t.Helper()
+
+	var got []string
+	for i := 0; i < n; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		ok := f.Next(ctx)
+		cancel()
+		require.True(t, ok, "Next error: %v", f.Err())
+		got = append(got, f.Current.Document.Lookup("_id", "_data").StringValue())
+	}
+	return got
+}
+
+func TestFeedOrdersByClusterTime(t *testing.T) {
+	t.Parallel()
+
+	streams := map[string]*fakeStream{
+		"a": {id: 1, batches: []bson.Raw{event(t, "a1", 1), event(t, "a3", 3), nil, nil}},
+		"b": {id: 1, batches: []bson.Raw{event(t, "b2", 2), nil, event(t, "b4", 4), nil}},
+	}
+	f := newFeed(streams, "a", "b")
+
+	got := collect(t, f, 4)
+	assert.Equal(t, []string{"a1", "b2", "a3", "b4"}, got)
+}
+
+func TestFeedWaitsForIdleSources(t *testing.T) {
+	t.Parallel()
+
+	// "b" is polled before "a" receives "a2", so finding no events does not
+	// show that "b" has no earlier event and "a2" must wait for the next poll.
+	streams := map[string]*fakeStream{
+		"a": {id: 1, batches: []bson.Raw{event(t, "a2", 2), nil}},
+		"b": {id: 1, batches: []bson.Raw{nil, event(t, "b1", 1), nil}},
+	}
+	f := newFeed(streams, "b", "a")
+
+	got := collect(t, f, 2)
+	assert.Equal(t, []string{"b1", "a2"}, got)
+}
+
+func TestFeedTiesUseSourceOrder(t *testing.T) {
+	t.Parallel()
+
+	streams := map[string]*fakeStream{
+		"a": {id: 1, batches: []bson.Raw{event(t, "a1", 1), nil}},
+		"b": {id: 1, batches: []bson.Raw{event(t, "b1", 1), nil}},
+	}
+	f := newFeed(streams, "b", "a")
+
+	got := collect(t, f, 2)
+	assert.Equal(t, []string{"b1", "a1"}, got)
+}
+
+func TestFeedResumeTokens(t *testing.T) {
+	t.Parallel()
+
+	streams := map[string]*fakeStream{
+		"a": {id: 1, batches: []bson.Raw{event(t, "a1", 1), event(t, "a3", 3)}},
+		"b": {id: 1, batches: []bson.Raw{nil, event(t, "b2", 2)}},
+	}
+	f := newFeed(streams, "a", "b")
+
+	assert.Len(t, f.ResumeTokens(), 0)
+
+	collect(t, f, 1)
+	tokens := f.ResumeTokens()
+	assert.Equal(t, "a1", tokens["a"].Lookup("_data").StringValue())
+	_, ok := tokens["b"]
+	assert.False(t, ok, "expected no token for source without events")
+
+	// "a3" is buffered once "b2" is returned, but the token of "a" must not
+	// move past "a1" until "a3" is returned.
+	collect(t, f, 1)
+	tokens = f.ResumeTokens()
+	assert.Equal(t, "a1", tokens["a"].Lookup("_data").StringValue())
+	assert.Equal(t, "b2", tokens["b"].Lookup("_data").StringValue())
+}
+
+func TestFeedErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("source error", func(t *testing.T) {
+		t.Parallel()
+
+		streamErr := errors.New("getMore failed")
+		streams := map[string]*fakeStream{
+			"a": {id: 1, batches: []bson.Raw{event(t, "a1", 1)}},
+			"b": {id: 1, err: streamErr},
+		}
+		f := newFeed(streams, "a", "b")
+
+		assert.False(t, f.Next(context.Background()), "expected Next to return false")
+		assert.ErrorIs(t, f.Err(), streamErr)
+		assert.False(t, f.Next(context.Background()), "expected Next to keep returning false")
+	})
+	t.Run("context done", func(t *testing.T) {
+		t.Parallel()
+
+		streams := map[string]*fakeStream{
+			"a": {id: 1},
+		}
+		f := newFeed(streams, "a")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.False(t, f.Next(ctx), "expected Next to return false")
+		assert.ErrorIs(t, f.Err(), context.DeadlineExceeded)
+	})
+	t.Run("closed streams", func(t *testing.T) {
+		t.Parallel()
+
+		// A closed stream no longer holds back the other sources.
+		streams := map[string]*fakeStream{
+			"a": {id: 1, batches: []bson.Raw{event(t, "a1", 1)}},
+			"b": {id: 0},
+		}
+		f := newFeed(streams, "a", "b")
+
+		got := collect(t, f, 1)
+		assert.Equal(t, []string{"a1"}, got)
+
+		streams["a"].id = 0
+		assert.False(t, f.Next(context.Background()), "expected Next to return false")
+		assert.Error(t, f.Err())
+	})
+}
+
+func TestFeedClose(t *testing.T) {
+	t.Parallel()
+
+	streams := map[string]*fakeStream{"a": {id: 1}, "b": {id: 1}}
+	f := newFeed(streams, "a", "b")
+
+	require.NoError(t, f.Close(context.Background()))
+	assert.True(t, streams["a"].closed, "expected stream a to be closed")
+	assert.True(t, streams["b"].closed, "expected stream b to be closed")
+}
+
+func TestOpenValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		cfg  Config
+	}{
+		{"no sources", Config{}},
+		{"no name", Config{Sources: []Source{{}}}},
+		{"no watcher", Config{Sources: []Source{{Name: "a"}}}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Open(context.Background(), tc.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestStartAfter(t *testing.T) {
+	t.Parallel()
+
+	token := event(t, "token", 1).Lookup("_id").Document()
+	opts := options.ChangeStream().
+		SetResumeAfter(bson.D{{"_data", "old"}}).
+		SetStartAtOperationTime(&bson.Timestamp{T: 1})
+
+	args := &options.ChangeStreamOptions{}
+	for _, lister := range []options.Lister[options.ChangeStreamOptions]{opts, startAfter(token)} {
+		for _, setter := range lister.List() {
+			require.NoError(t, setter(args))
+		}
+	}
+
+	assert.Equal(t, token, args.StartAfter)
+	assert.Nil(t, args.ResumeAfter)
+	assert.Nil(t, args.StartAtOperationTime)
+}
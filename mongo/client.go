@@ -22,6 +22,8 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/ptrutil"
 	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
 	"go.mongodb.org/mongo-driver/v2/internal/uuid"
+	"go.mongodb.org/mongo-driver/v2/mongo/audit"
+	"go.mongodb.org/mongo-driver/v2/mongo/fault"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -61,6 +63,7 @@ type Client struct {
 	localThreshold time.Duration
 	retryWrites    bool
 	retryReads     bool
+	retryPolicy    *driver.RetryPolicy
 	clock          *session.ClusterClock
 	readPreference *readpref.ReadPref
 	readConcern    *readconcern.ReadConcern
@@ -74,6 +77,16 @@ type Client struct {
 	timeout        *time.Duration
 	httpClient     *http.Client
 	logger         *logger.Logger
+	policy         *options.PolicyOptions
+	readOnly       bool
+	auditSink      audit.Sink
+	faultInjector  fault.Injector
+	interceptors   []driver.CommandInterceptor
+	memory         *memoryAccountant
+
+	heartbeatInterval time.Duration
+	replicationLag    replicationLagCache
+	buildInfo         buildInfoCache
 
 	// in-use encryption fields
 	isAutoEncryptionSet bool
@@ -84,6 +97,7 @@ type Client struct {
 	metadataClientFLE   *Client
 	internalClientFLE   *Client
 	encryptedFieldsMap  map[string]any
+	autoEncryptionInfo  AutoEncryptionInfo
 	authenticator       driver.Authenticator
 }
 
@@ -186,6 +200,12 @@ func newClient(opts ...*options.ClientOptions) (*Client, error) {
 	if clientOpts.RetryReads != nil {
 		client.retryReads = *clientOpts.RetryReads
 	}
+	// RetryPolicy
+	client.retryPolicy = newRetryPolicy(clientOpts.RetryPolicy)
+	// HeartbeatInterval
+	if clientOpts.HeartbeatInterval != nil {
+		client.heartbeatInterval = *clientOpts.HeartbeatInterval
+	}
 	// Timeout
 	client.timeout = clientOpts.Timeout
 	client.httpClient = clientOpts.HTTPClient
@@ -193,6 +213,23 @@ func newClient(opts ...*options.ClientOptions) (*Client, error) {
 	if clientOpts.WriteConcern != nil {
 		client.writeConcern = clientOpts.WriteConcern
 	}
+	// ReadOnly
+	if clientOpts.ReadOnly != nil {
+		client.readOnly = *clientOpts.ReadOnly
+	}
+	// AuditSink
+	client.auditSink = clientOpts.AuditSink
+	// FaultInjector
+	client.faultInjector = clientOpts.FaultInjector
+	// CommandInterceptors
+	client.interceptors = newCommandInterceptors(clientOpts.CommandInterceptors)
+	// MemoryLimit
+	client.memory = newMemoryAccountant(clientOpts.MemoryLimit)
+	// Policy
+	client.policy = clientOpts.Policy
+	if err := client.checkWritePolicy(nil, "", client.writeConcern); err != nil {
+		return nil, err
+	}
 	// AutoEncryptionOptions
 	if clientOpts.AutoEncryptionOptions != nil {
 		client.isAutoEncryptionSet = true
@@ -385,6 +422,67 @@ func (c *Client) Ping(ctx context.Context, rp *readpref.ReadPref) error {
 	return wrapErrors(res.Err())
 }
 
+// PingResult is the result of Client.PingWithResult.
+type PingResult struct {
+	// RTT is the time it took to run the ping command. It does not include
+	// server selection or connection checkout.
+	RTT time.Duration
+
+	// Address is the address of the server that answered the ping.
+	Address string
+
+	// ServerKind is the kind of the server that answered the ping, such as
+	// "RSPrimary" or "Mongos".
+	ServerKind string
+
+	// TopologyVersionProcessID and TopologyVersionCounter are the topology
+	// version of the server, as reported by its most recent heartbeat. They
+	// are zero if the server does not report a topology version.
+	TopologyVersionProcessID bson.ObjectID
+	TopologyVersionCounter   int64
+}
+
+// PingWithResult is like Ping, but it also returns the round-trip time of the ping command and a description of the
+// server that answered it. Unlike Ping, the command is not retried.
+func (c *Client) PingWithResult(ctx context.Context, rp *readpref.ReadPref) (PingResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if rp == nil {
+		rp = c.readPreference
+	}
+
+	db := c.Database("admin")
+	deployment, err := c.checkOutConnection(ctx, rp)
+	if err != nil {
+		return PingResult{}, err
+	}
+	defer deployment.Close(ctx)
+
+	start := time.Now()
+	res := db.runCommandOnConnection(ctx, bson.D{{"ping", 1}}, deployment, options.RunCmd().SetReadPreference(rp))
+	rtt := time.Since(start)
+	if res.Err != nil {
+		return PingResult{}, wrapErrors(res.Err)
+	}
+
+	desc := deployment.Conn.Description()
+	if describer, ok := deployment.Server.(interface{ Description() description.Server }); ok {
+		desc = describer.Description()
+	}
+	result := PingResult{
+		RTT:        rtt,
+		Address:    desc.Addr.String(),
+		ServerKind: desc.Kind.String(),
+	}
+	if desc.TopologyVersion != nil {
+		result.TopologyVersionProcessID = desc.TopologyVersion.ProcessID
+		result.TopologyVersionCounter = desc.TopologyVersion.Counter
+	}
+	return result, nil
+}
+
 // StartSession starts a new session configured with the given options.
 //
 // StartSession does not actually communicate with the server and will not error if the client is
@@ -432,6 +530,9 @@ func (c *Client) StartSession(opts ...options.Lister[options.SessionOptions]) (*
 	if sessArgs.Snapshot != nil {
 		coreOpts.Snapshot = sessArgs.Snapshot
 	}
+	if sessArgs.SnapshotTime != nil {
+		coreOpts.SnapshotTime = sessArgs.SnapshotTime
+	}
 
 	sess, err := session.NewClientSession(c.sessionPool, c.id, coreOpts)
 	if err != nil {
@@ -449,7 +550,8 @@ func (c *Client) endSessions(ctx context.Context) {
 	sessionIDs := c.sessionPool.IDSlice()
 	op := operation.NewEndSessions(nil).ClusterClock(c.clock).Deployment(c.deployment).
 		ServerSelector(&serverselector.ReadPref{ReadPref: readpref.PrimaryPreferred()}).
-		CommandMonitor(c.monitor).Database("admin").Crypt(c.cryptFLE).ServerAPI(c.serverAPI)
+		CommandMonitor(c.monitor).Database("admin").Crypt(c.cryptFLE).ServerAPI(c.serverAPI).
+		CommandInterceptors(c.interceptors)
 
 	totalNumIDs := len(sessionIDs)
 	var currentBatch []bsoncore.Document
@@ -484,6 +586,21 @@ func (c *Client) configureAutoEncryption(args *options.ClientOptions) error {
 		return err
 	}
 
+	c.autoEncryptionInfo = AutoEncryptionInfo{
+		Enabled:                     true,
+		QueryAnalysis:               QueryAnalysisMongocryptd,
+		CryptSharedLibVersionString: mc.CryptSharedLibVersionString(),
+	}
+	if v := mc.CryptSharedLibVersion(); v != 0 {
+		c.autoEncryptionInfo.QueryAnalysis = QueryAnalysisCryptShared
+		c.autoEncryptionInfo.CryptSharedLibVersion = formatCryptSharedLibVersion(v)
+	}
+	aeOpts := args.AutoEncryptionOptions
+	if (aeOpts.BypassAutoEncryption != nil && *aeOpts.BypassAutoEncryption) ||
+		(aeOpts.BypassQueryAnalysis != nil && *aeOpts.BypassQueryAnalysis) {
+		c.autoEncryptionInfo.QueryAnalysis = QueryAnalysisNone
+	}
+
 	// If the crypt_shared library was not loaded, try to spawn and connect to mongocryptd.
 	if mc.CryptSharedLibVersionString() == "" {
 		mongocryptdFLE, err := newMongocryptdClient(args.AutoEncryptionOptions)
@@ -575,7 +692,11 @@ func (c *Client) newMongoCrypt(opts *options.AutoEncryptionOptions) (*mongocrypt
 		cryptEncryptedFieldsMap[k] = encryptedFields
 	}
 
-	kmsProviders, err := marshal(opts.KmsProviders, c.bsonOpts, c.registry)
+	providers, err := addCustomKMSProviders(context.Background(), opts.KmsProviders, opts.CustomKMSProviders)
+	if err != nil {
+		return nil, err
+	}
+	kmsProviders, err := marshal(providers, c.bsonOpts, c.registry)
 	if err != nil {
 		return nil, fmt.Errorf("error creating KMS providers document: %w", err)
 	}
@@ -591,6 +712,17 @@ func (c *Client) newMongoCrypt(opts *options.AutoEncryptionOptions) (*mongocrypt
 		}
 		cryptSharedLibPath = str
 	}
+	if opts.CryptSharedLibPath != nil {
+		cryptSharedLibPath = *opts.CryptSharedLibPath
+	}
+
+	var minVersion uint64
+	if opts.CryptSharedLibMinVersion != nil {
+		minVersion, err = parseCryptSharedLibVersion(*opts.CryptSharedLibMinVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid crypt_shared library minimum version: %w", err)
+		}
+	}
 
 	// Explicitly disable loading the crypt_shared library if requested. Note that this is ONLY
 	// intended for use from tests; there is no supported public API for explicitly disabling
@@ -620,20 +752,31 @@ func (c *Client) newMongoCrypt(opts *options.AutoEncryptionOptions) (*mongocrypt
 	if val, ok := opts.ExtraOptions["cryptSharedLibRequired"]; ok {
 		b, ok := val.(bool)
 		if !ok {
+			mc.Close()
 			return nil, fmt.Errorf(
 				`expected AutoEncryption extra option "cryptSharedLibRequired" to be a bool, but is a %T`, val)
 		}
 		cryptSharedLibRequired = b
 	}
+	if opts.CryptSharedLibRequired != nil {
+		cryptSharedLibRequired = *opts.CryptSharedLibRequired
+	}
 
 	// If the "cryptSharedLibRequired" extra option is set to true, check the MongoCrypt version
 	// string to confirm that the library was successfully loaded. If the version string is empty,
 	// return an error indicating that we couldn't load the crypt_shared library.
 	if cryptSharedLibRequired && mc.CryptSharedLibVersionString() == "" {
+		mc.Close()
 		return nil, errors.New(
 			`AutoEncryption extra option "cryptSharedLibRequired" is true, but we failed to load the crypt_shared library`)
 	}
 
+	if v := mc.CryptSharedLibVersion(); v != 0 && v < minVersion {
+		mc.Close()
+		return nil, fmt.Errorf("loaded crypt_shared library version %s is older than the required minimum version %s",
+			formatCryptSharedLibVersion(v), formatCryptSharedLibVersion(minVersion))
+	}
+
 	return mc, nil
 }
 
@@ -724,8 +867,9 @@ func (c *Client) ListDatabases(ctx context.Context, filter any, opts ...options.
 	}
 	op := operation.NewListDatabases(filterDoc).
 		Session(sess).ReadPreference(c.readPreference).CommandMonitor(c.monitor).
-		ServerSelector(selector).ClusterClock(c.clock).Database("admin").Deployment(c.deployment).Crypt(c.cryptFLE).
-		ServerAPI(c.serverAPI).Timeout(c.timeout).Authenticator(c.authenticator)
+		ServerSelector(selector).ClusterClock(c.clock).Database("admin").Deployment(c.deploymentFor(ctx)).
+		RetryPolicy(c.retryPolicy).CommandInterceptors(c.interceptors).MemoryAccountant(c.memoryAccountant()).
+		Crypt(c.cryptFLE).ServerAPI(c.serverAPI).Timeout(c.timeout).Authenticator(c.authenticator)
 
 	if lda.NameOnly != nil {
 		op = op.NameOnly(*lda.NameOnly)
@@ -740,7 +884,7 @@ func (c *Client) ListDatabases(ctx context.Context, filter any, opts ...options.
 	}
 	op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = c.runOperation(ctx, "listDatabases", "", op.Execute)
 	if err != nil {
 		return ListDatabasesResult{}, wrapErrors(err)
 	}
@@ -866,11 +1010,81 @@ func (c *Client) NumberSessionsInProgress() int {
 	return int(c.sessionPool.CheckedOut())
 }
 
+// SessionPoolStats returns counters describing the Client's pool of server sessions, which can be exported as metrics
+// to detect sessions that are never ended or cursors that are never closed.
+func (c *Client) SessionPoolStats() session.PoolStats {
+	if c.sessionPool == nil {
+		return session.PoolStats{}
+	}
+	return c.sessionPool.Stats()
+}
+
+// ConnectionPoolStats returns statistics about the connection pool of each known server, keyed by server address. If
+// the Client is connected to a load balancer, the statistics are also segmented by the ID of the backend service
+// that each connection is connected to, which can be used to diagnose connections that are unevenly distributed
+// across the services behind the load balancer. The returned map is empty if the Client is not connected.
+func (c *Client) ConnectionPoolStats() map[string]topology.PoolStats {
+	topo, ok := c.deployment.(*topology.Topology)
+	if !ok {
+		return map[string]topology.PoolStats{}
+	}
+	return topo.PoolStats()
+}
+
+// SecondaryStaleness returns the driver's current estimate of how far each known replica set secondary lags behind
+// the primary, keyed by server address. These are the estimates compared against a read preference's
+// maxStalenessSeconds during server selection, so a secondary whose staleness exceeds that value is excluded.
+//
+// If there is no known primary, staleness is measured relative to the secondary with the most recent write. The
+// estimates are based on the most recent heartbeat from each server and include the heartbeat interval. The
+// returned map is empty if the Client is not connected to a replica set or no secondaries have been discovered.
+func (c *Client) SecondaryStaleness() map[string]time.Duration {
+	topo, ok := c.deployment.(*topology.Topology)
+	if !ok {
+		return map[string]time.Duration{}
+	}
+	return serverselector.SecondaryStaleness(topo.Description().Servers)
+}
+
+// TopologyDescription returns a snapshot of the Client's current view of the deployment, including the kind of each
+// known server, its average and recent round-trip times, tags, wire versions, and the error that caused it to be
+// marked Unknown, if any. It is the same description that is reported by ServerMonitor events, so applications can
+// inspect the topology without subscribing to those events and reconstructing its state. The snapshot is not updated
+// after it is returned.
+func (c *Client) TopologyDescription() event.TopologyDescription {
+	topo, ok := c.deployment.(*topology.Topology)
+	if !ok {
+		return event.TopologyDescription{}
+	}
+	return topo.EventDescription()
+}
+
+// ClusterTime returns the most recent $clusterTime document seen by the Client, in the same form as
+// Session.ClusterTime. It is nil if the deployment does not report cluster times or no command has completed yet.
+// Applications coordinating causal consistency across Client instances or processes can pass it to
+// AdvanceClusterTime on another Client.
+func (c *Client) ClusterTime() bson.Raw {
+	return c.clock.GetClusterTime()
+}
+
+// AdvanceClusterTime advances the cluster time tracked by the Client to d if d is greater than the current cluster
+// time. The cluster time is gossiped to the server by all subsequent operations, including operations run in
+// implicit sessions. d must be a document of the form {$clusterTime: {clusterTime: <timestamp>, ...}}, such as one
+// returned by ClusterTime or Session.ClusterTime.
+func (c *Client) AdvanceClusterTime(d bson.Raw) error {
+	if _, _, ok := d.Lookup("$clusterTime", "clusterTime").TimestampOK(); !ok {
+		return errors.New("cluster time document must contain a $clusterTime.clusterTime timestamp")
+	}
+	c.clock.AdvanceClusterTime(d)
+	return nil
+}
+
 func (c *Client) createBaseCursorOptions() driver.CursorOptions {
 	return driver.CursorOptions{
-		CommandMonitor: c.monitor,
-		Crypt:          c.cryptFLE,
-		ServerAPI:      c.serverAPI,
+		CommandMonitor:      c.monitor,
+		Crypt:               c.cryptFLE,
+		ServerAPI:           c.serverAPI,
+		CommandInterceptors: c.interceptors,
 	}
 }
 
@@ -923,6 +1137,17 @@ func (c *Client) BulkWrite(ctx context.Context, writes []ClientBulkWrite,
 		}
 		wc = bwo.WriteConcern
 	}
+	if err := c.checkReadOnly("bulkWrite", ""); err != nil {
+		return nil, err
+	}
+	if err := c.checkWritePolicy(sess, "", wc); err != nil {
+		return nil, err
+	}
+	for _, w := range writes {
+		if err := c.checkNamespacePolicy(w.Database + "." + w.Collection); err != nil {
+			return nil, err
+		}
+	}
 	acknowledged := wc.Acknowledged()
 	if !acknowledged {
 		if bwo.Ordered == nil || *bwo.Ordered {
@@ -971,6 +1196,7 @@ func (c *Client) BulkWrite(ctx context.Context, writes []ClientBulkWrite,
 	op.result.Acknowledged = acknowledged
 	op.result.HasVerboseResults = !op.errorsOnly
 	err = op.execute(ctx)
+	c.auditClientBulkWrite(ctx, writes, &op.result, err)
 	return &op.result, wrapErrors(err)
 }
 
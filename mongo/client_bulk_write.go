@@ -66,7 +66,7 @@ func (bw *clientBulkWrite) execute(ctx context.Context) error {
 		result:     &bw.result,
 		retryMode:  driver.RetryOnce,
 	}
-	err := driver.Operation{
+	err := bw.client.runOperation(ctx, "bulkWrite", "", driver.Operation{
 		CommandFn:         bw.newCommand(),
 		ProcessResponseFn: batches.processResponse,
 		Client:            bw.session,
@@ -76,7 +76,7 @@ func (bw *clientBulkWrite) execute(ctx context.Context) error {
 		Batches:           batches,
 		CommandMonitor:    bw.client.monitor,
 		Database:          database,
-		Deployment:        bw.client.deployment,
+		Deployment:        bw.client.deploymentFor(ctx),
 		Selector:          bw.selector,
 		WriteConcern:      bw.writeConcern,
 		Crypt:             bw.client.cryptFLE,
@@ -85,7 +85,11 @@ func (bw *clientBulkWrite) execute(ctx context.Context) error {
 		Logger:            bw.client.logger,
 		Authenticator:     bw.client.authenticator,
 		Name:              driverutil.BulkWriteOp,
-	}.Execute(ctx)
+
+		RetryPolicy:         bw.client.retryPolicy,
+		CommandInterceptors: bw.client.interceptors,
+		MemoryAccountant:    bw.client.memoryAccountant(),
+	}.Execute)
 	var exception *ClientBulkWriteException
 
 	var ce CommandError
@@ -470,6 +474,7 @@ func (mb *modelBatches) processResponse(ctx context.Context, resp bsoncore.Docum
 			Crypt:                 mb.client.cryptFLE,
 			ServerAPI:             mb.client.serverAPI,
 			MarshalValueEncoderFn: newEncoderFn(mb.client.bsonOpts, mb.client.registry),
+			CommandInterceptors:   mb.client.interceptors,
 		},
 	)
 	if err != nil {
@@ -48,7 +48,11 @@ func NewClientEncryption(keyVaultClient *Client, opts ...options.Lister[options.
 	db, coll := splitNamespace(cea.KeyVaultNamespace)
 	ce.keyVaultColl = ce.keyVaultClient.Database(db).Collection(coll, keyVaultCollOpts)
 
-	kmsProviders, err := marshal(cea.KmsProviders, nil, nil)
+	providers, err := addCustomKMSProviders(context.Background(), cea.KmsProviders, cea.CustomKMSProviders)
+	if err != nil {
+		return nil, err
+	}
+	kmsProviders, err := marshal(providers, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating KMS providers map: %w", err)
 	}
@@ -489,13 +493,28 @@ func (ce *ClientEncryption) RewrapManyDataKey(
 		return nil, err
 	}
 
-	rewrappedDocuments, err := ce.crypt.RewrapDataKey(ctx, filterdoc, co)
+	if args.BatchSize != nil || args.Progress != nil {
+		return ce.rewrapDataKeysInBatches(ctx, filterdoc, co, args)
+	}
+
+	bulkWriteResults, err := ce.rewrapDataKeys(ctx, filterdoc, co)
+	return &RewrapManyDataKeyResult{BulkWriteResult: bulkWriteResults}, err
+}
+
+// rewrapDataKeys rewraps all data keys matching filter and writes them to the key vault collection. It returns a nil
+// result if no data keys match.
+func (ce *ClientEncryption) rewrapDataKeys(
+	ctx context.Context,
+	filter bsoncore.Document,
+	co *mcopts.RewrapManyDataKeyOptions,
+) (*BulkWriteResult, error) {
+	rewrappedDocuments, err := ce.crypt.RewrapDataKey(ctx, filter, co)
 	if err != nil {
 		return nil, err
 	}
 	if len(rewrappedDocuments) == 0 {
 		// If there are no documents to rewrap, then do nothing.
-		return new(RewrapManyDataKeyResult), nil
+		return nil, nil
 	}
 
 	// Prepare the WriteModel slice for bulk updating the rewrapped data keys.
@@ -504,8 +523,81 @@ func (ce *ClientEncryption) RewrapManyDataKey(
 		return nil, err
 	}
 
-	bulkWriteResults, err := ce.keyVaultColl.BulkWrite(ctx, models)
-	return &RewrapManyDataKeyResult{BulkWriteResult: bulkWriteResults}, err
+	return ce.keyVaultColl.BulkWrite(ctx, models)
+}
+
+// rewrapDataKeysInBatches rewraps the data keys matching filter in batches of args.BatchSize, calling args.Progress
+// after each batch is written to the key vault collection.
+func (ce *ClientEncryption) rewrapDataKeysInBatches(
+	ctx context.Context,
+	filter bsoncore.Document,
+	co *mcopts.RewrapManyDataKeyOptions,
+	args *options.RewrapManyDataKeyOptions,
+) (*RewrapManyDataKeyResult, error) {
+	batchSize := 0
+	if args.BatchSize != nil {
+		if *args.BatchSize <= 0 {
+			return nil, fmt.Errorf("batch size must be positive, got %d", *args.BatchSize)
+		}
+		batchSize = int(*args.BatchSize)
+	}
+
+	ids, err := ce.keyVaultColl.Distinct(ctx, "_id", bson.Raw(filter)).Raw()
+	if err != nil {
+		return nil, err
+	}
+	values, err := ids.Values()
+	if err != nil {
+		return nil, err
+	}
+	if batchSize == 0 {
+		batchSize = len(values)
+	}
+
+	result := &RewrapManyDataKeyResult{}
+	for start := 0; start < len(values); start += batchSize {
+		end := start + batchSize
+		if end > len(values) {
+			end = len(values)
+		}
+
+		idArr := bsoncore.NewArrayBuilder()
+		for _, v := range values[start:end] {
+			idArr.AppendValue(bsoncore.Value{Type: bsoncore.Type(v.Type), Data: v.Value})
+		}
+		batchFilter := bsoncore.NewDocumentBuilder().
+			AppendDocument("_id", bsoncore.NewDocumentBuilder().AppendArray("$in", idArr.Build()).Build()).
+			Build()
+
+		bwr, err := ce.rewrapDataKeys(ctx, batchFilter, co)
+		if bwr != nil {
+			result.BulkWriteResult = mergeBulkWriteResults(result.BulkWriteResult, bwr)
+		}
+		if err != nil {
+			return result, err
+		}
+		if args.Progress != nil {
+			args.Progress(end, len(values))
+		}
+	}
+	return result, nil
+}
+
+// mergeBulkWriteResults adds the counts of b to a, allocating a if it is nil.
+func mergeBulkWriteResults(a, b *BulkWriteResult) *BulkWriteResult {
+	if a == nil {
+		a = &BulkWriteResult{Acknowledged: true}
+	}
+	a.InsertedCount += b.InsertedCount
+	a.MatchedCount += b.MatchedCount
+	a.ModifiedCount += b.ModifiedCount
+	a.DeletedCount += b.DeletedCount
+	a.UpsertedCount += b.UpsertedCount
+	a.Acknowledged = a.Acknowledged && b.Acknowledged
+	if b.OperationTime != nil && (a.OperationTime == nil || a.OperationTime.Before(*b.OperationTime)) {
+		a.OperationTime = b.OperationTime
+	}
+	return a
 }
 
 // splitNamespace takes a namespace in the form "database.collection" and returns (database name, collection name)
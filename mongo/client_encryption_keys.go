@@ -0,0 +1,266 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// KeyDocument is a data key document stored in the key vault collection.
+type KeyDocument struct {
+	// ID is the UUID of the data key.
+	ID bson.Binary `bson:"_id"`
+
+	// KeyAltNames are the alternate names of the data key.
+	KeyAltNames []string `bson:"keyAltNames,omitempty"`
+
+	// KeyMaterial is the data key encrypted with its master key.
+	KeyMaterial bson.Binary `bson:"keyMaterial"`
+
+	// MasterKey identifies the KMS provider and master key used to encrypt
+	// KeyMaterial. It always contains a "provider" field.
+	MasterKey bson.Raw `bson:"masterKey"`
+
+	CreationDate time.Time `bson:"creationDate"`
+	UpdateDate   time.Time `bson:"updateDate"`
+	Status       int32     `bson:"status"`
+}
+
+// Provider returns the name of the KMS provider used to encrypt the data key.
+func (kd KeyDocument) Provider() string {
+	provider, _ := kd.MasterKey.Lookup("provider").StringValueOK()
+	return provider
+}
+
+// ListKeys returns the data keys in the key vault collection that match filter.
+// If filter is nil, all data keys are returned. For example, the filter
+// bson.D{{"masterKey.provider", "aws"}} lists the data keys encrypted with an
+// AWS master key.
+func (ce *ClientEncryption) ListKeys(ctx context.Context, filter any) ([]KeyDocument, error) {
+	if ce.closed {
+		return nil, ErrClientDisconnected
+	}
+	if filter == nil {
+		filter = bson.D{}
+	}
+
+	cursor, err := ce.keyVaultColl.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var keys []KeyDocument
+	if err := cursor.All(ctx, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// ListKeysByAltName returns the data keys in the key vault collection that have
+// any of the given keyAltNames.
+func (ce *ClientEncryption) ListKeysByAltName(ctx context.Context, keyAltNames ...string) ([]KeyDocument, error) {
+	return ce.ListKeys(ctx, bson.D{{Key: "keyAltNames", Value: bson.D{{Key: "$in", Value: keyAltNames}}}})
+}
+
+// RotateMasterKey rewraps every data key encrypted with oldMasterKey of the
+// given KMS provider so that it is encrypted with newMasterKey instead. The
+// master keys have the same form as the master key passed to CreateDataKey.
+// If oldMasterKey is nil, all data keys of the provider are rewrapped, which is
+// required for the "local" and "kmip" providers whose key documents do not
+// identify a master key.
+//
+// Rotating a master key does not change the data keys themselves, so data
+// encrypted with them does not need to be re-encrypted. The old master key must
+// remain available until RotateMasterKey returns successfully. opts can be used
+// to set a batch size and progress callback.
+func (ce *ClientEncryption) RotateMasterKey(
+	ctx context.Context,
+	kmsProvider string,
+	oldMasterKey any,
+	newMasterKey any,
+	opts ...options.Lister[options.RewrapManyDataKeyOptions],
+) (*RewrapManyDataKeyResult, error) {
+	if ce.closed {
+		return nil, ErrClientDisconnected
+	}
+
+	var masterKey bsoncore.Document
+	if oldMasterKey != nil {
+		var err error
+		masterKey, err = marshal(oldMasterKey, ce.keyVaultClient.bsonOpts, ce.keyVaultClient.registry)
+		if err != nil {
+			return nil, err
+		}
+	}
+	filter, err := masterKeyFilter(kmsProvider, masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rotate := options.RewrapManyDataKey().SetProvider(kmsProvider)
+	if newMasterKey != nil {
+		rotate.SetMasterKey(newMasterKey)
+	}
+	rewrapOpts := make([]options.Lister[options.RewrapManyDataKeyOptions], 0, len(opts)+1)
+	rewrapOpts = append(rewrapOpts, opts...)
+	return ce.RewrapManyDataKey(ctx, filter, append(rewrapOpts, rotate)...)
+}
+
+// masterKeyFilter returns a key vault filter that matches the data keys of the
+// given KMS provider whose master key has the fields of masterKey. A nil
+// masterKey matches all data keys of the provider.
+func masterKeyFilter(kmsProvider string, masterKey bsoncore.Document) (bson.D, error) {
+	filter := bson.D{{Key: "masterKey.provider", Value: kmsProvider}}
+	if masterKey == nil {
+		return filter, nil
+	}
+
+	elems, err := masterKey.Elements()
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range elems {
+		if elem.Key() == "provider" {
+			continue
+		}
+		val := elem.Value()
+		filter = append(filter, bson.E{
+			Key:   "masterKey." + elem.Key(),
+			Value: bson.RawValue{Type: bson.Type(val.Type), Value: val.Data},
+		})
+	}
+	return filter, nil
+}
+
+var errNoKeyReferenceDatabases = errors.New("at least one database must be given to check for data key references")
+
+// KeyInUseError is returned by DeleteKeyIfUnused when a data key is referenced
+// by a collection.
+type KeyInUseError struct {
+	KeyID bson.Binary
+
+	// Namespaces are the collections, in the form "database.collection", whose
+	// encryptedFields or JSON Schema validator reference the data key.
+	Namespaces []string
+}
+
+// Error implements the error interface.
+func (e KeyInUseError) Error() string {
+	return fmt.Sprintf("data key %x is referenced by %v", e.KeyID.Data, e.Namespaces)
+}
+
+// DeleteKeyIfUnused deletes the data key with the given UUID only if it is not
+// referenced by the encryptedFields or $jsonSchema validator of any collection
+// in dbs. It returns a KeyInUseError if the data key is referenced.
+//
+// Only collection metadata is checked, so a data key that was used for
+// explicit encryption without a schema may still protect existing data.
+func (ce *ClientEncryption) DeleteKeyIfUnused(ctx context.Context, id bson.Binary, dbs ...*Database) (*DeleteResult, error) {
+	if ce.closed {
+		return nil, ErrClientDisconnected
+	}
+	if len(dbs) == 0 {
+		return nil, errNoKeyReferenceDatabases
+	}
+
+	namespaces, err := keyReferences(ctx, id, dbs)
+	if err != nil {
+		return nil, err
+	}
+	if len(namespaces) > 0 {
+		return nil, KeyInUseError{KeyID: id, Namespaces: namespaces}
+	}
+	return ce.DeleteKey(ctx, id)
+}
+
+// DeleteUnusedKeys deletes the data keys matching filter that are not
+// referenced by the encryptedFields or $jsonSchema validator of any collection
+// in dbs, and returns the UUIDs of the deleted keys. Data keys that are
+// referenced are skipped. At least one database must be given. See
+// DeleteKeyIfUnused for the limitations of this check.
+func (ce *ClientEncryption) DeleteUnusedKeys(ctx context.Context, filter any, dbs ...*Database) ([]bson.Binary, error) {
+	if ce.closed {
+		return nil, ErrClientDisconnected
+	}
+	if len(dbs) == 0 {
+		return nil, errNoKeyReferenceDatabases
+	}
+
+	keys, err := ce.ListKeys(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []bson.Binary
+	for _, key := range keys {
+		namespaces, err := keyReferences(ctx, key.ID, dbs)
+		if err != nil {
+			return deleted, err
+		}
+		if len(namespaces) > 0 {
+			continue
+		}
+		if _, err := ce.DeleteKey(ctx, key.ID); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, key.ID)
+	}
+	return deleted, nil
+}
+
+// keyReferences returns the namespaces of the collections in dbs whose options
+// contain the data key UUID id.
+func keyReferences(ctx context.Context, id bson.Binary, dbs []*Database) ([]string, error) {
+	var namespaces []string
+	for _, db := range dbs {
+		specs, err := db.ListCollectionSpecifications(ctx, bson.D{})
+		if err != nil {
+			return nil, err
+		}
+		for _, spec := range specs {
+			if containsBinary(spec.Options, id) {
+				namespaces = append(namespaces, db.Name()+"."+spec.Name)
+			}
+		}
+	}
+	return namespaces, nil
+}
+
+// containsBinary reports whether doc or any document or array nested in it
+// contains the binary value id.
+func containsBinary(doc bson.Raw, id bson.Binary) bool {
+	elems, err := doc.Elements()
+	if err != nil {
+		return false
+	}
+	for _, elem := range elems {
+		val := elem.Value()
+		switch val.Type {
+		case bson.TypeBinary:
+			subtype, data := val.Binary()
+			if subtype == id.Subtype && bytes.Equal(data, id.Data) {
+				return true
+			}
+		case bson.TypeEmbeddedDocument:
+			if containsBinary(val.Document(), id) {
+				return true
+			}
+		case bson.TypeArray:
+			if containsBinary(bson.Raw(val.Array()), id) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+func TestKeyDocument(t *testing.T) {
+	t.Parallel()
+
+	id := bson.Binary{Subtype: bson.TypeBinaryUUID, Data: make([]byte, 16)}
+	doc, err := bson.Marshal(bson.D{
+		{Key: "_id", Value: id},
+		{Key: "keyAltNames", Value: bson.A{"payments"}},
+		{Key: "keyMaterial", Value: bson.Binary{Data: []byte{1, 2, 3}}},
+		{Key: "masterKey", Value: bson.D{{Key: "provider", Value: "aws"}, {Key: "region", Value: "us-east-1"}}},
+		{Key: "status", Value: int32(0)},
+	})
+	require.NoError(t, err)
+
+	var kd KeyDocument
+	require.NoError(t, bson.Unmarshal(doc, &kd))
+	assert.Equal(t, id, kd.ID)
+	assert.Equal(t, []string{"payments"}, kd.KeyAltNames)
+	assert.Equal(t, "aws", kd.Provider())
+}
+
+func TestMasterKeyFilter(t *testing.T) {
+	t.Parallel()
+
+	filter, err := masterKeyFilter("local", nil)
+	require.NoError(t, err)
+	assert.Equal(t, bson.D{{Key: "masterKey.provider", Value: "local"}}, filter)
+
+	masterKey := bsoncore.NewDocumentBuilder().
+		AppendString("provider", "aws").
+		AppendString("region", "us-east-1").
+		AppendString("key", "arn:old").
+		Build()
+	filter, err = masterKeyFilter("aws", masterKey)
+	require.NoError(t, err)
+
+	got, err := bson.Marshal(filter)
+	require.NoError(t, err)
+	want := `{"masterKey.provider": "aws","masterKey.region": "us-east-1","masterKey.key": "arn:old"}`
+	assert.Equal(t, want, bson.Raw(got).String())
+}
+
+func TestContainsBinary(t *testing.T) {
+	t.Parallel()
+
+	id := bson.Binary{Subtype: bson.TypeBinaryUUID, Data: []byte("0123456789abcdef")}
+	other := bson.Binary{Subtype: bson.TypeBinaryUUID, Data: []byte("fedcba9876543210")}
+
+	encryptedFields, err := bson.Marshal(bson.D{{Key: "encryptedFields", Value: bson.D{
+		{Key: "fields", Value: bson.A{bson.D{{Key: "path", Value: "ssn"}, {Key: "keyId", Value: id}}}},
+	}}})
+	require.NoError(t, err)
+	assert.True(t, containsBinary(encryptedFields, id))
+	assert.False(t, containsBinary(encryptedFields, other))
+
+	validator, err := bson.Marshal(bson.D{{Key: "validator", Value: bson.D{{Key: "$jsonSchema", Value: bson.D{
+		{Key: "properties", Value: bson.D{{Key: "ssn", Value: bson.D{
+			{Key: "encrypt", Value: bson.D{{Key: "keyId", Value: bson.A{other}}}},
+		}}}},
+	}}}}})
+	require.NoError(t, err)
+	assert.True(t, containsBinary(validator, other))
+	assert.False(t, containsBinary(validator, id))
+}
+
+func TestKeyInUseError(t *testing.T) {
+	t.Parallel()
+
+	err := KeyInUseError{
+		KeyID:      bson.Binary{Subtype: bson.TypeBinaryUUID, Data: []byte{0xab, 0xcd}},
+		Namespaces: []string{"db.patients"},
+	}
+	assert.Equal(t, "data key abcd is referenced by [db.patients]", err.Error())
+}
@@ -24,6 +24,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/tag"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mongocrypt"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
 )
@@ -45,6 +46,34 @@ func TestClient(t *testing.T) {
 		client := setupClient()
 		assert.NotNil(t, client.deployment, "expected valid deployment, got nil")
 	})
+	t.Run("snapshot session time", func(t *testing.T) {
+		client := setupClient()
+		snapshotTime := bson.Timestamp{T: 42, I: 1}
+
+		sess, err := client.StartSession(options.Session().SetSnapshot(true).SetSnapshotTime(snapshotTime))
+		require.NoError(t, err, "StartSession error: %v", err)
+		defer sess.EndSession(bgCtx)
+		assert.Equal(t, &snapshotTime, sess.SnapshotTime(), "expected snapshot time %v, got %v", snapshotTime, sess.SnapshotTime())
+
+		_, err = client.StartSession(options.Session().SetSnapshotTime(snapshotTime))
+		assert.Error(t, err, "expected error setting snapshot time without snapshot")
+	})
+	t.Run("cluster time", func(t *testing.T) {
+		client := setupClient()
+		assert.Nil(t, client.ClusterTime(), "expected nil cluster time, got %v", client.ClusterTime())
+
+		later := bson.Raw(bsoncore.BuildDocument(nil, bsoncore.AppendDocumentElement(nil, "$clusterTime",
+			bsoncore.BuildDocument(nil, bsoncore.AppendTimestampElement(nil, "clusterTime", 10, 5)))))
+		earlier := bson.Raw(bsoncore.BuildDocument(nil, bsoncore.AppendDocumentElement(nil, "$clusterTime",
+			bsoncore.BuildDocument(nil, bsoncore.AppendTimestampElement(nil, "clusterTime", 5, 5)))))
+
+		require.NoError(t, client.AdvanceClusterTime(later), "AdvanceClusterTime error")
+		require.NoError(t, client.AdvanceClusterTime(earlier), "AdvanceClusterTime error")
+		assert.Equal(t, later, client.ClusterTime(), "expected cluster time %v, got %v", later, client.ClusterTime())
+
+		err := client.AdvanceClusterTime(bson.Raw(bsoncore.NewDocumentBuilder().AppendInt32("x", 1).Build()))
+		assert.Error(t, err, "expected error for invalid cluster time document")
+	})
 	t.Run("database", func(t *testing.T) {
 		dbName := "foo"
 		client := setupClient()
@@ -67,6 +96,12 @@ func TestClient(t *testing.T) {
 		err = client.Ping(bgCtx, nil)
 		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
 
+		_, err = client.PingWithResult(bgCtx, nil)
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+
+		err = client.WithConnection(bgCtx, func(context.Context) error { return nil })
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+
 		err = client.Disconnect(bgCtx)
 		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
 
@@ -186,7 +221,12 @@ func TestClient(t *testing.T) {
 		for _, tc := range testCases {
 			t.Run(tc.name, func(t *testing.T) {
 				_, err := newClient(tc.opts)
-				assert.Equal(t, tc.err, err, "expected error %v, got %v", tc.err, err)
+				if tc.err == nil {
+					assert.NoError(t, err)
+					return
+				}
+				assert.EqualError(t, err, tc.err.Error())
+				assert.ErrorIs(t, err, options.ErrInvalidOption)
 			})
 		}
 	})
@@ -230,7 +270,12 @@ func TestClient(t *testing.T) {
 		for _, tc := range testCases {
 			t.Run(tc.name, func(t *testing.T) {
 				_, err := newClient(tc.opts)
-				assert.Equal(t, tc.err, err, "expected error %v, got %v", tc.err, err)
+				if tc.err == nil {
+					assert.NoError(t, err)
+					return
+				}
+				assert.EqualError(t, err, tc.err.Error())
+				assert.ErrorIs(t, err, options.ErrInvalidOption)
 			})
 		}
 	})
@@ -515,3 +560,29 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, errmsg, err.Error(), "expected error %v, got %v", errmsg, err.Error())
 	})
 }
+
+func TestClientTopologyDescription(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a topology", func(t *testing.T) {
+		t.Parallel()
+
+		client := &Client{}
+		assert.Equal(t, event.TopologyDescription{}, client.TopologyDescription())
+	})
+	t.Run("servers", func(t *testing.T) {
+		t.Parallel()
+
+		client := setupClient(options.Client().SetHosts([]string{"a:27017", "b:27017"}))
+		defer func() { _ = client.Disconnect(context.Background()) }()
+
+		desc := client.TopologyDescription()
+		require.Len(t, desc.Servers, 2)
+
+		var addrs []string
+		for _, srv := range desc.Servers {
+			addrs = append(addrs, srv.Addr.String())
+		}
+		assert.ElementsMatch(t, []string{"a:27017", "b:27017"}, addrs)
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
 	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
+	"go.mongodb.org/mongo-driver/v2/mongo/audit"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -178,6 +179,11 @@ func (coll *Collection) Name() string {
 	return coll.name
 }
 
+// namespace returns the "database.collection" namespace of the Collection.
+func (coll *Collection) namespace() string {
+	return coll.db.name + "." + coll.name
+}
+
 // Database returns the Database that was used to create the Collection.
 func (coll *Collection) Database() *Database {
 	return coll.db
@@ -212,9 +218,22 @@ func (coll *Collection) BulkWrite(ctx context.Context, models []WriteModel,
 		return nil, err
 	}
 
-	wc := coll.writeConcern
-	if sess.TransactionRunning() {
-		wc = nil
+	// Ensure opts have the default case at the front.
+	opts = append([]options.Lister[options.BulkWriteOptions]{options.BulkWrite()}, opts...)
+	args, err := mongoutil.NewOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	wc, err := operationWriteConcern(sess, coll.writeConcern, args.WriteConcern)
+	if err != nil {
+		return nil, err
+	}
+	if err := coll.client.checkReadOnly("bulkWrite", coll.namespace()); err != nil {
+		return nil, err
+	}
+	if err := coll.client.checkWritePolicy(sess, coll.namespace(), wc); err != nil {
+		return nil, err
 	}
 	if !wc.Acknowledged() {
 		sess = nil
@@ -228,13 +247,6 @@ func (coll *Collection) BulkWrite(ctx context.Context, models []WriteModel,
 		}
 	}
 
-	// Ensure opts have the default case at the front.
-	opts = append([]options.Lister[options.BulkWriteOptions]{options.BulkWrite()}, opts...)
-	args, err := mongoutil.NewOptions(opts...)
-	if err != nil {
-		return nil, err
-	}
-
 	op := bulkWrite{
 		comment:                  args.Comment,
 		ordered:                  args.Ordered,
@@ -253,6 +265,14 @@ func (coll *Collection) BulkWrite(ctx context.Context, models []WriteModel,
 	}
 
 	err = op.execute(ctx)
+	op.result.OperationTime = sessionOperationTime(sess)
+	coll.client.emitAudit(ctx, audit.Record{
+		Namespace:   coll.namespace(),
+		Operation:   "bulkWrite",
+		DocumentIDs: upsertedIDs(op.result.UpsertedIDs),
+		Affected: op.result.InsertedCount + op.result.ModifiedCount +
+			op.result.UpsertedCount + op.result.DeletedCount,
+	}, err)
 
 	return &op.result, wrapErrors(err)
 }
@@ -261,7 +281,7 @@ func (coll *Collection) insert(
 	ctx context.Context,
 	documents []any,
 	opts ...options.Lister[options.InsertManyOptions],
-) ([]any, error) {
+) ([]any, *bson.Timestamp, error) {
 
 	if ctx == nil {
 		ctx = context.Background()
@@ -273,11 +293,11 @@ func (coll *Collection) insert(
 	for i, doc := range documents {
 		bsoncoreDoc, err := marshal(doc, coll.bsonOpts, coll.registry)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		bsoncoreDoc, id, err := ensureID(bsoncoreDoc, bson.NilObjectID, coll.bsonOpts, coll.registry)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		docs[i] = bsoncoreDoc
@@ -292,12 +312,23 @@ func (coll *Collection) insert(
 
 	err := coll.client.validSession(sess)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	wc := coll.writeConcern
-	if sess.TransactionRunning() {
-		wc = nil
+	args, err := mongoutil.NewOptions[options.InsertManyOptions](opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+
+	wc, err := operationWriteConcern(sess, coll.writeConcern, args.WriteConcern)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := coll.client.checkReadOnly("insert", coll.namespace()); err != nil {
+		return nil, nil, err
+	}
+	if err := coll.client.checkWritePolicy(sess, coll.namespace(), wc); err != nil {
+		return nil, nil, err
 	}
 	if !wc.Acknowledged() {
 		sess = nil
@@ -309,21 +340,18 @@ func (coll *Collection) insert(
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
 		ServerSelector(selector).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).Ordered(true).
+		Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
+		Crypt(coll.client.cryptFLE).Ordered(true).
 		ServerAPI(coll.client.serverAPI).Timeout(coll.client.timeout).Logger(coll.client.logger).Authenticator(coll.client.authenticator)
 
-	args, err := mongoutil.NewOptions[options.InsertManyOptions](opts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
-	}
-
 	if args.BypassDocumentValidation != nil && *args.BypassDocumentValidation {
 		op = op.BypassDocumentValidation(*args.BypassDocumentValidation)
 	}
 	if args.Comment != nil {
 		comment, err := marshalValue(args.Comment, coll.bsonOpts, coll.registry)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		op = op.Comment(comment)
 	}
@@ -341,10 +369,17 @@ func (coll *Collection) insert(
 	}
 	op = op.Retry(retry)
 
-	err = op.Execute(ctx)
+	execute := coll.client.memory.accountDocuments(docs, op.Execute)
+	err = coll.client.runOperation(ctx, "insert", coll.namespace(), execute)
+	opTime := sessionOperationTime(sess)
 	var wce driver.WriteCommandError
 	if !errors.As(err, &wce) {
-		return result, err
+		rec := audit.Record{Namespace: coll.namespace(), Operation: "insert", Affected: op.Result().N}
+		if err == nil {
+			rec.DocumentIDs = result
+		}
+		coll.client.emitAudit(ctx, rec, err)
+		return result, opTime, err
 	}
 
 	// remove the ids that had writeErrors from result
@@ -358,8 +393,14 @@ func (coll *Collection) insert(
 		}
 		result = append(result[:idIndex], result[idIndex+1:]...)
 	}
+	coll.client.emitAudit(ctx, audit.Record{
+		Namespace:   coll.namespace(),
+		Operation:   "insert",
+		DocumentIDs: result,
+		Affected:    op.Result().N,
+	}, err)
 
-	return result, err
+	return result, opTime, err
 }
 
 // InsertOne executes an insert command to insert a single document into the collection.
@@ -386,6 +427,9 @@ func (coll *Collection) InsertOne(ctx context.Context, document any,
 	if args.Comment != nil {
 		imOpts.SetComment(args.Comment)
 	}
+	if args.WriteConcern != nil {
+		imOpts.SetWriteConcern(args.WriteConcern)
+	}
 	if rawDataOpt := optionsutil.Value(args.Internal, "rawData"); rawDataOpt != nil {
 		imOpts.Opts = append(imOpts.Opts, func(opts *options.InsertManyOptions) error {
 			optionsutil.WithValue(opts.Internal, "rawData", rawDataOpt)
@@ -393,7 +437,7 @@ func (coll *Collection) InsertOne(ctx context.Context, document any,
 			return nil
 		})
 	}
-	res, err := coll.insert(ctx, []any{document}, imOpts)
+	res, opTime, err := coll.insert(ctx, []any{document}, imOpts)
 
 	rr, err := processWriteError(err)
 	if rr&rrOne == 0 && rr.isAcknowledged() {
@@ -401,8 +445,9 @@ func (coll *Collection) InsertOne(ctx context.Context, document any,
 	}
 
 	return &InsertOneResult{
-		InsertedID:   res[0],
-		Acknowledged: rr.isAcknowledged(),
+		InsertedID:    res[0],
+		Acknowledged:  rr.isAcknowledged(),
+		OperationTime: opTime,
 	}, err
 }
 
@@ -436,21 +481,28 @@ func (coll *Collection) InsertMany(
 		docSlice = append(docSlice, dv.Index(i).Interface())
 	}
 
-	result, err := coll.insert(ctx, docSlice, opts...)
-	rr, err := processWriteError(err)
+	result, opTime, insertErr := coll.insert(ctx, docSlice, opts...)
+	rr, err := processWriteError(insertErr)
 	if rr&rrMany == 0 {
 		return nil, err
 	}
 
 	imResult := &InsertManyResult{
-		InsertedIDs:  result,
-		Acknowledged: rr.isAcknowledged(),
+		InsertedIDs:   result,
+		Acknowledged:  rr.isAcknowledged(),
+		OperationTime: opTime,
 	}
 	var writeException WriteException
 	if !errors.As(err, &writeException) {
 		return imResult, err
 	}
 
+	var responses []bson.Raw
+	var wce driver.WriteCommandError
+	if errors.As(insertErr, &wce) {
+		responses = responsesFromDriverWriteCommandError(wce)
+	}
+
 	// create and return a BulkWriteException
 	bwErrors := make([]BulkWriteError, 0, len(writeException.WriteErrors))
 	for _, we := range writeException.WriteErrors {
@@ -464,6 +516,7 @@ func (coll *Collection) InsertMany(
 		WriteErrors:       bwErrors,
 		WriteConcernError: writeException.WriteConcernError,
 		Labels:            writeException.Labels,
+		Responses:         responses,
 	}
 }
 
@@ -495,9 +548,15 @@ func (coll *Collection) delete(
 		return nil, err
 	}
 
-	wc := coll.writeConcern
-	if sess.TransactionRunning() {
-		wc = nil
+	wc, err := operationWriteConcern(sess, coll.writeConcern, args.WriteConcern)
+	if err != nil {
+		return nil, err
+	}
+	if err := coll.client.checkReadOnly("delete", coll.namespace()); err != nil {
+		return nil, err
+	}
+	if err := coll.client.checkWritePolicy(sess, coll.namespace(), wc); err != nil {
+		return nil, err
 	}
 	if !wc.Acknowledged() {
 		sess = nil
@@ -533,7 +592,9 @@ func (coll *Collection) delete(
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
 		ServerSelector(selector).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).Ordered(true).
+		Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
+		Crypt(coll.client.cryptFLE).Ordered(true).
 		ServerAPI(coll.client.serverAPI).Timeout(coll.client.timeout).Logger(coll.client.logger).Authenticator(coll.client.authenticator)
 	if args.Comment != nil {
 		comment, err := marshalValue(args.Comment, coll.bsonOpts, coll.registry)
@@ -564,13 +625,20 @@ func (coll *Collection) delete(
 		retryMode = driver.RetryOncePerCommand
 	}
 	op = op.Retry(retryMode)
-	rr, err := processWriteError(op.Execute(ctx))
+	rr, err := processWriteError(coll.client.runOperation(ctx, "delete", coll.namespace(), op.Execute))
+	coll.client.emitAudit(ctx, audit.Record{
+		Namespace:  coll.namespace(),
+		Operation:  "delete",
+		FilterHash: filterHash(f),
+		Affected:   op.Result().N,
+	}, err)
 	if rr&expectedRr == 0 {
 		return nil, err
 	}
 	return &DeleteResult{
-		DeletedCount: op.Result().N,
-		Acknowledged: rr.isAcknowledged(),
+		DeletedCount:  op.Result().N,
+		Acknowledged:  rr.isAcknowledged(),
+		OperationTime: sessionOperationTime(sess),
 	}, err
 }
 
@@ -594,11 +662,12 @@ func (coll *Collection) DeleteOne(
 		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
 	}
 	deleteOptions := &options.DeleteManyOptions{
-		Collation: args.Collation,
-		Comment:   args.Comment,
-		Hint:      args.Hint,
-		Let:       args.Let,
-		Internal:  args.Internal,
+		Collation:    args.Collation,
+		Comment:      args.Comment,
+		Hint:         args.Hint,
+		Let:          args.Let,
+		WriteConcern: args.WriteConcern,
+		Internal:     args.Internal,
 	}
 
 	return coll.delete(ctx, filter, true, rrOne, deleteOptions)
@@ -670,9 +739,15 @@ func (coll *Collection) updateOrReplace(
 		return nil, err
 	}
 
-	wc := coll.writeConcern
-	if sess.TransactionRunning() {
-		wc = nil
+	wc, err := operationWriteConcern(sess, coll.writeConcern, args.WriteConcern)
+	if err != nil {
+		return nil, err
+	}
+	if err := coll.client.checkReadOnly("update", coll.namespace()); err != nil {
+		return nil, err
+	}
+	if err := coll.client.checkWritePolicy(sess, coll.namespace(), wc); err != nil {
+		return nil, err
 	}
 	if !wc.Acknowledged() {
 		sess = nil
@@ -684,7 +759,9 @@ func (coll *Collection) updateOrReplace(
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
 		ServerSelector(selector).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).Hint(args.Hint != nil).
+		Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
+		Crypt(coll.client.cryptFLE).Hint(args.Hint != nil).
 		ArrayFilters(args.ArrayFilters != nil).Ordered(true).ServerAPI(coll.client.serverAPI).
 		Timeout(coll.client.timeout).Logger(coll.client.logger).Authenticator(coll.client.authenticator)
 	if args.Let != nil {
@@ -716,19 +793,30 @@ func (coll *Collection) updateOrReplace(
 		retry = driver.RetryOncePerCommand
 	}
 	op = op.Retry(retry)
-	err = op.Execute(ctx)
+	err = coll.client.runOperation(ctx, "update", coll.namespace(), op.Execute)
 
 	rr, err := processWriteError(err)
+	opRes := op.Result()
+	rec := audit.Record{
+		Namespace:  coll.namespace(),
+		Operation:  "update",
+		FilterHash: filterHash(filter),
+		Affected:   opRes.NModified + int64(len(opRes.Upserted)),
+	}
+	for _, upsert := range opRes.Upserted {
+		rec.DocumentIDs = append(rec.DocumentIDs, upsert.ID)
+	}
+	coll.client.emitAudit(ctx, rec, err)
 	if rr&expectedRr == 0 {
 		return nil, err
 	}
 
-	opRes := op.Result()
 	res := &UpdateResult{
 		MatchedCount:  opRes.N,
 		ModifiedCount: opRes.NModified,
 		UpsertedCount: int64(len(opRes.Upserted)),
 		Acknowledged:  rr.isAcknowledged(),
+		OperationTime: sessionOperationTime(sess),
 	}
 	if len(opRes.Upserted) > 0 {
 		res.UpsertedID = opRes.Upserted[0].ID
@@ -804,6 +892,7 @@ func (coll *Collection) UpdateOne(
 		Hint:                     args.Hint,
 		Upsert:                   args.Upsert,
 		Let:                      args.Let,
+		WriteConcern:             args.WriteConcern,
 		Internal:                 args.Internal,
 	}
 
@@ -895,6 +984,7 @@ func (coll *Collection) ReplaceOne(
 		Hint:                     args.Hint,
 		Let:                      args.Let,
 		Comment:                  args.Comment,
+		WriteConcern:             args.WriteConcern,
 		Internal:                 args.Internal,
 	}
 
@@ -961,14 +1051,42 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 		return nil, err
 	}
 
+	args, err := mongoutil.NewOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	var wc *writeconcern.WriteConcern
 	if hasOutputStage {
-		wc = a.writeConcern
+		if wc, err = operationWriteConcern(sess, a.writeConcern, args.WriteConcern); err != nil {
+			return nil, err
+		}
 	}
-	rc := a.readConcern
-	if sess.TransactionRunning() {
-		wc = nil
-		rc = nil
+	rc, err := operationReadConcern(sess, a.readConcern, args.ReadConcern)
+	if err != nil {
+		return nil, err
+	}
+	ns := a.db
+	if a.col != "" {
+		ns += "." + a.col
+	}
+	if hasOutputStage {
+		if err = a.client.checkReadOnly("aggregate", ns); err != nil {
+			return nil, err
+		}
+	}
+	if err = a.client.checkWritePolicy(sess, ns, wc); err != nil {
+		return nil, err
+	}
+	if err = a.client.checkReadPolicy(sess, ns, rc); err != nil {
+		return nil, err
+	}
+	if a.client.policy != nil {
+		for _, stageNS := range pipelineNamespaces(a.db, bsoncore.Array(pipelineArr)) {
+			if err = a.client.checkNamespacePolicy(stageNS); err != nil {
+				return nil, err
+			}
+		}
 	}
 	if !wc.Acknowledged() {
 		closeImplicitSession(sess)
@@ -980,15 +1098,15 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 		selector = makeOutputAggregateSelector(sess, a.readPreference, a.client.localThreshold)
 	}
 
-	args, err := mongoutil.NewOptions(opts...)
-	if err != nil {
-		return nil, err
-	}
-
 	cursorOpts := a.client.createBaseCursorOptions()
 
 	cursorOpts.MarshalValueEncoderFn = newEncoderFn(a.bsonOpts, a.registry)
 
+	timeoutMode, err := applyTimeoutMode(a.ctx, args.TimeoutMode, a.client.timeout, &cursorOpts)
+	if err != nil {
+		return nil, err
+	}
+
 	op := operation.NewAggregate(pipelineArr).
 		Session(sess).
 		WriteConcern(wc).
@@ -999,7 +1117,8 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 		ClusterClock(a.client.clock).
 		Database(a.db).
 		Collection(a.col).
-		Deployment(a.client.deployment).
+		Deployment(a.client.deploymentFor(a.ctx)).RetryPolicy(a.client.retryPolicy).
+		CommandInterceptors(a.client.interceptors).MemoryAccountant(a.client.memoryAccountant()).
 		Crypt(a.client.cryptFLE).
 		ServerAPI(a.client.serverAPI).
 		HasOutputStage(hasOutputStage).
@@ -1079,13 +1198,17 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 	}
 	op = op.Retry(retry)
 
-	err = op.Execute(a.ctx)
+	err = a.client.runOperation(a.ctx, "aggregate", ns, op.Execute)
+	if hasOutputStage {
+		rec := audit.Record{Namespace: outputNamespace(a.db, bsoncore.Array(pipelineArr), ns), Operation: "aggregate"}
+		a.client.emitAudit(a.ctx, rec, err)
+	}
 	if err != nil {
 		var wce driver.WriteCommandError
 		if errors.As(err, &wce) && wce.WriteConcernError != nil {
 			return nil, *convertDriverWriteConcernError(wce.WriteConcernError)
 		}
-		return nil, wrapErrors(err)
+		return nil, wrapTimeoutModeError(timeoutMode, wrapErrors(err))
 	}
 
 	bc, err := op.Result(cursorOpts)
@@ -1093,6 +1216,11 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 		return nil, wrapErrors(err)
 	}
 	cursor, err := newCursorWithSession(bc, a.client.bsonOpts, a.registry, sess)
+	if cursor != nil {
+		cursor.timeoutMode = timeoutMode
+		cursor.memory = a.client.memory
+		cursor.retainBatches = retainBatches(args.CurrentLifetime)
+	}
 	return cursor, wrapErrors(err)
 }
 
@@ -1129,15 +1257,20 @@ func (coll *Collection) CountDocuments(ctx context.Context, filter any,
 		return 0, err
 	}
 
-	rc := coll.readConcern
-	if sess.TransactionRunning() {
-		rc = nil
+	rc, err := operationReadConcern(sess, coll.readConcern, args.ReadConcern)
+	if err != nil {
+		return 0, err
+	}
+	if err := coll.client.checkReadPolicy(sess, coll.namespace(), rc); err != nil {
+		return 0, err
 	}
 
 	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
 	op := operation.NewAggregate(pipelineArr).Session(sess).ReadConcern(rc).ReadPreference(coll.readPreference).
 		CommandMonitor(coll.client.monitor).ServerSelector(selector).ClusterClock(coll.client.clock).Database(coll.db.name).
-		Collection(coll.name).Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
+		Collection(coll.name).Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
+		Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
 		Timeout(coll.client.timeout).Authenticator(coll.client.authenticator)
 	if args.Collation != nil {
 		op.Collation(bsoncore.Document(toDocument(args.Collation)))
@@ -1171,7 +1304,7 @@ func (coll *Collection) CountDocuments(ctx context.Context, filter any,
 	}
 	op = op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = coll.client.runOperation(ctx, "aggregate", coll.namespace(), op.Execute)
 	if err != nil {
 		return 0, wrapErrors(err)
 	}
@@ -1222,20 +1355,25 @@ func (coll *Collection) EstimatedDocumentCount(
 		return 0, err
 	}
 
-	rc := coll.readConcern
-	if sess.TransactionRunning() {
-		rc = nil
-	}
-
 	args, err := mongoutil.NewOptions[options.EstimatedDocumentCountOptions](opts...)
 	if err != nil {
 		return 0, fmt.Errorf("failed to construct options from builder: %w", err)
 	}
 
+	rc, err := operationReadConcern(sess, coll.readConcern, args.ReadConcern)
+	if err != nil {
+		return 0, err
+	}
+	if err := coll.client.checkReadPolicy(sess, coll.namespace(), rc); err != nil {
+		return 0, err
+	}
+
 	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
 	op := operation.NewCount().Session(sess).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).CommandMonitor(coll.client.monitor).
-		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(coll.readPreference).
+		Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
+		ReadConcern(rc).ReadPreference(coll.readPreference).
 		ServerSelector(selector).Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
 		Timeout(coll.client.timeout).Authenticator(coll.client.authenticator)
 
@@ -1258,7 +1396,7 @@ func (coll *Collection) EstimatedDocumentCount(
 	}
 	op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = coll.client.runOperation(ctx, "count", coll.namespace(), op.Execute)
 	return op.Result().N, wrapErrors(err)
 }
 
@@ -1299,13 +1437,6 @@ func (coll *Collection) Distinct(
 		return &DistinctResult{err: err}
 	}
 
-	rc := coll.readConcern
-	if sess.TransactionRunning() {
-		rc = nil
-	}
-
-	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
-
 	args, err := mongoutil.NewOptions[options.DistinctOptions](opts...)
 	if err != nil {
 		err = fmt.Errorf("failed to construct options from builder: %w", err)
@@ -1313,10 +1444,22 @@ func (coll *Collection) Distinct(
 		return &DistinctResult{err: err}
 	}
 
+	rc, err := operationReadConcern(sess, coll.readConcern, args.ReadConcern)
+	if err != nil {
+		return &DistinctResult{err: err}
+	}
+	if err := coll.client.checkReadPolicy(sess, coll.namespace(), rc); err != nil {
+		return &DistinctResult{err: err}
+	}
+
+	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
+
 	op := operation.NewDistinct(fieldName, f).
 		Session(sess).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).CommandMonitor(coll.client.monitor).
-		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(coll.readPreference).
+		Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
+		ReadConcern(rc).ReadPreference(coll.readPreference).
 		ServerSelector(selector).Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
 		Timeout(coll.client.timeout).Authenticator(coll.client.authenticator)
 
@@ -1351,7 +1494,7 @@ func (coll *Collection) Distinct(
 	}
 	op = op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = coll.client.runOperation(ctx, "distinct", coll.namespace(), op.Execute)
 	if err != nil {
 		return &DistinctResult{err: wrapErrors(err)}
 	}
@@ -1424,9 +1567,12 @@ func (coll *Collection) find(
 		return nil, err
 	}
 
-	rc := coll.readConcern
-	if sess.TransactionRunning() {
-		rc = nil
+	rc, err := operationReadConcern(sess, coll.readConcern, args.ReadConcern)
+	if err != nil {
+		return nil, err
+	}
+	if err := coll.client.checkReadPolicy(sess, coll.namespace(), rc); err != nil {
+		return nil, err
 	}
 
 	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
@@ -1434,7 +1580,9 @@ func (coll *Collection) find(
 		Session(sess).ReadConcern(rc).ReadPreference(coll.readPreference).
 		CommandMonitor(coll.client.monitor).ServerSelector(selector).
 		ClusterClock(coll.client.clock).Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
+		Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
+		Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
 		Timeout(coll.client.timeout).Logger(coll.client.logger).Authenticator(coll.client.authenticator).
 		OmitMaxTimeMS(omitMaxTimeMS)
 
@@ -1442,6 +1590,10 @@ func (coll *Collection) find(
 
 	cursorOpts.MarshalValueEncoderFn = newEncoderFn(coll.bsonOpts, coll.registry)
 
+	timeoutMode, err := applyTimeoutMode(ctx, args.TimeoutMode, coll.client.timeout, &cursorOpts)
+	if err != nil {
+		return nil, err
+	}
 	if args.AllowDiskUse != nil {
 		op.AllowDiskUse(*args.AllowDiskUse)
 	}
@@ -1516,6 +1668,9 @@ func (coll *Collection) find(
 		}
 		op.Min(min)
 	}
+	if args.Exhaust != nil {
+		cursorOpts.Exhaust = *args.Exhaust
+	}
 	if args.NoCursorTimeout != nil {
 		op.NoCursorTimeout(*args.NoCursorTimeout)
 	}
@@ -1559,15 +1714,22 @@ func (coll *Collection) find(
 	}
 	op = op.Retry(retry)
 
-	if err = op.Execute(ctx); err != nil {
-		return nil, wrapErrors(err)
+	if err = coll.client.runOperation(ctx, "find", coll.namespace(), op.Execute); err != nil {
+		return nil, wrapTimeoutModeError(timeoutMode, wrapErrors(err))
 	}
 
 	bc, err := op.Result(cursorOpts)
 	if err != nil {
 		return nil, wrapErrors(err)
 	}
-	return newCursorWithSession(bc, coll.bsonOpts, coll.registry, sess)
+	cursor, err := newCursorWithSession(bc, coll.bsonOpts, coll.registry, sess)
+	if cursor != nil {
+		cursor.timeoutMode = timeoutMode
+		cursor.heartbeat = args.Heartbeat
+		cursor.memory = coll.client.memory
+		cursor.retainBatches = retainBatches(args.CurrentLifetime)
+	}
+	return cursor, err
 }
 
 func newFindArgsFromFindOneArgs(args *options.FindOneOptions) *options.FindOptions {
@@ -1586,6 +1748,7 @@ func newFindArgsFromFindOneArgs(args *options.FindOneOptions) *options.FindOptio
 		v.ShowRecordID = args.ShowRecordID
 		v.Skip = args.Skip
 		v.Sort = args.Sort
+		v.ReadConcern = args.ReadConcern
 		v.Internal = args.Internal
 	}
 	return v
@@ -1621,7 +1784,59 @@ func (coll *Collection) FindOne(ctx context.Context, filter any,
 	}
 }
 
-func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAndModify) *SingleResult {
+func newFindArgsFromExistsArgs(args *options.ExistsOptions) *options.FindOptions {
+	var limit int64 = -1
+	returnKey := true
+	v := &options.FindOptions{Limit: &limit, ReturnKey: &returnKey}
+	if args != nil {
+		v.Collation = args.Collation
+		v.Comment = args.Comment
+		v.Hint = args.Hint
+	}
+	return v
+}
+
+// Exists reports whether any document in the collection matches the filter.
+//
+// The filter parameter must be a document containing query operators. It cannot be nil. An empty filter reports
+// whether the collection contains any documents.
+//
+// Exists executes a find command with a limit of one that returns only index keys, so the server stops at the first
+// match and, if the filter can be answered from an index, never reads the matching document. Use
+// options.ExistsOptionsBuilder.SetHint to select the index when the query planner may not choose it.
+//
+// The opts parameter can be used to specify options for this operation (see the options.ExistsOptions documentation).
+//
+// For more information about the command, see https://www.mongodb.com/docs/manual/reference/command/find/.
+func (coll *Collection) Exists(ctx context.Context, filter any,
+	opts ...options.Lister[options.ExistsOptions]) (bool, error) {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	args, err := mongoutil.NewOptions(opts...)
+	if err != nil {
+		return false, err
+	}
+	cursor, err := coll.find(ctx, filter, false, newFindArgsFromExistsArgs(args))
+	if err != nil {
+		return false, err
+	}
+	defer cursor.Close(ctx)
+
+	if cursor.Next(ctx) {
+		return true, nil
+	}
+	return false, cursor.Err()
+}
+
+func (coll *Collection) findAndModify(
+	ctx context.Context,
+	filter bsoncore.Document,
+	op *operation.FindAndModify,
+	wcOverride *writeconcern.WriteConcern,
+) *SingleResult {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -1638,9 +1853,15 @@ func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAnd
 		return &SingleResult{err: err}
 	}
 
-	wc := coll.writeConcern
-	if sess.TransactionRunning() {
-		wc = nil
+	wc, err := operationWriteConcern(sess, coll.writeConcern, wcOverride)
+	if err != nil {
+		return &SingleResult{err: err}
+	}
+	if err := coll.client.checkReadOnly("findAndModify", coll.namespace()); err != nil {
+		return &SingleResult{err: err}
+	}
+	if err := coll.client.checkWritePolicy(sess, coll.namespace(), wc); err != nil {
+		return &SingleResult{err: err}
 	}
 	if !wc.Acknowledged() {
 		sess = nil
@@ -1660,20 +1881,37 @@ func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAnd
 		ClusterClock(coll.client.clock).
 		Database(coll.db.name).
 		Collection(coll.name).
-		Deployment(coll.client.deployment).
+		Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
 		Retry(retry).
 		Crypt(coll.client.cryptFLE)
 
-	rr, err := processWriteError(op.Execute(ctx))
+	rr, err := processWriteError(coll.client.runOperation(ctx, "findAndModify", coll.namespace(), op.Execute))
+	opRes := op.Result()
+	rec := audit.Record{
+		Namespace:  coll.namespace(),
+		Operation:  "findAndModify",
+		FilterHash: filterHash(filter),
+	}
+	if id := documentID(opRes.Value); id != nil {
+		rec.DocumentIDs = []any{id}
+	} else if opRes.LastErrorObject.Upserted != nil {
+		rec.DocumentIDs = []any{opRes.LastErrorObject.Upserted}
+	}
+	if len(opRes.Value) > 0 || opRes.LastErrorObject.Upserted != nil {
+		rec.Affected = 1
+	}
+	coll.client.emitAudit(ctx, rec, err)
 	if err != nil {
 		return &SingleResult{err: err}
 	}
 
 	return &SingleResult{
 		ctx:          ctx,
-		rdr:          bson.Raw(op.Result().Value),
+		rdr:          bson.Raw(opRes.Value),
 		bsonOpts:     coll.bsonOpts,
 		reg:          coll.registry,
+		upserted:     opRes.LastErrorObject.Upserted != nil,
 		Acknowledged: rr.isAcknowledged(),
 	}
 }
@@ -1755,7 +1993,7 @@ func (coll *Collection) FindOneAndDelete(
 		}
 	}
 
-	return coll.findAndModify(ctx, op)
+	return coll.findAndModify(ctx, f, op, args.WriteConcern)
 }
 
 // FindOneAndReplace executes a findAndModify command to replace at most one document in the collection
@@ -1857,7 +2095,7 @@ func (coll *Collection) FindOneAndReplace(
 		}
 	}
 
-	return coll.findAndModify(ctx, op)
+	return coll.findAndModify(ctx, f, op, args.WriteConcern)
 }
 
 // FindOneAndUpdate executes a findAndModify command to update at most one document in the collection and returns the
@@ -1971,7 +2209,7 @@ func (coll *Collection) FindOneAndUpdate(
 		}
 	}
 
-	return coll.findAndModify(ctx, op)
+	return coll.findAndModify(ctx, f, op, args.WriteConcern)
 }
 
 // Watch returns a change stream for all changes on the corresponding collection. See
@@ -2098,6 +2336,12 @@ func (coll *Collection) drop(ctx context.Context) error {
 	if sess.TransactionRunning() {
 		wc = nil
 	}
+	if err := coll.client.checkReadOnly("drop", coll.namespace()); err != nil {
+		return err
+	}
+	if err := coll.client.checkWritePolicy(sess, coll.namespace(), wc); err != nil {
+		return err
+	}
 	if !wc.Acknowledged() {
 		sess = nil
 	}
@@ -2108,17 +2352,46 @@ func (coll *Collection) drop(ctx context.Context) error {
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
 		ServerSelector(selector).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).
+		Deployment(coll.client.deploymentFor(ctx)).RetryPolicy(coll.client.retryPolicy).
+		CommandInterceptors(coll.client.interceptors).MemoryAccountant(coll.client.memoryAccountant()).
+		Crypt(coll.client.cryptFLE).
 		ServerAPI(coll.client.serverAPI).Timeout(coll.client.timeout).
 		Authenticator(coll.client.authenticator)
-	err = op.Execute(ctx)
+	err = coll.client.runOperation(ctx, "drop", coll.namespace(), op.Execute)
 
 	// ignore namespace not found errors
 	var driverErr driver.Error
-	if !errors.As(err, &driverErr) || !driverErr.NamespaceNotFound() {
-		return wrapErrors(err)
+	if errors.As(err, &driverErr) && driverErr.NamespaceNotFound() {
+		err = nil
+	}
+	coll.client.emitAudit(ctx, audit.Record{Namespace: coll.namespace(), Operation: "drop"}, err)
+	return wrapErrors(err)
+}
+
+// Rename renames the collection to newName in the same database by running the renameCollection command against
+// the admin database. If dropTarget is true, an existing collection named newName is dropped first; otherwise, the
+// rename fails if it exists.
+//
+// The Collection keeps referring to the old name after the rename. Use Database.Collection to get a Collection for
+// newName.
+func (coll *Collection) Rename(ctx context.Context, newName string, dropTarget bool) error {
+	if newName == "" {
+		return errors.New("new collection name is required")
+	}
+
+	to := coll.db.name + "." + newName
+	for _, ns := range []string{coll.namespace(), to} {
+		if err := coll.client.checkNamespacePolicy(ns); err != nil {
+			return err
+		}
 	}
-	return nil
+
+	cmd := bson.D{
+		{"renameCollection", coll.namespace()},
+		{"to", to},
+		{"dropTarget", dropTarget},
+	}
+	return coll.client.Database("admin").RunCommand(ctx, cmd).Err()
 }
 
 func toDocument(co *options.Collation) bson.Raw {
@@ -2238,6 +2511,46 @@ func makeOutputAggregateSelector(
 	return makePinnedSelector(sess, selector)
 }
 
+// operationWriteConcern returns the write concern of an operation run with
+// sess: override if it is set, or def otherwise. Operations in a transaction
+// use the write concern of the transaction, so nil is returned for them and
+// setting override is an error.
+func operationWriteConcern(
+	sess *session.Client,
+	def, override *writeconcern.WriteConcern,
+) (*writeconcern.WriteConcern, error) {
+	if sess.TransactionRunning() {
+		if override != nil {
+			return nil, errors.New("cannot set write concern after starting a transaction")
+		}
+		return nil, nil
+	}
+	if override != nil {
+		return override, nil
+	}
+	return def, nil
+}
+
+// operationReadConcern returns the read concern of an operation run with sess:
+// override if it is set, or def otherwise. Operations in a transaction use the
+// read concern of the transaction, so nil is returned for them and setting
+// override is an error.
+func operationReadConcern(
+	sess *session.Client,
+	def, override *readconcern.ReadConcern,
+) (*readconcern.ReadConcern, error) {
+	if sess.TransactionRunning() {
+		if override != nil {
+			return nil, errors.New("cannot set read concern after starting a transaction")
+		}
+		return nil, nil
+	}
+	if override != nil {
+		return override, nil
+	}
+	return def, nil
+}
+
 // isUnorderedMap returns true if val is a map with more than 1 element. It is typically used to
 // check for unordered Go values that are used in nested command documents where different field
 // orders mean different things. Examples are the "sort" and "hint" fields.
@@ -0,0 +1,203 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// These constants are the collection types reported by listCollections.
+const (
+	CollectionTypeCollection = "collection"
+	CollectionTypeView       = "view"
+	CollectionTypeTimeSeries = "timeseries"
+)
+
+// CollectionFilter is a filter for Database.ListCollections and
+// Database.ListCollectionSpecifications that selects collections by name and
+// type. It can be passed as the filter parameter:
+//
+//	specs, err := db.ListCollectionSpecifications(ctx, mongo.CollectionFilter{
+//		NamePrefix: "events_",
+//		Types:      []string{mongo.CollectionTypeTimeSeries},
+//	})
+//
+// A collection must match every field that is set. The filter only uses the
+// name and type of collections, so it can be combined with
+// options.ListCollections().SetNameOnly(true).
+type CollectionFilter struct {
+	// Names selects collections with one of the given names.
+	Names []string
+
+	// NamePrefix selects collections whose name starts with the given
+	// prefix.
+	NamePrefix string
+
+	// Types selects collections of one of the given types, such as
+	// CollectionTypeCollection or CollectionTypeView.
+	Types []string
+}
+
+// MarshalBSON implements the bson.Marshaler interface.
+func (f CollectionFilter) MarshalBSON() ([]byte, error) {
+	filter := bson.D{}
+
+	var name bson.D
+	switch len(f.Names) {
+	case 0:
+	case 1:
+		name = append(name, bson.E{"$eq", f.Names[0]})
+	default:
+		name = append(name, bson.E{"$in", f.Names})
+	}
+	if f.NamePrefix != "" {
+		name = append(name, bson.E{"$regex", "^" + regexp.QuoteMeta(f.NamePrefix)})
+	}
+	if name != nil {
+		filter = append(filter, bson.E{"name", name})
+	}
+
+	switch len(f.Types) {
+	case 0:
+	case 1:
+		filter = append(filter, bson.E{"type", f.Types[0]})
+	default:
+		filter = append(filter, bson.E{"type", bson.D{{"$in", f.Types}}})
+	}
+
+	return bson.Marshal(filter)
+}
+
+type collectionListSpecificationResponse struct {
+	Name string `bson:"name"`
+	Type string `bson:"type"`
+	Info *struct {
+		ReadOnly bool         `bson:"readOnly"`
+		UUID     *bson.Binary `bson:"uuid"`
+	} `bson:"info"`
+	Options bson.Raw                       `bson:"options"`
+	IDIndex indexListSpecificationResponse `bson:"idIndex"`
+}
+
+type collectionOptionsResponse struct {
+	Capped           bool     `bson:"capped"`
+	Size             *int64   `bson:"size"`
+	Max              *int64   `bson:"max"`
+	Validator        bson.Raw `bson:"validator"`
+	ValidationLevel  string   `bson:"validationLevel"`
+	ValidationAction string   `bson:"validationAction"`
+	TimeSeries       *struct {
+		TimeField             string `bson:"timeField"`
+		MetaField             string `bson:"metaField"`
+		Granularity           string `bson:"granularity"`
+		BucketMaxSpanSeconds  *int64 `bson:"bucketMaxSpanSeconds"`
+		BucketRoundingSeconds *int64 `bson:"bucketRoundingSeconds"`
+	} `bson:"timeseries"`
+	ClusteredIndex               bson.RawValue `bson:"clusteredIndex"`
+	ExpireAfterSeconds           *int64        `bson:"expireAfterSeconds"`
+	ViewOn                       string        `bson:"viewOn"`
+	Pipeline                     bson.Raw      `bson:"pipeline"`
+	ChangeStreamPreAndPostImages *struct {
+		Enabled bool `bson:"enabled"`
+	} `bson:"changeStreamPreAndPostImages"`
+}
+
+// specification converts a listCollections result document for a collection
+// in the database dbName to a CollectionSpecification. Options that do not have
+// the expected type leave their fields unset, but are still available in the
+// raw Options document.
+func (resp collectionListSpecificationResponse) specification(dbName string) CollectionSpecification {
+	spec := CollectionSpecification{
+		Name:    resp.Name,
+		Type:    resp.Type,
+		Options: resp.Options,
+		IDIndex: IndexSpecification(resp.IDIndex),
+	}
+
+	if resp.Info != nil {
+		spec.ReadOnly = resp.Info.ReadOnly
+		spec.UUID = resp.Info.UUID
+	}
+
+	// Pre-4.4 servers report a namespace in their responses, so we only set Namespace manually if it was not in
+	// the response.
+	if spec.IDIndex.Namespace == "" {
+		spec.IDIndex.Namespace = dbName + "." + spec.Name
+	}
+
+	elems, err := resp.Options.Elements()
+	if err != nil {
+		return spec
+	}
+	// Decode each option separately so that an option with an unexpected type
+	// is skipped without affecting the others.
+	var opts collectionOptionsResponse
+	for _, elem := range elems {
+		next := opts
+		if err := bson.Unmarshal(bsoncore.BuildDocument(nil, elem), &next); err == nil {
+			opts = next
+		}
+	}
+
+	spec.Capped = opts.Capped
+	spec.SizeInBytes = opts.Size
+	spec.MaxDocuments = opts.Max
+	spec.Validator = opts.Validator
+	spec.ValidationLevel = opts.ValidationLevel
+	spec.ValidationAction = opts.ValidationAction
+	spec.ExpireAfterSeconds = opts.ExpireAfterSeconds
+	spec.ViewOn = opts.ViewOn
+	spec.Pipeline = opts.Pipeline
+	if opts.ChangeStreamPreAndPostImages != nil {
+		spec.ChangeStreamPreAndPostImages = opts.ChangeStreamPreAndPostImages.Enabled
+	}
+
+	if ts := opts.TimeSeries; ts != nil {
+		spec.TimeSeries = &TimeSeriesSpecification{
+			TimeField:   ts.TimeField,
+			MetaField:   ts.MetaField,
+			Granularity: ts.Granularity,
+		}
+		if ts.BucketMaxSpanSeconds != nil {
+			d := time.Duration(*ts.BucketMaxSpanSeconds) * time.Second
+			spec.TimeSeries.BucketMaxSpan = &d
+		}
+		if ts.BucketRoundingSeconds != nil {
+			d := time.Duration(*ts.BucketRoundingSeconds) * time.Second
+			spec.TimeSeries.BucketRounding = &d
+		}
+	}
+
+	switch ci := opts.ClusteredIndex; ci.Type {
+	case bson.TypeBoolean:
+		if ci.Boolean() {
+			spec.ClusteredIndex = &ClusteredIndexSpecification{}
+		}
+	case bson.TypeEmbeddedDocument:
+		var index struct {
+			Name         string   `bson:"name"`
+			KeysDocument bson.Raw `bson:"key"`
+			Unique       bool     `bson:"unique"`
+			Version      int32    `bson:"v"`
+		}
+		if err := ci.Unmarshal(&index); err != nil {
+			break
+		}
+		spec.ClusteredIndex = &ClusteredIndexSpecification{
+			Name:         index.Name,
+			KeysDocument: index.KeysDocument,
+			Unique:       index.Unique,
+			Version:      index.Version,
+		}
+	}
+
+	return spec
+}
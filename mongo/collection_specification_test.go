@@ -0,0 +1,159 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestCollectionFilter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		filter CollectionFilter
+		want   bson.D
+	}{
+		{"empty", CollectionFilter{}, bson.D{}},
+		{"one name", CollectionFilter{Names: []string{"a"}}, bson.D{{"name", bson.D{{"$eq", "a"}}}}},
+		{
+			"names and prefix",
+			CollectionFilter{Names: []string{"a.b", "c"}, NamePrefix: "a."},
+			bson.D{{"name", bson.D{{"$in", bson.A{"a.b", "c"}}, {"$regex", `^a\.`}}}},
+		},
+		{"one type", CollectionFilter{Types: []string{CollectionTypeView}}, bson.D{{"type", "view"}}},
+		{
+			"types",
+			CollectionFilter{Types: []string{CollectionTypeCollection, CollectionTypeTimeSeries}},
+			bson.D{{"type", bson.D{{"$in", bson.A{"collection", "timeseries"}}}}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := bson.Marshal(tc.filter)
+			require.NoError(t, err, "Marshal error")
+			want, err := bson.Marshal(tc.want)
+			require.NoError(t, err, "Marshal error")
+			assert.Equal(t, bson.Raw(want).String(), bson.Raw(got).String())
+		})
+	}
+}
+
+func TestCollectionSpecification(t *testing.T) {
+	decode := func(t *testing.T, doc bson.D) CollectionSpecification {
+		t.Helper()
+
+		raw, err := bson.Marshal(doc)
+		require.NoError(t, err, "Marshal error")
+		var resp collectionListSpecificationResponse
+		require.NoError(t, bson.Unmarshal(raw, &resp), "Unmarshal error")
+		return resp.specification("db")
+	}
+
+	t.Run("capped with validator", func(t *testing.T) {
+		spec := decode(t, bson.D{
+			{"name", "coll"},
+			{"type", "collection"},
+			{"options", bson.D{
+				{"capped", true},
+				{"size", int32(4096)},
+				{"max", int32(10)},
+				{"validator", bson.D{{"x", bson.D{{"$gt", 0}}}}},
+				{"validationLevel", "moderate"},
+				{"validationAction", "warn"},
+				{"changeStreamPreAndPostImages", bson.D{{"enabled", true}}},
+			}},
+			{"idIndex", bson.D{{"v", int32(2)}, {"key", bson.D{{"_id", 1}}}, {"name", "_id_"}}},
+		})
+
+		assert.True(t, spec.Capped, "expected capped")
+		assert.Equal(t, int64(4096), *spec.SizeInBytes)
+		assert.Equal(t, int64(10), *spec.MaxDocuments)
+		assert.Equal(t, `{"x": {"$gt": {"$numberInt":"0"}}}`, spec.Validator.String())
+		assert.Equal(t, "moderate", spec.ValidationLevel)
+		assert.Equal(t, "warn", spec.ValidationAction)
+		assert.True(t, spec.ChangeStreamPreAndPostImages, "expected pre- and post-images")
+		assert.Equal(t, "db.coll", spec.IDIndex.Namespace)
+		assert.Nil(t, spec.TimeSeries)
+		assert.Nil(t, spec.ClusteredIndex)
+	})
+	t.Run("time series", func(t *testing.T) {
+		spec := decode(t, bson.D{
+			{"name", "ts"},
+			{"type", "timeseries"},
+			{"options", bson.D{
+				{"timeseries", bson.D{
+					{"timeField", "t"},
+					{"metaField", "m"},
+					{"bucketMaxSpanSeconds", int32(3600)},
+					{"bucketRoundingSeconds", int32(3600)},
+				}},
+				{"expireAfterSeconds", int64(86400)},
+				{"clusteredIndex", true},
+			}},
+		})
+
+		require.NotNil(t, spec.TimeSeries, "expected time-series options")
+		assert.Equal(t, "t", spec.TimeSeries.TimeField)
+		assert.Equal(t, "m", spec.TimeSeries.MetaField)
+		assert.Equal(t, time.Hour, *spec.TimeSeries.BucketMaxSpan)
+		assert.Equal(t, time.Hour, *spec.TimeSeries.BucketRounding)
+		assert.Equal(t, int64(86400), *spec.ExpireAfterSeconds)
+		assert.Equal(t, &ClusteredIndexSpecification{}, spec.ClusteredIndex)
+	})
+	t.Run("clustered", func(t *testing.T) {
+		spec := decode(t, bson.D{
+			{"name", "c"},
+			{"type", "collection"},
+			{"options", bson.D{
+				{"clusteredIndex", bson.D{{"v", int32(2)}, {"key", bson.D{{"_id", 1}}}, {"name", "_id_"}, {"unique", true}}},
+			}},
+		})
+
+		require.NotNil(t, spec.ClusteredIndex, "expected clustered index")
+		assert.Equal(t, "_id_", spec.ClusteredIndex.Name)
+		assert.Equal(t, `{"_id": {"$numberInt":"1"}}`, spec.ClusteredIndex.KeysDocument.String())
+		assert.True(t, spec.ClusteredIndex.Unique, "expected unique")
+		assert.Equal(t, int32(2), spec.ClusteredIndex.Version)
+	})
+	t.Run("view", func(t *testing.T) {
+		spec := decode(t, bson.D{
+			{"name", "v"},
+			{"type", "view"},
+			{"options", bson.D{
+				{"viewOn", "coll"},
+				{"pipeline", bson.A{bson.D{{"$match", bson.D{}}}}},
+			}},
+		})
+
+		assert.Equal(t, "coll", spec.ViewOn)
+		assert.Equal(t, `{"0": {"$match": {}}}`, spec.Pipeline.String())
+	})
+	t.Run("unexpected option types", func(t *testing.T) {
+		spec := decode(t, bson.D{
+			{"name", "coll"},
+			{"type", "collection"},
+			{"options", bson.D{
+				{"capped", true},
+				{"size", "large"},
+				{"timeseries", bson.D{{"timeField", int32(1)}}},
+				{"clusteredIndex", bson.D{{"name", bson.A{}}}},
+				{"validationLevel", "strict"},
+			}},
+		})
+
+		assert.True(t, spec.Capped, "expected capped")
+		assert.Nil(t, spec.SizeInBytes)
+		assert.Nil(t, spec.TimeSeries)
+		assert.Nil(t, spec.ClusteredIndex)
+		assert.Equal(t, "strict", spec.ValidationLevel)
+		assert.Equal(t, "large", spec.Options.Lookup("size").StringValue())
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/session"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
 )
 
@@ -116,6 +117,9 @@ func TestCollection(t *testing.T) {
 		_, err = coll.Aggregate(bgCtx, Pipeline{})
 		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
 
+		err = coll.AggregateEach(bgCtx, Pipeline{}, 0, func(bson.Raw) error { return nil })
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+
 		_, err = coll.EstimatedDocumentCount(bgCtx)
 		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
 
@@ -131,6 +135,9 @@ func TestCollection(t *testing.T) {
 		err = coll.FindOne(bgCtx, doc).Err()
 		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
 
+		_, err = coll.Exists(bgCtx, doc)
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+
 		err = coll.FindOneAndDelete(bgCtx, doc).Err()
 		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
 
@@ -140,6 +147,10 @@ func TestCollection(t *testing.T) {
 		err = coll.FindOneAndUpdate(bgCtx, doc, update).Err()
 		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
 	})
+	t.Run("rename without name", func(t *testing.T) {
+		err := setupColl("foo").Rename(bgCtx, "", false)
+		assert.EqualError(t, err, "new collection name is required")
+	})
 	t.Run("database accessor", func(t *testing.T) {
 		coll := setupColl("bar")
 		dbName := coll.Database().Name()
@@ -197,6 +208,12 @@ func TestCollection(t *testing.T) {
 		err = coll.FindOne(bgCtx, nil).Err()
 		assert.True(t, errors.Is(err, ErrNilDocument), "expected error %v, got %v", ErrNilDocument, err)
 
+		_, err = coll.Exists(bgCtx, nil)
+		assert.True(t, errors.Is(err, ErrNilDocument), "expected error %v, got %v", ErrNilDocument, err)
+
+		_, err = coll.Tail(bgCtx, nil)
+		assert.True(t, errors.Is(err, ErrNilDocument), "expected error %v, got %v", ErrNilDocument, err)
+
 		err = coll.FindOneAndDelete(bgCtx, nil).Err()
 		assert.True(t, errors.Is(err, ErrNilDocument), "expected error %v, got %v", ErrNilDocument, err)
 
@@ -295,6 +312,16 @@ func TestNewFindArgsFromFindOneArgs(t *testing.T) {
 				Limit: ptrutil.Ptr(int64(-1)),
 			},
 		},
+		{
+			name: "read concern",
+			args: &options.FindOneOptions{
+				ReadConcern: readconcern.Majority(),
+			},
+			want: &options.FindOptions{
+				Limit:       ptrutil.Ptr(int64(-1)),
+				ReadConcern: readconcern.Majority(),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -307,3 +334,98 @@ func TestNewFindArgsFromFindOneArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFindArgsFromExistsArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args *options.ExistsOptions
+		want *options.FindOptions
+	}{
+		{
+			name: "nil",
+			args: nil,
+			want: &options.FindOptions{
+				Limit:     ptrutil.Ptr(int64(-1)),
+				ReturnKey: ptrutil.Ptr(true),
+			},
+		},
+		{
+			name: "non empty",
+			args: &options.ExistsOptions{
+				Comment: "comment",
+				Hint:    "x_1",
+			},
+			want: &options.FindOptions{
+				Comment:   "comment",
+				Hint:      "x_1",
+				Limit:     ptrutil.Ptr(int64(-1)),
+				ReturnKey: ptrutil.Ptr(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test // Capture the range variable
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, newFindArgsFromExistsArgs(test.args))
+		})
+	}
+}
+
+func TestOperationConcerns(t *testing.T) {
+	t.Parallel()
+
+	collWC := writeconcern.Majority()
+	opWC := writeconcern.W1()
+	collRC := readconcern.Majority()
+	opRC := readconcern.Local()
+	txn := &session.Client{TransactionState: session.InProgress}
+
+	t.Run("collection default", func(t *testing.T) {
+		t.Parallel()
+
+		wc, err := operationWriteConcern(nil, collWC, nil)
+		require.NoError(t, err)
+		assert.Equal(t, collWC, wc)
+
+		rc, err := operationReadConcern(nil, collRC, nil)
+		require.NoError(t, err)
+		assert.Equal(t, collRC, rc)
+	})
+	t.Run("operation override", func(t *testing.T) {
+		t.Parallel()
+
+		wc, err := operationWriteConcern(nil, collWC, opWC)
+		require.NoError(t, err)
+		assert.Equal(t, opWC, wc)
+
+		rc, err := operationReadConcern(nil, collRC, opRC)
+		require.NoError(t, err)
+		assert.Equal(t, opRC, rc)
+	})
+	t.Run("transaction", func(t *testing.T) {
+		t.Parallel()
+
+		wc, err := operationWriteConcern(txn, collWC, nil)
+		require.NoError(t, err)
+		assert.Nil(t, wc)
+
+		rc, err := operationReadConcern(txn, collRC, nil)
+		require.NoError(t, err)
+		assert.Nil(t, rc)
+	})
+	t.Run("override in transaction", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := operationWriteConcern(txn, collWC, opWC)
+		assert.EqualError(t, err, "cannot set write concern after starting a transaction")
+
+		_, err = operationReadConcern(txn, collRC, opRC)
+		assert.EqualError(t, err, "cannot set read concern after starting a transaction")
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+)
+
+// newCommandInterceptors converts the command interceptors of a Client to the
+// interceptors applied by the driver, skipping nil interceptors.
+func newCommandInterceptors(opts []*options.CommandInterceptor) []driver.CommandInterceptor {
+	var interceptors []driver.CommandInterceptor
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+
+		var interceptor driver.CommandInterceptor
+		if fn := opt.Command; fn != nil {
+			interceptor.Command = func(ctx context.Context, database string, cmd bsoncore.Document) (bsoncore.Document, error) {
+				intercepted := &options.InterceptedCommand{
+					Name:     commandName(cmd),
+					Database: database,
+					Command:  bson.Raw(cmd),
+				}
+				if err := fn(ctx, intercepted); err != nil {
+					return nil, err
+				}
+				return bsoncore.Document(intercepted.Command), nil
+			}
+		}
+		if fn := opt.Reply; fn != nil {
+			interceptor.Reply = func(ctx context.Context, name, database string, reply bsoncore.Document, err error) error {
+				return fn(ctx, options.InterceptedReply{
+					Name:     name,
+					Database: database,
+					Reply:    bson.Raw(reply),
+					Err:      wrapErrors(err),
+				})
+			}
+		}
+		interceptors = append(interceptors, interceptor)
+	}
+	return interceptors
+}
@@ -0,0 +1,78 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+)
+
+func TestNewCommandInterceptors(t *testing.T) {
+	assert.Nil(t, newCommandInterceptors(nil))
+	assert.Nil(t, newCommandInterceptors([]*options.CommandInterceptor{nil}))
+
+	var gotCmd *options.InterceptedCommand
+	var gotReply options.InterceptedReply
+	interceptors := newCommandInterceptors([]*options.CommandInterceptor{
+		nil,
+		{
+			Command: func(_ context.Context, cmd *options.InterceptedCommand) error {
+				gotCmd = cmd
+				tagged, err := bson.Marshal(bson.D{{"find", "coll"}, {"comment", "tenant-1"}})
+				if err != nil {
+					return err
+				}
+				cmd.Command = tagged
+				return nil
+			},
+			Reply: func(_ context.Context, reply options.InterceptedReply) error {
+				gotReply = reply
+				return nil
+			},
+		},
+	})
+	require.Len(t, interceptors, 1)
+
+	cmd := bsoncore.NewDocumentBuilder().AppendString("find", "coll").Build()
+	res, err := interceptors[0].Command(context.Background(), "db", cmd)
+	require.NoError(t, err)
+	assert.Equal(t, "find", gotCmd.Name)
+	assert.Equal(t, "db", gotCmd.Database)
+	assert.Equal(t, "tenant-1", res.Lookup("comment").StringValue())
+
+	reply := bsoncore.NewDocumentBuilder().AppendInt32("ok", 1).Build()
+	err = interceptors[0].Reply(context.Background(), "find", "db", reply, driver.Error{Code: 13, Message: "unauthorized"})
+	require.NoError(t, err)
+	assert.Equal(t, "find", gotReply.Name)
+	assert.Equal(t, "db", gotReply.Database)
+	assert.Equal(t, bson.Raw(reply), gotReply.Reply)
+	var cmdErr CommandError
+	require.True(t, errors.As(gotReply.Err, &cmdErr), "expected CommandError, got %v", gotReply.Err)
+	assert.Equal(t, int32(13), cmdErr.Code)
+
+	t.Run("errors are returned", func(t *testing.T) {
+		denied := errors.New("denied")
+		interceptors := newCommandInterceptors([]*options.CommandInterceptor{{
+			Command: func(context.Context, *options.InterceptedCommand) error { return denied },
+			Reply:   func(context.Context, options.InterceptedReply) error { return denied },
+		}})
+		require.Len(t, interceptors, 1)
+
+		_, err := interceptors[0].Command(context.Background(), "db", cmd)
+		assert.ErrorIs(t, err, denied)
+		err = interceptors[0].Reply(context.Background(), "find", "db", reply, nil)
+		assert.ErrorIs(t, err, denied)
+	})
+}
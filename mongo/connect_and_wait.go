@@ -0,0 +1,103 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
+)
+
+// ErrServersUnreachable is returned by Client.ConnectAndWait, wrapped in an
+// error that describes the topology, when every known server of the deployment
+// failed its last heartbeat.
+var ErrServersUnreachable = errors.New("all known servers are unreachable")
+
+// ConnectAndWait blocks until the Client has discovered a server that matches
+// its read preference and has checked out a connection to it, which completes
+// the connection handshake and authentication. It is meant for applications
+// that must fail at startup if the deployment is misconfigured or unreachable,
+// instead of when they run their first operation.
+//
+// Unlike Ping, which keeps selecting a server until the server selection
+// timeout expires, ConnectAndWait returns an error wrapping
+// ErrServersUnreachable as soon as every known server of the deployment has
+// failed its last heartbeat, and returns connection and authentication errors
+// as soon as they occur. If servers are reachable but none matches the read
+// preference, for example during an election, it waits until one does or
+// until the server selection timeout or ctx expires. ConnectAndWait does not
+// send any command.
+func (c *Client) ConnectAndWait(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type checkOutResult struct {
+		deployment driver.ConnectionDeployment
+		err        error
+	}
+	done := make(chan checkOutResult, 1)
+	go func() {
+		deployment, err := c.checkOutConnection(ctx, c.readPreference)
+		done <- checkOutResult{deployment: deployment, err: err}
+	}()
+
+	var updates <-chan description.Topology
+	if subscriber, ok := c.deployment.(driver.Subscriber); ok {
+		// If the Client is disconnected, Subscribe fails and the checkout
+		// returns ErrClientDisconnected.
+		if sub, err := subscriber.Subscribe(); err == nil {
+			defer func() { _ = subscriber.Unsubscribe(sub) }()
+			updates = sub.Updates
+		}
+	}
+
+	for {
+		select {
+		case res := <-done:
+			if res.err != nil {
+				return res.err
+			}
+			_ = res.deployment.Close(ctx)
+			return nil
+		case desc, ok := <-updates:
+			if !ok {
+				updates = nil
+				continue
+			}
+			if !allServersUnreachable(desc) {
+				continue
+			}
+
+			cancel()
+			if res := <-done; res.err == nil {
+				_ = res.deployment.Close(ctx)
+				return nil
+			}
+			return topology.ServerSelectionError{Desc: desc, Wrapped: ErrServersUnreachable}
+		}
+	}
+}
+
+// allServersUnreachable returns true if desc has servers and every one of
+// them failed its last heartbeat.
+func allServersUnreachable(desc description.Topology) bool {
+	if len(desc.Servers) == 0 {
+		return false
+	}
+	for _, srv := range desc.Servers {
+		if srv.LastError == nil {
+			return false
+		}
+	}
+	return true
+}
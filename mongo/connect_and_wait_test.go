@@ -0,0 +1,78 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/xoptions"
+)
+
+func TestAllServersUnreachable(t *testing.T) {
+	failed := description.Server{Addr: address.Address("a:27017"), LastError: errors.New("connection refused")}
+	unknown := description.Server{Addr: address.Address("b:27017")}
+
+	testCases := []struct {
+		name    string
+		servers []description.Server
+		want    bool
+	}{
+		{"no servers", nil, false},
+		{"all failed", []description.Server{failed, failed}, true},
+		{"some not checked", []description.Server{failed, unknown}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := allServersUnreachable(description.Topology{Servers: tc.servers})
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestClient_ConnectAndWait(t *testing.T) {
+	t.Run("unreachable", func(t *testing.T) {
+		// Nothing listens on port 1, so the heartbeats fail immediately and
+		// ConnectAndWait must not wait for the server selection timeout.
+		client, err := Connect(options.Client().
+			ApplyURI("mongodb://127.0.0.1:1").
+			SetServerSelectionTimeout(time.Minute))
+		require.NoError(t, err)
+		defer func() { _ = client.Disconnect(context.Background()) }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		err = client.ConnectAndWait(ctx)
+		assert.ErrorIs(t, err, ErrServersUnreachable)
+		assert.NoError(t, ctx.Err(), "expected ConnectAndWait to fail before the context expired")
+	})
+	t.Run("mock deployment", func(t *testing.T) {
+		opts := options.Client()
+		err := xoptions.SetInternalClientOptions(opts, "deployment", drivertest.NewMockDeployment())
+		require.NoError(t, err)
+		client, err := Connect(opts)
+		require.NoError(t, err)
+
+		assert.NoError(t, client.ConnectAndWait(context.Background()))
+	})
+	t.Run("disconnected", func(t *testing.T) {
+		client, err := Connect(options.Client().ApplyURI("mongodb://127.0.0.1:1"))
+		require.NoError(t, err)
+		require.NoError(t, client.Disconnect(context.Background()))
+
+		err = client.ConnectAndWait(context.Background())
+		assert.ErrorIs(t, err, ErrClientDisconnected)
+	})
+}
@@ -27,8 +27,10 @@ import (
 // method or accessed as raw BSON via the Current field. This type is not goroutine safe and must not be used
 // concurrently by multiple goroutines.
 type Cursor struct {
-	// Current contains the BSON bytes of the current change document. This property is only valid until the next call
-	// to Next or TryNext. If continued access is required, a copy must be made.
+	// Current contains the BSON bytes of the current document. By default, it borrows the buffer holding the current
+	// batch and is only valid until the next call to Next, TryNext, NextBatch, or Close. If continued access is
+	// required, a copy must be made, such as with CurrentCopy. If the cursor was created with the
+	// options.CurrentUntilClose lifetime, Current, and any slice of it, remains valid indefinitely.
 	Current bson.Raw
 
 	bc            batchCursor
@@ -38,6 +40,33 @@ type Cursor struct {
 	registry      *bson.Registry
 	clientSession *session.Client
 
+	// createdAt is the time at which the cursor was created, and
+	// lastServerContact is the time at which it last received a batch from the
+	// server.
+	createdAt         time.Time
+	lastServerContact time.Time
+
+	// timeoutMode is the TimeoutMode the cursor was created with, or an empty
+	// string if none was set.
+	timeoutMode options.TimeoutMode
+
+	// heartbeat is called when a getMore returns an empty batch and the cursor
+	// is still alive.
+	heartbeat func(options.AwaitHeartbeat)
+
+	// retainBatches is true if each batch is copied so that Current remains
+	// valid after the cursor advances. See options.CurrentUntilClose.
+	retainBatches bool
+
+	// interner interns the strings decoded from the documents of the cursor
+	// if the MaxInternedStrings BSON option is set.
+	interner *bson.StringInterner
+
+	// memory accounts for the batch held by the cursor if the Client accounts
+	// for its memory, and batchMemory is the size of the accounted batch.
+	memory      *memoryAccountant
+	batchMemory int
+
 	err error
 }
 
@@ -66,7 +95,9 @@ func newCursorWithSession(
 		bsonOpts:      bsonOpts,
 		registry:      registry,
 		clientSession: clientSession,
+		createdAt:     time.Now(),
 	}
+	c.lastServerContact = c.createdAt
 	if bc.ID() == 0 {
 		c.closeImplicitSession()
 	}
@@ -219,18 +250,24 @@ func (c *Cursor) next(ctx context.Context, nonBlocking bool) bool {
 	// the context times out.
 	for {
 		// If we don't have a next batch
-		if !c.bc.Next(ctx) {
+		more := c.bc.Next(ctx)
+		c.lastServerContact = time.Now()
+		if !more {
 			// Do we have an error? If so we return false.
-			c.err = wrapErrors(c.bc.Err())
+			c.err = wrapTimeoutModeError(c.timeoutMode, wrapErrors(c.bc.Err()))
 			if c.err != nil {
 				return false
 			}
 			// Is the cursor ID zero?
 			if c.bc.ID() == 0 {
 				c.closeImplicitSession()
+				c.accountBatch(nil)
 				return false
 			}
 			// empty batch, but cursor is still valid.
+			if c.heartbeat != nil {
+				c.heartbeat(options.AwaitHeartbeat{CursorID: c.bc.ID(), Time: c.lastServerContact})
+			}
 			// use nonBlocking to determine if we should continue or return control to the caller.
 			if nonBlocking {
 				return false
@@ -245,6 +282,10 @@ func (c *Cursor) next(ctx context.Context, nonBlocking bool) bool {
 
 		// Use the new batch to update the batch and batchLength fields. Consume the first document in the batch.
 		c.batch = c.bc.Batch()
+		if c.retainBatches && c.batch != nil {
+			c.batch = &bsoncore.Iterator{List: append(bsoncore.Array(nil), c.batch.List...)}
+		}
+		c.accountBatch(c.batch)
 		c.batchLength = c.batch.Count()
 		val, err = c.batch.Next()
 		switch {
@@ -260,10 +301,43 @@ func (c *Cursor) next(ctx context.Context, nonBlocking bool) bool {
 	}
 }
 
+// accountBatch replaces the batch accounted for the cursor with batch, which
+// may be nil.
+func (c *Cursor) accountBatch(batch *bsoncore.Iterator) {
+	if c.memory == nil {
+		return
+	}
+	c.memory.Release(c.batchMemory)
+	c.batchMemory = 0
+	if batch != nil {
+		c.batchMemory = len(batch.List)
+	}
+	c.memory.Acquire(c.batchMemory)
+}
+
+// retainBatches reports whether a cursor created with the given lifetime must
+// copy each batch.
+func retainBatches(lifetime *options.CurrentLifetime) bool {
+	return lifetime != nil && *lifetime == options.CurrentUntilClose
+}
+
+// CurrentCopy returns a copy of Current that remains valid after the cursor advances or is closed. It returns nil if
+// there is no current document.
+func (c *Cursor) CurrentCopy() bson.Raw {
+	if c.Current == nil {
+		return nil
+	}
+	return append(bson.Raw(nil), c.Current...)
+}
+
+// getDecoder returns a Decoder for data configured with opts and reg. If the
+// MaxInternedStrings BSON option is set, the Decoder interns strings with
+// interner, or with a new StringInterner if interner is nil.
 func getDecoder(
 	data []byte,
 	opts *options.BSONOptions,
 	reg *bson.Registry,
+	interner *bson.StringInterner,
 ) *bson.Decoder {
 	dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(data)))
 
@@ -277,6 +351,21 @@ func getDecoder(
 		if opts.DefaultDocumentM {
 			dec.DefaultDocumentM()
 		}
+		if opts.DisallowUnknownFields {
+			dec.DisallowUnknownFields()
+		}
+		if opts.IntegersAsInt64 {
+			dec.IntegersAsInt64()
+		}
+		if opts.MaxInternedStrings > 0 {
+			if interner == nil {
+				interner = bson.NewStringInterner(opts.MaxInternedStrings)
+			}
+			dec.InternStrings(interner)
+		}
+		if opts.NumbersAsJSONNumber {
+			dec.NumbersAsJSONNumber()
+		}
 		if opts.ObjectIDAsHexString {
 			dec.ObjectIDAsHexString()
 		}
@@ -301,10 +390,22 @@ func getDecoder(
 	return dec
 }
 
+// stringInterner returns the StringInterner shared by the documents of the
+// cursor, or nil if the MaxInternedStrings BSON option is not set.
+func (c *Cursor) stringInterner() *bson.StringInterner {
+	if c.bsonOpts == nil || c.bsonOpts.MaxInternedStrings <= 0 {
+		return nil
+	}
+	if c.interner == nil {
+		c.interner = bson.NewStringInterner(c.bsonOpts.MaxInternedStrings)
+	}
+	return c.interner
+}
+
 // Decode will unmarshal the current document into val and return any errors from the unmarshalling process without any
 // modification. If val is nil or is a typed nil, an error will be returned.
 func (c *Cursor) Decode(val any) error {
-	dec := getDecoder(c.Current, c.bsonOpts, c.registry)
+	dec := getDecoder(c.Current, c.bsonOpts, c.registry, c.stringInterner())
 
 	return dec.Decode(val)
 }
@@ -316,6 +417,7 @@ func (c *Cursor) Err() error { return c.err }
 // the first call, any subsequent calls will not change the state.
 func (c *Cursor) Close(ctx context.Context) error {
 	defer c.closeImplicitSession()
+	c.accountBatch(nil)
 	return wrapErrors(c.bc.Close(ctx))
 }
 
@@ -361,6 +463,7 @@ func (c *Cursor) All(ctx context.Context, results any) error {
 		}
 
 		batch = c.bc.Batch()
+		c.accountBatch(batch)
 	}
 
 	if err = wrapErrors(c.bc.Err()); err != nil {
@@ -377,6 +480,47 @@ func (c *Cursor) RemainingBatchLength() int {
 	return c.batchLength
 }
 
+// NextBatch returns the documents remaining in the current batch or, if the current batch has been consumed, the
+// documents in the next batch, without decoding them. Forwarding whole batches avoids the per-document overhead of Next
+// for workloads that copy or stream documents elsewhere. NextBatch can be interleaved with calls to Next and TryNext.
+//
+// Like Next, NextBatch blocks until at least one document is available or an error occurs. If the cursor is
+// exhausted, NextBatch returns a nil slice and a nil error. If an error occurs, it is returned and also reported by
+// Err.
+//
+// The returned documents are subject to the same lifetime as Current, which is set to the last document in the
+// returned slice. By default, they are only valid until the next call to Next, TryNext, NextBatch, or Close.
+func (c *Cursor) NextBatch(ctx context.Context) ([]bson.Raw, error) {
+	if c.batchLength == 0 || c.batch == nil {
+		if !c.next(ctx, false) {
+			return nil, c.err
+		}
+		docs := make([]bson.Raw, 0, c.batchLength+1)
+		return c.drainBatch(append(docs, c.Current))
+	}
+	return c.drainBatch(make([]bson.Raw, 0, c.batchLength))
+}
+
+// drainBatch appends the documents remaining in the current batch to docs and sets Current to the last of them.
+func (c *Cursor) drainBatch(docs []bson.Raw) ([]bson.Raw, error) {
+	for {
+		val, err := c.batch.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			c.err = err
+			return nil, err
+		}
+		c.batchLength--
+		docs = append(docs, bson.Raw(val.Data))
+	}
+	if len(docs) > 0 {
+		c.Current = docs[len(docs)-1]
+	}
+	return docs, nil
+}
+
 // addFromBatch adds all documents from batch to sliceVal starting at the given index. It returns the new slice value,
 // the next empty index in the slice, and an error if one occurs.
 func (c *Cursor) addFromBatch(sliceVal reflect.Value, elemType reflect.Type, batch *bsoncore.Iterator,
@@ -396,7 +540,7 @@ func (c *Cursor) addFromBatch(sliceVal reflect.Value, elemType reflect.Type, bat
 		}
 
 		currElem := sliceVal.Index(index).Addr().Interface()
-		dec := getDecoder(doc, c.bsonOpts, c.registry)
+		dec := getDecoder(doc, c.bsonOpts, c.registry, c.stringInterner())
 		err = dec.Decode(currElem)
 		if err != nil {
 			return sliceVal, index, err
@@ -408,6 +552,46 @@ func (c *Cursor) addFromBatch(sliceVal reflect.Value, elemType reflect.Type, bat
 	return sliceVal, index, nil
 }
 
+// applyTimeoutMode configures cursorOpts so that the cursor created by an
+// operation applies timeout, the client-level timeout, according to mode, and
+// returns the mode or an empty string if mode is nil. It must be called before
+// the operation is executed so that a cursor lifetime deadline includes the
+// initial command.
+func applyTimeoutMode(
+	ctx context.Context,
+	mode *options.TimeoutMode,
+	timeout *time.Duration,
+	cursorOpts *driver.CursorOptions,
+) (options.TimeoutMode, error) {
+	if mode == nil {
+		return "", nil
+	}
+
+	switch *mode {
+	case options.TimeoutModeFirstBatch:
+	case options.TimeoutModeIteration:
+		cursorOpts.Timeout = timeout
+	case options.TimeoutModeCursorLifetime:
+		if deadline, ok := ctx.Deadline(); ok {
+			cursorOpts.Deadline = deadline
+		} else if timeout != nil && *timeout > 0 {
+			cursorOpts.Deadline = time.Now().Add(*timeout)
+		}
+	default:
+		return "", fmt.Errorf("invalid timeout mode: %q", *mode)
+	}
+	return *mode, nil
+}
+
+// wrapTimeoutModeError wraps err in a CursorTimeoutError if mode is set and err
+// is a timeout error.
+func wrapTimeoutModeError(mode options.TimeoutMode, err error) error {
+	if mode == "" || err == nil || !IsTimeout(err) {
+		return err
+	}
+	return CursorTimeoutError{Mode: mode, Wrapped: err}
+}
+
 func (c *Cursor) closeImplicitSession() {
 	if c.clientSession != nil && c.clientSession.IsImplicit {
 		c.clientSession.EndSession()
@@ -8,6 +8,7 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -15,6 +16,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/ptrutil"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
@@ -300,6 +302,48 @@ func TestNewCursorFromDocuments(t *testing.T) {
 	})
 }
 
+func TestCursorInternStrings(t *testing.T) {
+	docs := []any{
+		bson.D{{"status", "active"}},
+		bson.D{{"status", "active"}},
+	}
+
+	t.Run("All", func(t *testing.T) {
+		cur, err := NewCursorFromDocuments(docs, nil, nil)
+		require.NoError(t, err)
+		cur.bsonOpts = &options.BSONOptions{MaxInternedStrings: 10}
+
+		var res []struct{ Status string }
+		require.NoError(t, cur.All(context.Background(), &res))
+		require.Len(t, res, 2)
+		assert.Equal(t, "active", res[1].Status)
+		require.NotNil(t, cur.interner, "expected the cursor to intern strings")
+		assert.Equal(t, 1, cur.interner.Len())
+	})
+	t.Run("Decode", func(t *testing.T) {
+		cur, err := NewCursorFromDocuments(docs, nil, nil)
+		require.NoError(t, err)
+		cur.bsonOpts = &options.BSONOptions{MaxInternedStrings: 10}
+
+		var res struct{ Status string }
+		for cur.Next(context.Background()) {
+			require.NoError(t, cur.Decode(&res))
+		}
+		require.NoError(t, cur.Err())
+		require.NotNil(t, cur.interner, "expected the cursor to intern strings")
+		assert.Equal(t, 1, cur.interner.Len())
+	})
+	t.Run("disabled", func(t *testing.T) {
+		cur, err := NewCursorFromDocuments(docs, nil, nil)
+		require.NoError(t, err)
+		cur.bsonOpts = &options.BSONOptions{}
+
+		var res []struct{ Status string }
+		require.NoError(t, cur.All(context.Background(), &res))
+		assert.Nil(t, cur.interner)
+	})
+}
+
 func TestGetDecoder(t *testing.T) {
 	t.Parallel()
 
@@ -323,7 +367,7 @@ func TestGetDecoder(t *testing.T) {
 			require.Equal(t, ctxT, wantCtx.Type())
 
 			optsV.FieldByIndex(f.Index).SetBool(true)
-			gotDec := getDecoder(nil, &opts, nil)
+			gotDec := getDecoder(nil, &opts, nil, nil)
 			gotCtx := reflect.ValueOf(gotDec).Elem().Field(0)
 			require.Equal(t, ctxT, gotCtx.Type())
 
@@ -350,3 +394,229 @@ func BenchmarkNewCursorFromDocuments(b *testing.B) {
 		}
 	}
 }
+
+func TestApplyTimeoutMode(t *testing.T) {
+	t.Parallel()
+
+	timeout := 5 * time.Second
+	mode := func(m options.TimeoutMode) *options.TimeoutMode { return &m }
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		var opts driver.CursorOptions
+		got, err := applyTimeoutMode(context.Background(), nil, &timeout, &opts)
+		require.NoError(t, err)
+		assert.Equal(t, options.TimeoutMode(""), got)
+		assert.Nil(t, opts.Timeout)
+		assert.True(t, opts.Deadline.IsZero())
+	})
+	t.Run("first batch", func(t *testing.T) {
+		t.Parallel()
+
+		var opts driver.CursorOptions
+		got, err := applyTimeoutMode(context.Background(), mode(options.TimeoutModeFirstBatch), &timeout, &opts)
+		require.NoError(t, err)
+		assert.Equal(t, options.TimeoutModeFirstBatch, got)
+		assert.Nil(t, opts.Timeout)
+		assert.True(t, opts.Deadline.IsZero())
+	})
+	t.Run("iteration", func(t *testing.T) {
+		t.Parallel()
+
+		var opts driver.CursorOptions
+		_, err := applyTimeoutMode(context.Background(), mode(options.TimeoutModeIteration), &timeout, &opts)
+		require.NoError(t, err)
+		assert.Equal(t, &timeout, opts.Timeout)
+		assert.True(t, opts.Deadline.IsZero())
+	})
+	t.Run("cursor lifetime from client timeout", func(t *testing.T) {
+		t.Parallel()
+
+		var opts driver.CursorOptions
+		before := time.Now()
+		_, err := applyTimeoutMode(context.Background(), mode(options.TimeoutModeCursorLifetime), &timeout, &opts)
+		require.NoError(t, err)
+		assert.Nil(t, opts.Timeout)
+		assert.False(t, opts.Deadline.Before(before.Add(timeout)), "expected deadline at least %v after %v, got %v",
+			timeout, before, opts.Deadline)
+	})
+	t.Run("cursor lifetime from context deadline", func(t *testing.T) {
+		t.Parallel()
+
+		deadline := time.Now().Add(time.Minute)
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		var opts driver.CursorOptions
+		_, err := applyTimeoutMode(ctx, mode(options.TimeoutModeCursorLifetime), &timeout, &opts)
+		require.NoError(t, err)
+		assert.Equal(t, deadline, opts.Deadline)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var opts driver.CursorOptions
+		_, err := applyTimeoutMode(context.Background(), mode("forever"), &timeout, &opts)
+		assert.EqualError(t, err, `invalid timeout mode: "forever"`)
+	})
+}
+
+func TestWrapTimeoutModeError(t *testing.T) {
+	t.Parallel()
+
+	err := wrapTimeoutModeError(options.TimeoutModeCursorLifetime, context.DeadlineExceeded)
+	var cte CursorTimeoutError
+	require.True(t, errors.As(err, &cte), "expected CursorTimeoutError, got %v", err)
+	assert.Equal(t, options.TimeoutModeCursorLifetime, cte.Mode)
+	assert.True(t, IsTimeout(err), "expected wrapped error to be a timeout")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Equal(t, context.DeadlineExceeded, wrapTimeoutModeError("", context.DeadlineExceeded))
+	assert.Equal(t, ErrNilDocument, wrapTimeoutModeError(options.TimeoutModeIteration, ErrNilDocument))
+	assert.Nil(t, wrapTimeoutModeError(options.TimeoutModeIteration, nil))
+}
+
+// emptyBatchCursor is a testBatchCursor whose first getMores return empty
+// batches while the cursor remains alive, as a tailable await cursor does when
+// no new documents are available before maxAwaitTimeMS elapses.
+type emptyBatchCursor struct {
+	*testBatchCursor
+	empty int
+	pbrt  bsoncore.Document
+}
+
+var _ changeStreamCursor = (*emptyBatchCursor)(nil)
+
+func (ebc *emptyBatchCursor) ID() int64 {
+	if ebc.empty > 0 {
+		return 10
+	}
+	return ebc.testBatchCursor.ID()
+}
+
+func (ebc *emptyBatchCursor) Next(ctx context.Context) bool {
+	if ebc.empty > 0 {
+		ebc.empty--
+		ebc.batch = &bsoncore.Iterator{List: bsoncore.BuildArray(nil)}
+		return false
+	}
+	return ebc.testBatchCursor.Next(ctx)
+}
+
+func (ebc *emptyBatchCursor) PostBatchResumeToken() bsoncore.Document { return ebc.pbrt }
+func (ebc *emptyBatchCursor) KillCursor(context.Context) error        { return nil }
+
+func TestCursorHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	cursor, err := newCursor(&emptyBatchCursor{testBatchCursor: newTestBatchCursor(1, 1), empty: 2}, nil, nil)
+	require.NoError(t, err)
+
+	var heartbeats []options.AwaitHeartbeat
+	cursor.heartbeat = func(hb options.AwaitHeartbeat) {
+		heartbeats = append(heartbeats, hb)
+	}
+
+	assert.False(t, cursor.TryNext(context.Background()), "expected TryNext to return false for an empty batch")
+	require.Len(t, heartbeats, 1)
+	assert.Equal(t, int64(10), heartbeats[0].CursorID)
+	assert.False(t, heartbeats[0].Time.IsZero(), "expected heartbeat time to be set")
+	assert.Nil(t, heartbeats[0].ResumeToken)
+
+	assert.True(t, cursor.Next(context.Background()), "expected Next to return a document")
+	assert.Len(t, heartbeats, 2)
+
+	// Exhausting the cursor does not produce a heartbeat.
+	assert.False(t, cursor.Next(context.Background()), "expected Next to return false")
+	assert.NoError(t, cursor.Err())
+	assert.Len(t, heartbeats, 2)
+}
+
+func TestCursorCurrentLifetime(t *testing.T) {
+	// clobber overwrites the batch that Current was read from, as the driver
+	// may do to a borrowed buffer.
+	clobber := func(c *Cursor) {
+		for i := range c.bc.(*testBatchCursor).batch.List {
+			c.bc.(*testBatchCursor).batch.List[i] = 0
+		}
+	}
+
+	t.Run("until next", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(1, 2), nil, nil)
+		require.NoError(t, err, "newCursor error: %v", err)
+
+		require.True(t, cursor.Next(context.Background()), "expected Next to return true")
+		current := cursor.Current
+		copied := cursor.CurrentCopy()
+		clobber(cursor)
+
+		assert.Equal(t, make(bson.Raw, len(current)), current, "expected borrowed Current to be overwritten")
+		assert.Equal(t, int32(0), copied.Lookup("foo").Int32(), "expected copy to be unaffected")
+	})
+	t.Run("until close", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(2, 1), nil, nil)
+		require.NoError(t, err, "newCursor error: %v", err)
+		cursor.retainBatches = retainBatches(ptrutil.Ptr(options.CurrentUntilClose))
+
+		var docs []bson.Raw
+		for cursor.Next(context.Background()) {
+			docs = append(docs, cursor.Current)
+			clobber(cursor)
+		}
+		require.NoError(t, cursor.Err(), "cursor error: %v", cursor.Err())
+		require.NoError(t, cursor.Close(context.Background()), "Close error")
+
+		require.Len(t, docs, 2, "expected 2 documents, got %v", len(docs))
+		for i, doc := range docs {
+			assert.Equal(t, int32(i), doc.Lookup("foo").Int32(), "expected document %v to be retained", i)
+		}
+	})
+	t.Run("CurrentCopy without document", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(0, 0), nil, nil)
+		require.NoError(t, err, "newCursor error: %v", err)
+		assert.Nil(t, cursor.CurrentCopy(), "expected nil copy")
+	})
+}
+
+func TestCursorNextBatch(t *testing.T) {
+	values := func(docs []bson.Raw) []int32 {
+		var v []int32
+		for _, doc := range docs {
+			v = append(v, doc.Lookup("foo").Int32())
+		}
+		return v
+	}
+
+	t.Run("whole batches", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(2, 3), nil, nil)
+		require.NoError(t, err, "newCursor error: %v", err)
+
+		docs, err := cursor.NextBatch(context.Background())
+		require.NoError(t, err, "NextBatch error: %v", err)
+		assert.Equal(t, []int32{0, 1, 2}, values(docs))
+		assert.Equal(t, docs[2], cursor.Current, "expected Current to be the last document")
+		assert.Equal(t, 0, cursor.RemainingBatchLength())
+
+		docs, err = cursor.NextBatch(context.Background())
+		require.NoError(t, err, "NextBatch error: %v", err)
+		assert.Equal(t, []int32{3, 4, 5}, values(docs))
+
+		docs, err = cursor.NextBatch(context.Background())
+		require.NoError(t, err, "NextBatch error: %v", err)
+		assert.Nil(t, docs, "expected no documents from exhausted cursor")
+	})
+	t.Run("interleaved with Next", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(2, 3), nil, nil)
+		require.NoError(t, err, "newCursor error: %v", err)
+
+		require.True(t, cursor.Next(context.Background()), "expected Next to return true")
+		docs, err := cursor.NextBatch(context.Background())
+		require.NoError(t, err, "NextBatch error: %v", err)
+		assert.Equal(t, []int32{1, 2}, values(docs))
+
+		require.True(t, cursor.Next(context.Background()), "expected Next to return true")
+		assert.Equal(t, int32(3), cursor.Current.Lookup("foo").Int32())
+		assert.Equal(t, 2, cursor.RemainingBatchLength())
+	})
+}
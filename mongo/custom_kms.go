@@ -0,0 +1,81 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// localMasterKeySize is the required size in bytes of a local KMS provider's
+// master key.
+const localMasterKeySize = 96
+
+// NewWrappedLocalMasterKey generates a random local master key and returns it
+// encrypted by provider. The result can be stored alongside application
+// configuration and passed to SetCustomKMSProvider, so that the plaintext master
+// key never has to be stored.
+func NewWrappedLocalMasterKey(ctx context.Context, provider options.KMSProvider) ([]byte, error) {
+	if provider == nil {
+		return nil, errors.New("KMS provider must not be nil")
+	}
+
+	key := make([]byte, localMasterKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("error generating local master key: %w", err)
+	}
+	wrapped, err := provider.Encrypt(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("error wrapping local master key: %w", err)
+	}
+	return wrapped, nil
+}
+
+// addCustomKMSProviders returns a copy of kmsProviders with a named local KMS
+// provider added for each custom provider. The master key of each provider is
+// unwrapped with the provider's KMSProvider.
+func addCustomKMSProviders(
+	ctx context.Context,
+	kmsProviders map[string]map[string]any,
+	custom map[string]options.CustomKMSProvider,
+) (map[string]map[string]any, error) {
+	if len(custom) == 0 {
+		return kmsProviders, nil
+	}
+
+	providers := make(map[string]map[string]any, len(kmsProviders)+len(custom))
+	for name, provider := range kmsProviders {
+		providers[name] = provider
+	}
+	for name, cp := range custom {
+		if name == "" {
+			return nil, errors.New("custom KMS provider name must not be empty")
+		}
+		if cp.Provider == nil {
+			return nil, fmt.Errorf("custom KMS provider %q must not be nil", name)
+		}
+
+		fullName := "local:" + name
+		if _, ok := providers[fullName]; ok {
+			return nil, fmt.Errorf("custom KMS provider %q conflicts with an existing KMS provider", fullName)
+		}
+		key, err := cp.Provider.Decrypt(ctx, cp.WrappedKey)
+		if err != nil {
+			return nil, fmt.Errorf("error unwrapping master key for KMS provider %q: %w", fullName, err)
+		}
+		if len(key) != localMasterKeySize {
+			return nil, fmt.Errorf("expected the master key for KMS provider %q to be %d bytes, got %d",
+				fullName, localMasterKeySize, len(key))
+		}
+		providers[fullName] = map[string]any{"key": key}
+	}
+	return providers, nil
+}
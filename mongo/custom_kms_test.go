@@ -0,0 +1,109 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// xorKMSProvider is a KMSProvider that "encrypts" by XORing with a fixed byte.
+type xorKMSProvider struct {
+	err error
+}
+
+func (p xorKMSProvider) Encrypt(_ context.Context, plaintext []byte) ([]byte, error) {
+	return p.xor(plaintext)
+}
+
+func (p xorKMSProvider) Decrypt(_ context.Context, ciphertext []byte) ([]byte, error) {
+	return p.xor(ciphertext)
+}
+
+func (p xorKMSProvider) xor(b []byte) ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	out := make([]byte, len(b))
+	for i := range b {
+		out[i] = b[i] ^ 0x5a
+	}
+	return out, nil
+}
+
+func TestCustomKMSProviders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wrapped, err := NewWrappedLocalMasterKey(ctx, xorKMSProvider{})
+	require.NoError(t, err)
+	require.Len(t, wrapped, localMasterKeySize)
+
+	t.Run("adds named local provider", func(t *testing.T) {
+		t.Parallel()
+
+		kmsProviders := map[string]map[string]any{"aws": {"accessKeyId": "id"}}
+		got, err := addCustomKMSProviders(ctx, kmsProviders, map[string]options.CustomKMSProvider{
+			"vault": {Provider: xorKMSProvider{}, WrappedKey: wrapped},
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, got, 2)
+		assert.Equal(t, kmsProviders["aws"], got["aws"])
+		key, ok := got["local:vault"]["key"].([]byte)
+		require.True(t, ok, "expected key to be []byte, got %T", got["local:vault"]["key"])
+		unwrapped, _ := xorKMSProvider{}.Decrypt(ctx, wrapped)
+		assert.True(t, bytes.Equal(unwrapped, key), "expected unwrapped master key")
+
+		// The input map must not be modified.
+		assert.Len(t, kmsProviders, 1)
+	})
+
+	errUnavailable := errors.New("vault unavailable")
+	testCases := []struct {
+		name     string
+		existing map[string]map[string]any
+		custom   options.CustomKMSProvider
+	}{
+		{
+			name:   "decrypt error",
+			custom: options.CustomKMSProvider{Provider: xorKMSProvider{err: errUnavailable}, WrappedKey: wrapped},
+		},
+		{
+			name:   "wrong key size",
+			custom: options.CustomKMSProvider{Provider: xorKMSProvider{}, WrappedKey: wrapped[:32]},
+		},
+		{
+			name:   "nil provider",
+			custom: options.CustomKMSProvider{WrappedKey: wrapped},
+		},
+		{
+			name:     "conflicting name",
+			existing: map[string]map[string]any{"local:vault": {"key": make([]byte, localMasterKeySize)}},
+			custom:   options.CustomKMSProvider{Provider: xorKMSProvider{}, WrappedKey: wrapped},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := addCustomKMSProviders(ctx, tc.existing, map[string]options.CustomKMSProvider{"vault": tc.custom})
+			assert.Error(t, err)
+		})
+	}
+
+	_, err = NewWrappedLocalMasterKey(ctx, xorKMSProvider{err: errUnavailable})
+	assert.ErrorIs(t, err, errUnavailable)
+}
@@ -18,6 +18,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
 	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
+	"go.mongodb.org/mongo-driver/v2/mongo/audit"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -157,10 +158,10 @@ func (db *Database) processRunCommand(
 	cmd any,
 	cursorCommand bool,
 	opts ...options.Lister[options.RunCmdOptions],
-) (*operation.Command, *session.Client, error) {
+) (*operation.Command, *session.Client, bsoncore.Document, error) {
 	args, err := mongoutil.NewOptions[options.RunCmdOptions](append(defaultRunCmdOpts, opts...)...)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to construct options from builder: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to construct options from builder: %w", err)
 	}
 
 	sess := sessionFromContext(ctx)
@@ -169,20 +170,28 @@ func (db *Database) processRunCommand(
 	}
 
 	if err := db.client.validSession(sess); err != nil {
-		return nil, sess, err
+		return nil, sess, nil, err
 	}
 
 	if sess != nil && sess.TransactionRunning() && args.ReadPreference != nil && args.ReadPreference.Mode() != readpref.PrimaryMode {
-		return nil, sess, errors.New("read preference in a transaction must be primary")
+		return nil, sess, nil, errors.New("read preference in a transaction must be primary")
 	}
 
 	if isUnorderedMap(cmd) {
-		return nil, sess, ErrMapForOrderedArgument{"cmd"}
+		return nil, sess, nil, ErrMapForOrderedArgument{"cmd"}
 	}
 
 	runCmdDoc, err := marshal(cmd, db.bsonOpts, db.registry)
 	if err != nil {
-		return nil, sess, err
+		return nil, sess, nil, err
+	}
+	if err := db.client.checkCommandPolicy(sess, db.name, runCmdDoc); err != nil {
+		return nil, sess, nil, err
+	}
+	if !isReadCommand(runCmdDoc) {
+		if err := db.client.checkReadOnly(commandName(runCmdDoc), runCommandNamespace(db.name, runCmdDoc)); err != nil {
+			return nil, sess, nil, err
+		}
 	}
 
 	var readSelect description.ServerSelector
@@ -204,17 +213,37 @@ func (db *Database) processRunCommand(
 		cursorOpts := db.client.createBaseCursorOptions()
 
 		cursorOpts.MarshalValueEncoderFn = newEncoderFn(db.bsonOpts, db.registry)
+		if args.BatchSize != nil {
+			cursorOpts.BatchSize = *args.BatchSize
+		}
+		if args.MaxAwaitTime != nil {
+			cursorOpts.SetMaxAwaitTime(*args.MaxAwaitTime)
+		}
+		if args.Comment != nil {
+			comment, err := marshalValue(args.Comment, db.bsonOpts, db.registry)
+			if err != nil {
+				return nil, sess, nil, err
+			}
+			cursorOpts.Comment = comment
+		}
 
 		op = operation.NewCursorCommand(runCmdDoc, cursorOpts)
 	default:
 		op = operation.NewCommand(runCmdDoc)
 	}
 
+	retry := driver.RetryNone
+	if args.Retryable != nil && *args.Retryable && db.client.retryReads {
+		retry = driver.RetryOncePerCommand
+	}
+	op = op.Retry(retry)
+
 	return op.Session(sess).CommandMonitor(db.client.monitor).
 		ServerSelector(readSelect).ClusterClock(db.client.clock).
-		Database(db.name).Deployment(db.client.deployment).
+		Database(db.name).Deployment(db.client.deploymentFor(ctx)).RetryPolicy(db.client.retryPolicy).
+		CommandInterceptors(db.client.interceptors).MemoryAccountant(db.client.memoryAccountant()).
 		Crypt(db.client.cryptFLE).ReadPreference(args.ReadPreference).ServerAPI(db.client.serverAPI).
-		Timeout(db.client.timeout).Logger(db.client.logger).Authenticator(db.client.authenticator), sess, nil
+		Timeout(db.client.timeout).Logger(db.client.logger).Authenticator(db.client.authenticator), sess, runCmdDoc, nil
 }
 
 // RunCommand executes the given command against the database.
@@ -226,6 +255,9 @@ func (db *Database) processRunCommand(
 // user must supply these values manually in the user-provided runCommand
 // parameter.
 //
+// RunCommand does not retry the command unless it is marked idempotent with
+// RunCmdOptions.Retryable, in which case it is retried like a retryable read.
+//
 // The runCommand parameter must be a document for the command to be executed. It cannot be nil.
 // This must be an order-preserving type such as bson.D. Map types such as bson.M are not valid.
 //
@@ -244,13 +276,15 @@ func (db *Database) RunCommand(
 		ctx = context.Background()
 	}
 
-	op, sess, err := db.processRunCommand(ctx, runCommand, false, opts...)
+	op, sess, runCmdDoc, err := db.processRunCommand(ctx, runCommand, false, opts...)
 	defer closeImplicitSession(sess)
 	if err != nil {
 		return &SingleResult{err: err}
 	}
+	cmdName, ns := commandName(runCmdDoc), runCommandNamespace(db.name, runCmdDoc)
 
-	err = op.Execute(ctx)
+	err = db.client.runOperation(ctx, cmdName, ns, op.Execute)
+	db.client.auditCommand(ctx, db.name, runCmdDoc, err)
 	// RunCommand can be used to run a write, thus execute may return a write error
 	rr, convErr := processWriteError(err)
 	return &SingleResult{
@@ -286,13 +320,16 @@ func (db *Database) RunCommandCursor(
 		ctx = context.Background()
 	}
 
-	op, sess, err := db.processRunCommand(ctx, runCommand, true, opts...)
+	op, sess, runCmdDoc, err := db.processRunCommand(ctx, runCommand, true, opts...)
 	if err != nil {
 		closeImplicitSession(sess)
 		return nil, wrapErrors(err)
 	}
+	cmdName, ns := commandName(runCmdDoc), runCommandNamespace(db.name, runCmdDoc)
 
-	if err = op.Execute(ctx); err != nil {
+	err = db.client.runOperation(ctx, cmdName, ns, op.Execute)
+	db.client.auditCommand(ctx, db.name, runCmdDoc, err)
+	if err != nil {
 		closeImplicitSession(sess)
 		if errors.Is(err, driver.ErrNoCursor) {
 			return nil, errors.New(
@@ -307,6 +344,9 @@ func (db *Database) RunCommandCursor(
 		return nil, wrapErrors(err)
 	}
 	cursor, err := newCursorWithSession(bc, db.bsonOpts, db.registry, sess)
+	if cursor != nil {
+		cursor.memory = db.client.memory
+	}
 	return cursor, wrapErrors(err)
 }
 
@@ -332,6 +372,12 @@ func (db *Database) Drop(ctx context.Context) error {
 	if sess.TransactionRunning() {
 		wc = nil
 	}
+	if err := db.client.checkReadOnly("dropDatabase", db.name); err != nil {
+		return err
+	}
+	if err := db.client.checkWritePolicy(sess, db.name, wc); err != nil {
+		return err
+	}
 	if !wc.Acknowledged() {
 		sess = nil
 	}
@@ -341,16 +387,19 @@ func (db *Database) Drop(ctx context.Context) error {
 	op := operation.NewDropDatabase().
 		Session(sess).WriteConcern(wc).CommandMonitor(db.client.monitor).
 		ServerSelector(selector).ClusterClock(db.client.clock).
-		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.cryptFLE).
+		Database(db.name).Deployment(db.client.deploymentFor(ctx)).RetryPolicy(db.client.retryPolicy).
+		CommandInterceptors(db.client.interceptors).MemoryAccountant(db.client.memoryAccountant()).
+		Crypt(db.client.cryptFLE).
 		ServerAPI(db.client.serverAPI).Authenticator(db.client.authenticator)
 
-	err = op.Execute(ctx)
+	err = db.client.runOperation(ctx, "dropDatabase", db.name, op.Execute)
 
 	var driverErr driver.Error
-	if err != nil && (!errors.As(err, &driverErr) || !driverErr.NamespaceNotFound()) {
-		return wrapErrors(err)
+	if errors.As(err, &driverErr) && driverErr.NamespaceNotFound() {
+		err = nil
 	}
-	return nil
+	db.client.emitAudit(ctx, audit.Record{Namespace: db.name, Operation: "dropDatabase"}, err)
+	return wrapErrors(err)
 }
 
 // ListCollectionSpecifications executes a listCollections command and returns a slice of CollectionSpecification
@@ -358,7 +407,7 @@ func (db *Database) Drop(ctx context.Context) error {
 //
 // The filter parameter must be a document containing query operators and can be used to select which collections
 // are included in the result. It cannot be nil. An empty document (e.g. bson.D{}) should be used to include all
-// collections.
+// collections. A CollectionFilter can be used to select collections by name and type.
 //
 // The opts parameter can be used to specify options for the operation (see the options.ListCollectionsOptions
 // documentation).
@@ -374,16 +423,7 @@ func (db *Database) ListCollectionSpecifications(
 		return nil, err
 	}
 
-	var resp []struct {
-		Name string `bson:"name"`
-		Type string `bson:"type"`
-		Info *struct {
-			ReadOnly bool         `bson:"readOnly"`
-			UUID     *bson.Binary `bson:"uuid"`
-		} `bson:"info"`
-		Options bson.Raw                       `bson:"options"`
-		IDIndex indexListSpecificationResponse `bson:"idIndex"`
-	}
+	var resp []collectionListSpecificationResponse
 
 	err = cursor.All(ctx, &resp)
 	if err != nil {
@@ -392,23 +432,7 @@ func (db *Database) ListCollectionSpecifications(
 
 	specs := make([]CollectionSpecification, len(resp))
 	for idx, spec := range resp {
-		specs[idx] = CollectionSpecification{
-			Name:    spec.Name,
-			Type:    spec.Type,
-			Options: spec.Options,
-			IDIndex: IndexSpecification(spec.IDIndex),
-		}
-
-		if spec.Info != nil {
-			specs[idx].ReadOnly = spec.Info.ReadOnly
-			specs[idx].UUID = spec.Info.UUID
-		}
-
-		// Pre-4.4 servers report a namespace in their responses, so we only set Namespace manually if it was not in
-		// the response.
-		if specs[idx].IDIndex.Namespace == "" {
-			specs[idx].IDIndex.Namespace = db.name + "." + specs[idx].Name
-		}
+		specs[idx] = spec.specification(db.name)
 	}
 
 	return specs, nil
@@ -418,7 +442,7 @@ func (db *Database) ListCollectionSpecifications(
 //
 // The filter parameter must be a document containing query operators and can be used to select which collections
 // are included in the result. It cannot be nil. An empty document (e.g. bson.D{}) should be used to include all
-// collections.
+// collections. A CollectionFilter can be used to select collections by name and type.
 //
 // The opts parameter can be used to specify options for the operation (see the options.ListCollectionsOptions
 // documentation).
@@ -456,6 +480,10 @@ func (db *Database) ListCollections(
 		closeImplicitSession(sess)
 		return nil, err
 	}
+	if err = db.client.checkNamespacePolicy(db.name); err != nil {
+		closeImplicitSession(sess)
+		return nil, err
+	}
 
 	var selector description.ServerSelector
 
@@ -471,7 +499,9 @@ func (db *Database) ListCollections(
 	op := operation.NewListCollections(filterDoc).
 		Session(sess).ReadPreference(db.readPreference).CommandMonitor(db.client.monitor).
 		ServerSelector(selector).ClusterClock(db.client.clock).
-		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.cryptFLE).
+		Database(db.name).Deployment(db.client.deploymentFor(ctx)).RetryPolicy(db.client.retryPolicy).
+		CommandInterceptors(db.client.interceptors).MemoryAccountant(db.client.memoryAccountant()).
+		Crypt(db.client.cryptFLE).
 		ServerAPI(db.client.serverAPI).Timeout(db.client.timeout).Authenticator(db.client.authenticator)
 
 	cursorOpts := db.client.createBaseCursorOptions()
@@ -500,7 +530,7 @@ func (db *Database) ListCollections(
 	}
 	op = op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = db.client.runOperation(ctx, "listCollections", db.name, op.Execute)
 	if err != nil {
 		closeImplicitSession(sess)
 		return nil, wrapErrors(err)
@@ -520,7 +550,7 @@ func (db *Database) ListCollections(
 //
 // The filter parameter must be a document containing query operators and can be used to select which collections
 // are included in the result. It cannot be nil. An empty document (e.g. bson.D{}) should be used to include all
-// collections.
+// collections. A CollectionFilter can be used to select collections by name and type.
 //
 // The opts parameter can be used to specify options for the operation (see the options.ListCollectionsOptions
 // documentation).
@@ -735,7 +765,7 @@ func (db *Database) createCollectionWithEncryptedFields(
 	}
 
 	op.EncryptedFields(efBSON)
-	if err := db.executeCreateOperation(ctx, op); err != nil {
+	if err := db.executeCreateOperation(ctx, name, op); err != nil {
 		return err
 	}
 
@@ -757,7 +787,7 @@ func (db *Database) createCollection(
 	if err != nil {
 		return err
 	}
-	return db.executeCreateOperation(ctx, op)
+	return db.executeCreateOperation(ctx, name, op)
 }
 
 func (db *Database) createCollectionOperation(
@@ -841,6 +871,9 @@ func (db *Database) createCollectionOperation(
 		if err != nil {
 			return nil, fmt.Errorf("failed to construct DefaultIndexArgs from options: %w", err)
 		}
+		if err := validateTimeSeriesOptions(timeSeriesArgs); err != nil {
+			return nil, err
+		}
 
 		idx, doc := bsoncore.AppendDocumentStart(nil)
 		doc = bsoncore.AppendStringElement(doc, "timeField", timeSeriesArgs.TimeField)
@@ -917,10 +950,111 @@ func (db *Database) CreateView(ctx context.Context, viewName, viewOn string, pip
 		op.Collation(bsoncore.Document(toDocument(args.Collation)))
 	}
 
-	return db.executeCreateOperation(ctx, op)
+	return db.executeCreateOperation(ctx, viewName, op)
 }
 
-func (db *Database) executeCreateOperation(ctx context.Context, op *operation.Create) error {
+// CloneCollectionStructure creates the collection dst with the options and indexes of the collection src, without
+// copying any documents. Both collections are in this database. If src is a view, dst is created as a view with the
+// same definition.
+//
+// CloneCollectionStructure returns an error if src does not exist or is a Queryable Encryption collection. If
+// creating the indexes fails, dst is not dropped.
+func (db *Database) CloneCollectionStructure(ctx context.Context, src, dst string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	specs, err := db.ListCollectionSpecifications(ctx, CollectionFilter{Names: []string{src}})
+	if err != nil {
+		return err
+	}
+	if len(specs) == 0 {
+		return fmt.Errorf("collection %q not found in database %q", src, db.name)
+	}
+
+	create, err := cloneCreateCommand(dst, specs[0])
+	if err != nil {
+		return err
+	}
+	if err := db.RunCommand(ctx, create).Err(); err != nil {
+		return err
+	}
+	if specs[0].Type == CollectionTypeView {
+		return nil
+	}
+
+	cursor, err := db.Collection(src).Indexes().List(ctx)
+	if err != nil {
+		return err
+	}
+	var indexes []bson.Raw
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return err
+	}
+
+	models, err := cloneIndexModels(indexes)
+	if err != nil {
+		return err
+	}
+	if len(models) == 0 {
+		return nil
+	}
+	return db.RunCommand(ctx, bson.D{{"createIndexes", dst}, {"indexes", models}}).Err()
+}
+
+// cloneCreateCommand returns the create command for a collection named dst
+// with the options of spec.
+func cloneCreateCommand(dst string, spec CollectionSpecification) (bson.D, error) {
+	cmd := bson.D{{"create", dst}}
+	if len(spec.Options) == 0 {
+		return cmd, nil
+	}
+
+	elems, err := spec.Options.Elements()
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range elems {
+		if elem.Key() == "encryptedFields" {
+			return nil, fmt.Errorf("cannot clone Queryable Encryption collection %q", spec.Name)
+		}
+		cmd = append(cmd, bson.E{elem.Key(), elem.Value()})
+	}
+	return cmd, nil
+}
+
+// cloneIndexModels converts the listIndexes results of a collection to the
+// index documents of a createIndexes command. The _id and clustered indexes
+// are skipped because they are created with the collection.
+func cloneIndexModels(indexes []bson.Raw) (bson.A, error) {
+	var models bson.A
+	for _, index := range indexes {
+		if name, _ := index.Lookup("name").StringValueOK(); name == "_id_" {
+			continue
+		}
+		if clustered, _ := index.Lookup("clustered").BooleanOK(); clustered {
+			continue
+		}
+
+		elems, err := index.Elements()
+		if err != nil {
+			return nil, err
+		}
+		model := make(bson.D, 0, len(elems))
+		for _, elem := range elems {
+			// Pre-4.4 servers report the namespace of the source collection.
+			if elem.Key() == "ns" {
+				continue
+			}
+			model = append(model, bson.E{elem.Key(), elem.Value()})
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+// executeCreateOperation runs op, which creates the collection or view name.
+func (db *Database) executeCreateOperation(ctx context.Context, name string, op *operation.Create) error {
 	sess := sessionFromContext(ctx)
 	if sess == nil && db.client.sessionPool != nil {
 		sess = session.NewImplicitClientSession(db.client.sessionPool, db.client.id)
@@ -936,6 +1070,12 @@ func (db *Database) executeCreateOperation(ctx context.Context, op *operation.Cr
 	if sess.TransactionRunning() {
 		wc = nil
 	}
+	if err := db.client.checkReadOnly("create", db.name); err != nil {
+		return err
+	}
+	if err := db.client.checkWritePolicy(sess, db.name, wc); err != nil {
+		return err
+	}
 	if !wc.Acknowledged() {
 		sess = nil
 	}
@@ -947,10 +1087,13 @@ func (db *Database) executeCreateOperation(ctx context.Context, op *operation.Cr
 		ServerSelector(selector).
 		ClusterClock(db.client.clock).
 		Database(db.name).
-		Deployment(db.client.deployment).
+		Deployment(db.client.deploymentFor(ctx)).RetryPolicy(db.client.retryPolicy).
+		CommandInterceptors(db.client.interceptors).MemoryAccountant(db.client.memoryAccountant()).
 		Crypt(db.client.cryptFLE)
 
-	return wrapErrors(op.Execute(ctx))
+	err = db.client.runOperation(ctx, "create", db.name, op.Execute)
+	db.client.emitAudit(ctx, audit.Record{Namespace: db.name + "." + name, Operation: "create"}, err)
+	return wrapErrors(err)
 }
 
 // GridFSBucket is used to construct a GridFS bucket which can be used as a
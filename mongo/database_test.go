@@ -101,6 +101,13 @@ func TestDatabase(t *testing.T) {
 
 		_, err = db.ListCollections(bgCtx, bson.D{})
 		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+
+		_, err = db.RunCommands(bgCtx, []bson.D{{{"ping", 1}}})
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+	})
+	t.Run("run commands without commands", func(t *testing.T) {
+		_, err := setupDb("foo").RunCommands(bgCtx, nil)
+		assert.EqualError(t, err, "at least one command must be provided")
 	})
 	t.Run("TransientTransactionError label", func(t *testing.T) {
 		client := setupClient(options.Client().ApplyURI("mongodb://nonexistent").SetServerSelectionTimeout(3 * time.Second))
@@ -154,3 +161,48 @@ func TestDatabase(t *testing.T) {
 		assert.True(t, errors.Is(err, ErrNilDocument), "expected error %v, got %v", ErrNilDocument, err)
 	})
 }
+
+func TestCloneCollectionStructure(t *testing.T) {
+	raw := func(doc bson.D) bson.Raw {
+		b, err := bson.Marshal(doc)
+		require.NoError(t, err, "Marshal error")
+		return b
+	}
+
+	t.Run("create command", func(t *testing.T) {
+		spec := CollectionSpecification{
+			Name:    "src",
+			Options: raw(bson.D{{"capped", true}, {"size", int64(4096)}}),
+		}
+		cmd, err := cloneCreateCommand("dst", spec)
+		require.NoError(t, err, "cloneCreateCommand error")
+
+		want := raw(bson.D{{"create", "dst"}, {"capped", true}, {"size", int64(4096)}})
+		assert.Equal(t, want, raw(cmd))
+	})
+	t.Run("create command without options", func(t *testing.T) {
+		cmd, err := cloneCreateCommand("dst", CollectionSpecification{Name: "src"})
+		require.NoError(t, err, "cloneCreateCommand error")
+		assert.Equal(t, bson.D{{"create", "dst"}}, cmd)
+	})
+	t.Run("encrypted collection", func(t *testing.T) {
+		spec := CollectionSpecification{
+			Name:    "src",
+			Options: raw(bson.D{{"encryptedFields", bson.D{}}}),
+		}
+		_, err := cloneCreateCommand("dst", spec)
+		assert.EqualError(t, err, `cannot clone Queryable Encryption collection "src"`)
+	})
+	t.Run("index models", func(t *testing.T) {
+		models, err := cloneIndexModels([]bson.Raw{
+			raw(bson.D{{"v", int32(2)}, {"key", bson.D{{"_id", int32(1)}}}, {"name", "_id_"}}),
+			raw(bson.D{{"v", int32(2)}, {"key", bson.D{{"_id", int32(1)}}}, {"name", "clustered"}, {"clustered", true}}),
+			raw(bson.D{{"v", int32(2)}, {"key", bson.D{{"x", int32(1)}}}, {"name", "x_1"}, {"ns", "db.src"}, {"unique", true}}),
+		})
+		require.NoError(t, err, "cloneIndexModels error")
+		require.Len(t, models, 1)
+
+		want := raw(bson.D{{"v", int32(2)}, {"key", bson.D{{"x", int32(1)}}}, {"name", "x_1"}, {"unique", true}})
+		assert.Equal(t, want, raw(models[0].(bson.D)))
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// defaultDeleteByIDsBatchSize is the number of ids deleted by each delete
+	// command if DeleteByIDs is called with a batch size that is not positive.
+	defaultDeleteByIDsBatchSize = 1000
+
+	// maxDeleteByIDsBatchBytes is the maximum total size of the ids in a
+	// single delete command. It is half of the maximum BSON document size so
+	// that the command, which wraps the ids in a filter, stays below the limit.
+	maxDeleteByIDsBatchBytes = 8 * 1024 * 1024
+)
+
+// DeleteByIDsProgress describes the progress of a DeleteByIDs call. It is
+// passed to the progress callback after each batch is deleted.
+type DeleteByIDsProgress struct {
+	// Processed is the number of ids in the batches that have been deleted so
+	// far, including ids that did not match a document.
+	Processed int
+
+	// Total is the number of ids passed to DeleteByIDs.
+	Total int
+
+	// Deleted is the number of documents deleted so far.
+	Deleted int64
+}
+
+// DeleteByIDs deletes the documents in the collection whose _id is one of ids.
+// The ids are split into batches of at most batchSize ids, and each batch is
+// deleted with a separate delete command with an $in filter, so that the
+// number of ids is not limited by the maximum size of a command. If batchSize
+// is not positive, a batch size of 1000 is used. Batches are also split so
+// that the ids in a batch take up no more than 8MiB.
+//
+// If progress is not nil, it is called after each batch is deleted. For
+// example:
+//
+//	res, err := coll.DeleteByIDs(ctx, ids, 5000, func(p mongo.DeleteByIDsProgress) {
+//		log.Printf("deleted %d documents (%d/%d ids)", p.Deleted, p.Processed, p.Total)
+//	})
+//
+// The batches are not deleted atomically. If a batch fails, DeleteByIDs stops
+// and returns the error along with a DeleteResult that counts the documents
+// deleted by the preceding batches. Because deleting an id that was already
+// deleted has no effect, the call can be retried with the same ids.
+//
+// The opts parameter can be used to specify options for each delete command
+// (see the options.DeleteManyOptions documentation).
+//
+// For more information about the command, see
+// https://www.mongodb.com/docs/manual/reference/command/delete/.
+func (coll *Collection) DeleteByIDs(
+	ctx context.Context,
+	ids []any,
+	batchSize int,
+	progress func(DeleteByIDsProgress),
+	opts ...options.Lister[options.DeleteManyOptions],
+) (*DeleteResult, error) {
+	if batchSize <= 0 {
+		batchSize = defaultDeleteByIDsBatchSize
+	}
+
+	// Marshal all ids before deleting anything so that an invalid id does not
+	// leave the deletion half done.
+	vals := make([]bson.RawValue, len(ids))
+	for i, id := range ids {
+		val, err := marshalValue(id, coll.bsonOpts, coll.registry)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling id %d: %w", i, err)
+		}
+		vals[i] = bson.RawValue{Type: bson.Type(val.Type), Value: val.Data}
+	}
+
+	res := &DeleteResult{Acknowledged: true}
+	state := DeleteByIDsProgress{Total: len(ids)}
+	for _, batch := range splitIDBatches(vals, batchSize, maxDeleteByIDsBatchBytes) {
+		filter := bson.D{{"_id", bson.D{{"$in", batch}}}}
+		batchRes, err := coll.DeleteMany(ctx, filter, opts...)
+		if batchRes != nil {
+			res.DeletedCount += batchRes.DeletedCount
+			res.Acknowledged = res.Acknowledged && batchRes.Acknowledged
+			res.OperationTime = batchRes.OperationTime
+		}
+		if err != nil {
+			return res, err
+		}
+
+		state.Processed += len(batch)
+		state.Deleted = res.DeletedCount
+		if progress != nil {
+			progress(state)
+		}
+	}
+	return res, nil
+}
+
+// splitIDBatches splits ids into consecutive batches that have at most
+// batchSize ids and whose ids take up at most maxBytes, unless a single id is
+// larger than maxBytes, in which case it is in a batch by itself.
+func splitIDBatches(ids []bson.RawValue, batchSize, maxBytes int) [][]bson.RawValue {
+	var batches [][]bson.RawValue
+	start, size := 0, 0
+	for i, id := range ids {
+		if i > start && (i-start == batchSize || size+len(id.Value) > maxBytes) {
+			batches = append(batches, ids[start:i])
+			start, size = i, 0
+		}
+		size += len(id.Value)
+	}
+	if start < len(ids) {
+		batches = append(batches, ids[start:])
+	}
+	return batches
+}
@@ -0,0 +1,53 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+)
+
+func TestSplitIDBatches(t *testing.T) {
+	id := func(size int) bson.RawValue {
+		return bson.RawValue{Type: bson.TypeBinary, Value: make([]byte, size)}
+	}
+	sizes := func(batches [][]bson.RawValue) []int {
+		var n []int
+		for _, b := range batches {
+			n = append(n, len(b))
+		}
+		return n
+	}
+
+	testCases := []struct {
+		name      string
+		ids       []bson.RawValue
+		batchSize int
+		maxBytes  int
+		want      []int
+	}{
+		{"empty", nil, 2, 100, nil},
+		{"single batch", []bson.RawValue{id(1), id(1)}, 2, 100, []int{2}},
+		{"by count", []bson.RawValue{id(1), id(1), id(1), id(1), id(1)}, 2, 100, []int{2, 2, 1}},
+		{"by size", []bson.RawValue{id(40), id(40), id(40), id(10)}, 10, 100, []int{2, 2}},
+		{"oversized id", []bson.RawValue{id(10), id(200), id(10)}, 10, 100, []int{1, 1, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			batches := splitIDBatches(tc.ids, tc.batchSize, tc.maxBytes)
+			assert.Equal(t, tc.want, sizes(batches))
+
+			var n int
+			for _, b := range batches {
+				n += len(b)
+			}
+			assert.Equal(t, len(tc.ids), n)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// DistinctAs executes a distinct command to find the unique values for a specified field in coll and decodes them
+// into values of type T. For example:
+//
+//	names, err := mongo.DistinctAs[string](ctx, coll, "name", bson.D{})
+//
+// Each value is decoded with the registry and BSON options of coll, so numeric values are converted as they would be
+// when decoding a document field of type T: for example, int32, int64, and whole double values can all be decoded
+// into an int64, but a fractional double cannot. If a value cannot be decoded, an error that includes the index of
+// the value is returned. Values of mixed types can be decoded with T set to any or bson.RawValue.
+//
+// The filter and opts parameters are the same as for Collection.Distinct. If the distinct command matches no
+// documents, an empty slice is returned.
+func DistinctAs[T any](
+	ctx context.Context,
+	coll *Collection,
+	fieldName string,
+	filter any,
+	opts ...options.Lister[options.DistinctOptions],
+) ([]T, error) {
+	return decodeDistinct[T](coll.Distinct(ctx, fieldName, filter, opts...))
+}
+
+// decodeDistinct decodes the values in dr into values of type T.
+func decodeDistinct[T any](dr *DistinctResult) ([]T, error) {
+	arr, err := dr.Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := bsoncore.Array(arr).Values()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]T, 0, len(vals))
+	for i, val := range vals {
+		// Decode each value as a field of a document so that it is converted
+		// exactly as a struct field of type T would be.
+		doc := bsoncore.NewDocumentBuilder().AppendValue("v", val).Build()
+
+		var holder struct{ V T }
+		if err := getDecoder(doc, dr.bsonOpts, dr.reg, nil).Decode(&holder); err != nil {
+			return nil, fmt.Errorf("error decoding distinct value %d: %w", i, err)
+		}
+		out = append(out, holder.V)
+	}
+	return out, nil
+}
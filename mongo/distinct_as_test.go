@@ -0,0 +1,73 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+func TestDecodeDistinct(t *testing.T) {
+	newResult := func(build func(*bsoncore.ArrayBuilder)) *DistinctResult {
+		ab := bsoncore.NewArrayBuilder()
+		build(ab)
+		return &DistinctResult{arr: bson.RawArray(ab.Build()), reg: bson.NewRegistry()}
+	}
+
+	t.Run("numeric conversion", func(t *testing.T) {
+		dr := newResult(func(ab *bsoncore.ArrayBuilder) {
+			ab.AppendInt32(1).AppendInt64(2).AppendDouble(3)
+		})
+
+		got, err := decodeDistinct[int64](dr)
+		require.NoError(t, err, "decodeDistinct error")
+		assert.Equal(t, []int64{1, 2, 3}, got)
+	})
+	t.Run("strings", func(t *testing.T) {
+		dr := newResult(func(ab *bsoncore.ArrayBuilder) {
+			ab.AppendString("a").AppendString("b")
+		})
+
+		got, err := decodeDistinct[string](dr)
+		require.NoError(t, err, "decodeDistinct error")
+		assert.Equal(t, []string{"a", "b"}, got)
+	})
+	t.Run("mixed types", func(t *testing.T) {
+		dr := newResult(func(ab *bsoncore.ArrayBuilder) {
+			ab.AppendString("a").AppendInt32(1)
+		})
+
+		got, err := decodeDistinct[any](dr)
+		require.NoError(t, err, "decodeDistinct error")
+		assert.Equal(t, []any{"a", int32(1)}, got)
+	})
+	t.Run("empty", func(t *testing.T) {
+		got, err := decodeDistinct[string](newResult(func(*bsoncore.ArrayBuilder) {}))
+		require.NoError(t, err, "decodeDistinct error")
+		assert.NotNil(t, got, "expected an empty slice")
+		assert.Len(t, got, 0)
+	})
+	t.Run("conversion error", func(t *testing.T) {
+		dr := newResult(func(ab *bsoncore.ArrayBuilder) {
+			ab.AppendInt32(1).AppendDouble(1.5)
+		})
+
+		_, err := decodeDistinct[int64](dr)
+		assert.ErrorContains(t, err, "error decoding distinct value 1")
+	})
+	t.Run("operation error", func(t *testing.T) {
+		opErr := errors.New("distinct failed")
+
+		_, err := decodeDistinct[string](&DistinctResult{err: opErr})
+		assert.ErrorIs(t, err, opErr)
+	})
+}
@@ -102,6 +102,21 @@
 // using a different DNS server (8.8.8.8 is the common default), and, if that's not possible, avoiding the "mongodb+srv"
 // scheme.
 //
+// # Kerberos Authentication
+//
+// Using the GSSAPI authentication mechanism requires specifying a build tag during compilation. The "gssapi" build
+// tag uses the system Kerberos libraries through cgo (GSS-API on Linux and macOS, SSPI on Windows):
+//
+//	go build -tags gssapi
+//
+// Alternatively, blank importing the separate go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth/krb5 module
+// registers a pure Go Kerberos implementation, which does not require cgo and can be used for statically linked or
+// cross-compiled binaries on any platform:
+//
+//	import _ "go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth/krb5"
+//
+// See that package's documentation for how it locates the Kerberos configuration and credentials.
+//
 // # In-Use Encryption
 //
 // MongoDB provides two approaches to In-Use Encryption: Queryable Encryption (QE) and Client-Side Field Level Encryption (CSFLE).
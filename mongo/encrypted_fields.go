@@ -0,0 +1,330 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var (
+	tTime       = reflect.TypeOf(time.Time{})
+	tDateTime   = reflect.TypeOf(bson.DateTime(0))
+	tDecimal128 = reflect.TypeOf(bson.Decimal128{})
+	tObjectID   = reflect.TypeOf(bson.ObjectID{})
+	tBinary     = reflect.TypeOf(bson.Binary{})
+	tByteSlice  = reflect.TypeOf([]byte(nil))
+)
+
+// EncryptedFieldsFromStruct derives a Queryable Encryption encryptedFields
+// document from the "encrypt" struct tags of v, which must be a struct or a
+// pointer to a struct. Keeping the schema in struct tags ensures that it cannot
+// drift apart from the Go model used to read and write the collection. The
+// result can be passed to CreateCollectionOptionsBuilder.SetEncryptedFields,
+// AutoEncryptionOptions.SetEncryptedFieldsMap, or
+// ClientEncryption.CreateEncryptedCollection.
+//
+// The tag value is the query type, "equality", "range", or "none" for an
+// encrypted field that cannot be queried, optionally followed by
+// comma-separated options:
+//
+//	type Patient struct {
+//		Name string    `bson:"name"`
+//		SSN  string    `bson:"ssn" encrypt:"equality"`
+//		Age  int32     `bson:"age" encrypt:"range,min=0,max=150,sparsity=1"`
+//		DOB  time.Time `bson:"dob" encrypt:"range,min=1900-01-01T00:00:00Z,max=2100-01-01T00:00:00Z"`
+//		Note string    `bson:"note" encrypt:"none"`
+//	}
+//
+// The "contention" option is accepted by "equality" and "range" fields, and the
+// "min", "max", "sparsity", "precision", and "trimFactor" options by "range"
+// fields. Date bounds are given in RFC 3339 format.
+//
+// Field paths follow the "bson" struct tags, and untagged fields of struct
+// type are traversed to find nested encrypted fields. The BSON type of each
+// field is derived from its Go type. Because an int can be encoded as either a
+// BSON int32 or int64, int fields must be declared as int32 or int64 instead.
+// Every generated field has a null keyId, so CreateEncryptedCollection creates
+// a data key for it.
+func EncryptedFieldsFromStruct(v any) (bson.D, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct or pointer to a struct, got %T", v)
+	}
+
+	fields, err := appendEncryptedFields(nil, t, "")
+	if err != nil {
+		return nil, err
+	}
+	return bson.D{{Key: "fields", Value: fields}}, nil
+}
+
+func appendEncryptedFields(fields bson.A, t reflect.Type, prefix string) (bson.A, error) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name, inline, skip := bsonFieldName(sf)
+		if skip {
+			continue
+		}
+		path := prefix + name
+		ft := sf.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		tag, ok := sf.Tag.Lookup("encrypt")
+		if !ok {
+			if ft.Kind() == reflect.Struct && !isBSONValueStruct(ft) {
+				nestedPrefix := path + "."
+				if inline || sf.Anonymous {
+					nestedPrefix = prefix
+				}
+				var err error
+				if fields, err = appendEncryptedFields(fields, ft, nestedPrefix); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		field, err := encryptedField(path, ft, tag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encrypt tag on field %s.%s: %w", t.Name(), sf.Name, err)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// bsonFieldName returns the BSON key of a struct field, following the rules
+// of the default struct codec.
+func bsonFieldName(sf reflect.StructField) (name string, inline, skip bool) {
+	tag := sf.Tag.Get("bson")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	for _, opt := range parts[1:] {
+		if opt == "inline" {
+			inline = true
+		}
+	}
+	if parts[0] != "" {
+		return parts[0], inline, false
+	}
+	return strings.ToLower(sf.Name), inline, false
+}
+
+// isBSONValueStruct reports whether t is a struct type that is encoded as a
+// single BSON value rather than as an embedded document.
+func isBSONValueStruct(t reflect.Type) bool {
+	switch t {
+	case tTime, tDecimal128, tObjectID, tBinary:
+		return true
+	}
+	return false
+}
+
+// encryptedFieldBSONType returns the BSON type alias for values of Go type t.
+func encryptedFieldBSONType(t reflect.Type) (string, error) {
+	switch t {
+	case tTime, tDateTime:
+		return "date", nil
+	case tDecimal128:
+		return "decimal", nil
+	case tObjectID:
+		return "objectId", nil
+	case tBinary, tByteSlice:
+		return "binData", nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "string", nil
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return "int", nil
+	case reflect.Int64, reflect.Uint32:
+		return "long", nil
+	case reflect.Float32, reflect.Float64:
+		return "double", nil
+	case reflect.Struct, reflect.Map:
+		return "object", nil
+	case reflect.Slice, reflect.Array:
+		return "array", nil
+	case reflect.Int:
+		return "", fmt.Errorf("type %s may be encoded as BSON int or long; use int32 or int64", t)
+	}
+	return "", fmt.Errorf("unsupported type %s", t)
+}
+
+func encryptedField(path string, t reflect.Type, tag string) (any, error) {
+	bsonType, err := encryptedFieldBSONType(t)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(tag, ",")
+	queryType := parts[0]
+	opts := make(map[string]string, len(parts)-1)
+	for _, part := range parts[1:] {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("expected option of the form key=value, got %q", part)
+		}
+		opts[key] = val
+	}
+
+	var contention *int64
+	if s, ok := opts["contention"]; ok {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contention %q: %w", s, err)
+		}
+		contention = &n
+		delete(opts, "contention")
+	}
+
+	switch queryType {
+	case "none":
+		if contention != nil {
+			return nil, fmt.Errorf("option %q is not valid for query type %q", "contention", queryType)
+		}
+		if err := checkNoOptions(queryType, opts); err != nil {
+			return nil, err
+		}
+		return bson.D{
+			{Key: "path", Value: path},
+			{Key: "bsonType", Value: bsonType},
+			{Key: "keyId", Value: nil},
+		}, nil
+	case options.QueryTypeEquality:
+		if err := checkNoOptions(queryType, opts); err != nil {
+			return nil, err
+		}
+		queries := bson.D{{Key: "queryType", Value: options.QueryTypeEquality}}
+		if contention != nil {
+			queries = append(queries, bson.E{Key: "contention", Value: *contention})
+		}
+		return bson.D{
+			{Key: "path", Value: path},
+			{Key: "bsonType", Value: bsonType},
+			{Key: "keyId", Value: nil},
+			{Key: "queries", Value: queries},
+		}, nil
+	case options.QueryTypeRange:
+		ro, err := rangeOptionsFromTag(bsonType, opts)
+		if err != nil {
+			return nil, err
+		}
+		return RangeField{Path: path, BSONType: bsonType, Contention: contention, Range: ro}, nil
+	}
+	return nil, fmt.Errorf("unknown query type %q", queryType)
+}
+
+func checkNoOptions(queryType string, opts map[string]string) error {
+	for key := range opts {
+		return fmt.Errorf("option %q is not valid for query type %q", key, queryType)
+	}
+	return nil
+}
+
+func rangeOptionsFromTag(bsonType string, opts map[string]string) (*options.RangeOptionsBuilder, error) {
+	ro := options.Range()
+	for key, s := range opts {
+		switch key {
+		case "min", "max":
+			val, err := rangeBound(bsonType, s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", key, s, err)
+			}
+			if key == "min" {
+				ro.SetMin(val)
+			} else {
+				ro.SetMax(val)
+			}
+		case "sparsity":
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid sparsity %q: %w", s, err)
+			}
+			ro.SetSparsity(n)
+		case "precision", "trimFactor":
+			n, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", key, s, err)
+			}
+			if key == "precision" {
+				ro.SetPrecision(int32(n))
+			} else {
+				ro.SetTrimFactor(int32(n))
+			}
+		default:
+			return nil, fmt.Errorf("option %q is not valid for query type %q", key, options.QueryTypeRange)
+		}
+	}
+	return ro, nil
+}
+
+// rangeBound parses s as a range index bound of the given BSON type.
+func rangeBound(bsonType, s string) (bson.RawValue, error) {
+	var v any
+	switch bsonType {
+	case "int":
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return bson.RawValue{}, err
+		}
+		v = int32(n)
+	case "long":
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return bson.RawValue{}, err
+		}
+		v = n
+	case "double":
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return bson.RawValue{}, err
+		}
+		v = f
+	case "decimal":
+		d, err := bson.ParseDecimal128(s)
+		if err != nil {
+			return bson.RawValue{}, err
+		}
+		v = d
+	case "date":
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return bson.RawValue{}, err
+		}
+		v = bson.NewDateTimeFromTime(tm)
+	default:
+		return bson.RawValue{}, fmt.Errorf("BSON type %q does not support range queries", bsonType)
+	}
+
+	t, data, err := bson.MarshalValue(v)
+	if err != nil {
+		return bson.RawValue{}, err
+	}
+	return bson.RawValue{Type: t, Value: data}, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestEncryptedFieldsFromStruct(t *testing.T) {
+	t.Parallel()
+
+	type address struct {
+		Street string `bson:"street" encrypt:"none"`
+		City   string `bson:"city"`
+	}
+	type patient struct {
+		Name     string    `bson:"name"`
+		SSN      string    `bson:"ssn" encrypt:"equality,contention=4"`
+		Age      int32     `bson:"age" encrypt:"range,min=0,max=150,sparsity=1"`
+		Admitted time.Time `bson:"admitted" encrypt:"range,min=2000-01-01T00:00:00Z,max=2100-01-01T00:00:00Z"`
+		Address  *address  `bson:"address"`
+		Internal string    `bson:"-" encrypt:"equality"`
+	}
+
+	doc, err := EncryptedFieldsFromStruct(&patient{})
+	require.NoError(t, err)
+
+	got, err := bson.Marshal(doc)
+	require.NoError(t, err)
+	want := `{"fields": [` +
+		`{"path": "ssn","bsonType": "string","keyId": null,` +
+		`"queries": {"queryType": "equality","contention": {"$numberLong":"4"}}},` +
+		`{"path": "age","bsonType": "int","keyId": null,` +
+		`"queries": {"queryType": "range","min": {"$numberInt":"0"},"max": {"$numberInt":"150"},"sparsity": {"$numberLong":"1"}}},` +
+		`{"path": "admitted","bsonType": "date","keyId": null,` +
+		`"queries": {"queryType": "range","min": {"$date":{"$numberLong":"946684800000"}},"max": {"$date":{"$numberLong":"4102444800000"}}}},` +
+		`{"path": "address.street","bsonType": "string","keyId": null}]}`
+	assert.Equal(t, want, bson.Raw(got).String())
+}
+
+func TestEncryptedFieldsFromStructErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		val  any
+	}{
+		{"not a struct", "patient"},
+		{"int field", struct {
+			Age int `encrypt:"range"`
+		}{}},
+		{"unknown query type", struct {
+			SSN string `encrypt:"prefix"`
+		}{}},
+		{"range option on equality", struct {
+			SSN string `encrypt:"equality,min=0"`
+		}{}},
+		{"contention on none", struct {
+			SSN string `encrypt:"none,contention=2"`
+		}{}},
+		{"invalid bound", struct {
+			Age int32 `encrypt:"range,min=young"`
+		}{}},
+		{"malformed option", struct {
+			Age int32 `encrypt:"range,sparsity"`
+		}{}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := EncryptedFieldsFromStruct(tc.val)
+			assert.Error(t, err)
+		})
+	}
+
+	// Range queries are not supported on strings, which is reported when the
+	// document is marshaled.
+	doc, err := EncryptedFieldsFromStruct(struct {
+		Name string `encrypt:"range"`
+	}{})
+	require.NoError(t, err)
+	_, err = bson.Marshal(doc)
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultEnsureIndexesConcurrency = 8
+
+// Namespace identifies a collection by the names of its database and
+// collection.
+type Namespace struct {
+	Database   string
+	Collection string
+}
+
+// String returns the namespace in "database.collection" form.
+func (ns Namespace) String() string {
+	return ns.Database + "." + ns.Collection
+}
+
+// EnsureIndexesResult is the result of creating the indexes of a namespace with
+// Client.EnsureIndexesForAll.
+type EnsureIndexesResult struct {
+	// Names are the names of the indexes, in the order of the index models.
+	Names []string
+
+	// Err is the error returned when creating the indexes, if any.
+	Err error
+}
+
+// EnsureIndexesError is returned by Client.EnsureIndexesForAll if the indexes
+// of some namespaces could not be created.
+type EnsureIndexesError struct {
+	// Failed contains the namespaces whose indexes could not be created, in
+	// sorted order. The error for each namespace is in the results returned by
+	// EnsureIndexesForAll.
+	Failed []Namespace
+
+	// Total is the number of namespaces passed to EnsureIndexesForAll.
+	Total int
+}
+
+// Error implements the error interface.
+func (e EnsureIndexesError) Error() string {
+	return fmt.Sprintf("failed to ensure indexes for %d of %d namespaces, including %s",
+		len(e.Failed), e.Total, e.Failed[0])
+}
+
+// EnsureIndexesForAll creates the given indexes for each namespace, running up
+// to a configurable number of createIndexes commands concurrently. It is meant
+// for deployments, such as multi-tenant systems, that keep the same indexes on
+// the collections of many databases:
+//
+//	indexes := make(map[mongo.Namespace][]mongo.IndexModel)
+//	for _, tenant := range tenants {
+//		indexes[mongo.Namespace{Database: tenant, Collection: "orders"}] = orderIndexes
+//	}
+//	results, err := client.EnsureIndexesForAll(ctx, indexes)
+//
+// Creating an index that already exists with the same options does nothing, so
+// EnsureIndexesForAll can be run every time an application starts. Collections
+// that do not exist are created.
+//
+// EnsureIndexesForAll returns a result for every namespace. If the indexes of
+// any namespace could not be created, it also returns an EnsureIndexesError
+// listing the failed namespaces. The indexes of the other namespaces are still
+// created. Namespaces that have not been started when ctx is done fail with the
+// error of ctx.
+//
+// The opts parameter can be used to specify options for the operation (see the
+// options.EnsureIndexesOptions documentation).
+func (c *Client) EnsureIndexesForAll(
+	ctx context.Context,
+	indexes map[Namespace][]IndexModel,
+	opts ...options.Lister[options.EnsureIndexesOptions],
+) (map[Namespace]EnsureIndexesResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	args, err := mongoutil.NewOptions[options.EnsureIndexesOptions](opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+
+	concurrency := defaultEnsureIndexesConcurrency
+	if args.Concurrency != nil {
+		if *args.Concurrency < 1 {
+			return nil, fmt.Errorf("concurrency must be at least 1, got %d", *args.Concurrency)
+		}
+		concurrency = *args.Concurrency
+	}
+
+	namespaces := make([]Namespace, 0, len(indexes))
+	for ns := range indexes {
+		if ns.Database == "" || ns.Collection == "" {
+			return nil, fmt.Errorf("invalid namespace %q: database and collection names are required", ns)
+		}
+		if len(indexes[ns]) == 0 {
+			return nil, fmt.Errorf("no indexes specified for namespace %q", ns)
+		}
+		namespaces = append(namespaces, ns)
+	}
+	sort.Slice(namespaces, func(i, j int) bool {
+		if namespaces[i].Database != namespaces[j].Database {
+			return namespaces[i].Database < namespaces[j].Database
+		}
+		return namespaces[i].Collection < namespaces[j].Collection
+	})
+
+	createOpts := options.CreateIndexes()
+	if args.CommitQuorum != nil {
+		createOpts.Opts = append(createOpts.Opts, func(opts *options.CreateIndexesOptions) error {
+			opts.CommitQuorum = args.CommitQuorum
+			return nil
+		})
+	}
+
+	var mu sync.Mutex
+	results := make(map[Namespace]EnsureIndexesResult, len(namespaces))
+
+	var group errgroup.Group
+	group.SetLimit(concurrency)
+	for _, ns := range namespaces {
+		ns := ns
+		group.Go(func() error {
+			var res EnsureIndexesResult
+			if err := ctx.Err(); err != nil {
+				res.Err = err
+			} else {
+				iv := c.Database(ns.Database).Collection(ns.Collection).Indexes()
+				res.Names, res.Err = iv.CreateMany(ctx, indexes[ns], createOpts)
+			}
+
+			mu.Lock()
+			results[ns] = res
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	var failed []Namespace
+	for _, ns := range namespaces {
+		if results[ns].Err != nil {
+			failed = append(failed, ns)
+		}
+	}
+	if len(failed) > 0 {
+		return results, EnsureIndexesError{Failed: failed, Total: len(namespaces)}
+	}
+	return results, nil
+}
@@ -0,0 +1,79 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestEnsureIndexesForAll(t *testing.T) {
+	client := setupClient()
+	models := []IndexModel{{Keys: bson.D{{"x", 1}}}}
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			indexes map[Namespace][]IndexModel
+			opts    *options.EnsureIndexesOptionsBuilder
+			err     string
+		}{
+			{
+				"zero concurrency",
+				map[Namespace][]IndexModel{{"db", "coll"}: models},
+				options.EnsureIndexes().SetConcurrency(0),
+				"concurrency must be at least 1, got 0",
+			},
+			{
+				"missing collection",
+				map[Namespace][]IndexModel{{"db", ""}: models},
+				options.EnsureIndexes(),
+				`invalid namespace "db.": database and collection names are required`,
+			},
+			{
+				"no indexes",
+				map[Namespace][]IndexModel{{"db", "coll"}: nil},
+				options.EnsureIndexes(),
+				`no indexes specified for namespace "db.coll"`,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				results, err := client.EnsureIndexesForAll(context.Background(), tc.indexes, tc.opts)
+				assert.EqualError(t, err, tc.err)
+				assert.Nil(t, results)
+			})
+		}
+	})
+	t.Run("context done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		indexes := map[Namespace][]IndexModel{
+			{"db2", "coll"}: models,
+			{"db1", "coll"}: models,
+		}
+		results, err := client.EnsureIndexesForAll(ctx, indexes, options.EnsureIndexes().SetConcurrency(1))
+
+		var ensureErr EnsureIndexesError
+		require.True(t, errors.As(err, &ensureErr), "expected EnsureIndexesError, got %v", err)
+		assert.Equal(t, []Namespace{{"db1", "coll"}, {"db2", "coll"}}, ensureErr.Failed)
+		assert.Equal(t, 2, ensureErr.Total)
+		assert.EqualError(t, err, "failed to ensure indexes for 2 of 2 namespaces, including db1.coll")
+
+		require.Len(t, results, 2)
+		for ns, res := range results {
+			assert.ErrorIs(t, res.Err, context.Canceled, "expected context error for %s", ns)
+		}
+	})
+}
@@ -17,6 +17,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/codecutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mongocrypt"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
@@ -65,6 +66,26 @@ func (e ErrMapForOrderedArgument) Error() string {
 	return fmt.Sprintf("multi-key map passed in for ordered parameter %v", e.ParamName)
 }
 
+// ReadOnlyError is returned when a write operation is run with a Client that was
+// created with options.ClientOptions.SetReadOnly(true). The operation is not
+// sent to the server.
+type ReadOnlyError struct {
+	// Operation is the name of the rejected command, e.g. "insert".
+	Operation string
+
+	// Namespace is the "database.collection" namespace or database name the
+	// operation would have written to. It is empty for Client.BulkWrite.
+	Namespace string
+}
+
+// Error implements the error interface.
+func (e ReadOnlyError) Error() string {
+	if e.Namespace == "" {
+		return fmt.Sprintf("cannot run write operation %q: client is read-only", e.Operation)
+	}
+	return fmt.Sprintf("cannot run write operation %q on namespace %q: client is read-only", e.Operation, e.Namespace)
+}
+
 // wrapErrors wraps error types and values that are defined in "internal" and
 // "x" packages with error types and values that are defined in this package.
 // That allows users to inspect the errors using errors.Is/errors.As without
@@ -327,6 +348,23 @@ func (e MongocryptdError) Unwrap() error {
 	return e.Wrapped
 }
 
+// CursorTimeoutError wraps a timeout error returned by an operation that creates a cursor, or by the cursor itself,
+// when the operation was configured with a TimeoutMode. It reports the mode that determined the deadline that expired.
+type CursorTimeoutError struct {
+	Mode    options.TimeoutMode
+	Wrapped error
+}
+
+// Error implements the error interface.
+func (e CursorTimeoutError) Error() string {
+	return fmt.Sprintf("%v (timeout mode %q)", e.Wrapped, e.Mode)
+}
+
+// Unwrap returns the underlying error.
+func (e CursorTimeoutError) Unwrap() error {
+	return e.Wrapped
+}
+
 // LabeledError is an interface for errors with labels.
 type LabeledError interface {
 	error
@@ -520,6 +558,22 @@ func (we WriteErrors) Error() string {
 	return "write errors: " + joinBatchErrors(errs)
 }
 
+// responsesFromDriverWriteCommandError returns the server responses of the
+// batches that failed with wce.
+func responsesFromDriverWriteCommandError(wce driver.WriteCommandError) []bson.Raw {
+	if len(wce.Responses) == 0 {
+		if wce.Raw == nil {
+			return nil
+		}
+		return []bson.Raw{bson.Raw(wce.Raw)}
+	}
+	responses := make([]bson.Raw, 0, len(wce.Responses))
+	for _, res := range wce.Responses {
+		responses = append(responses, bson.Raw(res))
+	}
+	return responses
+}
+
 func writeErrorsFromDriverWriteErrors(errs driver.WriteErrors) WriteErrors {
 	wes := make(WriteErrors, 0, len(errs))
 	for _, err := range errs {
@@ -687,6 +741,12 @@ type BulkWriteException struct {
 
 	// The categories to which the exception belongs.
 	Labels []string
+
+	// The original server responses of the batches that failed, in the order
+	// in which they were sent. The responses are not copied, so the Raw and
+	// Details fields of WriteErrors and WriteConcernError reference the same
+	// memory.
+	Responses []bson.Raw
 }
 
 // Error implements the error interface.
@@ -16,6 +16,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
 )
@@ -760,3 +761,26 @@ func (n netErr) Temporary() bool {
 }
 
 var _ net.Error = (*netErr)(nil)
+
+func TestResponsesFromDriverWriteCommandError(t *testing.T) {
+	first := bsoncore.NewDocumentBuilder().AppendInt32("ok", 1).AppendInt32("n", 1).Build()
+	second := bsoncore.NewDocumentBuilder().AppendInt32("ok", 1).AppendInt32("n", 2).Build()
+
+	t.Run("no responses", func(t *testing.T) {
+		assert.Nil(t, responsesFromDriverWriteCommandError(driver.WriteCommandError{}))
+	})
+	t.Run("raw only", func(t *testing.T) {
+		got := responsesFromDriverWriteCommandError(driver.WriteCommandError{Raw: first})
+		require.Len(t, got, 1)
+		assert.Equal(t, bson.Raw(first), got[0])
+	})
+	t.Run("responses are not copied", func(t *testing.T) {
+		got := responsesFromDriverWriteCommandError(driver.WriteCommandError{
+			Raw:       second,
+			Responses: []bsoncore.Document{first, second},
+		})
+		require.Len(t, got, 2)
+		assert.True(t, &got[0][0] == &first[0], "expected first response to share memory with the server response")
+		assert.True(t, &got[1][0] == &second[0], "expected second response to share memory with the server response")
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/fault"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+)
+
+// commandName returns the name of cmd, which is the key of its first element.
+func commandName(cmd bsoncore.Document) string {
+	elem, err := cmd.IndexErr(0)
+	if err != nil {
+		return ""
+	}
+	return elem.Key()
+}
+
+// runOperation runs execute, which executes the operation with the given
+// command name and namespace. If the Client accounts for its memory, execute
+// is delayed while the accounted memory is above the soft limit. The retry
+// policy, command interceptors, memory accountant and pinned deployment of the
+// Client are set on the operation by execute itself.
+func (c *Client) runOperation(
+	ctx context.Context,
+	name, ns string,
+	execute func(context.Context) error,
+) error {
+	if err := c.memory.wait(ctx); err != nil {
+		return err
+	}
+	if err := c.injectFault(ctx, name, ns); err != nil {
+		return err
+	}
+	return execute(ctx)
+}
+
+// injectFault returns the error injected by the fault injector of the Client
+// into the operation with the given command name and namespace, after any
+// injected delay, or nil if no error is injected. The returned error is
+// processed like an error returned by the server, so it must be passed through
+// the same error handling as the error of the operation.
+func (c *Client) injectFault(ctx context.Context, name, ns string) error {
+	if c.faultInjector == nil {
+		return nil
+	}
+	f := c.faultInjector.Inject(ctx, fault.Operation{Name: name, Namespace: ns})
+	if f == nil {
+		return nil
+	}
+
+	if f.Delay > 0 {
+		timer := time.NewTimer(f.Delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+	switch {
+	case f.Drop:
+		return driver.Error{
+			Message: "network error",
+			Labels:  []string{driver.NetworkError},
+			Wrapped: fault.ErrDropped,
+		}
+	case f.Err != nil:
+		return f.Err
+	}
+	return nil
+}
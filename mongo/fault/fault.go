@@ -0,0 +1,168 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package fault injects delays and errors into the operations run by a
+// mongo.Client, so that the resilience of an application can be tested against
+// a real server or a fake without configuring failpoints on the server:
+//
+//	injector := fault.NewRules(1,
+//		// Slow down 10% of all finds.
+//		fault.Rule{
+//			Operations: []string{"find"},
+//			Percentage: 10,
+//			Fault:      fault.Fault{Delay: 500 * time.Millisecond},
+//		},
+//		// Drop 1% of the writes to the orders collection.
+//		fault.Rule{
+//			Operations: []string{"insert", "update", "delete"},
+//			Namespaces: []string{"app.orders"},
+//			Percentage: 1,
+//			Fault:      fault.Fault{Drop: true},
+//		},
+//	)
+//	client, err := mongo.Connect(options.Client().ApplyURI(uri).SetFaultInjector(injector))
+//
+// Faults are injected before an operation is sent to the server, so a dropped
+// or failed operation has no effect on the server and is not retried by the
+// driver.
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+)
+
+// ErrDropped is wrapped by the error returned by an operation that was dropped
+// by an Injector.
+var ErrDropped = errors.New("operation dropped by fault injector")
+
+// Operation describes an operation that is about to be run.
+type Operation struct {
+	// Name is the name of the command, such as "find", "insert", or
+	// "aggregate".
+	Name string
+
+	// Namespace is the "database.collection" namespace or database name the
+	// operation runs against. It is empty for operations that do not run
+	// against a database, such as Client.BulkWrite and transaction commits.
+	Namespace string
+}
+
+// Fault describes a fault injected into an operation.
+type Fault struct {
+	// Delay delays the operation. The delay is interrupted if the Context of
+	// the operation is done, in which case the operation returns the error of
+	// the Context.
+	Delay time.Duration
+
+	// Drop causes the operation to fail with a network error that wraps
+	// ErrDropped, as if the connection to the server had been lost.
+	// mongo.IsNetworkError reports true for the returned error.
+	Drop bool
+
+	// Err, if set, is returned by the operation. It is ignored if Drop is set.
+	Err error
+}
+
+// Injector decides which faults are injected into operations. Implementations
+// must be safe for concurrent use.
+type Injector interface {
+	// Inject returns the fault to inject into op, or nil to run op normally.
+	Inject(ctx context.Context, op Operation) *Fault
+}
+
+// InjectorFunc is an adapter that allows a function to be used as an Injector.
+type InjectorFunc func(context.Context, Operation) *Fault
+
+var _ Injector = InjectorFunc(nil)
+
+// Inject implements the Injector interface.
+func (f InjectorFunc) Inject(ctx context.Context, op Operation) *Fault {
+	return f(ctx, op)
+}
+
+// Rule injects a fault into a percentage of the operations it matches.
+type Rule struct {
+	// Operations contains the command names the rule matches. If empty, the
+	// rule matches all operations.
+	Operations []string
+
+	// Namespaces contains path.Match patterns for the namespaces the rule
+	// matches, such as "app.*". If empty, the rule matches all namespaces.
+	Namespaces []string
+
+	// Percentage is the percentage, between 0 and 100, of matching operations
+	// the fault is injected into.
+	Percentage float64
+
+	// Fault is the fault to inject.
+	Fault Fault
+}
+
+func (r *Rule) matches(op Operation) bool {
+	if len(r.Operations) > 0 && !contains(r.Operations, op.Name) {
+		return false
+	}
+	if len(r.Namespaces) == 0 {
+		return true
+	}
+	for _, pattern := range r.Namespaces {
+		if ok, _ := path.Match(pattern, op.Namespace); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Rules is an Injector that injects the fault of the first Rule that matches an
+// operation and is selected by its percentage.
+type Rules struct {
+	rules []Rule
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+var _ Injector = &Rules{}
+
+// NewRules creates a Rules Injector. The seed seeds the random selection of
+// operations, so runs that perform the same sequence of operations inject the
+// same faults.
+func NewRules(seed int64, rules ...Rule) *Rules {
+	return &Rules{rules: rules, rng: rand.New(rand.NewSource(seed))}
+}
+
+// Inject implements the Injector interface.
+func (r *Rules) Inject(_ context.Context, op Operation) *Fault {
+	for i := range r.rules {
+		rule := &r.rules[i]
+		if !rule.matches(op) {
+			continue
+		}
+
+		r.mu.Lock()
+		selected := r.rng.Float64()*100 < rule.Percentage
+		r.mu.Unlock()
+		if selected {
+			f := rule.Fault
+			return &f
+		}
+	}
+	return nil
+}
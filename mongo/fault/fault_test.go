@@ -0,0 +1,72 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+)
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("boom")
+	rules := NewRules(1,
+		Rule{
+			Operations: []string{"find"},
+			Namespaces: []string{"app.*"},
+			Percentage: 100,
+			Fault:      Fault{Delay: time.Second},
+		},
+		Rule{
+			Operations: []string{"insert"},
+			Percentage: 0,
+			Fault:      Fault{Drop: true},
+		},
+		Rule{
+			Namespaces: []string{"billing.invoices"},
+			Percentage: 100,
+			Fault:      Fault{Err: errBoom},
+		},
+	)
+	ctx := context.Background()
+
+	assert.Equal(t, &Fault{Delay: time.Second}, rules.Inject(ctx, Operation{Name: "find", Namespace: "app.users"}))
+	assert.Nil(t, rules.Inject(ctx, Operation{Name: "find", Namespace: "other.users"}))
+	assert.Nil(t, rules.Inject(ctx, Operation{Name: "insert", Namespace: "app.users"}))
+	assert.Equal(t, &Fault{Err: errBoom}, rules.Inject(ctx, Operation{Name: "insert", Namespace: "billing.invoices"}))
+}
+
+func TestRulesPercentage(t *testing.T) {
+	t.Parallel()
+
+	newRules := func() *Rules {
+		return NewRules(42, Rule{Percentage: 25, Fault: Fault{Drop: true}})
+	}
+	run := func(r *Rules) []bool {
+		selected := make([]bool, 1000)
+		for i := range selected {
+			selected[i] = r.Inject(context.Background(), Operation{Name: "find"}) != nil
+		}
+		return selected
+	}
+
+	first := run(newRules())
+	assert.Equal(t, first, run(newRules()), "expected runs with the same seed to inject the same faults")
+
+	var n int
+	for _, s := range first {
+		if s {
+			n++
+		}
+	}
+	assert.True(t, n > 200 && n < 300, "expected about 25%% of operations to be selected, got %d of 1000", n)
+}
@@ -0,0 +1,71 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/mongo/fault"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestFaultInjector(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	var mu sync.Mutex
+	var ops []fault.Operation
+	injector := fault.InjectorFunc(func(_ context.Context, op fault.Operation) *fault.Fault {
+		mu.Lock()
+		ops = append(ops, op)
+		mu.Unlock()
+
+		switch op.Name {
+		case "insert":
+			return &fault.Fault{Drop: true}
+		case "delete":
+			return &fault.Fault{Err: errBoom}
+		case "find":
+			return &fault.Fault{Delay: time.Hour}
+		}
+		return &fault.Fault{Err: errBoom}
+	})
+
+	client := setupClient(options.Client().
+		ApplyURI("mongodb://localhost:1").
+		SetServerSelectionTimeout(10 * time.Millisecond).
+		SetFaultInjector(injector))
+	coll := client.Database("app").Collection("orders")
+
+	_, err := coll.InsertOne(context.Background(), bson.D{{Key: "x", Value: 1}})
+	assert.True(t, IsNetworkError(err), "expected a network error, got %v", err)
+	assert.ErrorIs(t, err, fault.ErrDropped)
+
+	_, err = coll.DeleteMany(context.Background(), bson.D{})
+	assert.ErrorIs(t, err, errBoom)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = coll.Find(ctx, bson.D{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	err = client.Database("app").RunCommand(context.Background(), bson.D{{Key: "count", Value: "orders"}}).Err()
+	assert.ErrorIs(t, err, errBoom)
+
+	want := []fault.Operation{
+		{Name: "insert", Namespace: "app.orders"},
+		{Name: "delete", Namespace: "app.orders"},
+		{Name: "find", Namespace: "app.orders"},
+		{Name: "count", Namespace: "app.orders"},
+	}
+	assert.Equal(t, want, ops)
+}
@@ -0,0 +1,206 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/internal/csot"
+	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
+)
+
+// Feature is a server feature that is available starting with a minimum wire protocol version. The wire version of a
+// server is reported in its hello response as maxWireVersion and increases with each server release, e.g. 17 for
+// MongoDB 6.0, 21 for 7.0, and 25 for 8.0.
+type Feature struct {
+	// Name describes the feature in errors.
+	Name string
+
+	// MinWireVersion is the minimum maxWireVersion of a server that supports the feature.
+	MinWireVersion int32
+}
+
+// String implements the fmt.Stringer interface.
+func (f Feature) String() string {
+	return fmt.Sprintf("%s (maxWireVersion %d)", f.Name, f.MinWireVersion)
+}
+
+// These are server features that commonly require alternative implementations in applications that support several
+// server versions.
+var (
+	// FeatureClientBulkWrite is the bulkWrite command used by Client.BulkWrite, available in MongoDB 8.0.
+	FeatureClientBulkWrite = Feature{Name: "bulkWrite command", MinWireVersion: 25}
+
+	// FeatureQueryableEncryption is Queryable Encryption, available in MongoDB 7.0.
+	FeatureQueryableEncryption = Feature{Name: "Queryable Encryption", MinWireVersion: 21}
+
+	// FeatureChangeStreamPreAndPostImages is change stream pre- and post-images, available in MongoDB 6.0.
+	FeatureChangeStreamPreAndPostImages = Feature{Name: "change stream pre- and post-images", MinWireVersion: 17}
+
+	// FeatureSnapshotReads is snapshot reads outside of transactions, available in MongoDB 5.0.
+	FeatureSnapshotReads = Feature{Name: "snapshot reads", MinWireVersion: 13}
+
+	// FeatureTimeSeriesCollections is time series collections, available in MongoDB 5.0.
+	FeatureTimeSeriesCollections = Feature{Name: "time series collections", MinWireVersion: 13}
+)
+
+// ErrNoCompatibleImplementation is returned by Dispatcher.Select if the deployment supports none of the features of
+// the registered implementations and there is no fallback.
+var ErrNoCompatibleImplementation = errors.New("no implementation is compatible with the deployment")
+
+// SupportsFeature returns whether every server in the deployment supports feature. A server must be selectable for
+// writes, so SupportsFeature blocks until the Client has connected or server selection times out.
+//
+// In a mixed-version deployment, such as a sharded cluster or replica set that is being upgraded, a feature is only
+// reported as supported once all known servers support it, so that an operation using the feature succeeds regardless
+// of the server it is sent to.
+func (c *Client) SupportsFeature(ctx context.Context, feature Feature) (bool, error) {
+	wv, err := c.minWireVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+	return wv >= feature.MinWireVersion, nil
+}
+
+// minWireVersion returns the lowest maxWireVersion of the servers in the
+// deployment.
+func (c *Client) minWireVersion(ctx context.Context) (int32, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := csot.WithServerSelectionTimeout(ctx, c.deployment.GetServerSelectionTimeout())
+	defer cancel()
+
+	server, err := c.deployment.SelectServer(ctx, &serverselector.Write{})
+	if err != nil {
+		return 0, fmt.Errorf("error selecting server to check maxWireVersion: %w", err)
+	}
+
+	if topo, ok := c.deployment.(*topology.Topology); ok {
+		if wv, ok := minServerWireVersion(topo.Description().Servers); ok {
+			return wv, nil
+		}
+	}
+
+	// The server descriptions are not available, e.g. behind a load balancer,
+	// so use the version of the selected server.
+	conn, err := server.Connection(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error getting connection to check maxWireVersion: %w", err)
+	}
+	defer conn.Close()
+
+	wv := conn.Description().WireVersion
+	if wv == nil {
+		return 0, errors.New("server did not report a maxWireVersion")
+	}
+	return wv.Max, nil
+}
+
+// minServerWireVersion returns the lowest maxWireVersion of the servers that
+// have reported one.
+func minServerWireVersion(servers []description.Server) (int32, bool) {
+	var lowest int32
+	var found bool
+	for _, s := range servers {
+		if s.Kind == description.ServerKindLoadBalancer || s.WireVersion == nil {
+			continue
+		}
+		if !found || s.WireVersion.Max < lowest {
+			lowest = s.WireVersion.Max
+			found = true
+		}
+	}
+	return lowest, found
+}
+
+// Dispatcher chooses between alternative implementations of an operation based on the features supported by the
+// deployment. Implementations are values of type F, which is typically a function type. For example, to insert
+// documents into several collections with a single bulkWrite command when it is available:
+//
+//	type writeFn func(ctx context.Context, client *mongo.Client, writes []Write) error
+//
+//	writeAll := mongo.NewDispatcher[writeFn]().
+//		Register(mongo.FeatureClientBulkWrite, writeWithClientBulkWrite).
+//		Fallback(writeWithCollectionBulkWrites)
+//
+//	write, err := writeAll.Select(ctx, client)
+//	if err != nil {
+//		return err
+//	}
+//	return write(ctx, client, writes)
+//
+// A Dispatcher is typically created once, when a package is initialized, and is safe for concurrent use by Select
+// once it is configured.
+type Dispatcher[F any] struct {
+	impls       []dispatchImpl[F]
+	fallback    F
+	hasFallback bool
+}
+
+type dispatchImpl[F any] struct {
+	feature Feature
+	impl    F
+}
+
+// NewDispatcher creates a Dispatcher with no implementations.
+func NewDispatcher[F any]() *Dispatcher[F] {
+	return &Dispatcher[F]{}
+}
+
+// Register adds impl as an implementation that requires feature. Implementations are preferred in the order in which
+// they are registered, so the implementation that requires the newest feature should be registered first.
+func (d *Dispatcher[F]) Register(feature Feature, impl F) *Dispatcher[F] {
+	d.impls = append(d.impls, dispatchImpl[F]{feature: feature, impl: impl})
+	return d
+}
+
+// Fallback sets the implementation used if the deployment supports none of the registered features.
+func (d *Dispatcher[F]) Fallback(impl F) *Dispatcher[F] {
+	d.fallback = impl
+	d.hasFallback = true
+	return d
+}
+
+// Select returns the first registered implementation whose feature is supported by the deployment client is
+// connected to, or the fallback implementation if there is none. If no implementation is compatible and there is no
+// fallback, an error wrapping ErrNoCompatibleImplementation is returned.
+//
+// The deployment is checked on every call, so that the implementation changes when the deployment is upgraded.
+// Select does not run a command; it uses the server versions known to the Client.
+func (d *Dispatcher[F]) Select(ctx context.Context, client *Client) (F, error) {
+	wv, err := client.minWireVersion(ctx)
+	if err != nil {
+		var zero F
+		return zero, err
+	}
+	return d.selectFor(wv)
+}
+
+// selectFor returns the implementation to use with servers whose lowest
+// maxWireVersion is wv.
+func (d *Dispatcher[F]) selectFor(wv int32) (F, error) {
+	for _, impl := range d.impls {
+		if wv >= impl.feature.MinWireVersion {
+			return impl.impl, nil
+		}
+	}
+	if d.hasFallback {
+		return d.fallback, nil
+	}
+
+	var zero F
+	if len(d.impls) == 0 {
+		return zero, fmt.Errorf("%w: no implementations are registered", ErrNoCompatibleImplementation)
+	}
+	return zero, fmt.Errorf("%w: maxWireVersion %d does not support %v", ErrNoCompatibleImplementation, wv,
+		d.impls[len(d.impls)-1].feature)
+}
@@ -0,0 +1,93 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+)
+
+func TestMinServerWireVersion(t *testing.T) {
+	server := func(kind description.ServerKind, maxWireVersion int32) description.Server {
+		return description.Server{
+			Kind:        kind,
+			WireVersion: &description.VersionRange{Min: 0, Max: maxWireVersion},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		servers []description.Server
+		want    int32
+		wantOK  bool
+	}{
+		{"no servers", nil, 0, false},
+		{"unknown servers", []description.Server{{Kind: description.Unknown}}, 0, false},
+		{"load balancer", []description.Server{server(description.ServerKindLoadBalancer, 25)}, 0, false},
+		{"single server", []description.Server{server(description.ServerKindStandalone, 21)}, 21, true},
+		{
+			"mixed versions",
+			[]description.Server{
+				server(description.ServerKindRSPrimary, 25),
+				server(description.ServerKindRSSecondary, 21),
+				{Kind: description.Unknown},
+				server(description.ServerKindRSSecondary, 25),
+			},
+			21,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := minServerWireVersion(tc.servers)
+			assert.Equal(t, tc.wantOK, ok, "expected ok %v, got %v", tc.wantOK, ok)
+			assert.Equal(t, tc.want, got, "expected wire version %d, got %d", tc.want, got)
+		})
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	d := NewDispatcher[string]().
+		Register(FeatureClientBulkWrite, "client bulkWrite").
+		Register(FeatureChangeStreamPreAndPostImages, "pre-images").
+		Fallback("fallback")
+
+	testCases := []struct {
+		name        string
+		wireVersion int32
+		want        string
+	}{
+		{"newest feature", 25, "client bulkWrite"},
+		{"newer than every feature", 30, "client bulkWrite"},
+		{"older feature", 21, "pre-images"},
+		{"exact minimum", 17, "pre-images"},
+		{"fallback", 13, "fallback"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := d.selectFor(tc.wireVersion)
+			require.NoError(t, err, "selectFor error")
+			assert.Equal(t, tc.want, got, "expected %q, got %q", tc.want, got)
+		})
+	}
+
+	t.Run("no compatible implementation", func(t *testing.T) {
+		d := NewDispatcher[func() int]().Register(FeatureClientBulkWrite, func() int { return 1 })
+
+		got, err := d.selectFor(21)
+		assert.ErrorIs(t, err, ErrNoCompatibleImplementation)
+		assert.ErrorContains(t, err, "maxWireVersion 21 does not support bulkWrite command (maxWireVersion 25)")
+		assert.Nil(t, got, "expected no implementation")
+	})
+	t.Run("no implementations", func(t *testing.T) {
+		_, err := NewDispatcher[string]().selectFor(25)
+		assert.ErrorIs(t, err, ErrNoCompatibleImplementation)
+	})
+}
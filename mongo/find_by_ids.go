@@ -0,0 +1,114 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// FindByIDs finds the documents in coll whose _id is one of ids with a single $in query and decodes them into
+// values of type T. The documents are returned in the order of ids, and missing contains the ids, in order, for which
+// no document was found. If an id appears more than once in ids, its document is returned once for each occurrence.
+// For example:
+//
+//	users, missing, err := mongo.FindByIDs[User](ctx, coll, []any{id1, id2, id3})
+//
+// Ids are matched to documents by BSON value, so an id matches a document whose _id is equal but of a different
+// numeric type, such as an int32 id and an int64 _id, as it would in a query.
+//
+// The opts parameter can be used to specify options for the Find operation (see the options.FindOptions
+// documentation). The sort, skip, and limit options should not be set because the order of the documents is
+// determined by ids. If a projection is set, it must include the _id field.
+func FindByIDs[T any](
+	ctx context.Context,
+	coll *Collection,
+	ids []any,
+	opts ...options.Lister[options.FindOptions],
+) (docs []T, missing []any, err error) {
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	keys := make([]string, len(ids))
+	unique := make([]any, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for i, id := range ids {
+		val, err := marshalValue(id, coll.bsonOpts, coll.registry)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error marshaling id %d: %w", i, err)
+		}
+		keys[i] = idKey(val)
+		if !seen[keys[i]] {
+			seen[keys[i]] = true
+			unique = append(unique, id)
+		}
+	}
+
+	cursor, err := coll.Find(ctx, bson.D{{"_id", bson.D{{"$in", unique}}}}, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+
+	found := make(map[string]T, len(unique))
+	for cursor.Next(ctx) {
+		id, err := cursor.Current.LookupErr("_id")
+		if err != nil {
+			return nil, nil, fmt.Errorf("found document without an _id; the projection must include _id: %w", err)
+		}
+		var doc T
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, nil, err
+		}
+		found[idKey(bsoncore.Value{Type: bsoncore.Type(id.Type), Data: id.Value})] = doc
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	docs = make([]T, 0, len(found))
+	for i, key := range keys {
+		if doc, ok := found[key]; ok {
+			docs = append(docs, doc)
+		} else {
+			missing = append(missing, ids[i])
+		}
+	}
+	return docs, missing, nil
+}
+
+// idKey returns a map key that is equal for equal _id values. Numbers that are
+// integers are keyed by their int64 value, so that, for example, int32(1),
+// int64(1), and 1.0 have the same key, as they match the same documents.
+func idKey(val bsoncore.Value) string {
+	var n int64
+	var ok bool
+	switch val.Type {
+	case bsoncore.TypeInt32:
+		var i32 int32
+		i32, ok = val.Int32OK()
+		n = int64(i32)
+	case bsoncore.TypeInt64:
+		n, ok = val.Int64OK()
+	case bsoncore.TypeDouble:
+		var f float64
+		f, ok = val.DoubleOK()
+		ok = ok && f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64
+		n = int64(f)
+	}
+	if ok {
+		return string(binary.LittleEndian.AppendUint64([]byte{'n'}, uint64(n)))
+	}
+	return string(byte(val.Type)) + string(val.Data)
+}
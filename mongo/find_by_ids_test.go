@@ -0,0 +1,54 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+func TestIDKey(t *testing.T) {
+	key := func(id any) string {
+		t.Helper()
+		val, err := marshalValue(id, nil, bson.NewRegistry())
+		require.NoError(t, err)
+		return idKey(val)
+	}
+
+	oid := bson.NewObjectID()
+	assert.Equal(t, key(oid), key(oid))
+	assert.NotEqual(t, key(oid), key(bson.NewObjectID()))
+	assert.Equal(t, key("a"), key("a"))
+	assert.NotEqual(t, key("a"), key("b"))
+
+	// Equal numbers match the same documents regardless of type.
+	assert.Equal(t, key(int32(1)), key(int64(1)))
+	assert.Equal(t, key(int64(1)), key(1.0))
+	assert.Equal(t, key(int32(-5)), key(-5.0))
+	assert.NotEqual(t, key(int32(1)), key(1.5))
+	assert.NotEqual(t, key(int32(1)), key("1"))
+
+	// Documents are compared by their bytes.
+	assert.Equal(t, key(bson.D{{"a", 1}}), key(bson.D{{"a", 1}}))
+	assert.NotEqual(t, key(bson.D{{"a", 1}}), key(bson.D{{"a", 2}}))
+
+	// A value from a document has the same key as the marshaled id.
+	doc := bsoncore.NewDocumentBuilder().AppendInt64("_id", 7).Build()
+	assert.Equal(t, key(int32(7)), idKey(doc.Lookup("_id")))
+}
+
+func TestFindByIDsEmpty(t *testing.T) {
+	docs, missing, err := FindByIDs[bson.D](context.Background(), nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, docs)
+	assert.Nil(t, missing)
+}
@@ -0,0 +1,64 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// getOrCreateAttempts is the number of times GetOrCreate runs the upsert. A
+// second attempt is needed when a concurrent GetOrCreate inserts the document
+// first and the upsert fails with a duplicate key error.
+const getOrCreateAttempts = 2
+
+// GetOrCreate atomically returns the document in coll that matches filter,
+// inserting newDoc if there is no such document. The returned bool is true if
+// the document was inserted. For example:
+//
+//	user, created, err := mongo.GetOrCreate[User](ctx, coll,
+//		bson.D{{"email", email}},
+//		bson.D{{"name", name}, {"createdAt", time.Now()}})
+//
+// GetOrCreate executes a findAndModify command that upserts with newDoc in a
+// $setOnInsert update and returns the document after the update, so an
+// existing document is returned unchanged. When a document is inserted, it
+// contains the equality fields of filter as well as the fields of newDoc, so
+// newDoc must not contain fields that are also in filter.
+//
+// Two concurrent upserts with the same filter can both attempt the insert. If
+// the filter fields have a unique index, one of them fails with a duplicate key
+// error, in which case GetOrCreate retries once and returns the document
+// inserted by the other. Without a unique index on the filter fields, the
+// server cannot detect the race and both calls may insert a document.
+//
+// For more information about the command, see
+// https://www.mongodb.com/docs/manual/reference/command/findAndModify/.
+func GetOrCreate[T any](ctx context.Context, coll *Collection, filter any, newDoc any) (T, bool, error) {
+	var doc T
+	if newDoc == nil {
+		return doc, false, ErrNilDocument
+	}
+
+	update := bson.D{{"$setOnInsert", newDoc}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var err error
+	for i := 0; i < getOrCreateAttempts; i++ {
+		res := coll.FindOneAndUpdate(ctx, filter, update, opts)
+		if err = res.Err(); err == nil {
+			err = res.Decode(&doc)
+			return doc, res.upserted, err
+		}
+		if !IsDuplicateKeyError(err) {
+			break
+		}
+	}
+	return doc, false, err
+}
@@ -0,0 +1,419 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/csot"
+	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
+)
+
+// HealthCheckKind identifies the check of a HealthChecker that failed.
+type HealthCheckKind string
+
+// These constants are the checks performed by a HealthChecker.
+const (
+	HealthCheckServerSelection HealthCheckKind = "serverSelection"
+	HealthCheckPoolHeadroom    HealthCheckKind = "poolHeadroom"
+	HealthCheckReplicationLag  HealthCheckKind = "replicationLag"
+)
+
+// HealthPolicy configures the checks performed by a HealthChecker. The zero
+// value only checks that a primary can be selected.
+type HealthPolicy struct {
+	// ReadPreference is the read preference that a server must be selectable
+	// for. The default is readpref.Primary().
+	ReadPreference *readpref.ReadPref
+
+	// MinPoolHeadroom is the minimum fraction, between 0 and 1, of the maximum
+	// connection pool size that must not be in use on each server. For
+	// example, 0.1 fails the check if more than 90% of the connections a pool
+	// may open are in use. It is not checked if zero or if the maximum pool
+	// size is unlimited.
+	MinPoolHeadroom float64
+
+	// MaxReplicationLag is the maximum estimated staleness of each replica set
+	// secondary, as reported by Client.SecondaryStaleness. It is not checked
+	// if zero.
+	MaxReplicationLag time.Duration
+}
+
+// HealthFailure describes a failed check of a HealthChecker.
+type HealthFailure struct {
+	// Check is the check that failed.
+	Check HealthCheckKind
+
+	// Server is the address of the server the check failed for. It is empty
+	// for checks that do not apply to a single server.
+	Server string
+
+	// Reason describes why the check failed.
+	Reason string
+}
+
+// String returns a description of the failure.
+func (hf HealthFailure) String() string {
+	if hf.Server == "" {
+		return fmt.Sprintf("%s: %s", hf.Check, hf.Reason)
+	}
+	return fmt.Sprintf("%s: %s: %s", hf.Check, hf.Server, hf.Reason)
+}
+
+// HealthError is returned by HealthChecker.Check if any check fails.
+type HealthError struct {
+	// Failures contains a HealthFailure for each failed check, ordered by check
+	// and server.
+	Failures []HealthFailure
+}
+
+// Error implements the error interface.
+func (e *HealthError) Error() string {
+	reasons := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		reasons = append(reasons, f.String())
+	}
+	return "health check failed: " + strings.Join(reasons, "; ")
+}
+
+// HealthChecker checks whether a Client is ready to serve requests against the
+// thresholds of a HealthPolicy, for example to implement a Kubernetes readiness
+// probe:
+//
+//	checker := mongo.NewHealthChecker(client, mongo.HealthPolicy{
+//		ReadPreference:    readpref.SecondaryPreferred(),
+//		MinPoolHeadroom:   0.1,
+//		MaxReplicationLag: 30 * time.Second,
+//	})
+//	http.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+//		ctx, cancel := context.WithTimeout(r.Context(), time.Second)
+//		defer cancel()
+//		if err := checker.Check(ctx); err != nil {
+//			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+//		}
+//	})
+//
+// A HealthChecker only uses server selection and the state maintained by
+// background monitoring, so it is cheap enough to run on every probe, but it
+// does not verify that connections can be established or authenticated. Use
+// Client.Health instead to report the reachability and authentication status
+// of each server, for example on a diagnostics endpoint.
+//
+// A HealthChecker is safe for concurrent use.
+type HealthChecker struct {
+	client *Client
+	policy HealthPolicy
+}
+
+// NewHealthChecker creates a HealthChecker that checks client according to
+// policy.
+func NewHealthChecker(client *Client, policy HealthPolicy) *HealthChecker {
+	if policy.ReadPreference == nil {
+		policy.ReadPreference = readpref.Primary()
+	}
+	return &HealthChecker{client: client, policy: policy}
+}
+
+// Check runs all checks of the policy and returns a *HealthError describing
+// the checks that failed, or nil if all checks pass. Checking that a server
+// can be selected does not send any commands, but it waits for a suitable
+// server until ctx is done or the server selection timeout of the Client
+// elapses. The other checks use the state of the Client that is maintained by
+// background monitoring.
+func (hc *HealthChecker) Check(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var failures []HealthFailure
+	selectCtx, cancel := csot.WithServerSelectionTimeout(ctx, hc.client.deployment.GetServerSelectionTimeout())
+	selector := &serverselector.ReadPref{ReadPref: hc.policy.ReadPreference}
+	_, err := hc.client.deployment.SelectServer(selectCtx, selector)
+	cancel()
+	if err != nil {
+		failures = append(failures, HealthFailure{
+			Check:  HealthCheckServerSelection,
+			Reason: fmt.Sprintf("no server is available for read preference %v: %v", hc.policy.ReadPreference.Mode(), err),
+		})
+	}
+
+	if topo, ok := hc.client.deployment.(*topology.Topology); ok {
+		failures = append(failures, hc.checkPools(topo.PoolStats())...)
+	}
+
+	if hc.policy.MaxReplicationLag > 0 {
+		for addr, staleness := range hc.client.SecondaryStaleness() {
+			if staleness > hc.policy.MaxReplicationLag {
+				failures = append(failures, HealthFailure{
+					Check:  HealthCheckReplicationLag,
+					Server: addr,
+					Reason: fmt.Sprintf("estimated lag %v exceeds %v", staleness, hc.policy.MaxReplicationLag),
+				})
+			}
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		if failures[i].Check != failures[j].Check {
+			return failures[i].Check < failures[j].Check
+		}
+		return failures[i].Server < failures[j].Server
+	})
+	return &HealthError{Failures: failures}
+}
+
+func (hc *HealthChecker) checkPools(stats map[string]topology.PoolStats) []HealthFailure {
+	if hc.policy.MinPoolHeadroom <= 0 {
+		return nil
+	}
+
+	var failures []HealthFailure
+	for addr, ps := range stats {
+		if ps.MaxSize == 0 {
+			continue
+		}
+		headroom := 1 - float64(ps.InUse())/float64(ps.MaxSize)
+		if headroom < hc.policy.MinPoolHeadroom {
+			failures = append(failures, HealthFailure{
+				Check:  HealthCheckPoolHeadroom,
+				Server: addr,
+				Reason: fmt.Sprintf("%d of %d connections are in use", ps.InUse(), ps.MaxSize),
+			})
+		}
+	}
+	return failures
+}
+
+// HealthAuthStatus is the authentication status of a server reported by
+// Client.Health.
+type HealthAuthStatus string
+
+// These constants are the authentication statuses reported by Client.Health.
+const (
+	// HealthAuthNotConfigured means that the Client has no credentials, so
+	// connections are not authenticated.
+	HealthAuthNotConfigured HealthAuthStatus = "notConfigured"
+
+	// HealthAuthSucceeded means that a connection to the server is
+	// authenticated.
+	HealthAuthSucceeded HealthAuthStatus = "succeeded"
+
+	// HealthAuthFailed means that authenticating a connection to the server
+	// failed.
+	HealthAuthFailed HealthAuthStatus = "failed"
+
+	// HealthAuthUnknown means that no connection to the server was
+	// authenticated, because the server is unreachable or is an arbiter or a
+	// ghost, which do not authenticate connections.
+	HealthAuthUnknown HealthAuthStatus = "unknown"
+)
+
+// ServerHealth describes the health of a server as reported by Client.Health.
+type ServerHealth struct {
+	// Address is the address of the server.
+	Address string `json:"address"`
+
+	// Kind is the kind of the server, such as "RSPrimary" or "Mongos".
+	Kind string `json:"kind"`
+
+	// Reachable is true if the last heartbeat of the server succeeded and,
+	// for servers that authenticate connections, a connection to it could
+	// be checked out or its connection pool is saturated.
+	Reachable bool `json:"reachable"`
+
+	// PoolSaturated is true if all connections of the connection pool of the
+	// server are in use, so no connection was checked out and Auth is
+	// HealthAuthUnknown.
+	PoolSaturated bool `json:"poolSaturated,omitempty"`
+
+	// Auth is the authentication status of the server.
+	Auth HealthAuthStatus `json:"auth"`
+
+	// RTT is the average round-trip time of the heartbeats of the server,
+	// or 0 if it has not been measured.
+	RTT time.Duration `json:"rtt"`
+
+	// LastHeartbeat is the time at which the description of the server was
+	// last updated.
+	LastHeartbeat time.Time `json:"lastHeartbeat"`
+
+	// Error describes why the server is not reachable or why authentication
+	// failed. It is empty if the server is healthy.
+	Error string `json:"error,omitempty"`
+}
+
+// HealthStatus describes the health of the servers of a deployment as
+// reported by Client.Health.
+type HealthStatus struct {
+	// TopologyKind is the kind of the deployment, such as "ReplicaSetWithPrimary"
+	// or "Sharded".
+	TopologyKind string `json:"topologyKind"`
+
+	// Servers contains a ServerHealth for each known server, ordered by
+	// address.
+	Servers []ServerHealth `json:"servers"`
+}
+
+// Ready returns true if at least one server is reachable and, if the Client
+// has credentials, accepts them. Servers whose connection pool is saturated are
+// not counted as ready.
+func (hs HealthStatus) Ready() bool {
+	for _, s := range hs.Servers {
+		if s.Reachable && (s.Auth == HealthAuthSucceeded || s.Auth == HealthAuthNotConfigured) {
+			return true
+		}
+	}
+	return false
+}
+
+// Health reports the reachability, authentication status and round-trip time
+// of each known server of the deployment. Unlike Ping, which only runs a
+// command on the selected server, Health checks out a connection to every
+// reachable server concurrently, which authenticates the connection if it is
+// new, and does not wait for server selection. It does not wait for a
+// connection to a server whose connection pool is saturated, but reports the
+// saturation instead. It does not send any commands beyond the connection
+// handshake and authentication. Use a HealthChecker instead to check the
+// Client against thresholds without checking out connections. The returned
+// HealthStatus is suitable for encoding as JSON, for example in the response
+// of a Kubernetes readiness endpoint:
+//
+//	http.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+//		ctx, cancel := context.WithTimeout(r.Context(), time.Second)
+//		defer cancel()
+//		status, err := client.Health(ctx)
+//		if err == nil && !status.Ready() {
+//			w.WriteHeader(http.StatusServiceUnavailable)
+//		}
+//		_ = json.NewEncoder(w).Encode(status)
+//	})
+//
+// Health returns ErrClientDisconnected if the Client is disconnected. The
+// returned HealthStatus has no servers if the deployment of the Client does
+// not expose its servers.
+func (c *Client) Health(ctx context.Context) (HealthStatus, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	topo, ok := c.deployment.(*topology.Topology)
+	if !ok {
+		return HealthStatus{}, nil
+	}
+	// A closed topology has no servers, so check that it is connected before
+	// reporting an empty status. FindServer only fails if it is closed.
+	if _, err := topo.FindServer(description.Server{}); err != nil {
+		return HealthStatus{}, wrapErrors(err)
+	}
+	desc := topo.Description()
+	status := HealthStatus{
+		TopologyKind: desc.Kind.String(),
+		Servers:      make([]ServerHealth, len(desc.Servers)),
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(desc.Servers))
+	for i, srv := range desc.Servers {
+		wg.Add(1)
+		go func(i int, srv description.Server) {
+			defer wg.Done()
+			status.Servers[i], errs[i] = c.serverHealth(ctx, topo, srv)
+		}(i, srv)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return HealthStatus{}, wrapErrors(err)
+		}
+	}
+	sort.Slice(status.Servers, func(i, j int) bool {
+		return status.Servers[i].Address < status.Servers[j].Address
+	})
+	return status, nil
+}
+
+// serverHealth returns the health of the server described by desc. It only
+// returns an error if the topology is closed.
+func (c *Client) serverHealth(
+	ctx context.Context,
+	topo *topology.Topology,
+	desc description.Server,
+) (ServerHealth, error) {
+	sh := ServerHealth{
+		Address:       desc.Addr.String(),
+		Kind:          desc.Kind.String(),
+		Auth:          HealthAuthUnknown,
+		RTT:           desc.AverageRTT,
+		LastHeartbeat: desc.LastUpdateTime,
+	}
+	switch {
+	case desc.LastError != nil:
+		sh.Error = desc.LastError.Error()
+		return sh, nil
+	case desc.Kind == description.Unknown:
+		sh.Error = "server has not been checked yet"
+		return sh, nil
+	case desc.Kind == description.ServerKindRSArbiter || desc.Kind == description.ServerKindRSGhost:
+		sh.Reachable = true
+		return sh, nil
+	}
+
+	srv, err := topo.FindServer(desc)
+	if err != nil {
+		return sh, err
+	}
+	if srv == nil {
+		sh.Error = "server was removed from the topology"
+		return sh, nil
+	}
+	// Checking out a connection from a saturated pool waits until one is
+	// checked in, so report the saturation instead.
+	if poolSaturated(srv.PoolStats()) {
+		sh.Reachable = true
+		sh.PoolSaturated = true
+		return sh, nil
+	}
+	conn, err := srv.Connection(ctx)
+	if err != nil {
+		var authErr *auth.Error
+		if errors.As(err, &authErr) {
+			// The server responded to the handshake, so it is reachable.
+			sh.Reachable = true
+			sh.Auth = HealthAuthFailed
+		}
+		sh.Error = err.Error()
+		return sh, nil
+	}
+	_ = conn.Close()
+
+	sh.Reachable = true
+	sh.Auth = HealthAuthSucceeded
+	if c.authenticator == nil {
+		sh.Auth = HealthAuthNotConfigured
+	}
+	return sh, nil
+}
+
+// poolSaturated reports whether all connections that a pool with the given
+// statistics may open are in use.
+func poolSaturated(ps topology.PoolStats) bool {
+	return ps.MaxSize > 0 && ps.Idle == 0 && uint64(ps.Open) >= ps.MaxSize
+}
@@ -0,0 +1,164 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/xoptions"
+)
+
+func TestHealthChecker(t *testing.T) {
+	t.Run("server selection", func(t *testing.T) {
+		client := setupClient(options.Client().
+			ApplyURI("mongodb://localhost:1").
+			SetServerSelectionTimeout(10 * time.Millisecond))
+		defer func() { _ = client.Disconnect(context.Background()) }()
+
+		err := NewHealthChecker(client, HealthPolicy{ReadPreference: readpref.Nearest()}).Check(context.Background())
+		var he *HealthError
+		require.True(t, errors.As(err, &he), "expected a *HealthError, got %v", err)
+		require.Len(t, he.Failures, 1)
+		assert.Equal(t, HealthCheckServerSelection, he.Failures[0].Check)
+		assert.Equal(t, "", he.Failures[0].Server)
+		assert.Contains(t, he.Error(), "read preference nearest")
+	})
+	t.Run("default read preference", func(t *testing.T) {
+		hc := NewHealthChecker(setupClient(), HealthPolicy{})
+		assert.Equal(t, readpref.PrimaryMode, hc.policy.ReadPreference.Mode())
+	})
+	t.Run("pool headroom", func(t *testing.T) {
+		hc := NewHealthChecker(setupClient(), HealthPolicy{MinPoolHeadroom: 0.2})
+		failures := hc.checkPools(map[string]topology.PoolStats{
+			"a:27017": {Open: 10, Idle: 3, MaxSize: 10},
+			"b:27017": {Open: 9, Idle: 0, MaxSize: 10},
+			"c:27017": {Open: 100, Idle: 0, MaxSize: 0},
+		})
+		require.Len(t, failures, 1)
+		assert.Equal(t, HealthFailure{
+			Check:  HealthCheckPoolHeadroom,
+			Server: "b:27017",
+			Reason: "9 of 10 connections are in use",
+		}, failures[0])
+
+		hc = NewHealthChecker(setupClient(), HealthPolicy{})
+		assert.Len(t, hc.checkPools(map[string]topology.PoolStats{"b:27017": {Open: 10, MaxSize: 10}}), 0)
+	})
+	t.Run("error message", func(t *testing.T) {
+		err := &HealthError{Failures: []HealthFailure{
+			{Check: HealthCheckServerSelection, Reason: "timed out"},
+			{Check: HealthCheckReplicationLag, Server: "b:27017", Reason: "estimated lag 1m0s exceeds 30s"},
+		}}
+		assert.Equal(t,
+			"health check failed: serverSelection: timed out; replicationLag: b:27017: estimated lag 1m0s exceeds 30s",
+			err.Error())
+	})
+}
+
+func TestHealthStatusReady(t *testing.T) {
+	testCases := []struct {
+		name    string
+		servers []ServerHealth
+		want    bool
+	}{
+		{"no servers", nil, false},
+		{"unreachable", []ServerHealth{{Auth: HealthAuthUnknown}}, false},
+		{"auth failed", []ServerHealth{{Reachable: true, Auth: HealthAuthFailed}}, false},
+		{"arbiter only", []ServerHealth{{Reachable: true, Auth: HealthAuthUnknown}}, false},
+		{"authenticated", []ServerHealth{{Reachable: true, Auth: HealthAuthSucceeded}}, true},
+		{
+			"one of several",
+			[]ServerHealth{{Auth: HealthAuthUnknown}, {Reachable: true, Auth: HealthAuthNotConfigured}},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, HealthStatus{Servers: tc.servers}.Ready())
+		})
+	}
+}
+
+func TestClient_Health(t *testing.T) {
+	t.Run("unreachable", func(t *testing.T) {
+		client, err := Connect(options.Client().ApplyURI("mongodb://127.0.0.1:1"))
+		require.NoError(t, err)
+		defer func() { _ = client.Disconnect(context.Background()) }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		err = client.ConnectAndWait(ctx)
+		require.True(t, errors.Is(err, ErrServersUnreachable), "expected ErrServersUnreachable, got %v", err)
+
+		status, err := client.Health(ctx)
+		require.NoError(t, err)
+		assert.False(t, status.Ready(), "expected status not to be ready")
+		require.Len(t, status.Servers, 1)
+		assert.Equal(t, "127.0.0.1:1", status.Servers[0].Address)
+		assert.False(t, status.Servers[0].Reachable, "expected server to be unreachable")
+		assert.Equal(t, HealthAuthUnknown, status.Servers[0].Auth)
+		assert.NotEqual(t, "", status.Servers[0].Error, "expected an error")
+	})
+	t.Run("arbiter", func(t *testing.T) {
+		sh, err := setupClient().serverHealth(context.Background(), nil, description.Server{
+			Addr: address.Address("a:27017"),
+			Kind: description.ServerKindRSArbiter,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ServerHealth{
+			Address:   "a:27017",
+			Kind:      "RSArbiter",
+			Reachable: true,
+			Auth:      HealthAuthUnknown,
+		}, sh)
+	})
+	t.Run("pool saturated", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			stats topology.PoolStats
+			want  bool
+		}{
+			{"unlimited", topology.PoolStats{Open: 100}, false},
+			{"below max", topology.PoolStats{Open: 9, MaxSize: 10}, false},
+			{"idle connection", topology.PoolStats{Open: 10, Idle: 1, MaxSize: 10}, false},
+			{"all in use", topology.PoolStats{Open: 10, MaxSize: 10}, true},
+		}
+		for _, tc := range testCases {
+			assert.Equal(t, tc.want, poolSaturated(tc.stats), "wrong result for %s", tc.name)
+		}
+	})
+	t.Run("mock deployment", func(t *testing.T) {
+		opts := options.Client()
+		err := xoptions.SetInternalClientOptions(opts, "deployment", drivertest.NewMockDeployment())
+		require.NoError(t, err)
+		client, err := Connect(opts)
+		require.NoError(t, err)
+
+		status, err := client.Health(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, status.Servers, 0)
+	})
+	t.Run("disconnected", func(t *testing.T) {
+		client, err := Connect(options.Client().ApplyURI("mongodb://127.0.0.1:1"))
+		require.NoError(t, err)
+		require.NoError(t, client.Disconnect(context.Background()))
+
+		_, err = client.Health(context.Background())
+		assert.ErrorIs(t, err, ErrClientDisconnected)
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// InterruptedIndexBuild describes an index build that is listed as in progress
+// on a collection but is not being run by the server, for example because the
+// primary that was building the index stepped down or restarted.
+type InterruptedIndexBuild struct {
+	// Name is the name of the index.
+	Name string
+
+	// BuildUUID is the UUID of the interrupted build.
+	BuildUUID bson.Binary
+
+	// Spec is the specification of the index, as reported by the listIndexes
+	// command.
+	Spec bson.Raw
+}
+
+// inProgressIndex is a listIndexes result for an index that is still being
+// built. It is only returned if includeBuildUUIDs is set.
+type inProgressIndex struct {
+	Spec      bson.Raw     `bson:"spec"`
+	BuildUUID *bson.Binary `bson:"buildUUID"`
+}
+
+// currentIndexBuild is a currentOp result for a createIndexes command.
+type currentIndexBuild struct {
+	Command struct {
+		Indexes []struct {
+			Name string `bson:"name"`
+		} `bson:"indexes"`
+	} `bson:"command"`
+}
+
+// InterruptedBuilds returns the index builds of the collection that are in
+// progress according to the listIndexes command but have no corresponding
+// createIndexes operation according to the currentOp command. This is the case
+// for builds that were interrupted by a failover and were not resumed by the
+// new primary.
+//
+// The currentOp command is run on the admin database and requires the inprog
+// privilege. A build that starts or finishes between the two commands can be
+// reported incorrectly, so the result should be confirmed before acting on it,
+// for example by calling InterruptedBuilds again after a delay.
+func (iv IndexView) InterruptedBuilds(ctx context.Context) ([]InterruptedIndexBuild, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	cursor, err := iv.coll.db.RunCommandCursor(ctx, bson.D{
+		{"listIndexes", iv.coll.name},
+		{"includeBuildUUIDs", true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var indexes []inProgressIndex
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return nil, err
+	}
+
+	var inprog []currentIndexBuild
+	var found bool
+	for _, index := range indexes {
+		if index.BuildUUID != nil {
+			found = true
+			break
+		}
+	}
+	if found {
+		var res struct {
+			Inprog []currentIndexBuild `bson:"inprog"`
+		}
+		err := iv.coll.client.Database("admin").RunCommand(ctx, bson.D{
+			{"currentOp", 1},
+			{"$all", true},
+			{"ns", iv.coll.namespace()},
+			{"command.createIndexes", iv.coll.name},
+		}).Decode(&res)
+		if err != nil {
+			return nil, err
+		}
+		inprog = res.Inprog
+	}
+
+	return interruptedIndexBuilds(indexes, inprog)
+}
+
+// interruptedIndexBuilds returns the in-progress indexes that are not being
+// built by any of the createIndexes operations in inprog.
+func interruptedIndexBuilds(indexes []inProgressIndex, inprog []currentIndexBuild) ([]InterruptedIndexBuild, error) {
+	building := make(map[string]bool)
+	for _, op := range inprog {
+		for _, index := range op.Command.Indexes {
+			building[index.Name] = true
+		}
+	}
+
+	var interrupted []InterruptedIndexBuild
+	for _, index := range indexes {
+		if index.BuildUUID == nil {
+			continue
+		}
+		name, ok := index.Spec.Lookup("name").StringValueOK()
+		if !ok {
+			return nil, errors.New("listIndexes returned an in-progress index build without a name")
+		}
+		if building[name] {
+			continue
+		}
+		interrupted = append(interrupted, InterruptedIndexBuild{
+			Name:      name,
+			BuildUUID: *index.BuildUUID,
+			Spec:      index.Spec,
+		})
+	}
+	return interrupted, nil
+}
+
+// RestartInterruptedBuilds finds the interrupted index builds of the collection
+// with InterruptedBuilds and re-issues a createIndexes command for each of
+// them. It is meant for automation that runs after replica set maintenance,
+// such as rolling restarts, to make sure that no index is left half-built.
+//
+// RestartInterruptedBuilds returns the builds that were restarted. If a build
+// cannot be restarted, it returns the builds restarted so far and the error.
+// Re-issuing a build that the server has resumed in the meantime joins that
+// build rather than starting a second one.
+func (iv IndexView) RestartInterruptedBuilds(ctx context.Context) ([]InterruptedIndexBuild, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	interrupted, err := iv.InterruptedBuilds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restarted := make([]InterruptedIndexBuild, 0, len(interrupted))
+	for _, build := range interrupted {
+		models, err := cloneIndexModels([]bson.Raw{build.Spec})
+		if err != nil {
+			return restarted, err
+		}
+		cmd := bson.D{{"createIndexes", iv.coll.name}, {"indexes", models}}
+		if err := iv.coll.db.RunCommand(ctx, cmd).Err(); err != nil {
+			return restarted, fmt.Errorf("failed to restart build of index %q: %w", build.Name, err)
+		}
+		restarted = append(restarted, build)
+	}
+	return restarted, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestInterruptedIndexBuilds(t *testing.T) {
+	t.Parallel()
+
+	indexSpec := func(name string) bson.Raw {
+		doc, err := bson.Marshal(bson.D{{"v", 2}, {"key", bson.D{{name, 1}}}, {"name", name}})
+		require.NoError(t, err, "Marshal error")
+		return doc
+	}
+	building := func(names ...string) currentIndexBuild {
+		var op currentIndexBuild
+		for _, name := range names {
+			op.Command.Indexes = append(op.Command.Indexes, struct {
+				Name string `bson:"name"`
+			}{name})
+		}
+		return op
+	}
+
+	uuidA := bson.Binary{Subtype: bson.TypeBinaryUUID, Data: []byte("aaaaaaaaaaaaaaaa")}
+	uuidB := bson.Binary{Subtype: bson.TypeBinaryUUID, Data: []byte("bbbbbbbbbbbbbbbb")}
+	indexes := []inProgressIndex{
+		{}, // A completed index.
+		{Spec: indexSpec("a"), BuildUUID: &uuidA},
+		{Spec: indexSpec("b"), BuildUUID: &uuidB},
+	}
+
+	t.Run("all builds running", func(t *testing.T) {
+		t.Parallel()
+
+		interrupted, err := interruptedIndexBuilds(indexes, []currentIndexBuild{building("a", "b")})
+		require.NoError(t, err)
+		assert.Len(t, interrupted, 0)
+	})
+	t.Run("build not running", func(t *testing.T) {
+		t.Parallel()
+
+		interrupted, err := interruptedIndexBuilds(indexes, []currentIndexBuild{building("a")})
+		require.NoError(t, err)
+		want := []InterruptedIndexBuild{{Name: "b", BuildUUID: uuidB, Spec: indexSpec("b")}}
+		assert.Equal(t, want, interrupted)
+	})
+	t.Run("no operations", func(t *testing.T) {
+		t.Parallel()
+
+		interrupted, err := interruptedIndexBuilds(indexes, nil)
+		require.NoError(t, err)
+		require.Len(t, interrupted, 2)
+		assert.Equal(t, "a", interrupted[0].Name)
+		assert.Equal(t, "b", interrupted[1].Name)
+	})
+	t.Run("missing name", func(t *testing.T) {
+		t.Parallel()
+
+		spec, err := bson.Marshal(bson.D{{"key", bson.D{{"c", 1}}}})
+		require.NoError(t, err)
+		_, err = interruptedIndexBuilds([]inProgressIndex{{Spec: spec, BuildUUID: &uuidA}}, nil)
+		assert.EqualError(t, err, "listIndexes returned an in-progress index build without a name")
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
 	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
+	"go.mongodb.org/mongo-driver/v2/mongo/audit"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
@@ -72,6 +73,10 @@ func (iv IndexView) List(ctx context.Context, opts ...options.Lister[options.Lis
 		closeImplicitSession(sess)
 		return nil, err
 	}
+	if err = iv.coll.client.checkNamespacePolicy(iv.coll.namespace()); err != nil {
+		closeImplicitSession(sess)
+		return nil, err
+	}
 	var selector description.ServerSelector
 
 	selector = &serverselector.Composite{
@@ -86,7 +91,9 @@ func (iv IndexView) List(ctx context.Context, opts ...options.Lister[options.Lis
 		Session(sess).CommandMonitor(iv.coll.client.monitor).
 		ServerSelector(selector).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).
-		Deployment(iv.coll.client.deployment).ServerAPI(iv.coll.client.serverAPI).
+		Deployment(iv.coll.client.deploymentFor(ctx)).RetryPolicy(iv.coll.client.retryPolicy).
+		CommandInterceptors(iv.coll.client.interceptors).MemoryAccountant(iv.coll.client.memoryAccountant()).
+		ServerAPI(iv.coll.client.serverAPI).
 		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Authenticator(iv.coll.client.authenticator)
 
 	cursorOpts := iv.coll.client.createBaseCursorOptions()
@@ -114,7 +121,7 @@ func (iv IndexView) List(ctx context.Context, opts ...options.Lister[options.Lis
 	}
 	op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = iv.coll.client.runOperation(ctx, "listIndexes", iv.coll.namespace(), op.Execute)
 	if err != nil {
 		// for namespaceNotFound errors, return an empty cursor and do not throw an error
 		closeImplicitSession(sess)
@@ -261,6 +268,12 @@ func (iv IndexView) CreateMany(
 	if sess.TransactionRunning() {
 		wc = nil
 	}
+	if err := iv.coll.client.checkReadOnly("createIndexes", iv.coll.namespace()); err != nil {
+		return nil, err
+	}
+	if err := iv.coll.client.checkWritePolicy(sess, iv.coll.namespace(), wc); err != nil {
+		return nil, err
+	}
 	if !wc.Acknowledged() {
 		sess = nil
 	}
@@ -275,7 +288,9 @@ func (iv IndexView) CreateMany(
 	op := operation.NewCreateIndexes(indexes).
 		Session(sess).WriteConcern(wc).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).CommandMonitor(iv.coll.client.monitor).
-		Deployment(iv.coll.client.deployment).ServerSelector(selector).ServerAPI(iv.coll.client.serverAPI).
+		Deployment(iv.coll.client.deploymentFor(ctx)).RetryPolicy(iv.coll.client.retryPolicy).
+		CommandInterceptors(iv.coll.client.interceptors).MemoryAccountant(iv.coll.client.memoryAccountant()).
+		ServerSelector(selector).ServerAPI(iv.coll.client.serverAPI).
 		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Authenticator(iv.coll.client.authenticator)
 	if args.CommitQuorum != nil {
 		commitQuorum, err := marshalValue(args.CommitQuorum, iv.coll.bsonOpts, iv.coll.registry)
@@ -291,7 +306,9 @@ func (iv IndexView) CreateMany(
 		}
 	}
 
-	_, err = processWriteError(op.Execute(ctx))
+	err = iv.coll.client.runOperation(ctx, "createIndexes", iv.coll.namespace(), op.Execute)
+	iv.coll.client.emitAudit(ctx, audit.Record{Namespace: iv.coll.namespace(), Operation: "createIndexes"}, err)
+	_, err = processWriteError(err)
 	if err != nil {
 		return nil, err
 	}
@@ -412,6 +429,12 @@ func (iv IndexView) drop(ctx context.Context, index any, opts ...options.Lister[
 	if sess.TransactionRunning() {
 		wc = nil
 	}
+	if err := iv.coll.client.checkReadOnly("dropIndexes", iv.coll.namespace()); err != nil {
+		return err
+	}
+	if err := iv.coll.client.checkWritePolicy(sess, iv.coll.namespace(), wc); err != nil {
+		return err
+	}
 	if !wc.Acknowledged() {
 		sess = nil
 	}
@@ -421,7 +444,9 @@ func (iv IndexView) drop(ctx context.Context, index any, opts ...options.Lister[
 	op := operation.NewDropIndexes(index).Session(sess).WriteConcern(wc).CommandMonitor(iv.coll.client.monitor).
 		ServerSelector(selector).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).
-		Deployment(iv.coll.client.deployment).ServerAPI(iv.coll.client.serverAPI).
+		Deployment(iv.coll.client.deploymentFor(ctx)).RetryPolicy(iv.coll.client.retryPolicy).
+		CommandInterceptors(iv.coll.client.interceptors).MemoryAccountant(iv.coll.client.memoryAccountant()).
+		ServerAPI(iv.coll.client.serverAPI).
 		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Authenticator(iv.coll.client.authenticator)
 
 	if rawDataOpt := optionsutil.Value(args.Internal, "rawData"); rawDataOpt != nil {
@@ -430,7 +455,8 @@ func (iv IndexView) drop(ctx context.Context, index any, opts ...options.Lister[
 		}
 	}
 
-	err = op.Execute(ctx)
+	err = iv.coll.client.runOperation(ctx, "dropIndexes", iv.coll.namespace(), op.Execute)
+	iv.coll.client.emitAudit(ctx, audit.Record{Namespace: iv.coll.namespace(), Operation: "dropIndexes"}, err)
 	if err != nil {
 		return wrapErrors(err)
 	}
@@ -0,0 +1,254 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/wiremessage"
+)
+
+const (
+	defaultInsertPlanMaxBatchCount  = 100000
+	defaultInsertPlanMaxMessageSize = 48000000
+)
+
+// InsertBatch is a batch of documents planned by Collection.PlanInsertMany.
+type InsertBatch struct {
+	// Documents are the documents of the batch, in the order in which they
+	// were passed to PlanInsertMany. They are marshalled with the BSON options
+	// and registry of the Collection, and an _id field is added to documents
+	// without one, so InsertMany sends them unchanged.
+	Documents []bson.Raw
+
+	// IDs are the _id values of the documents of the batch.
+	IDs []any
+
+	// Size is the total size in bytes of the documents of the batch.
+	Size int
+
+	// CompressedSize is the estimated size in bytes of the documents of the
+	// batch after compression. It is equal to Size if no compressor is set.
+	CompressedSize int
+}
+
+// PlanInsertMany splits documents into batches that InsertMany can each insert
+// with a single insert command. It is meant for bulk loaders that control the
+// size of the InsertMany calls they make, for example to track progress or to
+// bound the amount of data that is resent if a call fails:
+//
+//	batches, err := coll.PlanInsertMany(docs, options.InsertPlan().SetCompressor("zstd"))
+//	if err != nil {
+//		return err
+//	}
+//	for _, batch := range batches {
+//		if _, err := coll.InsertMany(ctx, batch.Documents); err != nil {
+//			return err
+//		}
+//	}
+//
+// Each batch holds as many documents as fit within MaxBatchCount and
+// MaxMessageSize. The size of each batch after compression with the compressor
+// set with SetCompressor is estimated, and batches whose estimated size
+// exceeds MaxCompressedSize are shortened, so that the compressed batches
+// approach but do not exceed it. The server applies maxMessageSizeBytes to
+// messages after decompressing them, so compression cannot be used to send
+// batches larger than MaxMessageSize. A document that exceeds the limits on
+// its own is placed in a batch by itself.
+//
+// The documents parameter must be a slice of documents to insert. The
+// documents are marshalled with the BSON options and registry of the
+// Collection. The opts parameter can be used to specify options for the
+// planner (see the options.InsertPlanOptions documentation).
+func (coll *Collection) PlanInsertMany(
+	documents any,
+	opts ...options.Lister[options.InsertPlanOptions],
+) ([]InsertBatch, error) {
+	args, err := mongoutil.NewOptions[options.InsertPlanOptions](opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+	planner, err := newInsertPlanner(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dv := reflect.ValueOf(documents)
+	if dv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("invalid documents: %w", ErrNotSlice)
+	}
+	if dv.Len() == 0 {
+		return nil, fmt.Errorf("invalid documents: %w", ErrEmptySlice)
+	}
+
+	ids := make([]any, 0, dv.Len())
+	docs := make([]bsoncore.Document, 0, dv.Len())
+	for i := 0; i < dv.Len(); i++ {
+		bsoncoreDoc, err := marshal(dv.Index(i).Interface(), coll.bsonOpts, coll.registry)
+		if err != nil {
+			return nil, err
+		}
+		bsoncoreDoc, id, err := ensureID(bsoncoreDoc, bson.NilObjectID, coll.bsonOpts, coll.registry)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		docs = append(docs, bsoncoreDoc)
+	}
+
+	ranges, err := planner.plan(docs)
+	if err != nil {
+		return nil, err
+	}
+	batches := make([]InsertBatch, 0, len(ranges))
+	for _, r := range ranges {
+		batchDocs := make([]bson.Raw, 0, r.end-r.start)
+		for _, doc := range docs[r.start:r.end] {
+			batchDocs = append(batchDocs, bson.Raw(doc))
+		}
+		batches = append(batches, InsertBatch{
+			Documents:      batchDocs,
+			IDs:            ids[r.start:r.end],
+			Size:           r.size,
+			CompressedSize: r.compressedSize,
+		})
+	}
+	return batches, nil
+}
+
+// insertPlanner splits documents into batches within the limits of an insert
+// plan.
+type insertPlanner struct {
+	compression       driver.CompressionOpts
+	maxBatchCount     int
+	maxMessageSize    int
+	maxCompressedSize int
+}
+
+// insertBatchRange is a batch of the documents from start to end.
+type insertBatchRange struct {
+	start, end     int
+	size           int
+	compressedSize int
+}
+
+func newInsertPlanner(args *options.InsertPlanOptions) (insertPlanner, error) {
+	p := insertPlanner{
+		compression:    driver.CompressionOpts{Compressor: wiremessage.CompressorNoOp},
+		maxBatchCount:  defaultInsertPlanMaxBatchCount,
+		maxMessageSize: defaultInsertPlanMaxMessageSize,
+	}
+	if args.Compressor != nil {
+		switch strings.ToLower(*args.Compressor) {
+		case "snappy":
+			p.compression.Compressor = wiremessage.CompressorSnappy
+		case "zlib":
+			p.compression.Compressor = wiremessage.CompressorZLib
+			p.compression.ZlibLevel = wiremessage.DefaultZlibLevel
+		case "zstd":
+			p.compression.Compressor = wiremessage.CompressorZstd
+			p.compression.ZstdLevel = wiremessage.DefaultZstdLevel
+		default:
+			return insertPlanner{}, fmt.Errorf("unsupported compressor %q", *args.Compressor)
+		}
+	}
+	if args.MaxBatchCount != nil {
+		if *args.MaxBatchCount < 1 {
+			return insertPlanner{}, fmt.Errorf("max batch count must be at least 1, got %d", *args.MaxBatchCount)
+		}
+		p.maxBatchCount = *args.MaxBatchCount
+	}
+	if args.MaxMessageSize != nil {
+		if *args.MaxMessageSize < 1 {
+			return insertPlanner{}, fmt.Errorf("max message size must be at least 1, got %d", *args.MaxMessageSize)
+		}
+		p.maxMessageSize = *args.MaxMessageSize
+	}
+	p.maxCompressedSize = p.maxMessageSize
+	if args.MaxCompressedSize != nil {
+		if *args.MaxCompressedSize < 1 {
+			return insertPlanner{}, fmt.Errorf("max compressed size must be at least 1, got %d", *args.MaxCompressedSize)
+		}
+		p.maxCompressedSize = *args.MaxCompressedSize
+	}
+	return p, nil
+}
+
+// plan splits docs into batches. Each batch is the longest run of documents
+// within the count and size limits whose compressed size does not exceed the
+// compressed size limit, or a single document.
+func (p insertPlanner) plan(docs []bsoncore.Document) ([]insertBatchRange, error) {
+	var batches []insertBatchRange
+	for start := 0; start < len(docs); {
+		end, size := start+1, len(docs[start])
+		for end < len(docs) && end-start < p.maxBatchCount && size+len(docs[end]) <= p.maxMessageSize {
+			size += len(docs[end])
+			end++
+		}
+
+		compressed, err := p.compressedSize(docs[start:end])
+		if err != nil {
+			return nil, err
+		}
+		if compressed > p.maxCompressedSize && end-start > 1 {
+			// The compressed size grows with the number of documents, so
+			// search for the longest batch that is within the limit.
+			lo, hi := start+2, end-1
+			end = start + 1
+			for lo <= hi {
+				mid := lo + (hi-lo)/2
+				c, err := p.compressedSize(docs[start:mid])
+				if err != nil {
+					return nil, err
+				}
+				if c <= p.maxCompressedSize {
+					end = mid
+					lo = mid + 1
+				} else {
+					hi = mid - 1
+				}
+			}
+
+			size = 0
+			for _, doc := range docs[start:end] {
+				size += len(doc)
+			}
+			if compressed, err = p.compressedSize(docs[start:end]); err != nil {
+				return nil, err
+			}
+		}
+
+		batches = append(batches, insertBatchRange{
+			start:          start,
+			end:            end,
+			size:           size,
+			compressedSize: compressed,
+		})
+		start = end
+	}
+	return batches, nil
+}
+
+// compressedSize returns the size of docs after compression.
+func (p insertPlanner) compressedSize(docs []bsoncore.Document) (int, error) {
+	var payload []byte
+	for _, doc := range docs {
+		payload = append(payload, doc...)
+	}
+	compressed, err := driver.CompressPayload(payload, p.compression)
+	if err != nil {
+		return 0, err
+	}
+	return len(compressed), nil
+}
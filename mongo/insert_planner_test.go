@@ -0,0 +1,150 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestPlanInsertMany(t *testing.T) {
+	coll := setupClient().Database("db").Collection("coll")
+
+	// Each document is 1,024 bytes and highly compressible.
+	docs := make([]bson.D, 10)
+	for i := range docs {
+		docs[i] = bson.D{{"_id", int32(i)}, {"s", strings.Repeat("a", 1024-22)}}
+	}
+	docSize := len(marshalTestDoc(t, docs[0]))
+	require.Equal(t, 1024, docSize)
+
+	batchLengths := func(batches []InsertBatch) []int {
+		lengths := make([]int, 0, len(batches))
+		for _, b := range batches {
+			lengths = append(lengths, len(b.Documents))
+		}
+		return lengths
+	}
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			docs any
+			opts *options.InsertPlanOptionsBuilder
+			err  string
+		}{
+			{"unknown compressor", docs, options.InsertPlan().SetCompressor("lz4"), `unsupported compressor "lz4"`},
+			{"zero batch count", docs, options.InsertPlan().SetMaxBatchCount(0), "max batch count must be at least 1, got 0"},
+			{"zero message size", docs, options.InsertPlan().SetMaxMessageSize(0), "max message size must be at least 1, got 0"},
+			{"zero compressed size", docs, options.InsertPlan().SetMaxCompressedSize(0),
+				"max compressed size must be at least 1, got 0"},
+			{"not a slice", docs[0][0], options.InsertPlan(), "invalid documents: must provide a non-empty slice"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				batches, err := coll.PlanInsertMany(tc.docs, tc.opts)
+				assert.EqualError(t, err, tc.err)
+				assert.Nil(t, batches)
+			})
+		}
+
+		_, err := coll.PlanInsertMany([]bson.D{})
+		assert.True(t, errors.Is(err, ErrEmptySlice), "expected error %v, got %v", ErrEmptySlice, err)
+	})
+	t.Run("defaults", func(t *testing.T) {
+		batches, err := coll.PlanInsertMany(docs)
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+		assert.Equal(t, 10*docSize, batches[0].Size)
+		assert.Equal(t, batches[0].Size, batches[0].CompressedSize, "expected no compression")
+	})
+	t.Run("batch count", func(t *testing.T) {
+		batches, err := coll.PlanInsertMany(docs, options.InsertPlan().SetMaxBatchCount(4))
+		require.NoError(t, err)
+		assert.Equal(t, []int{4, 4, 2}, batchLengths(batches))
+		assert.Equal(t, marshalTestDoc(t, docs[4]), batches[1].Documents[0], "expected documents in order")
+		assert.Equal(t, []any{int32(4), int32(5), int32(6), int32(7)}, batches[1].IDs)
+	})
+	t.Run("message size", func(t *testing.T) {
+		batches, err := coll.PlanInsertMany(docs, options.InsertPlan().SetMaxMessageSize(3*docSize+10))
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 3, 3, 1}, batchLengths(batches))
+		for _, b := range batches {
+			assert.Equal(t, len(b.Documents)*docSize, b.Size)
+		}
+	})
+	t.Run("compressed size", func(t *testing.T) {
+		opts := options.InsertPlan().SetCompressor("snappy").SetMaxCompressedSize(docSize)
+		batches, err := coll.PlanInsertMany(docs, opts)
+		require.NoError(t, err)
+		require.True(t, len(batches) < len(docs), "expected compressed batches of several documents, got %v",
+			batchLengths(batches))
+
+		var total int
+		for _, b := range batches {
+			total += len(b.Documents)
+			assert.True(t, b.CompressedSize < b.Size, "expected documents to compress")
+			if len(b.Documents) > 1 {
+				assert.True(t, b.CompressedSize <= docSize, "compressed size %d exceeds limit", b.CompressedSize)
+			}
+		}
+		assert.Equal(t, len(docs), total)
+	})
+	t.Run("compression does not exceed message size", func(t *testing.T) {
+		opts := options.InsertPlan().SetCompressor("zstd").SetMaxMessageSize(2 * docSize)
+		batches, err := coll.PlanInsertMany(docs, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 2, 2, 2, 2}, batchLengths(batches))
+	})
+	t.Run("oversized document", func(t *testing.T) {
+		batches, err := coll.PlanInsertMany(docs[:3], options.InsertPlan().SetMaxMessageSize(docSize/2))
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 1, 1}, batchLengths(batches))
+	})
+	t.Run("missing _id is added", func(t *testing.T) {
+		batches, err := coll.PlanInsertMany([]bson.D{{{"x", int32(1)}}})
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+		require.Len(t, batches[0].IDs, 1)
+		id, ok := batches[0].IDs[0].(bson.ObjectID)
+		require.True(t, ok, "expected an ObjectID, got %T", batches[0].IDs[0])
+
+		want := marshalTestDoc(t, bson.D{{"_id", id}, {"x", int32(1)}})
+		assert.Equal(t, want, batches[0].Documents[0])
+		assert.Equal(t, len(want), batches[0].Size)
+	})
+	t.Run("InsertMany sends the planned documents", func(t *testing.T) {
+		batches, err := coll.PlanInsertMany([]bson.D{{{"x", int32(1)}}, {{"_id", "a"}, {"x", int32(2)}}})
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+
+		// InsertMany marshals each document and adds an _id to documents
+		// without one, which must leave the planned documents unchanged.
+		for i, doc := range batches[0].Documents {
+			marshalled, err := marshal(doc, coll.bsonOpts, coll.registry)
+			require.NoError(t, err)
+			sent, id, err := ensureID(marshalled, bson.NilObjectID, coll.bsonOpts, coll.registry)
+			require.NoError(t, err)
+			assert.Equal(t, []byte(doc), []byte(sent), "expected document %d to be sent unchanged", i)
+			assert.Equal(t, batches[0].IDs[i], id)
+		}
+	})
+}
+
+func marshalTestDoc(t *testing.T, doc any) bson.Raw {
+	t.Helper()
+
+	b, err := bson.Marshal(doc)
+	require.NoError(t, err)
+	return b
+}
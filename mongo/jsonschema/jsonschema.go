@@ -0,0 +1,539 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package jsonschema generates $jsonSchema validators from Go structs, so that
+// server-side document validation stays in sync with the application models
+// that documents are marshaled from:
+//
+//	type Order struct {
+//		ID       bson.ObjectID `bson:"_id"`
+//		Customer string        `bson:"customer" jsonschema:"minLength=1"`
+//		Status   string        `bson:"status" jsonschema:"enum=pending|paid|shipped"`
+//		Notes    string        `bson:"notes,omitempty"`
+//	}
+//
+//	validator, err := jsonschema.Validator(Order{})
+//	if err != nil {
+//		return err
+//	}
+//	opts := options.CreateCollection().SetValidator(validator)
+//	err = db.CreateCollection(ctx, "orders", opts)
+//
+// The generated schema follows the rules of the default bson registry:
+//
+//   - Field names and the "omitempty", "minsize", "inline", and "-" options are
+//     taken from the "bson" struct tag. Fields are required unless they have
+//     the "omitempty" option.
+//   - Go types map to the BSON types they are marshaled as, e.g. time.Time to
+//     "date" and int64 to "long". Pointers, slices, and maps also allow null,
+//     which is how nil values are marshaled.
+//   - Nested structs generate nested object schemas, slices and arrays generate
+//     array schemas, and maps with string keys generate object schemas whose
+//     additional properties are constrained by the map value type.
+//   - Interfaces and types that implement bson.Marshaler or
+//     bson.ValueMarshaler are not constrained.
+//
+// Additional constraints are set with the "jsonschema" struct tag, which is a
+// comma-separated list of key=value pairs:
+//
+//	description=text   a description of the field
+//	enum=a|b|c         the allowed values, parsed according to the field type
+//	minimum=n          the minimum value of a number
+//	maximum=n          the maximum value of a number
+//	minLength=n        the minimum length of a string
+//	maxLength=n        the maximum length of a string
+//	pattern=regex      a regular expression a string must match
+//	minItems=n         the minimum length of an array
+//	maxItems=n         the maximum length of an array
+//
+// Values cannot contain commas.
+package jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var (
+	tTime           = reflect.TypeOf(time.Time{})
+	tDateTime       = reflect.TypeOf(bson.DateTime(0))
+	tObjectID       = reflect.TypeOf(bson.ObjectID{})
+	tDecimal128     = reflect.TypeOf(bson.Decimal128{})
+	tTimestamp      = reflect.TypeOf(bson.Timestamp{})
+	tBinary         = reflect.TypeOf(bson.Binary{})
+	tVector         = reflect.TypeOf(bson.Vector{})
+	tRegex          = reflect.TypeOf(bson.Regex{})
+	tJavaScript     = reflect.TypeOf(bson.JavaScript(""))
+	tSymbol         = reflect.TypeOf(bson.Symbol(""))
+	tD              = reflect.TypeOf(bson.D{})
+	tM              = reflect.TypeOf(bson.M{})
+	tA              = reflect.TypeOf(bson.A{})
+	tRaw            = reflect.TypeOf(bson.Raw{})
+	tRawValue       = reflect.TypeOf(bson.RawValue{})
+	tMarshaler      = reflect.TypeOf((*bson.Marshaler)(nil)).Elem()
+	tValueMarshaler = reflect.TypeOf((*bson.ValueMarshaler)(nil)).Elem()
+)
+
+// Config configures schema generation. The zero value generates schemas that
+// allow fields that are not declared by the struct.
+type Config struct {
+	// Strict sets additionalProperties to false in the object schema of every
+	// struct, so that documents with undeclared fields are rejected. The
+	// top-level schema always allows the _id field.
+	Strict bool
+}
+
+// Schema returns the $jsonSchema document for the struct type of v, which may
+// be a struct or a pointer to a struct, using the default Config.
+func Schema(v any) (bson.D, error) {
+	return Config{}.Schema(v)
+}
+
+// Validator returns a validator document {"$jsonSchema": schema} for the struct
+// type of v using the default Config. It can be passed to
+// options.CreateCollectionOptionsBuilder.SetValidator or to the validator field
+// of a collMod command.
+func Validator(v any) (bson.D, error) {
+	return Config{}.Validator(v)
+}
+
+// Validator is like the package-level Validator function, but uses c.
+func (c Config) Validator(v any) (bson.D, error) {
+	schema, err := c.Schema(v)
+	if err != nil {
+		return nil, err
+	}
+	return bson.D{{"$jsonSchema", schema}}, nil
+}
+
+// Schema is like the package-level Schema function, but uses c.
+func (c Config) Schema(v any) (bson.D, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, errors.New("jsonschema: cannot generate a schema for nil")
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("jsonschema: expected a struct, got %v", t)
+	}
+
+	g := &generator{cfg: c, visiting: make(map[reflect.Type]bool)}
+	s, err := g.structSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	if c.Strict && !s.hasProperty("_id") {
+		s.properties = append(bson.D{{"_id", bson.D{}}}, s.properties...)
+	}
+	return s.doc(), nil
+}
+
+// schema is a $jsonSchema document under construction.
+type schema struct {
+	bsonTypes   []string
+	description string
+	enum        bson.A
+	minimum     any
+	maximum     any
+	minLength   *int64
+	maxLength   *int64
+	pattern     string
+	minItems    *int64
+	maxItems    *int64
+	items       *schema
+
+	required             []string
+	properties           bson.D
+	additionalProperties any
+}
+
+func (s *schema) hasProperty(name string) bool {
+	for _, p := range s.properties {
+		if p.Key == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *schema) doc() bson.D {
+	var d bson.D
+	switch len(s.bsonTypes) {
+	case 0:
+	case 1:
+		d = append(d, bson.E{"bsonType", s.bsonTypes[0]})
+	default:
+		types := make(bson.A, 0, len(s.bsonTypes))
+		for _, t := range s.bsonTypes {
+			types = append(types, t)
+		}
+		d = append(d, bson.E{"bsonType", types})
+	}
+	if s.description != "" {
+		d = append(d, bson.E{"description", s.description})
+	}
+	if len(s.required) > 0 {
+		d = append(d, bson.E{"required", s.required})
+	}
+	if s.properties != nil {
+		d = append(d, bson.E{"properties", s.properties})
+	}
+	switch ap := s.additionalProperties.(type) {
+	case nil:
+	case *schema:
+		d = append(d, bson.E{"additionalProperties", ap.doc()})
+	default:
+		d = append(d, bson.E{"additionalProperties", ap})
+	}
+	if s.items != nil {
+		d = append(d, bson.E{"items", s.items.doc()})
+	}
+	if s.enum != nil {
+		d = append(d, bson.E{"enum", s.enum})
+	}
+	if s.minimum != nil {
+		d = append(d, bson.E{"minimum", s.minimum})
+	}
+	if s.maximum != nil {
+		d = append(d, bson.E{"maximum", s.maximum})
+	}
+	if s.minLength != nil {
+		d = append(d, bson.E{"minLength", *s.minLength})
+	}
+	if s.maxLength != nil {
+		d = append(d, bson.E{"maxLength", *s.maxLength})
+	}
+	if s.pattern != "" {
+		d = append(d, bson.E{"pattern", s.pattern})
+	}
+	if s.minItems != nil {
+		d = append(d, bson.E{"minItems", *s.minItems})
+	}
+	if s.maxItems != nil {
+		d = append(d, bson.E{"maxItems", *s.maxItems})
+	}
+	if d == nil {
+		d = bson.D{}
+	}
+	return d
+}
+
+type generator struct {
+	cfg      Config
+	visiting map[reflect.Type]bool
+}
+
+// bsonTags are the options of a "bson" struct tag that affect the schema.
+type bsonTags struct {
+	name      string
+	omitEmpty bool
+	minSize   bool
+	inline    bool
+	skip      bool
+}
+
+func parseBSONTag(sf reflect.StructField) bsonTags {
+	tags := bsonTags{name: strings.ToLower(sf.Name)}
+	tag, ok := sf.Tag.Lookup("bson")
+	if !ok && !strings.Contains(string(sf.Tag), ":") && len(sf.Tag) > 0 {
+		tag = string(sf.Tag)
+	}
+	if tag == "-" {
+		tags.skip = true
+		return tags
+	}
+	for i, opt := range strings.Split(tag, ",") {
+		if i == 0 {
+			if opt != "" {
+				tags.name = opt
+			}
+			continue
+		}
+		switch opt {
+		case "omitempty":
+			tags.omitEmpty = true
+		case "minsize":
+			tags.minSize = true
+		case "inline":
+			tags.inline = true
+		}
+	}
+	return tags
+}
+
+// structSchema returns the object schema of the struct type t.
+func (g *generator) structSchema(t reflect.Type) (*schema, error) {
+	if g.visiting[t] {
+		return nil, fmt.Errorf("jsonschema: recursive type %v is not supported", t)
+	}
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	s := &schema{bsonTypes: []string{"object"}, properties: bson.D{}}
+	if err := g.addFields(s, t); err != nil {
+		return nil, err
+	}
+	if g.cfg.Strict && s.additionalProperties == nil {
+		s.additionalProperties = false
+	}
+	return s, nil
+}
+
+// addFields adds the properties of the fields of the struct type t to s.
+func (g *generator) addFields(s *schema, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+		tags := parseBSONTag(sf)
+		if tags.skip {
+			continue
+		}
+
+		if tags.inline {
+			ft := sf.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			switch ft.Kind() {
+			case reflect.Struct:
+				if g.visiting[ft] {
+					return fmt.Errorf("jsonschema: recursive type %v is not supported", ft)
+				}
+				g.visiting[ft] = true
+				err := g.addFields(s, ft)
+				delete(g.visiting, ft)
+				if err != nil {
+					return err
+				}
+			case reflect.Map:
+				// The keys of an inline map become fields of the document.
+				vs, err := g.typeSchema(ft.Elem(), bsonTags{})
+				if err != nil {
+					return fmt.Errorf("%s: %w", sf.Name, err)
+				}
+				s.additionalProperties = vs
+			default:
+				return fmt.Errorf("jsonschema: inline field %s must be a struct or a map, got %v", sf.Name, sf.Type)
+			}
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		fs, err := g.typeSchema(sf.Type, tags)
+		if err != nil {
+			return fmt.Errorf("%s: %w", sf.Name, err)
+		}
+		if err := applyTag(fs, sf); err != nil {
+			return err
+		}
+		s.properties = append(s.properties, bson.E{tags.name, fs.doc()})
+		if !tags.omitEmpty {
+			s.required = append(s.required, tags.name)
+		}
+	}
+	return nil
+}
+
+// typeSchema returns the schema of values of type t.
+func (g *generator) typeSchema(t reflect.Type, tags bsonTags) (*schema, error) {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nullable = true
+	}
+
+	s, err := g.nonNullSchema(t, tags)
+	if err != nil {
+		return nil, err
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		nullable = true
+	}
+	if nullable && len(s.bsonTypes) > 0 {
+		s.bsonTypes = append(s.bsonTypes, "null")
+	}
+	return s, nil
+}
+
+func (g *generator) nonNullSchema(t reflect.Type, tags bsonTags) (*schema, error) {
+	of := func(types ...string) (*schema, error) {
+		return &schema{bsonTypes: types}, nil
+	}
+
+	switch t {
+	case tTime, tDateTime:
+		return of("date")
+	case tObjectID:
+		return of("objectId")
+	case tDecimal128:
+		return of("decimal")
+	case tTimestamp:
+		return of("timestamp")
+	case tBinary, tVector:
+		return of("binData")
+	case tRegex:
+		return of("regex")
+	case tJavaScript:
+		return of("javascript")
+	case tSymbol:
+		return of("symbol")
+	case tD, tM, tRaw:
+		return of("object")
+	case tA:
+		return of("array")
+	case tRawValue:
+		return &schema{}, nil
+	}
+	if t.Implements(tMarshaler) || t.Implements(tValueMarshaler) ||
+		reflect.PtrTo(t).Implements(tMarshaler) || reflect.PtrTo(t).Implements(tValueMarshaler) {
+		return &schema{}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return of("bool")
+	case reflect.String:
+		return of("string")
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return of("int")
+	case reflect.Int:
+		// int values are marshaled as int32 if they fit.
+		return of("int", "long")
+	case reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		if tags.minSize {
+			return of("int", "long")
+		}
+		return of("long")
+	case reflect.Float32, reflect.Float64:
+		return of("double")
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return of("binData")
+		}
+		items, err := g.typeSchema(t.Elem(), bsonTags{minSize: tags.minSize})
+		if err != nil {
+			return nil, err
+		}
+		return &schema{bsonTypes: []string{"array"}, items: items}, nil
+	case reflect.Map:
+		values, err := g.typeSchema(t.Elem(), bsonTags{minSize: tags.minSize})
+		if err != nil {
+			return nil, err
+		}
+		return &schema{bsonTypes: []string{"object"}, additionalProperties: values}, nil
+	case reflect.Struct:
+		return g.structSchema(t)
+	case reflect.Interface:
+		return &schema{}, nil
+	default:
+		return nil, fmt.Errorf("jsonschema: unsupported type %v", t)
+	}
+}
+
+// applyTag applies the constraints of the "jsonschema" struct tag of sf to s.
+func applyTag(s *schema, sf reflect.StructField) error {
+	tag, ok := sf.Tag.Lookup("jsonschema")
+	if !ok || tag == "" {
+		return nil
+	}
+
+	vt := sf.Type
+	for vt.Kind() == reflect.Ptr {
+		vt = vt.Elem()
+	}
+	for _, opt := range strings.Split(tag, ",") {
+		key, value, ok := strings.Cut(opt, "=")
+		if !ok {
+			return fmt.Errorf("jsonschema: invalid option %q for field %s: expected key=value", opt, sf.Name)
+		}
+
+		var err error
+		switch key {
+		case "description":
+			s.description = value
+		case "enum":
+			s.enum = bson.A{}
+			for _, v := range strings.Split(value, "|") {
+				var ev any
+				if ev, err = parseValue(vt, v); err != nil {
+					break
+				}
+				s.enum = append(s.enum, ev)
+			}
+		case "minimum":
+			s.minimum, err = parseNumber(vt, value)
+		case "maximum":
+			s.maximum, err = parseNumber(vt, value)
+		case "minLength":
+			s.minLength, err = parseLength(value)
+		case "maxLength":
+			s.maxLength, err = parseLength(value)
+		case "pattern":
+			s.pattern = value
+		case "minItems":
+			s.minItems, err = parseLength(value)
+		case "maxItems":
+			s.maxItems, err = parseLength(value)
+		default:
+			return fmt.Errorf("jsonschema: unknown option %q for field %s", key, sf.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("jsonschema: invalid %s for field %s: %w", key, sf.Name, err)
+		}
+	}
+	return nil
+}
+
+// parseValue parses an enum value of a field of type t.
+func parseValue(t reflect.Type, v string) (any, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return v, nil
+	case reflect.Bool:
+		return strconv.ParseBool(v)
+	default:
+		return parseNumber(t, v)
+	}
+}
+
+// parseNumber parses a numeric value of a field of type t as the BSON type the
+// field is marshaled as.
+func parseNumber(t reflect.Type, v string) (any, error) {
+	switch t.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		i, err := strconv.ParseInt(v, 10, 32)
+		return int32(i), err
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return strconv.ParseInt(v, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return nil, fmt.Errorf("not supported for type %v", t)
+	}
+}
+
+func parseLength(v string) (*int64, error) {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("must not be negative, got %d", n)
+	}
+	return &n, nil
+}
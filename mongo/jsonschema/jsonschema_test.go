@@ -0,0 +1,157 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package jsonschema
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type address struct {
+	Street string `bson:"street"`
+	Zip    string `bson:"zip,omitempty" jsonschema:"pattern=^[0-9]{5}$"`
+}
+
+type order struct {
+	ID        bson.ObjectID    `bson:"_id"`
+	Status    string           `bson:"status" jsonschema:"enum=pending|paid,description=Order status"`
+	Quantity  int32            `bson:"quantity" jsonschema:"minimum=1,maximum=100"`
+	Total     float64          `bson:"total"`
+	Created   time.Time        `bson:"created"`
+	Shipping  *address         `bson:"shipping"`
+	Tags      []string         `bson:"tags,omitempty" jsonschema:"maxItems=10"`
+	Counts    map[string]int64 `bson:"counts,omitempty"`
+	Extra     bson.D           `bson:"extra,omitempty"`
+	Anything  any              `bson:"anything,omitempty"`
+	Ignored   string           `bson:"-"`
+	internal  string           //nolint:unused
+	Untagged  bool
+	Sizes     []int64           `bson:"sizes,minsize,omitempty"`
+	Raw       bson.RawValue     `bson:"raw,omitempty"`
+	Labels    map[string]string `bson:",omitempty"`
+	Signature []byte            `bson:"signature,omitempty"`
+}
+
+func toJSON(t *testing.T, d bson.D) string {
+	t.Helper()
+
+	b, err := bson.MarshalExtJSON(d, false, false)
+	require.NoError(t, err, "MarshalExtJSON error")
+	return string(b)
+}
+
+func TestSchema(t *testing.T) {
+	got, err := Schema(&order{})
+	require.NoError(t, err, "Schema error")
+
+	want := `{"bsonType":"object",` +
+		`"required":["_id","status","quantity","total","created","shipping","untagged"],` +
+		`"properties":{` +
+		`"_id":{"bsonType":"objectId"},` +
+		`"status":{"bsonType":"string","description":"Order status","enum":["pending","paid"]},` +
+		`"quantity":{"bsonType":"int","minimum":1,"maximum":100},` +
+		`"total":{"bsonType":"double"},` +
+		`"created":{"bsonType":"date"},` +
+		`"shipping":{"bsonType":["object","null"],"required":["street"],"properties":{` +
+		`"street":{"bsonType":"string"},"zip":{"bsonType":"string","pattern":"^[0-9]{5}$"}}},` +
+		`"tags":{"bsonType":["array","null"],"items":{"bsonType":"string"},"maxItems":10},` +
+		`"counts":{"bsonType":["object","null"],"additionalProperties":{"bsonType":"long"}},` +
+		`"extra":{"bsonType":["object","null"]},` +
+		`"anything":{},` +
+		`"untagged":{"bsonType":"bool"},` +
+		`"sizes":{"bsonType":["array","null"],"items":{"bsonType":["int","long"]}},` +
+		`"raw":{},` +
+		`"labels":{"bsonType":["object","null"],"additionalProperties":{"bsonType":"string"}},` +
+		`"signature":{"bsonType":["binData","null"]}}}`
+	assert.Equal(t, want, toJSON(t, got))
+}
+
+func TestValidator(t *testing.T) {
+	type item struct {
+		Name string `bson:"name"`
+	}
+
+	got, err := Config{Strict: true}.Validator(item{})
+	require.NoError(t, err, "Validator error")
+
+	want := `{"$jsonSchema":{"bsonType":"object","required":["name"],` +
+		`"properties":{"_id":{},"name":{"bsonType":"string"}},"additionalProperties":false}}`
+	assert.Equal(t, want, toJSON(t, got))
+}
+
+func TestInline(t *testing.T) {
+	type base struct {
+		Version int `bson:"version"`
+	}
+	type document struct {
+		base  `bson:",inline"`
+		Name  string         `bson:"name"`
+		Other map[string]int `bson:",inline"`
+	}
+
+	got, err := Config{Strict: true}.Schema(document{})
+	require.NoError(t, err, "Schema error")
+
+	want := `{"bsonType":"object","required":["version","name"],` +
+		`"properties":{"_id":{},"version":{"bsonType":["int","long"]},"name":{"bsonType":"string"}},` +
+		`"additionalProperties":{"bsonType":["int","long"]}}`
+	assert.Equal(t, want, toJSON(t, got))
+}
+
+type node struct {
+	Children []node `bson:"children"`
+}
+
+func TestSchemaErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   any
+		wantErr string
+	}{
+		{"nil", nil, "cannot generate a schema for nil"},
+		{"not a struct", 1, "expected a struct, got int"},
+		{"recursive type", node{}, "recursive type jsonschema.node is not supported"},
+		{
+			"invalid option",
+			struct {
+				X int `jsonschema:"minimum"`
+			}{},
+			`invalid option "minimum" for field X`,
+		},
+		{
+			"unknown option",
+			struct {
+				X int `jsonschema:"exclusiveMinimum=1"`
+			}{},
+			`unknown option "exclusiveMinimum" for field X`,
+		},
+		{
+			"invalid enum",
+			struct {
+				X int `jsonschema:"enum=1|two"`
+			}{},
+			"invalid enum for field X",
+		},
+		{
+			"unsupported type",
+			struct {
+				C chan int
+			}{},
+			"C: jsonschema: unsupported type chan int",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Schema(tc.value)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
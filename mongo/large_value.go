@@ -0,0 +1,266 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// DefaultLargeValueThreshold is the default encoded size, in bytes, above which
+// LargeValue.Prepare stores a value in a LargeValueStore.
+const DefaultLargeValueThreshold = 1024 * 1024
+
+const largeValueFilename = "largeValue"
+
+// ErrLargeValueNotLoaded is returned by LargeValue.Get if the value was
+// offloaded and no LargeValueStore was given to load it from.
+var ErrLargeValueNotLoaded = errors.New("large value is offloaded and must be loaded from a LargeValueStore")
+
+// largeValueRef is stored in place of an offloaded LargeValue.
+type largeValueRef struct {
+	ID     bson.ObjectID `bson:"largeValueId"`
+	Length int64         `bson:"length"`
+}
+
+// largeValueDoc wraps a value so that values of any BSON type can be stored
+// as a document.
+type largeValueDoc[T any] struct {
+	V T `bson:"v"`
+}
+
+// LargeValueStore stores the values of LargeValue fields that are too large to
+// be embedded in their parent document. The values are stored as files in a
+// GridFS bucket, so they can exceed the 16MiB BSON document size limit.
+// Values are encoded and decoded with the registry and BSON options of the
+// database of the bucket.
+type LargeValueStore struct {
+	bucket    *GridFSBucket
+	threshold int
+	bsonOpts  *options.BSONOptions
+	registry  *bson.Registry
+}
+
+// NewLargeValueStore creates a LargeValueStore that stores values in bucket.
+// Values whose encoded size exceeds threshold bytes are offloaded. If
+// threshold is not positive, DefaultLargeValueThreshold is used.
+func NewLargeValueStore(bucket *GridFSBucket, threshold int) *LargeValueStore {
+	if threshold <= 0 {
+		threshold = DefaultLargeValueThreshold
+	}
+	s := &LargeValueStore{bucket: bucket, threshold: threshold}
+	if bucket != nil {
+		s.bsonOpts = bucket.db.bsonOpts
+		s.registry = bucket.db.registry
+	}
+	return s
+}
+
+// encode marshals v with the registry and BSON options of s, or with the
+// default registry if s is nil.
+func (s *LargeValueStore) encode(v any) (bsoncore.Document, error) {
+	if s == nil {
+		return marshal(v, nil, nil)
+	}
+	return marshal(v, s.bsonOpts, s.registry)
+}
+
+// decode unmarshals doc into v with the registry and BSON options of s, or
+// with the default registry if s is nil.
+func (s *LargeValueStore) decode(doc []byte, v any) error {
+	if s == nil {
+		return getDecoder(doc, nil, nil, nil).Decode(v)
+	}
+	return getDecoder(doc, s.bsonOpts, s.registry, nil).Decode(v)
+}
+
+// LargeValue is a field value of type T that is embedded in its parent
+// document when it is small, and stored in a LargeValueStore when it is too
+// large. This avoids "document too large" errors for documents that
+// occasionally contain an oversized field.
+//
+// LargeValue is a manual helper rather than a codec: encoding and decoding the
+// parent document never stores or loads values. Prepare must be called before
+// the parent document is written, and Get loads an offloaded value:
+//
+//	type Report struct {
+//		ID   bson.ObjectID            `bson:"_id"`
+//		Body mongo.LargeValue[[]byte] `bson:"body"`
+//	}
+//
+//	report := Report{ID: bson.NewObjectID(), Body: mongo.NewLargeValue(body)}
+//	if err := report.Body.Prepare(ctx, store); err != nil {
+//		return err
+//	}
+//	_, err = coll.InsertOne(ctx, report)
+//
+// An offloaded value is encoded as a reference document of the form
+// {largeValueId: <ObjectID>, length: <int64>}. Decoding a document keeps the
+// encoded value, which Get decodes with the registry and BSON options of its
+// LargeValueStore. The zero LargeValue holds the zero value of T.
+type LargeValue[T any] struct {
+	value T
+
+	// decoded is true if value holds the value. It is false for values that
+	// were decoded from a document or offloaded and not read with Get yet.
+	decoded bool
+
+	// raw is the encoded value, if it was encoded by Prepare or decoded from a
+	// document and not read with Get yet.
+	raw bson.RawValue
+
+	// ref references the offloaded value, if any.
+	ref *largeValueRef
+}
+
+var (
+	_ bson.ValueMarshaler   = LargeValue[any]{}
+	_ bson.ValueUnmarshaler = &LargeValue[any]{}
+)
+
+// NewLargeValue returns a LargeValue holding v.
+func NewLargeValue[T any](v T) LargeValue[T] {
+	return LargeValue[T]{value: v, decoded: true}
+}
+
+// Set replaces the value of lv with v. If the previous value was offloaded,
+// it is not deleted from the LargeValueStore; call Delete first to remove it.
+func (lv *LargeValue[T]) Set(v T) {
+	*lv = NewLargeValue(v)
+}
+
+// Offloaded reports whether the value is stored in a LargeValueStore rather
+// than embedded in the parent document.
+func (lv *LargeValue[T]) Offloaded() bool {
+	return lv.ref != nil
+}
+
+// Prepare encodes the value with the registry and BSON options of s and, if
+// its encoded size exceeds the threshold of s, stores it in s so that the
+// parent document only contains a reference to it. It must be called before
+// the parent document is written; otherwise the value is embedded and encoded
+// with the default registry. Prepare does nothing if the value has not been
+// changed since it was prepared, decoded or offloaded.
+func (lv *LargeValue[T]) Prepare(ctx context.Context, s *LargeValueStore) error {
+	if lv.ref != nil || lv.raw.Type != 0 {
+		return nil
+	}
+
+	doc, err := s.encode(largeValueDoc[T]{V: lv.value})
+	if err != nil {
+		return err
+	}
+	if len(doc) <= s.threshold {
+		v := doc.Lookup("v")
+		lv.raw = bson.RawValue{Type: bson.Type(v.Type), Value: v.Data}
+		return nil
+	}
+
+	id, err := s.bucket.UploadFromStream(ctx, largeValueFilename, bytes.NewReader(doc))
+	if err != nil {
+		return err
+	}
+	lv.ref = &largeValueRef{ID: id, Length: int64(len(doc))}
+	return nil
+}
+
+// Get returns the value of lv. If the value is offloaded and has not been
+// loaded yet, it is loaded from s. Values are decoded with the registry and
+// BSON options of s, or with the default registry if s is nil. If s is nil,
+// ErrLargeValueNotLoaded is returned for offloaded values that have not been
+// loaded.
+func (lv *LargeValue[T]) Get(ctx context.Context, s *LargeValueStore) (T, error) {
+	if lv.decoded || (lv.ref == nil && lv.raw.Type == 0) {
+		return lv.value, nil
+	}
+
+	var zero T
+	var doc []byte
+	if lv.ref != nil {
+		if s == nil {
+			return zero, ErrLargeValueNotLoaded
+		}
+		var buf bytes.Buffer
+		if _, err := s.bucket.DownloadToStream(ctx, lv.ref.ID, &buf); err != nil {
+			return zero, err
+		}
+		doc = buf.Bytes()
+	} else {
+		doc = bsoncore.NewDocumentBuilder().
+			AppendValue("v", bsoncore.Value{Type: bsoncore.Type(lv.raw.Type), Data: lv.raw.Value}).
+			Build()
+	}
+
+	var wrapped largeValueDoc[T]
+	if err := s.decode(doc, &wrapped); err != nil {
+		return zero, err
+	}
+	// The caller may modify the returned value, so it is encoded again by the
+	// next call to Prepare.
+	lv.value, lv.decoded, lv.raw = wrapped.V, true, bson.RawValue{}
+	return lv.value, nil
+}
+
+// Delete removes an offloaded value from s. If the value was loaded, it is
+// embedded in the parent document the next time the document is prepared and
+// written; otherwise lv is reset to the zero value of T. Delete does nothing if
+// the value is not offloaded.
+func (lv *LargeValue[T]) Delete(ctx context.Context, s *LargeValueStore) error {
+	if lv.ref == nil {
+		return nil
+	}
+	if err := s.bucket.Delete(ctx, lv.ref.ID); err != nil {
+		return err
+	}
+	if !lv.decoded {
+		var zero T
+		lv.value = zero
+	}
+	lv.ref = nil
+	lv.decoded = true
+	return nil
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface. It encodes a
+// reference document if the value is offloaded, and otherwise the value as
+// encoded by Prepare or decoded from a document. Values that were not
+// prepared are encoded with the default registry.
+func (lv LargeValue[T]) MarshalBSONValue() (byte, []byte, error) {
+	switch {
+	case lv.ref != nil:
+		doc := bsoncore.NewDocumentBuilder().
+			AppendObjectID("largeValueId", lv.ref.ID).
+			AppendInt64("length", lv.ref.Length).
+			Build()
+		return byte(bson.TypeEmbeddedDocument), doc, nil
+	case lv.raw.Type != 0:
+		return byte(lv.raw.Type), lv.raw.Value, nil
+	}
+	t, data, err := bson.MarshalValue(lv.value)
+	return byte(t), data, err
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface. A
+// reference document is decoded as an offloaded value that has not been
+// loaded. Any other value is kept encoded until Get is called.
+func (lv *LargeValue[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	if bson.Type(typ) == bson.TypeEmbeddedDocument {
+		if id, ok := bson.Raw(data).Lookup("largeValueId").ObjectIDOK(); ok {
+			length, _ := bson.Raw(data).Lookup("length").AsInt64OK()
+			*lv = LargeValue[T]{ref: &largeValueRef{ID: id, Length: length}}
+			return nil
+		}
+	}
+
+	*lv = LargeValue[T]{raw: bson.RawValue{Type: bson.Type(typ), Value: append([]byte(nil), data...)}}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestLargeValue(t *testing.T) {
+	t.Parallel()
+
+	type report struct {
+		Body LargeValue[string] `bson:"body"`
+	}
+
+	t.Run("inline", func(t *testing.T) {
+		t.Parallel()
+
+		r := report{Body: NewLargeValue("hello")}
+		store := NewLargeValueStore(nil, 0)
+		require.NoError(t, r.Body.Prepare(context.Background(), store), "Prepare error")
+		assert.False(t, r.Body.Offloaded(), "expected small value not to be offloaded")
+
+		doc, err := bson.Marshal(r)
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, `{"body": "hello"}`, bson.Raw(doc).String())
+
+		var got report
+		require.NoError(t, bson.Unmarshal(doc, &got), "Unmarshal error")
+		v, err := got.Body.Get(context.Background(), nil)
+		require.NoError(t, err, "Get error")
+		assert.Equal(t, "hello", v)
+	})
+	t.Run("offloaded reference", func(t *testing.T) {
+		t.Parallel()
+
+		id := bson.NewObjectID()
+		doc, err := bson.Marshal(bson.D{{Key: "body", Value: bson.D{
+			{Key: "largeValueId", Value: id},
+			{Key: "length", Value: int64(2048)},
+		}}})
+		require.NoError(t, err, "Marshal error")
+
+		var got report
+		require.NoError(t, bson.Unmarshal(doc, &got), "Unmarshal error")
+		assert.True(t, got.Body.Offloaded(), "expected value to be offloaded")
+		_, err = got.Body.Get(context.Background(), nil)
+		assert.ErrorIs(t, err, ErrLargeValueNotLoaded)
+
+		// Re-encoding an offloaded value preserves the reference without loading it.
+		encoded, err := bson.Marshal(got)
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, bson.Raw(doc), bson.Raw(encoded))
+	})
+	t.Run("set replaces reference", func(t *testing.T) {
+		t.Parallel()
+
+		lv := LargeValue[string]{ref: &largeValueRef{ID: bson.NewObjectID()}}
+		lv.Set("replaced")
+		assert.False(t, lv.Offloaded(), "expected Set to clear the reference")
+		v, err := lv.Get(context.Background(), nil)
+		require.NoError(t, err, "Get error")
+		assert.Equal(t, "replaced", v)
+	})
+	t.Run("store registry and BSON options", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewLargeValueStore(nil, 0)
+		store.bsonOpts = &options.BSONOptions{IntMinSize: true, DefaultDocumentM: true}
+
+		n := NewLargeValue(int64(1))
+		require.NoError(t, n.Prepare(context.Background(), store), "Prepare error")
+		typ, _, err := n.MarshalBSONValue()
+		require.NoError(t, err, "MarshalBSONValue error")
+		assert.Equal(t, byte(bson.TypeInt32), typ, "expected the value to be encoded with IntMinSize")
+
+		doc, err := bson.Marshal(bson.D{{Key: "body", Value: bson.D{{Key: "x", Value: int32(1)}}}})
+		require.NoError(t, err, "Marshal error")
+		var got struct {
+			Body LargeValue[any] `bson:"body"`
+		}
+		require.NoError(t, bson.Unmarshal(doc, &got), "Unmarshal error")
+		v, err := got.Body.Get(context.Background(), store)
+		require.NoError(t, err, "Get error")
+		assert.Equal(t, bson.M{"x": int32(1)}, v, "expected the value to be decoded with DefaultDocumentM")
+	})
+}
@@ -0,0 +1,341 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// defaultMaterializedViewInterval is the default time between runs of a
+// MaterializedView.
+const defaultMaterializedViewInterval = time.Minute
+
+// MaterializedViewConfig configures a MaterializedView.
+type MaterializedViewConfig struct {
+	// Pipeline is the aggregation pipeline that is run on new source
+	// documents. It is required and its last stage must be $merge. It accepts
+	// the same types as the pipeline parameter of Collection.Aggregate.
+	Pipeline any
+
+	// WatermarkField is the dotted path of a field of the source documents
+	// whose values increase as documents are inserted, such as an ObjectID _id
+	// or a creation date. It is required. Each run only processes documents
+	// with a value greater than the high-water mark of the previous run.
+	WatermarkField string
+
+	// InitialWatermark is the high-water mark of the first run, e.g. the value
+	// returned by MaterializedView.Watermark before a restart. If not set,
+	// the first run processes all documents that have the watermark field.
+	InitialWatermark any
+
+	// Interval is the time between runs started by MaterializedView.Run. The
+	// default is 1 minute.
+	Interval time.Duration
+
+	// AggregateOptions are the options of the aggregate command.
+	AggregateOptions *options.AggregateOptionsBuilder
+
+	// OnRun, if set, is called after each run with its result.
+	OnRun func(MaterializedViewRun)
+}
+
+// MaterializedViewRun is the result of a run of a MaterializedView.
+type MaterializedViewRun struct {
+	// Started is the time the run started.
+	Started time.Time
+
+	// Duration is the time the run took.
+	Duration time.Duration
+
+	// Skipped is true if there were no new source documents, so the pipeline
+	// was not run.
+	Skipped bool
+
+	// Watermark is the high-water mark after the run.
+	Watermark bson.RawValue
+
+	// Err is the error of a failed run. The high-water mark is not advanced
+	// by a failed run, so its documents are processed again by the next run.
+	Err error
+}
+
+// MaterializedViewStats are the metrics of a MaterializedView.
+type MaterializedViewStats struct {
+	// Runs is the number of runs, including skipped and failed runs.
+	Runs int64
+
+	// Skipped is the number of runs that found no new source documents.
+	Skipped int64
+
+	// Failed is the number of runs that returned an error.
+	Failed int64
+
+	// LastRun is the result of the latest run. It is the zero value if there
+	// have been no runs.
+	LastRun MaterializedViewRun
+
+	// LastSuccess is the start time of the latest run that did not fail.
+	LastSuccess time.Time
+}
+
+// MaterializedView incrementally maintains the output of an aggregation
+// pipeline that ends with $merge. Each run only aggregates the source
+// documents inserted since the previous run, as tracked by the high-water mark
+// of a field with increasing values:
+//
+//	view, err := mongo.NewMaterializedView(db.Collection("orders"), mongo.MaterializedViewConfig{
+//		Pipeline: mongo.Pipeline{
+//			{{"$group", bson.D{{"_id", "$customer"}, {"total", bson.D{{"$sum", "$amount"}}}}}},
+//			{{"$merge", bson.D{
+//				{"into", "customerTotals"},
+//				{"whenMatched", bson.A{bson.D{{"$set", bson.D{
+//					{"total", bson.D{{"$add", bson.A{"$total", "$$new.total"}}}},
+//				}}}}},
+//			}}},
+//		},
+//		WatermarkField: "_id",
+//		Interval:       5 * time.Minute,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	go view.Run(ctx)
+//
+// Each run finds the current maximum of the watermark field and aggregates the
+// documents with values greater than the previous high-water mark and at most
+// the new one, so documents inserted during a run are processed by the next
+// run. Documents inserted with a value that is not greater than the high-water
+// mark, such as updated documents, are not processed.
+//
+// A MaterializedView is safe for concurrent use. Runs are never concurrent.
+type MaterializedView struct {
+	source   *Collection
+	pipeline bsoncore.Array
+	field    string
+	interval time.Duration
+	aggOpts  *options.AggregateOptionsBuilder
+	onRun    func(MaterializedViewRun)
+
+	// runMu serializes runs.
+	runMu sync.Mutex
+
+	mu        sync.Mutex
+	watermark bson.RawValue
+	stats     MaterializedViewStats
+}
+
+// NewMaterializedView creates a MaterializedView that aggregates the documents
+// of source as configured by cfg. It returns an error if cfg is invalid.
+func NewMaterializedView(source *Collection, cfg MaterializedViewConfig) (*MaterializedView, error) {
+	if source == nil {
+		return nil, errors.New("a source collection is required")
+	}
+	if cfg.WatermarkField == "" {
+		return nil, errors.New("a watermark field is required")
+	}
+	if cfg.Pipeline == nil {
+		return nil, errors.New("a pipeline is required")
+	}
+
+	pipeline, hasOutputStage, err := marshalAggregatePipeline(cfg.Pipeline, source.bsonOpts, source.registry)
+	if err != nil {
+		return nil, err
+	}
+	if !hasOutputStage || lastStageName(bsoncore.Array(pipeline)) != "$merge" {
+		return nil, errors.New("the last stage of the pipeline must be $merge")
+	}
+
+	mv := &MaterializedView{
+		source:   source,
+		pipeline: bsoncore.Array(pipeline),
+		field:    cfg.WatermarkField,
+		interval: cfg.Interval,
+		aggOpts:  cfg.AggregateOptions,
+		onRun:    cfg.OnRun,
+	}
+	if mv.interval <= 0 {
+		mv.interval = defaultMaterializedViewInterval
+	}
+	if cfg.InitialWatermark != nil {
+		val, err := marshalValue(cfg.InitialWatermark, source.bsonOpts, source.registry)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling InitialWatermark: %w", err)
+		}
+		mv.watermark = bson.RawValue{Type: bson.Type(val.Type), Value: val.Data}
+	}
+	return mv, nil
+}
+
+// lastStageName returns the name of the last stage of pipeline.
+func lastStageName(pipeline bsoncore.Array) string {
+	values, err := pipeline.Values()
+	if err != nil || len(values) == 0 {
+		return ""
+	}
+	stage, ok := values[len(values)-1].DocumentOK()
+	if !ok {
+		return ""
+	}
+	elem, err := stage.IndexErr(0)
+	if err != nil {
+		return ""
+	}
+	return elem.Key()
+}
+
+// Run runs the view immediately and then at the configured interval until ctx
+// is done, and returns the error of ctx. Failed runs do not stop Run; their
+// errors are reported to OnRun and by Stats.
+func (mv *MaterializedView) Run(ctx context.Context) error {
+	ticker := time.NewTicker(mv.interval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = mv.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce aggregates the source documents inserted since the previous run and
+// advances the high-water mark if the aggregation succeeds. It returns the
+// result of the run and its error, if any.
+func (mv *MaterializedView) RunOnce(ctx context.Context) (MaterializedViewRun, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	mv.runMu.Lock()
+	defer mv.runMu.Unlock()
+
+	run := MaterializedViewRun{Started: time.Now(), Watermark: mv.Watermark()}
+	upper, found, err := mv.nextWatermark(ctx, run.Watermark)
+	switch {
+	case err != nil:
+		run.Err = err
+	case !found:
+		run.Skipped = true
+	default:
+		run.Err = mv.aggregate(ctx, run.Watermark, upper)
+		if run.Err == nil {
+			run.Watermark = upper
+		}
+	}
+	run.Duration = time.Since(run.Started)
+
+	mv.mu.Lock()
+	mv.stats.Runs++
+	if run.Skipped {
+		mv.stats.Skipped++
+	}
+	if run.Err != nil {
+		mv.stats.Failed++
+	} else {
+		mv.stats.LastSuccess = run.Started
+		mv.watermark = run.Watermark
+	}
+	mv.stats.LastRun = run
+	mv.mu.Unlock()
+
+	if mv.onRun != nil {
+		mv.onRun(run)
+	}
+	return run, run.Err
+}
+
+// nextWatermark returns the maximum value of the watermark field that is
+// greater than lower, and false if there is no such value.
+func (mv *MaterializedView) nextWatermark(ctx context.Context, lower bson.RawValue) (bson.RawValue, bool, error) {
+	cond := bson.D{{"$exists", true}}
+	if lower.Type != 0 {
+		cond = bson.D{{"$gt", lower}}
+	}
+	opts := options.FindOne().
+		SetSort(bson.D{{mv.field, -1}}).
+		SetProjection(bson.D{{"_id", 0}, {mv.field, 1}})
+
+	doc, err := mv.source.FindOne(ctx, bson.D{{mv.field, cond}}, opts).Raw()
+	if errors.Is(err, ErrNoDocuments) {
+		return bson.RawValue{}, false, nil
+	}
+	if err != nil {
+		return bson.RawValue{}, false, fmt.Errorf("error finding high-water mark: %w", err)
+	}
+
+	val, err := doc.LookupErr(strings.Split(mv.field, ".")...)
+	if err != nil {
+		return bson.RawValue{}, false, fmt.Errorf("error finding high-water mark: %w", err)
+	}
+	return val, true, nil
+}
+
+// aggregate runs the pipeline on the documents with a watermark field value
+// greater than lower and at most upper.
+func (mv *MaterializedView) aggregate(ctx context.Context, lower, upper bson.RawValue) error {
+	cond := bson.D{{"$lte", upper}}
+	if lower.Type != 0 {
+		cond = append(bson.D{{"$gt", lower}}, cond...)
+	}
+	match, err := bson.Marshal(bson.D{{"$match", bson.D{{mv.field, cond}}}})
+	if err != nil {
+		return err
+	}
+
+	stages, err := mv.pipeline.Values()
+	if err != nil {
+		return err
+	}
+	aidx, pipeline := bsoncore.AppendArrayStart(nil)
+	pipeline = bsoncore.AppendDocumentElement(pipeline, "0", match)
+	for i, stage := range stages {
+		pipeline = bsoncore.AppendValueElement(pipeline, strconv.Itoa(i+1), stage)
+	}
+	pipeline, _ = bsoncore.AppendArrayEnd(pipeline, aidx)
+
+	var opts []options.Lister[options.AggregateOptions]
+	if mv.aggOpts != nil {
+		opts = append(opts, mv.aggOpts)
+	}
+	cursor, err := mv.source.Aggregate(ctx, bsoncore.Array(pipeline), opts...)
+	if err != nil {
+		return err
+	}
+	return cursor.Close(ctx)
+}
+
+// Watermark returns the high-water mark of the view: the greatest value of the
+// watermark field that has been processed. It is the zero value if no
+// documents have been processed and no InitialWatermark was configured. Pass
+// it as InitialWatermark to continue the view after a restart.
+func (mv *MaterializedView) Watermark() bson.RawValue {
+	mv.mu.Lock()
+	defer mv.mu.Unlock()
+
+	return mv.watermark
+}
+
+// Stats returns the metrics of the view.
+func (mv *MaterializedView) Stats() MaterializedViewStats {
+	mv.mu.Lock()
+	defer mv.mu.Unlock()
+
+	return mv.stats
+}
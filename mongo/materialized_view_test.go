@@ -0,0 +1,96 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestNewMaterializedView(t *testing.T) {
+	coll := setupColl("source")
+	merge := bson.D{{"$merge", bson.D{{"into", "target"}}}}
+
+	testCases := []struct {
+		name   string
+		source *Collection
+		cfg    MaterializedViewConfig
+		errStr string
+	}{
+		{
+			name:   "no source",
+			cfg:    MaterializedViewConfig{Pipeline: Pipeline{merge}, WatermarkField: "_id"},
+			errStr: "a source collection is required",
+		},
+		{
+			name:   "no watermark field",
+			source: coll,
+			cfg:    MaterializedViewConfig{Pipeline: Pipeline{merge}},
+			errStr: "a watermark field is required",
+		},
+		{
+			name:   "no pipeline",
+			source: coll,
+			cfg:    MaterializedViewConfig{WatermarkField: "_id"},
+			errStr: "a pipeline is required",
+		},
+		{
+			name:   "no output stage",
+			source: coll,
+			cfg: MaterializedViewConfig{
+				Pipeline:       Pipeline{{{"$match", bson.D{}}}},
+				WatermarkField: "_id",
+			},
+			errStr: "the last stage of the pipeline must be $merge",
+		},
+		{
+			name:   "$out",
+			source: coll,
+			cfg: MaterializedViewConfig{
+				Pipeline:       Pipeline{{{"$out", "target"}}},
+				WatermarkField: "_id",
+			},
+			errStr: "the last stage of the pipeline must be $merge",
+		},
+		{
+			name:   "$merge",
+			source: coll,
+			cfg: MaterializedViewConfig{
+				Pipeline:       bson.A{bson.D{{"$match", bson.D{}}}, merge},
+				WatermarkField: "_id",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mv, err := NewMaterializedView(tc.source, tc.cfg)
+			if tc.errStr != "" {
+				assert.EqualError(t, err, tc.errStr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, defaultMaterializedViewInterval, mv.interval)
+			assert.Equal(t, bson.Type(0), mv.Watermark().Type, "expected no initial watermark")
+		})
+	}
+}
+
+func TestMaterializedViewInitialWatermark(t *testing.T) {
+	oid := bson.NewObjectID()
+	mv, err := NewMaterializedView(setupColl("source"), MaterializedViewConfig{
+		Pipeline:         Pipeline{{{"$merge", "target"}}},
+		WatermarkField:   "_id",
+		InitialWatermark: oid,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, oid, mv.Watermark().ObjectID())
+	assert.Equal(t, int64(0), mv.Stats().Runs)
+}
@@ -0,0 +1,210 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+)
+
+// defaultMemoryMaxWait is the default maximum time that an operation is
+// delayed while the accounted memory is at or above the soft limit.
+const defaultMemoryMaxWait = time.Second
+
+// MemoryStats reports the memory accounted by a Client configured with
+// options.ClientOptions.SetMemoryLimit.
+type MemoryStats struct {
+	// InUse is the number of bytes currently accounted.
+	InUse int64
+
+	// Peak is the highest number of bytes accounted since the Client was
+	// created.
+	Peak int64
+
+	// Limit is the soft limit, or 0 if none is set.
+	Limit int64
+
+	// DelayedOperations is the number of operations that were delayed because
+	// the accounted memory was at or above the soft limit.
+	DelayedOperations int64
+
+	// LimitExceededOperations is the number of operations that started while
+	// the accounted memory was at or above the soft limit, because their
+	// maximum wait expired or because the maximum wait is 0.
+	LimitExceededOperations int64
+}
+
+// memoryAccountant accounts for the memory held by a Client and delays new
+// operations while the accounted memory is at or above the soft limit.
+type memoryAccountant struct {
+	limit   int64
+	maxWait time.Duration
+
+	mu       sync.Mutex
+	inUse    int64
+	peak     int64
+	delayed  int64
+	exceeded int64
+
+	// released is closed when memory is released while operations wait for
+	// the accounted memory to drop below the limit.
+	released chan struct{}
+}
+
+// newMemoryAccountant returns a memoryAccountant configured with opts, or nil
+// if opts is nil.
+func newMemoryAccountant(opts *options.MemoryLimitOptions) *memoryAccountant {
+	if opts == nil {
+		return nil
+	}
+	m := &memoryAccountant{maxWait: defaultMemoryMaxWait}
+	if opts.SoftLimit != nil {
+		m.limit = *opts.SoftLimit
+	}
+	if opts.MaxWait != nil {
+		m.maxWait = *opts.MaxWait
+	}
+	return m
+}
+
+// memoryAccountant returns the memory accountant of c, or nil if c does not
+// account for its memory.
+func (c *Client) memoryAccountant() driver.MemoryAccountant {
+	if c.memory == nil {
+		return nil
+	}
+	return c.memory
+}
+
+// Acquire accounts for n more bytes.
+func (m *memoryAccountant) Acquire(n int) {
+	if m == nil || n <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inUse += int64(n)
+	if m.inUse > m.peak {
+		m.peak = m.inUse
+	}
+}
+
+// Release accounts for n fewer bytes and wakes up the operations waiting for
+// the accounted memory to drop below the limit, if it does.
+func (m *memoryAccountant) Release(n int) {
+	if m == nil || n <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inUse -= int64(n)
+	if m.released != nil && m.inUse < m.limit {
+		close(m.released)
+		m.released = nil
+	}
+}
+
+// wait blocks while the accounted memory is at or above the limit, until the
+// maximum wait expires. It returns the error of ctx if ctx is done first.
+func (m *memoryAccountant) wait(ctx context.Context) error {
+	if m == nil || m.limit <= 0 {
+		return nil
+	}
+
+	m.mu.Lock()
+	if m.inUse < m.limit {
+		m.mu.Unlock()
+		return nil
+	}
+	if m.maxWait == 0 {
+		m.exceeded++
+		m.mu.Unlock()
+		return nil
+	}
+	m.delayed++
+	m.mu.Unlock()
+
+	timer := time.NewTimer(m.maxWait)
+	defer timer.Stop()
+	for {
+		m.mu.Lock()
+		if m.inUse < m.limit {
+			m.mu.Unlock()
+			return nil
+		}
+		if m.released == nil {
+			m.released = make(chan struct{})
+		}
+		released := m.released
+		m.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-timer.C:
+			m.mu.Lock()
+			m.exceeded++
+			m.mu.Unlock()
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// accountDocuments returns a function that runs execute while the memory of
+// docs is accounted for.
+func (m *memoryAccountant) accountDocuments(
+	docs []bsoncore.Document,
+	execute func(context.Context) error,
+) func(context.Context) error {
+	if m == nil {
+		return execute
+	}
+	return func(ctx context.Context) error {
+		var n int
+		for _, doc := range docs {
+			n += len(doc)
+		}
+		m.Acquire(n)
+		defer m.Release(n)
+
+		return execute(ctx)
+	}
+}
+
+func (m *memoryAccountant) stats() MemoryStats {
+	if m == nil {
+		return MemoryStats{}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return MemoryStats{
+		InUse:                   m.inUse,
+		Peak:                    m.peak,
+		Limit:                   m.limit,
+		DelayedOperations:       m.delayed,
+		LimitExceededOperations: m.exceeded,
+	}
+}
+
+// MemoryStats returns the memory accounted by the Client. It returns a zero
+// MemoryStats if the Client was not configured with
+// options.ClientOptions.SetMemoryLimit.
+func (c *Client) MemoryStats() MemoryStats {
+	return c.memory.stats()
+}
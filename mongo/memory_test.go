@@ -0,0 +1,131 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+func TestMemoryAccountant(t *testing.T) {
+	t.Run("nil options", func(t *testing.T) {
+		m := newMemoryAccountant(nil)
+		assert.Nil(t, m)
+		assert.NoError(t, m.wait(context.Background()))
+		assert.Equal(t, MemoryStats{}, m.stats())
+	})
+	t.Run("accounting only", func(t *testing.T) {
+		m := newMemoryAccountant(options.MemoryLimit())
+		m.Acquire(10)
+		m.Acquire(5)
+		m.Release(10)
+		assert.NoError(t, m.wait(context.Background()))
+		assert.Equal(t, MemoryStats{InUse: 5, Peak: 15}, m.stats())
+	})
+	t.Run("below limit", func(t *testing.T) {
+		m := newMemoryAccountant(options.MemoryLimit().SetSoftLimit(10))
+		m.Acquire(9)
+		assert.NoError(t, m.wait(context.Background()))
+		assert.Equal(t, int64(0), m.stats().DelayedOperations)
+	})
+	t.Run("waits for release", func(t *testing.T) {
+		m := newMemoryAccountant(options.MemoryLimit().SetSoftLimit(10).SetMaxWait(time.Minute))
+		m.Acquire(10)
+
+		done := make(chan error, 1)
+		go func() { done <- m.wait(context.Background()) }()
+
+		select {
+		case err := <-done:
+			t.Fatalf("expected wait to block, got %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		m.Release(1)
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for wait to return after release")
+		}
+		stats := m.stats()
+		assert.Equal(t, int64(1), stats.DelayedOperations)
+		assert.Equal(t, int64(0), stats.LimitExceededOperations)
+	})
+	t.Run("max wait expires", func(t *testing.T) {
+		m := newMemoryAccountant(options.MemoryLimit().SetSoftLimit(10).SetMaxWait(time.Millisecond))
+		m.Acquire(20)
+
+		assert.NoError(t, m.wait(context.Background()))
+		stats := m.stats()
+		assert.Equal(t, int64(1), stats.DelayedOperations)
+		assert.Equal(t, int64(1), stats.LimitExceededOperations)
+	})
+	t.Run("zero max wait", func(t *testing.T) {
+		m := newMemoryAccountant(options.MemoryLimit().SetSoftLimit(10).SetMaxWait(0))
+		m.Acquire(20)
+
+		assert.NoError(t, m.wait(context.Background()))
+		stats := m.stats()
+		assert.Equal(t, int64(0), stats.DelayedOperations)
+		assert.Equal(t, int64(1), stats.LimitExceededOperations)
+	})
+	t.Run("context done", func(t *testing.T) {
+		m := newMemoryAccountant(options.MemoryLimit().SetSoftLimit(10).SetMaxWait(time.Minute))
+		m.Acquire(20)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, m.wait(ctx), context.DeadlineExceeded)
+	})
+	t.Run("account documents", func(t *testing.T) {
+		m := newMemoryAccountant(options.MemoryLimit())
+		docs := []bsoncore.Document{
+			bsoncore.NewDocumentBuilder().AppendInt32("x", 1).Build(),
+			bsoncore.NewDocumentBuilder().AppendString("y", "abc").Build(),
+		}
+
+		var inUse int64
+		execute := m.accountDocuments(docs, func(context.Context) error {
+			inUse = m.stats().InUse
+			return nil
+		})
+		require.NoError(t, execute(context.Background()))
+		assert.Equal(t, int64(len(docs[0])+len(docs[1])), inUse)
+		assert.Equal(t, int64(0), m.stats().InUse)
+	})
+}
+
+func TestCursorMemoryAccounting(t *testing.T) {
+	tbc := newTestBatchCursor(2, 3)
+	first, second := len(tbc.batches[0].List), len(tbc.batches[1].List)
+
+	cursor, err := newCursor(tbc, nil, nil)
+	require.NoError(t, err)
+	cursor.memory = newMemoryAccountant(options.MemoryLimit())
+
+	require.True(t, cursor.Next(context.Background()), "expected a document")
+	assert.Equal(t, int64(first), cursor.memory.stats().InUse)
+
+	for i := 0; i < 3; i++ {
+		require.True(t, cursor.Next(context.Background()), "expected a document")
+	}
+	assert.Equal(t, int64(second), cursor.memory.stats().InUse)
+
+	for cursor.Next(context.Background()) {
+	}
+	assert.Equal(t, int64(0), cursor.memory.stats().InUse)
+
+	require.NoError(t, cursor.Close(context.Background()))
+	assert.Equal(t, int64(0), cursor.memory.stats().InUse)
+}
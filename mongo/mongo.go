@@ -59,6 +59,9 @@ func getEncoder(
 		if opts.IntMinSize {
 			enc.IntMinSize()
 		}
+		if opts.Float32SliceAsVector {
+			enc.Float32SliceAsVector()
+		}
 		if opts.NilByteSliceAsEmpty {
 			enc.NilByteSliceAsEmpty()
 		}
@@ -152,7 +155,7 @@ func ensureID(
 		var id struct {
 			ID any `bson:"_id"`
 		}
-		dec := getDecoder(doc, bsonOpts, reg)
+		dec := getDecoder(doc, bsonOpts, reg, nil)
 		err = dec.Decode(&id)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error unmarshaling BSON document: %w", err)
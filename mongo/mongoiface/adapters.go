@@ -0,0 +1,193 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongoiface
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// WrapClient returns c as a Client. It returns nil if c is nil.
+func WrapClient(c *mongo.Client) Client {
+	if c == nil {
+		return nil
+	}
+	return client{c}
+}
+
+// WrapDatabase returns db as a Database. It returns nil if db is nil.
+func WrapDatabase(db *mongo.Database) Database {
+	if db == nil {
+		return nil
+	}
+	return database{db}
+}
+
+// WrapCollection returns coll as a Collection. It returns nil if coll is nil.
+func WrapCollection(coll *mongo.Collection) Collection {
+	if coll == nil {
+		return nil
+	}
+	return collection{coll}
+}
+
+// WrapCursor returns c as a Cursor. It returns nil if c is nil, so that the
+// Cursor returned with an error by an operation is nil.
+func WrapCursor(c *mongo.Cursor) Cursor {
+	if c == nil {
+		return nil
+	}
+	return cursor{c}
+}
+
+// WrapSingleResult returns sr as a SingleResult. It returns nil if sr is nil.
+func WrapSingleResult(sr *mongo.SingleResult) SingleResult {
+	if sr == nil {
+		return nil
+	}
+	return sr
+}
+
+// client adapts a mongo.Client to the Client interface.
+type client struct {
+	*mongo.Client
+}
+
+var _ Client = client{}
+
+func (c client) Database(name string, opts ...options.Lister[options.DatabaseOptions]) Database {
+	return WrapDatabase(c.Client.Database(name, opts...))
+}
+
+// database adapts a mongo.Database to the Database interface.
+type database struct {
+	*mongo.Database
+}
+
+var _ Database = database{}
+
+func (db database) Aggregate(
+	ctx context.Context,
+	pipeline any,
+	opts ...options.Lister[options.AggregateOptions],
+) (Cursor, error) {
+	c, err := db.Database.Aggregate(ctx, pipeline, opts...)
+	return WrapCursor(c), err
+}
+
+func (db database) Client() Client {
+	return WrapClient(db.Database.Client())
+}
+
+func (db database) Collection(name string, opts ...options.Lister[options.CollectionOptions]) Collection {
+	return WrapCollection(db.Database.Collection(name, opts...))
+}
+
+func (db database) ListCollections(
+	ctx context.Context,
+	filter any,
+	opts ...options.Lister[options.ListCollectionsOptions],
+) (Cursor, error) {
+	c, err := db.Database.ListCollections(ctx, filter, opts...)
+	return WrapCursor(c), err
+}
+
+func (db database) RunCommand(
+	ctx context.Context,
+	runCommand any,
+	opts ...options.Lister[options.RunCmdOptions],
+) SingleResult {
+	return WrapSingleResult(db.Database.RunCommand(ctx, runCommand, opts...))
+}
+
+func (db database) RunCommandCursor(
+	ctx context.Context,
+	runCommand any,
+	opts ...options.Lister[options.RunCmdOptions],
+) (Cursor, error) {
+	c, err := db.Database.RunCommandCursor(ctx, runCommand, opts...)
+	return WrapCursor(c), err
+}
+
+// collection adapts a mongo.Collection to the Collection interface.
+type collection struct {
+	*mongo.Collection
+}
+
+var _ Collection = collection{}
+
+func (coll collection) Aggregate(
+	ctx context.Context,
+	pipeline any,
+	opts ...options.Lister[options.AggregateOptions],
+) (Cursor, error) {
+	c, err := coll.Collection.Aggregate(ctx, pipeline, opts...)
+	return WrapCursor(c), err
+}
+
+func (coll collection) Clone(opts ...options.Lister[options.CollectionOptions]) Collection {
+	return WrapCollection(coll.Collection.Clone(opts...))
+}
+
+func (coll collection) Database() Database {
+	return WrapDatabase(coll.Collection.Database())
+}
+
+func (coll collection) Find(ctx context.Context, filter any, opts ...options.Lister[options.FindOptions]) (Cursor, error) {
+	c, err := coll.Collection.Find(ctx, filter, opts...)
+	return WrapCursor(c), err
+}
+
+func (coll collection) FindOne(
+	ctx context.Context,
+	filter any,
+	opts ...options.Lister[options.FindOneOptions],
+) SingleResult {
+	return WrapSingleResult(coll.Collection.FindOne(ctx, filter, opts...))
+}
+
+func (coll collection) FindOneAndDelete(
+	ctx context.Context,
+	filter any,
+	opts ...options.Lister[options.FindOneAndDeleteOptions],
+) SingleResult {
+	return WrapSingleResult(coll.Collection.FindOneAndDelete(ctx, filter, opts...))
+}
+
+func (coll collection) FindOneAndReplace(
+	ctx context.Context,
+	filter any,
+	replacement any,
+	opts ...options.Lister[options.FindOneAndReplaceOptions],
+) SingleResult {
+	return WrapSingleResult(coll.Collection.FindOneAndReplace(ctx, filter, replacement, opts...))
+}
+
+func (coll collection) FindOneAndUpdate(
+	ctx context.Context,
+	filter any,
+	update any,
+	opts ...options.Lister[options.FindOneAndUpdateOptions],
+) SingleResult {
+	return WrapSingleResult(coll.Collection.FindOneAndUpdate(ctx, filter, update, opts...))
+}
+
+// cursor adapts a mongo.Cursor to the Cursor interface.
+type cursor struct {
+	*mongo.Cursor
+}
+
+var _ Cursor = cursor{}
+
+func (c cursor) Current() bson.Raw {
+	return c.Cursor.Current
+}
+
+var _ SingleResult = &mongo.SingleResult{}
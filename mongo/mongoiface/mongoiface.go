@@ -0,0 +1,194 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package mongoiface provides interfaces for the Client, Database, Collection,
+// Cursor, and SingleResult types of the mongo package, so that code that uses
+// them can be tested with mocks generated by standard tools, such as mockgen,
+// or written by hand.
+//
+// The interfaces have the methods of the corresponding mongo types, except
+// that methods that return a *mongo.Client, *mongo.Database,
+// *mongo.Collection, *mongo.Cursor, or *mongo.SingleResult return the
+// corresponding interface instead, so that a mock Database can return a mock
+// Collection. The Wrap functions adapt the mongo types to the interfaces:
+//
+//	func NewStore(db mongoiface.Database) *Store { ... }
+//
+//	store := NewStore(mongoiface.WrapDatabase(client.Database("app")))
+//
+// Mocks can return real cursors and results built from documents with
+// mongo.NewCursorFromDocuments and mongo.NewSingleResultFromDocument, wrapped
+// with WrapCursor and WrapSingleResult.
+package mongoiface
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/session"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
+)
+
+// Client is an interface for mongo.Client.
+type Client interface {
+	AddShardToZone(ctx context.Context, shard, zone string) error
+	AdvanceClusterTime(d bson.Raw) error
+	AutoEncryptionInfo() mongo.AutoEncryptionInfo
+	BuildInfo(ctx context.Context) (*mongo.ServerBuildInfo, error)
+	BulkWrite(ctx context.Context, writes []mongo.ClientBulkWrite,
+		opts ...options.Lister[options.ClientBulkWriteOptions]) (*mongo.ClientBulkWriteResult, error)
+	ClusterTime() bson.Raw
+	ConnectAndWait(ctx context.Context) error
+	ConnectionPoolStats() map[string]topology.PoolStats
+	Database(name string, opts ...options.Lister[options.DatabaseOptions]) Database
+	Disconnect(ctx context.Context) error
+	EnsureIndexesForAll(ctx context.Context, indexes map[mongo.Namespace][]mongo.IndexModel,
+		opts ...options.Lister[options.EnsureIndexesOptions]) (map[mongo.Namespace]mongo.EnsureIndexesResult, error)
+	Health(ctx context.Context) (mongo.HealthStatus, error)
+	ListDatabaseNames(ctx context.Context, filter any,
+		opts ...options.Lister[options.ListDatabasesOptions]) ([]string, error)
+	ListDatabases(ctx context.Context, filter any,
+		opts ...options.Lister[options.ListDatabasesOptions]) (mongo.ListDatabasesResult, error)
+	ListZones(ctx context.Context) ([]mongo.Zone, error)
+	MemoryStats() mongo.MemoryStats
+	NumberSessionsInProgress() int
+	Ping(ctx context.Context, rp *readpref.ReadPref) error
+	PingWithResult(ctx context.Context, rp *readpref.ReadPref) (mongo.PingResult, error)
+	RemoveShardFromZone(ctx context.Context, shard, zone string) error
+	ReplicationLag(ctx context.Context) (*mongo.ReplicationLagReport, error)
+	SecondaryStaleness() map[string]time.Duration
+	SessionPoolStats() session.PoolStats
+	StartSession(opts ...options.Lister[options.SessionOptions]) (*mongo.Session, error)
+	SubscribeBuildInfo(fn func(mongo.ServerBuildInfo)) (unsubscribe func())
+	SupportsFeature(ctx context.Context, feature mongo.Feature) (bool, error)
+	TopologyDescription() event.TopologyDescription
+	UpdateZoneKeyRange(ctx context.Context, ns string, min, max any, zone string) error
+	UseSession(ctx context.Context, fn func(context.Context) error) error
+	UseSessionWithOptions(ctx context.Context, opts *options.SessionOptionsBuilder,
+		fn func(context.Context) error) error
+	Watch(ctx context.Context, pipeline any,
+		opts ...options.Lister[options.ChangeStreamOptions]) (*mongo.ChangeStream, error)
+	WithConnection(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Database is an interface for mongo.Database.
+type Database interface {
+	Aggregate(ctx context.Context, pipeline any, opts ...options.Lister[options.AggregateOptions]) (Cursor, error)
+	Client() Client
+	CloneCollectionStructure(ctx context.Context, src, dst string) error
+	Collection(name string, opts ...options.Lister[options.CollectionOptions]) Collection
+	CreateCollection(ctx context.Context, name string, opts ...options.Lister[options.CreateCollectionOptions]) error
+	CreateView(ctx context.Context, viewName, viewOn string, pipeline any,
+		opts ...options.Lister[options.CreateViewOptions]) error
+	Drop(ctx context.Context) error
+	GridFSBucket(opts ...options.Lister[options.BucketOptions]) *mongo.GridFSBucket
+	ListCollectionNames(ctx context.Context, filter any,
+		opts ...options.Lister[options.ListCollectionsOptions]) ([]string, error)
+	ListCollectionSpecifications(ctx context.Context, filter any,
+		opts ...options.Lister[options.ListCollectionsOptions]) ([]mongo.CollectionSpecification, error)
+	ListCollections(ctx context.Context, filter any,
+		opts ...options.Lister[options.ListCollectionsOptions]) (Cursor, error)
+	Name() string
+	RunCommand(ctx context.Context, runCommand any, opts ...options.Lister[options.RunCmdOptions]) SingleResult
+	RunCommandCursor(ctx context.Context, runCommand any, opts ...options.Lister[options.RunCmdOptions]) (Cursor, error)
+	RunCommands(ctx context.Context, cmds []bson.D,
+		opts ...options.Lister[options.RunCommandsOptions]) ([]mongo.CommandResult, error)
+	Watch(ctx context.Context, pipeline any,
+		opts ...options.Lister[options.ChangeStreamOptions]) (*mongo.ChangeStream, error)
+}
+
+// Collection is an interface for mongo.Collection.
+type Collection interface {
+	Aggregate(ctx context.Context, pipeline any, opts ...options.Lister[options.AggregateOptions]) (Cursor, error)
+	AggregateEach(ctx context.Context, pipeline any, workers int, fn func(doc bson.Raw) error,
+		opts ...options.Lister[options.AggregateOptions]) error
+	BulkWrite(ctx context.Context, models []mongo.WriteModel,
+		opts ...options.Lister[options.BulkWriteOptions]) (*mongo.BulkWriteResult, error)
+	ChangeStreamPreAndPostImagesEnabled(ctx context.Context) (bool, error)
+	Clone(opts ...options.Lister[options.CollectionOptions]) Collection
+	CountDocuments(ctx context.Context, filter any, opts ...options.Lister[options.CountOptions]) (int64, error)
+	Database() Database
+	DeleteByIDs(ctx context.Context, ids []any, batchSize int, progress func(mongo.DeleteByIDsProgress),
+		opts ...options.Lister[options.DeleteManyOptions]) (*mongo.DeleteResult, error)
+	DeleteMany(ctx context.Context, filter any,
+		opts ...options.Lister[options.DeleteManyOptions]) (*mongo.DeleteResult, error)
+	DeleteOne(ctx context.Context, filter any,
+		opts ...options.Lister[options.DeleteOneOptions]) (*mongo.DeleteResult, error)
+	Distinct(ctx context.Context, fieldName string, filter any,
+		opts ...options.Lister[options.DistinctOptions]) *mongo.DistinctResult
+	Drop(ctx context.Context, opts ...options.Lister[options.DropCollectionOptions]) error
+	EnableChangeStreamPreAndPostImages(ctx context.Context, enabled bool) error
+	EstimatedDocumentCount(ctx context.Context,
+		opts ...options.Lister[options.EstimatedDocumentCountOptions]) (int64, error)
+	Exists(ctx context.Context, filter any, opts ...options.Lister[options.ExistsOptions]) (bool, error)
+	Find(ctx context.Context, filter any, opts ...options.Lister[options.FindOptions]) (Cursor, error)
+	FindOne(ctx context.Context, filter any, opts ...options.Lister[options.FindOneOptions]) SingleResult
+	FindOneAndDelete(ctx context.Context, filter any,
+		opts ...options.Lister[options.FindOneAndDeleteOptions]) SingleResult
+	FindOneAndReplace(ctx context.Context, filter any, replacement any,
+		opts ...options.Lister[options.FindOneAndReplaceOptions]) SingleResult
+	FindOneAndUpdate(ctx context.Context, filter any, update any,
+		opts ...options.Lister[options.FindOneAndUpdateOptions]) SingleResult
+	Indexes() mongo.IndexView
+	InsertMany(ctx context.Context, documents any,
+		opts ...options.Lister[options.InsertManyOptions]) (*mongo.InsertManyResult, error)
+	InsertOne(ctx context.Context, document any,
+		opts ...options.Lister[options.InsertOneOptions]) (*mongo.InsertOneResult, error)
+	ModifyTimeSeries(ctx context.Context, opts ...options.Lister[options.ModifyTimeSeriesOptions]) error
+	Name() string
+	PlanInsertMany(documents any, opts ...options.Lister[options.InsertPlanOptions]) ([]mongo.InsertBatch, error)
+	Rename(ctx context.Context, newName string, dropTarget bool) error
+	ReplaceOne(ctx context.Context, filter any, replacement any,
+		opts ...options.Lister[options.ReplaceOptions]) (*mongo.UpdateResult, error)
+	SearchIndexes() mongo.SearchIndexView
+	Tail(ctx context.Context, filter any, opts ...options.Lister[options.TailOptions]) (*mongo.TailableCursor, error)
+	UpdateByID(ctx context.Context, id any, update any,
+		opts ...options.Lister[options.UpdateOneOptions]) (*mongo.UpdateResult, error)
+	UpdateMany(ctx context.Context, filter any, update any,
+		opts ...options.Lister[options.UpdateManyOptions]) (*mongo.UpdateResult, error)
+	UpdateOne(ctx context.Context, filter any, update any,
+		opts ...options.Lister[options.UpdateOneOptions]) (*mongo.UpdateResult, error)
+	ValidateWatchOptions(ctx context.Context, opts ...options.Lister[options.ChangeStreamOptions]) error
+	Watch(ctx context.Context, pipeline any,
+		opts ...options.Lister[options.ChangeStreamOptions]) (*mongo.ChangeStream, error)
+}
+
+// Cursor is an interface for mongo.Cursor.
+type Cursor interface {
+	All(ctx context.Context, results any) error
+	Close(ctx context.Context) error
+	CloseIfIdle(ctx context.Context, idle time.Duration) (bool, error)
+
+	// Current returns the value of the Current field of mongo.Cursor, which
+	// is the current document of the cursor.
+	Current() bson.Raw
+
+	CurrentCopy() bson.Raw
+	Decode(val any) error
+	Err() error
+	ID() int64
+	IdleTime() time.Duration
+	Next(ctx context.Context) bool
+	NextBatch(ctx context.Context) ([]bson.Raw, error)
+	PinnedServer() (mongo.PinnedServer, bool)
+	RemainingBatchLength() int
+	SetBatchSize(batchSize int32)
+	SetComment(comment any)
+	SetMaxAwaitTime(dur time.Duration)
+	TryNext(ctx context.Context) bool
+}
+
+// SingleResult is an interface for mongo.SingleResult.
+type SingleResult interface {
+	Decode(v any) error
+	Err() error
+	Raw() (bson.Raw, error)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongoiface
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/mongotest"
+)
+
+func TestInterfacesMirrorTypes(t *testing.T) {
+	testCases := []struct {
+		concrete reflect.Type
+		iface    reflect.Type
+		extra    []string
+	}{
+		{reflect.TypeOf(&mongo.Client{}), reflect.TypeOf((*Client)(nil)).Elem(), nil},
+		{reflect.TypeOf(&mongo.Database{}), reflect.TypeOf((*Database)(nil)).Elem(), nil},
+		{reflect.TypeOf(&mongo.Collection{}), reflect.TypeOf((*Collection)(nil)).Elem(), nil},
+		{reflect.TypeOf(&mongo.Cursor{}), reflect.TypeOf((*Cursor)(nil)).Elem(), []string{"Current"}},
+		{reflect.TypeOf(&mongo.SingleResult{}), reflect.TypeOf((*SingleResult)(nil)).Elem(), nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.iface.Name(), func(t *testing.T) {
+			want := append([]string(nil), tc.extra...)
+			for i := 0; i < tc.concrete.NumMethod(); i++ {
+				want = append(want, tc.concrete.Method(i).Name)
+			}
+			got := make([]string, 0, tc.iface.NumMethod())
+			for i := 0; i < tc.iface.NumMethod(); i++ {
+				got = append(got, tc.iface.Method(i).Name)
+			}
+			assert.ElementsMatch(t, want, got, "expected %v to have the methods of %v", tc.iface, tc.concrete)
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	assert.Nil(t, WrapClient(nil))
+	assert.Nil(t, WrapDatabase(nil))
+	assert.Nil(t, WrapCollection(nil))
+	assert.Nil(t, WrapCursor(nil))
+	assert.Nil(t, WrapSingleResult(nil))
+
+	md := mongotest.NewDeployment()
+	mc, err := mongotest.Connect(md)
+	require.NoError(t, err)
+	defer func() { _ = mc.Disconnect(context.Background()) }()
+
+	c := WrapClient(mc)
+	coll := c.Database("db").Collection("coll")
+	assert.Equal(t, "coll", coll.Name())
+	assert.Equal(t, "db", coll.Database().Name())
+	assert.Equal(t, "coll", coll.Clone().Name())
+	assert.Equal(t, mc, coll.Database().Client().(client).Client)
+
+	md.AddResponses(mongotest.CursorResponse("db.coll", 0, bson.D{{"x", int32(1)}}))
+	cursor, err := coll.Find(context.Background(), bson.D{})
+	require.NoError(t, err)
+	require.True(t, cursor.Next(context.Background()), "expected a document, got error %v", cursor.Err())
+	assert.Equal(t, int32(1), cursor.Current().Lookup("x").Int32())
+	require.NoError(t, cursor.Close(context.Background()))
+
+	md.AddResponses(mongotest.CommandErrorResponse(mongotest.CommandError{Code: 2, Message: "bad filter"}))
+	cursor, err = coll.Find(context.Background(), bson.D{})
+	assert.Error(t, err, "expected Find to fail")
+	assert.Nil(t, cursor, "expected a nil Cursor")
+
+	md.AddResponses(mongotest.CursorResponse("db.coll", 0))
+	err = coll.FindOne(context.Background(), bson.D{}).Err()
+	assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+}
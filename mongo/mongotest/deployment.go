@@ -0,0 +1,449 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/csot"
+	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/wiremessage"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/xoptions"
+)
+
+const serverAddress = address.Address("mongotest:27017")
+
+var sessionTimeoutMinutes int64 = 30
+
+// serverDescription is the description of the server of every Deployment.
+var serverDescription = description.Server{
+	Addr:                  serverAddress,
+	CanonicalAddr:         serverAddress,
+	MaxDocumentSize:       16777216,
+	MaxMessageSize:        48000000,
+	MaxBatchCount:         100000,
+	SessionTimeoutMinutes: &sessionTimeoutMinutes,
+	Kind:                  description.ServerKindRSPrimary,
+	WireVersion: &description.VersionRange{
+		Max: driverutil.MaxWireVersion,
+	},
+}
+
+// Command is a command received by a Deployment.
+type Command struct {
+	// Name is the name of the command, which is the key of its first element.
+	Name string
+
+	// Database is the database the command was run against.
+	Database string
+
+	// Command is the command document. The documents that the driver sends
+	// separately from the command document, such as the documents of an
+	// insert command, are included as an array, as in command monitoring
+	// events.
+	Command bson.Raw
+
+	// ConnectionID is the ID of the connection the command was sent on. Each
+	// operation checks out a new connection unless it is pinned to one.
+	ConnectionID int64
+}
+
+// HandlerFunc returns the response of a Deployment to cmd. If it returns an
+// error, the command fails as if the connection had been closed with that
+// error, which the driver reports as a network error and may retry.
+type HandlerFunc func(ctx context.Context, cmd *Command) (bson.D, error)
+
+// Deployment is an in-memory mock deployment. It is safe for concurrent use.
+//
+// A Deployment responds to each command with the handler registered for the
+// name of the command with Handle, if any, or else with the next response
+// added with AddResponses. If there is neither, it responds with a command
+// error.
+type Deployment struct {
+	mu        sync.Mutex
+	handlers  map[string]HandlerFunc
+	responses []bson.D
+	commands  []Command
+	latency   time.Duration
+
+	nextConnectionID atomic.Int64
+}
+
+var _ driver.Deployment = &Deployment{}
+var _ driver.Server = &Deployment{}
+var _ driver.Connector = &Deployment{}
+var _ driver.Disconnector = &Deployment{}
+var _ driver.Subscriber = &Deployment{}
+
+// NewDeployment creates a new Deployment with the given responses, which
+// are sent in order to the commands that do not have a handler.
+func NewDeployment(responses ...bson.D) *Deployment {
+	return &Deployment{
+		handlers:  make(map[string]HandlerFunc),
+		responses: responses,
+	}
+}
+
+// Connect creates a new Client that runs its operations against d. The
+// options in opts are applied as for mongo.Connect, except that the hosts
+// and connection settings are ignored.
+func Connect(d *Deployment, opts ...*options.ClientOptions) (*mongo.Client, error) {
+	deploymentOpts := options.Client()
+	if err := xoptions.SetInternalClientOptions(deploymentOpts, "deployment", d); err != nil {
+		return nil, err
+	}
+	return mongo.Connect(append(opts, deploymentOpts)...)
+}
+
+// AddResponses adds responses to the end of the queue of responses of d.
+func (d *Deployment) AddResponses(responses ...bson.D) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.responses = append(d.responses, responses...)
+}
+
+// ClearResponses removes the responses of d that have not been sent.
+func (d *Deployment) ClearResponses() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.responses = nil
+}
+
+// Handle registers fn to respond to the commands named name, such as "find"
+// or "insert". Handlers take precedence over the responses added with
+// AddResponses. If fn is nil, the handler for name is removed.
+func (d *Deployment) Handle(name string, fn HandlerFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if fn == nil {
+		delete(d.handlers, name)
+		return
+	}
+	d.handlers[name] = fn
+}
+
+// SetLatency sets the time that d waits before sending each response. The
+// default is 0.
+func (d *Deployment) SetLatency(latency time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.latency = latency
+}
+
+// Commands returns the commands received by d, in the order in which they
+// were received.
+func (d *Deployment) Commands() []Command {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]Command(nil), d.commands...)
+}
+
+// ClearCommands clears the commands received by d.
+func (d *Deployment) ClearCommands() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.commands = nil
+}
+
+// SelectServer implements the driver.Deployment interface. It always returns
+// d.
+func (d *Deployment) SelectServer(context.Context, description.ServerSelector) (driver.Server, error) {
+	return d, nil
+}
+
+// GetServerSelectionTimeout implements the driver.Deployment interface. It
+// always returns 0.
+func (*Deployment) GetServerSelectionTimeout() time.Duration {
+	return 0
+}
+
+// Kind implements the driver.Deployment interface. It always returns
+// description.TopologyKindSingle.
+func (*Deployment) Kind() description.TopologyKind {
+	return description.TopologyKindSingle
+}
+
+// Connection implements the driver.Server interface. It returns a new
+// connection to d.
+func (d *Deployment) Connection(context.Context) (*mnet.Connection, error) {
+	return mnet.NewConnection(&connection{
+		deployment: d,
+		id:         d.nextConnectionID.Add(1),
+	}), nil
+}
+
+// RTTMonitor implements the driver.Server interface.
+func (*Deployment) RTTMonitor() driver.RTTMonitor {
+	return &csot.ZeroRTTMonitor{}
+}
+
+// Connect is a no-op method which implements the driver.Connector interface.
+func (*Deployment) Connect() error {
+	return nil
+}
+
+// Disconnect is a no-op method which implements the driver.Disconnector
+// interface.
+func (*Deployment) Disconnect(context.Context) error {
+	return nil
+}
+
+// Subscribe implements the driver.Subscriber interface.
+func (*Deployment) Subscribe() (*driver.Subscription, error) {
+	updates := make(chan description.Topology, 1)
+	updates <- description.Topology{
+		Kind:                  description.TopologyKindSingle,
+		SessionTimeoutMinutes: &sessionTimeoutMinutes,
+	}
+	return &driver.Subscription{Updates: updates}, nil
+}
+
+// Unsubscribe is a no-op method which implements the driver.Subscriber
+// interface.
+func (*Deployment) Unsubscribe(*driver.Subscription) error {
+	return nil
+}
+
+// record records cmd, which does not have a response.
+func (d *Deployment) record(cmd Command) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.commands = append(d.commands, cmd)
+}
+
+// respond records cmd and returns the response to it.
+func (d *Deployment) respond(ctx context.Context, cmd Command) (bson.D, error) {
+	d.mu.Lock()
+	d.commands = append(d.commands, cmd)
+	handler := d.handlers[cmd.Name]
+	var res bson.D
+	if handler == nil && len(d.responses) > 0 {
+		res = d.responses[0]
+		d.responses = d.responses[1:]
+	}
+	d.mu.Unlock()
+
+	if handler != nil {
+		return handler(ctx, &cmd)
+	}
+	if res == nil {
+		return bson.D{
+			{"ok", 0},
+			{"errmsg", fmt.Sprintf("mongotest: no response for %q command", cmd.Name)},
+		}, nil
+	}
+	return res, nil
+}
+
+// connection is a connection to a Deployment. It prepares the response to a
+// command when the command is written and returns it when it is read.
+type connection struct {
+	deployment *Deployment
+	id         int64
+
+	requestID int32
+	response  bson.D
+	err       error
+	pending   bool
+}
+
+var _ mnet.ReadWriteCloser = &connection{}
+var _ mnet.Describer = &connection{}
+
+// Write parses the command in wm and prepares the response to it.
+func (c *connection) Write(ctx context.Context, wm []byte) error {
+	requestID, moreToCome, cmd, err := parseCommand(wm)
+	if err != nil {
+		return err
+	}
+	cmd.ConnectionID = c.id
+
+	if moreToCome {
+		// The driver does not read the response to unacknowledged writes.
+		c.deployment.record(cmd)
+		return nil
+	}
+	res, err := c.deployment.respond(ctx, cmd)
+	c.requestID, c.response, c.err, c.pending = requestID, res, err, true
+	return nil
+}
+
+// Read returns the response to the last command written to the connection
+// after the latency of the deployment.
+func (c *connection) Read(ctx context.Context) ([]byte, error) {
+	if !c.pending {
+		return nil, errors.New("mongotest: no command was sent on the connection")
+	}
+	c.pending = false
+
+	c.deployment.mu.Lock()
+	latency := c.deployment.latency
+	c.deployment.mu.Unlock()
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+
+	if c.err != nil {
+		return nil, c.err
+	}
+	doc, err := bson.Marshal(c.response)
+	if err != nil {
+		return nil, fmt.Errorf("mongotest: error marshalling response: %w", err)
+	}
+
+	idx, dst := wiremessage.AppendHeaderStart(nil, wiremessage.NextRequestID(), c.requestID, wiremessage.OpMsg)
+	dst = wiremessage.AppendMsgFlags(dst, 0)
+	dst = wiremessage.AppendMsgSectionType(dst, wiremessage.SingleDocument)
+	dst = append(dst, doc...)
+	return bsoncore.UpdateLength(dst, idx, int32(len(dst[idx:]))), nil
+}
+
+// Close is a no-op.
+func (*connection) Close() error {
+	return nil
+}
+
+// Description returns the description of the server of the deployment.
+func (*connection) Description() description.Server {
+	return serverDescription
+}
+
+// ID returns the identifier of the connection.
+func (c *connection) ID() string {
+	return fmt.Sprintf("%s[-%d]", serverAddress, c.id)
+}
+
+// ServerConnectionID returns the ID of the connection.
+func (c *connection) ServerConnectionID() *int64 {
+	id := c.id
+	return &id
+}
+
+// DriverConnectionID returns the ID of the connection.
+func (c *connection) DriverConnectionID() int64 {
+	return c.id
+}
+
+// Address returns the address of the deployment.
+func (*connection) Address() address.Address {
+	return serverAddress
+}
+
+// Stale returns false.
+func (*connection) Stale() bool {
+	return false
+}
+
+func (*connection) OIDCTokenGenID() uint64 {
+	return 0
+}
+
+func (*connection) SetOIDCTokenGenID(uint64) {}
+
+// parseCommand parses the OP_MSG wire message wm. It returns the request ID
+// of the message, whether its moreToCome flag is set, and its command.
+func parseCommand(wm []byte) (int32, bool, Command, error) {
+	_, requestID, _, opcode, rem, ok := wiremessage.ReadHeader(wm)
+	if !ok {
+		return 0, false, Command{}, errors.New("mongotest: malformed wire message header")
+	}
+	if opcode != wiremessage.OpMsg {
+		return 0, false, Command{}, fmt.Errorf("mongotest: unsupported opcode %v", opcode)
+	}
+	flags, rem, ok := wiremessage.ReadMsgFlags(rem)
+	if !ok {
+		return 0, false, Command{}, errors.New("mongotest: malformed OP_MSG flags")
+	}
+
+	var body bsoncore.Document
+	var sequences []bsoncore.Element
+	for len(rem) > 0 {
+		var stype wiremessage.SectionType
+		stype, rem, ok = wiremessage.ReadMsgSectionType(rem)
+		if !ok {
+			return 0, false, Command{}, errors.New("mongotest: malformed OP_MSG section")
+		}
+		switch stype {
+		case wiremessage.SingleDocument:
+			body, rem, ok = wiremessage.ReadMsgSectionSingleDocument(rem)
+		case wiremessage.DocumentSequence:
+			var identifier string
+			var data []byte
+			identifier, data, rem, ok = wiremessage.ReadMsgSectionRawDocumentSequence(rem)
+			if ok {
+				sequences = append(sequences, sequenceElement(identifier, data))
+			}
+		default:
+			return 0, false, Command{}, fmt.Errorf("mongotest: unsupported OP_MSG section type %v", stype)
+		}
+		if !ok {
+			return 0, false, Command{}, errors.New("mongotest: malformed OP_MSG section")
+		}
+	}
+	if body == nil {
+		return 0, false, Command{}, errors.New("mongotest: OP_MSG has no command document")
+	}
+
+	if len(sequences) > 0 {
+		idx, doc := bsoncore.AppendDocumentStart(nil)
+		doc = append(doc, body[4:len(body)-1]...)
+		for _, elem := range sequences {
+			doc = append(doc, elem...)
+		}
+		body, _ = bsoncore.AppendDocumentEnd(doc, idx)
+	}
+
+	cmd := Command{Command: bson.Raw(body)}
+	if elem, err := body.IndexErr(0); err == nil {
+		cmd.Name = elem.Key()
+	}
+	cmd.Database, _ = body.Lookup("$db").StringValueOK()
+	return requestID, flags&wiremessage.MoreToCome == wiremessage.MoreToCome, cmd, nil
+}
+
+// sequenceElement returns an array element named identifier that holds the
+// documents of a document sequence.
+func sequenceElement(identifier string, data []byte) bsoncore.Element {
+	idx, arr := bsoncore.AppendArrayElementStart(nil, identifier)
+	for i := 0; len(data) > 0; i++ {
+		doc, rest, ok := bsoncore.ReadDocument(data)
+		if !ok {
+			break
+		}
+		arr = bsoncore.AppendDocumentElement(arr, strconv.Itoa(i), doc)
+		data = rest
+	}
+	arr, _ = bsoncore.AppendArrayEnd(arr, idx)
+	return arr
+}
@@ -0,0 +1,143 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongotest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+)
+
+func TestDeployment(t *testing.T) {
+	newColl := func(t *testing.T, md *Deployment, opts ...*options.ClientOptions) *mongo.Collection {
+		t.Helper()
+
+		client, err := Connect(md, opts...)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = client.Disconnect(context.Background()) })
+		return client.Database("db").Collection("coll")
+	}
+
+	t.Run("responses", func(t *testing.T) {
+		md := NewDeployment(
+			CursorResponse("db.coll", 42, bson.D{{"x", int32(1)}}),
+			GetMoreResponse("db.coll", 0, bson.D{{"x", int32(2)}}),
+		)
+		coll := newColl(t, md)
+
+		cursor, err := coll.Find(context.Background(), bson.D{{"x", bson.D{{"$gt", 0}}}})
+		require.NoError(t, err)
+		var docs []bson.D
+		require.NoError(t, cursor.All(context.Background(), &docs))
+		assert.Equal(t, []bson.D{{{"x", int32(1)}}, {{"x", int32(2)}}}, docs)
+
+		cmds := md.Commands()
+		require.Len(t, cmds, 2)
+		assert.Equal(t, "find", cmds[0].Name)
+		assert.Equal(t, "db", cmds[0].Database)
+		assert.Equal(t, "coll", cmds[0].Command.Lookup("find").StringValue())
+		assert.Equal(t, "getMore", cmds[1].Name)
+		assert.Equal(t, int64(42), cmds[1].Command.Lookup("getMore").Int64())
+
+		md.ClearCommands()
+		assert.Len(t, md.Commands(), 0)
+	})
+	t.Run("document sequences", func(t *testing.T) {
+		md := NewDeployment(SuccessResponse(bson.E{"n", 2}))
+		coll := newColl(t, md)
+
+		_, err := coll.InsertMany(context.Background(), []bson.D{{{"_id", 1}}, {{"_id", 2}}})
+		require.NoError(t, err)
+
+		cmds := md.Commands()
+		require.Len(t, cmds, 1)
+		vals, err := cmds[0].Command.Lookup("documents").Array().Values()
+		require.NoError(t, err)
+		require.Len(t, vals, 2)
+		assert.Equal(t, int32(2), vals[1].Document().Lookup("_id").Int32())
+	})
+	t.Run("handlers", func(t *testing.T) {
+		md := NewDeployment(SuccessResponse(bson.E{"n", 1}))
+		md.Handle("count", func(_ context.Context, cmd *Command) (bson.D, error) {
+			return SuccessResponse(bson.E{"n", int64(len(cmd.Database))}), nil
+		})
+		coll := newColl(t, md)
+
+		n, err := coll.EstimatedDocumentCount(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+
+		md.Handle("count", nil)
+		n, err = coll.EstimatedDocumentCount(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+	})
+	t.Run("command errors", func(t *testing.T) {
+		md := NewDeployment(
+			CommandErrorResponse(CommandError{Code: 13, Name: "Unauthorized", Message: "not authorized"}),
+			WriteErrorsResponse(0, WriteError{Index: 0, Code: 11000, Message: "duplicate key"}),
+		)
+		coll := newColl(t, md, options.Client().SetRetryWrites(false))
+
+		_, err := coll.DeleteOne(context.Background(), bson.D{})
+		var cmdErr mongo.CommandError
+		require.True(t, errors.As(err, &cmdErr), "expected CommandError, got %v", err)
+		assert.Equal(t, int32(13), cmdErr.Code)
+
+		_, err = coll.InsertOne(context.Background(), bson.D{{"_id", 1}})
+		assert.True(t, mongo.IsDuplicateKeyError(err), "expected duplicate key error, got %v", err)
+
+		_, err = coll.InsertOne(context.Background(), bson.D{{"_id", 1}})
+		require.True(t, errors.As(err, &cmdErr), "expected CommandError, got %v", err)
+		assert.Equal(t, `mongotest: no response for "insert" command`, cmdErr.Message)
+	})
+	t.Run("network errors", func(t *testing.T) {
+		md := NewDeployment()
+		md.Handle("find", func(context.Context, *Command) (bson.D, error) {
+			return nil, io.EOF
+		})
+		coll := newColl(t, md, options.Client().SetRetryReads(false))
+
+		err := coll.FindOne(context.Background(), bson.D{}).Err()
+		assert.True(t, mongo.IsNetworkError(err), "expected network error, got %v", err)
+	})
+	t.Run("latency", func(t *testing.T) {
+		md := NewDeployment(SuccessResponse())
+		md.SetLatency(time.Second)
+		coll := newColl(t, md)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := coll.Database().RunCommand(ctx, bson.D{{"ping", 1}}).Err()
+		assert.True(t, mongo.IsTimeout(err), "expected timeout error, got %v", err)
+	})
+	t.Run("unacknowledged writes", func(t *testing.T) {
+		md := NewDeployment(SuccessResponse())
+		coll := newColl(t, md, options.Client().SetWriteConcern(writeconcern.Unacknowledged()))
+
+		_, err := coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+		require.NoError(t, err)
+		require.Len(t, md.Commands(), 1)
+
+		err = coll.Database().RunCommand(context.Background(), bson.D{{"ping", 1}}).Err()
+		require.NoError(t, err, "expected the response to be sent to the next command")
+
+		md.AddResponses(SuccessResponse())
+		md.ClearResponses()
+		err = coll.Database().RunCommand(context.Background(), bson.D{{"ping", 1}}).Err()
+		assert.Error(t, err, "expected an error after clearing the responses")
+	})
+}
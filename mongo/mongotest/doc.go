@@ -0,0 +1,68 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package mongotest provides two ways to test code that uses a mongo.Client:
+// an in-memory mock deployment for unit tests that do not need a server, and
+// ephemeral mongod processes for tests that need a real one.
+//
+// # Mock deployment
+//
+// A Deployment responds to the commands it receives with canned responses,
+// records every command so that tests can inspect them, and can simulate
+// network errors and latency. NewDeployment creates a Deployment, and Connect
+// creates a Client that sends its commands to it:
+//
+//	md := mongotest.NewDeployment()
+//	md.AddResponses(mongotest.CursorResponse("db.coll", 0, bson.D{{"x", 1}}))
+//
+//	client, err := mongotest.Connect(md)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer client.Disconnect(context.Background())
+//
+//	var res bson.D
+//	err = client.Database("db").Collection("coll").FindOne(ctx, bson.D{}).Decode(&res)
+//
+// The Deployment behaves as a single replica set primary. It does not
+// interpret commands, so the responses must have the shape that the driver
+// expects for each command, which the response helpers of this package build
+// for common cases. Testing with a real deployment remains the only way to
+// verify the behavior of the server.
+//
+// # Ephemeral servers
+//
+// StartEphemeral starts a mongod process, so that tests which need a real
+// server can run with "go test" without a separately managed deployment:
+//
+//	func TestMain(m *testing.M) {
+//		srv, err := mongotest.StartEphemeral(context.Background(), mongotest.Options{})
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		code := m.Run()
+//		_ = srv.Stop(context.Background())
+//		os.Exit(code)
+//	}
+//
+// Within a single test, set Options.Cleanup to t.Cleanup to stop the server
+// automatically when the test finishes:
+//
+//	srv, err := mongotest.StartEphemeral(ctx, mongotest.Options{Cleanup: t.Cleanup})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	coll := srv.Client.Database("test").Collection("users")
+//
+// Each server uses a new temporary data directory and a free port on the
+// loopback interface, so servers started by parallel tests do not interfere.
+//
+// The mongod binary is found as described in the Options.BinaryPath
+// documentation. If no binary is installed, Options.DownloadURL and
+// Options.DownloadSHA256 can be set to a MongoDB server archive from
+// https://www.mongodb.com/try/download/community and its checksum. The archive
+// is downloaded once, verified and cached.
+package mongotest
@@ -0,0 +1,210 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongotest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// binaryEnv is the environment variable that can specify the mongod binary.
+const binaryEnv = "MONGOD_BINARY"
+
+// ErrBinaryNotFound is returned by StartEphemeral if no mongod binary is found
+// and Options.DownloadURL is not set.
+var ErrBinaryNotFound = errors.New("mongotest: mongod binary not found; set Options.BinaryPath, " +
+	binaryEnv + ", or Options.DownloadURL, or add mongod to the PATH")
+
+// binaryName is the file name of the mongod binary.
+func binaryName() string {
+	if runtime.GOOS == "windows" {
+		return "mongod.exe"
+	}
+	return "mongod"
+}
+
+// findBinary returns the path of the mongod binary to run, downloading it if
+// necessary.
+func findBinary(ctx context.Context, opts Options) (string, error) {
+	if opts.BinaryPath != "" {
+		return opts.BinaryPath, nil
+	}
+	if p := os.Getenv(binaryEnv); p != "" {
+		return p, nil
+	}
+	if p, err := exec.LookPath(binaryName()); err == nil {
+		return p, nil
+	}
+	if opts.DownloadURL == "" {
+		return "", ErrBinaryNotFound
+	}
+	if opts.DownloadSHA256 == "" {
+		return "", errors.New("mongotest: Options.DownloadSHA256 must be set if Options.DownloadURL is set")
+	}
+
+	cacheDir := opts.CacheDir
+	if cacheDir == "" {
+		userCache, err := os.UserCacheDir()
+		if err != nil {
+			return "", fmt.Errorf("error finding cache directory: %w", err)
+		}
+		cacheDir = filepath.Join(userCache, "mongo-go-driver", "mongotest")
+	}
+	return download(ctx, opts.DownloadURL, opts.DownloadSHA256, cacheDir)
+}
+
+// download downloads the server archive at url, verifies that its SHA-256
+// checksum is the hex-encoded checksum, and extracts the mongod binary into a
+// subdirectory of cacheDir named after the URL and the checksum. If the binary
+// was already extracted, it is not downloaded again.
+func download(ctx context.Context, url, checksum, cacheDir string) (string, error) {
+	want, err := hex.DecodeString(checksum)
+	if err != nil || len(want) != sha256.Size {
+		return "", fmt.Errorf("invalid SHA-256 checksum %q", checksum)
+	}
+
+	key := sha256.Sum256([]byte(url + "\n" + strings.ToLower(checksum)))
+	dir := filepath.Join(cacheDir, hex.EncodeToString(key[:8]))
+	binary := filepath.Join(dir, binaryName())
+	if _, err := os.Stat(binary); err == nil {
+		return binary, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("error creating cache directory: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error downloading %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error downloading %s: %s", url, resp.Status)
+	}
+
+	// Download to a temporary file because zip archives require random
+	// access, and so that an interrupted download is not mistaken for a
+	// cached archive.
+	archive, err := os.CreateTemp(dir, "archive-")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(archive, hash), resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("error downloading %s: %w", url, err)
+	}
+	if got := hash.Sum(nil); !bytes.Equal(got, want) {
+		return "", fmt.Errorf("SHA-256 checksum of %s is %x, expected %s", url, got, checksum)
+	}
+
+	// Extract to a temporary file and rename it so that concurrent downloads
+	// do not observe a partially written binary.
+	tmp, err := os.CreateTemp(dir, "mongod-")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if strings.HasSuffix(path.Base(req.URL.Path), ".zip") {
+		err = extractZip(archive, size, tmp)
+	} else {
+		err = extractTarGz(archive, tmp)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("error extracting %s: %w", url, err)
+	}
+
+	if err := os.Chmod(tmp.Name(), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), binary); err != nil {
+		return "", err
+	}
+	return binary, nil
+}
+
+// isBinary reports whether name is the path of the mongod binary in a server
+// archive, such as "mongodb-linux-x86_64-ubuntu2204-7.0.14/bin/mongod".
+func isBinary(name string) bool {
+	return path.Base(name) == binaryName() && path.Base(path.Dir(name)) == "bin"
+}
+
+// extractTarGz copies the mongod binary from the gzipped tar archive r to w.
+func extractTarGz(r io.ReadSeeker, w io.Writer) error {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("archive does not contain bin/%s", binaryName())
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeReg && isBinary(hdr.Name) {
+			_, err := io.Copy(w, tr)
+			return err
+		}
+	}
+}
+
+// extractZip copies the mongod binary from the zip archive r to w.
+func extractZip(r io.ReaderAt, size int64, w io.Writer) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		if !isBinary(f.Name) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		_, err = io.Copy(w, rc)
+		return err
+	}
+	return fmt.Errorf("archive does not contain bin/%s", binaryName())
+}
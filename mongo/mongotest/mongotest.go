@@ -0,0 +1,321 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongotest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/errutil"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	defaultStartupTimeout = 30 * time.Second
+
+	// readyPollInterval is the time between attempts to connect to a starting
+	// server.
+	readyPollInterval = 100 * time.Millisecond
+
+	// maxLogSize is the number of bytes of mongod output included in startup
+	// errors.
+	maxLogSize = 4096
+)
+
+// Options configures an ephemeral server started by StartEphemeral.
+type Options struct {
+	// BinaryPath is the path of the mongod binary. If it is empty, the
+	// MONGOD_BINARY environment variable is used, then a mongod binary on the
+	// PATH, and finally a binary downloaded from DownloadURL.
+	BinaryPath string
+
+	// DownloadURL is the URL of a MongoDB server archive (.tgz or .zip)
+	// containing bin/mongod, used if no mongod binary is found. The archive is
+	// downloaded once and cached in CacheDir. The default is "", which means
+	// that nothing is downloaded.
+	DownloadURL string
+
+	// DownloadSHA256 is the hex-encoded SHA-256 checksum of the archive at
+	// DownloadURL, as published in the .sha256 file next to the archive. It is
+	// required if DownloadURL is set, and the archive is not extracted if its
+	// checksum does not match.
+	DownloadSHA256 string
+
+	// CacheDir is the directory downloaded binaries are cached in. The default
+	// is a "mongo-go-driver/mongotest" directory in os.UserCacheDir.
+	CacheDir string
+
+	// ReplicaSet is the name of a single-member replica set to initiate, which
+	// is required for transactions and change streams. The default is "",
+	// which means that a standalone server is started.
+	ReplicaSet string
+
+	// Args are additional command-line arguments passed to mongod, such as
+	// "--setParameter" "enableTestCommands=1".
+	Args []string
+
+	// StartupTimeout is the maximum time to wait for the server to become
+	// ready, not including the time to download a binary. The default is 30
+	// seconds.
+	StartupTimeout time.Duration
+
+	// ClientOptions are applied to the Client connected to the server after
+	// its URI. The default is nil.
+	ClientOptions *options.ClientOptions
+
+	// Output, if set, receives the output of mongod.
+	Output io.Writer
+
+	// Cleanup, if set, is called with a function that stops the server, e.g.
+	// testing.T.Cleanup. This stops the server automatically at the end of a
+	// test.
+	Cleanup func(func())
+}
+
+// Server is a running ephemeral mongod process.
+type Server struct {
+	// Client is connected to the server. It is disconnected by Stop.
+	Client *mongo.Client
+
+	// URI is the connection string of the server.
+	URI string
+
+	host   string
+	cmd    *exec.Cmd
+	dbPath string
+	exited chan struct{}
+
+	stopOnce sync.Once
+	stopErr  error
+}
+
+// StartEphemeral starts a mongod process with a temporary data directory on a
+// free port, waits until it accepts commands, and returns it with a connected
+// Client. If opts.ReplicaSet is set, the replica set is initiated and
+// StartEphemeral waits until the server is primary.
+//
+// The server must be stopped with Stop, or with opts.Cleanup, to terminate the
+// process and remove its data directory. The lifetime of the server is not
+// tied to ctx, which only bounds the startup.
+func StartEphemeral(ctx context.Context, opts Options) (*Server, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	binary, err := findBinary(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dbPath, err := os.MkdirTemp("", "mongotest-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating data directory: %w", err)
+	}
+
+	port, err := freePort()
+	if err != nil {
+		_ = os.RemoveAll(dbPath)
+		return nil, err
+	}
+
+	args := []string{
+		"--dbpath", dbPath,
+		"--port", strconv.Itoa(port),
+		"--bind_ip", "127.0.0.1",
+	}
+	if opts.ReplicaSet != "" {
+		args = append(args, "--replSet", opts.ReplicaSet)
+	}
+	args = append(args, opts.Args...)
+
+	// Keep the end of the output so that it can be included in startup
+	// errors.
+	log := &tailBuffer{max: maxLogSize}
+	var output io.Writer = log
+	if opts.Output != nil {
+		output = io.MultiWriter(log, opts.Output)
+	}
+
+	cmd := exec.Command(binary, args...)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	if err := cmd.Start(); err != nil {
+		_ = os.RemoveAll(dbPath)
+		return nil, fmt.Errorf("error starting %s: %w", binary, err)
+	}
+
+	host := net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+	srv := &Server{
+		URI:    "mongodb://" + host + "/?directConnection=true",
+		host:   host,
+		cmd:    cmd,
+		dbPath: dbPath,
+		exited: make(chan struct{}),
+	}
+	go func() {
+		_ = cmd.Wait()
+		close(srv.exited)
+	}()
+
+	if err := srv.start(ctx, opts); err != nil {
+		_ = srv.Stop(context.Background())
+		return nil, fmt.Errorf("%w\nmongod output:\n%s", err, log.String())
+	}
+
+	if opts.Cleanup != nil {
+		opts.Cleanup(func() { _ = srv.Stop(context.Background()) })
+	}
+	return srv, nil
+}
+
+// start connects the Client and waits for the server to become ready.
+func (s *Server) start(ctx context.Context, opts Options) error {
+	timeout := opts.StartupTimeout
+	if timeout == 0 {
+		timeout = defaultStartupTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	clientOpts := options.Client().ApplyURI(s.URI)
+	if opts.ClientOptions != nil {
+		clientOpts = options.MergeClientOptions(clientOpts, opts.ClientOptions)
+	}
+	client, err := mongo.Connect(clientOpts)
+	if err != nil {
+		return err
+	}
+	s.Client = client
+
+	admin := client.Database("admin")
+	if err := s.waitFor(ctx, "the server to start", func(ctx context.Context) bool {
+		return admin.RunCommand(ctx, bson.D{{"ping", 1}}).Err() == nil
+	}); err != nil {
+		return err
+	}
+
+	if opts.ReplicaSet == "" {
+		return nil
+	}
+
+	cfg := bson.D{
+		{"_id", opts.ReplicaSet},
+		{"members", bson.A{bson.D{{"_id", 0}, {"host", s.host}}}},
+	}
+	if err := admin.RunCommand(ctx, bson.D{{"replSetInitiate", cfg}}).Err(); err != nil {
+		return fmt.Errorf("error initiating replica set: %w", err)
+	}
+	return s.waitFor(ctx, "the server to become primary", func(ctx context.Context) bool {
+		var hello struct {
+			IsWritablePrimary bool `bson:"isWritablePrimary"`
+		}
+		err := admin.RunCommand(ctx, bson.D{{"hello", 1}}).Decode(&hello)
+		return err == nil && hello.IsWritablePrimary
+	})
+}
+
+// waitFor calls ready until it returns true, the process exits, or ctx is
+// done.
+func (s *Server) waitFor(ctx context.Context, what string, ready func(context.Context) bool) error {
+	for {
+		attemptCtx, cancel := context.WithTimeout(ctx, time.Second)
+		ok := ready(attemptCtx)
+		cancel()
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-s.exited:
+			return fmt.Errorf("mongod exited while waiting for %s: %v", what, s.cmd.ProcessState)
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s: %w", what, ctx.Err())
+		case <-time.After(readyPollInterval):
+		}
+	}
+}
+
+// Stop disconnects the Client, terminates the mongod process, and removes its
+// data directory. Stop is idempotent and safe to call concurrently.
+func (s *Server) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		var errs []error
+		if s.Client != nil {
+			if err := s.Client.Disconnect(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		// The data directory is temporary, so the process is killed rather
+		// than shut down cleanly.
+		if err := s.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			errs = append(errs, err)
+		}
+		select {
+		case <-s.exited:
+		case <-ctx.Done():
+			errs = append(errs, ctx.Err())
+		}
+
+		if err := os.RemoveAll(s.dbPath); err != nil {
+			errs = append(errs, err)
+		}
+		s.stopErr = errutil.Join(errs...)
+	})
+	return s.stopErr
+}
+
+// freePort returns a TCP port on the loopback interface that is not in use.
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, fmt.Errorf("error finding a free port: %w", err)
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// tailBuffer is an io.Writer that keeps the last max bytes written to it.
+type tailBuffer struct {
+	mu  sync.Mutex
+	max int
+	buf bytes.Buffer
+}
+
+func (tb *tailBuffer) Write(p []byte) (int, error) {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	tb.buf.Write(p)
+	if extra := tb.buf.Len() - tb.max; extra > 0 {
+		tb.buf.Next(extra)
+	}
+	return len(p), nil
+}
+
+func (tb *tailBuffer) String() string {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	return tb.buf.String()
+}
@@ -0,0 +1,203 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongotest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func tarGzArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		require.NoError(t, err, "WriteHeader error")
+		_, err = tw.Write([]byte(content))
+		require.NoError(t, err, "Write error")
+	}
+	require.NoError(t, tw.Close(), "tar Close error")
+	require.NoError(t, gz.Close(), "gzip Close error")
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err, "Create error")
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err, "Write error")
+	}
+	require.NoError(t, zw.Close(), "zip Close error")
+	return buf.Bytes()
+}
+
+func TestFindBinary(t *testing.T) {
+	// Hide any mongod installed on the machine.
+	t.Setenv("PATH", t.TempDir())
+	t.Setenv(binaryEnv, "")
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := findBinary(context.Background(), Options{})
+		assert.ErrorIs(t, err, ErrBinaryNotFound)
+	})
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv(binaryEnv, "/opt/mongodb/bin/mongod")
+
+		got, err := findBinary(context.Background(), Options{})
+		require.NoError(t, err)
+		assert.Equal(t, "/opt/mongodb/bin/mongod", got)
+	})
+	t.Run("binary path", func(t *testing.T) {
+		t.Setenv(binaryEnv, "/opt/mongodb/bin/mongod")
+
+		got, err := findBinary(context.Background(), Options{BinaryPath: "/usr/local/bin/mongod"})
+		require.NoError(t, err)
+		assert.Equal(t, "/usr/local/bin/mongod", got)
+	})
+	t.Run("download without checksum", func(t *testing.T) {
+		_, err := findBinary(context.Background(), Options{DownloadURL: "http://localhost/mongodb.tgz"})
+		assert.ErrorContains(t, err, "DownloadSHA256 must be set")
+	})
+}
+
+func TestDownload(t *testing.T) {
+	binary := "#!/bin/sh\n"
+	files := map[string]string{
+		"mongodb-test-1.0.0/README":              "readme",
+		"mongodb-test-1.0.0/bin/mongos":          "mongos",
+		"mongodb-test-1.0.0/bin/" + binaryName(): binary,
+	}
+
+	testCases := []struct {
+		name    string
+		path    string
+		archive []byte
+	}{
+		{"tgz", "/mongodb-test-1.0.0.tgz", tarGzArchive(t, files)},
+		{"zip", "/mongodb-test-1.0.0.zip", zipArchive(t, files)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				_, _ = w.Write(tc.archive)
+			}))
+			defer srv.Close()
+
+			cacheDir := t.TempDir()
+			for i := 0; i < 2; i++ {
+				got, err := download(context.Background(), srv.URL+tc.path, checksum(tc.archive), cacheDir)
+				require.NoError(t, err, "download error")
+
+				content, err := os.ReadFile(got)
+				require.NoError(t, err, "ReadFile error")
+				assert.Equal(t, binary, string(content), "unexpected binary content")
+			}
+			assert.Equal(t, int32(1), requests.Load(), "expected the archive to be downloaded once")
+		})
+	}
+	t.Run("missing binary", func(t *testing.T) {
+		archive := tarGzArchive(t, map[string]string{"mongodb-test-1.0.0/bin/mongos": "mongos"})
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(archive)
+		}))
+		defer srv.Close()
+
+		_, err := download(context.Background(), srv.URL+"/mongodb.tgz", checksum(archive), t.TempDir())
+		assert.ErrorContains(t, err, "archive does not contain bin/"+binaryName())
+	})
+	t.Run("checksum mismatch", func(t *testing.T) {
+		archive := tarGzArchive(t, files)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(archive)
+		}))
+		defer srv.Close()
+
+		cacheDir := t.TempDir()
+		_, err := download(context.Background(), srv.URL+"/mongodb.tgz", checksum([]byte("other")), cacheDir)
+		assert.ErrorContains(t, err, "SHA-256 checksum")
+
+		extracted, err := filepath.Glob(filepath.Join(cacheDir, "*", binaryName()))
+		require.NoError(t, err, "Glob error")
+		assert.Len(t, extracted, 0, "expected no binary to be extracted")
+	})
+	t.Run("invalid checksum", func(t *testing.T) {
+		_, err := download(context.Background(), "http://localhost/mongodb.tgz", "abc", t.TempDir())
+		assert.ErrorContains(t, err, "invalid SHA-256 checksum")
+	})
+	t.Run("HTTP error", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		defer srv.Close()
+
+		_, err := download(context.Background(), srv.URL+"/mongodb.tgz", checksum(nil), t.TempDir())
+		assert.ErrorContains(t, err, "404")
+	})
+}
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestStartEphemeral(t *testing.T) {
+	t.Run("process exits", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("requires a shell script")
+		}
+
+		script := filepath.Join(t.TempDir(), "mongod")
+		err := os.WriteFile(script, []byte("#!/bin/sh\necho invalid option >&2\nexit 2\n"), 0o755)
+		require.NoError(t, err, "WriteFile error")
+
+		_, err = StartEphemeral(context.Background(), Options{BinaryPath: script, StartupTimeout: 10 * time.Second})
+		assert.ErrorContains(t, err, "mongod exited")
+		assert.ErrorContains(t, err, "invalid option")
+	})
+	t.Run("server", func(t *testing.T) {
+		if _, err := findBinary(context.Background(), Options{}); errors.Is(err, ErrBinaryNotFound) {
+			t.Skip("mongod is not installed")
+		}
+
+		srv, err := StartEphemeral(context.Background(), Options{ReplicaSet: "rs0", Cleanup: t.Cleanup})
+		require.NoError(t, err, "StartEphemeral error")
+
+		coll := srv.Client.Database("test").Collection("coll")
+		_, err = coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+		require.NoError(t, err, "InsertOne error")
+
+		require.NoError(t, srv.Stop(context.Background()), "Stop error")
+		_, err = os.Stat(srv.dbPath)
+		assert.True(t, os.IsNotExist(err), "expected the data directory to be removed")
+	})
+}
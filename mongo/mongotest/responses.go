@@ -0,0 +1,101 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongotest
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// CommandError is a command error returned by the server.
+type CommandError struct {
+	Code    int32
+	Message string
+	Name    string
+	Labels  []string
+}
+
+// WriteError is a write error returned by the server for one document of a
+// write command.
+type WriteError struct {
+	Index   int
+	Code    int
+	Message string
+}
+
+// SuccessResponse creates a response for a successful command with the given
+// elements.
+func SuccessResponse(elems ...bson.E) bson.D {
+	return append(bson.D{{"ok", 1}}, elems...)
+}
+
+// CursorResponse creates a response for a command that returns a cursor, such
+// as find or aggregate, with the first batch of documents of the cursor. ns is
+// the namespace of the cursor, in the form "database.collection", and id is
+// the ID of the cursor, which is 0 if the batch holds all of its documents.
+func CursorResponse(ns string, id int64, batch ...bson.D) bson.D {
+	return cursorResponse(ns, id, "firstBatch", batch)
+}
+
+// GetMoreResponse creates a response for a getMore command with the next
+// batch of documents of the cursor with the given namespace and ID. The ID is
+// 0 if the batch holds the last documents of the cursor.
+func GetMoreResponse(ns string, id int64, batch ...bson.D) bson.D {
+	return cursorResponse(ns, id, "nextBatch", batch)
+}
+
+func cursorResponse(ns string, id int64, identifier string, batch []bson.D) bson.D {
+	arr := make(bson.A, 0, len(batch))
+	for _, doc := range batch {
+		arr = append(arr, doc)
+	}
+
+	return bson.D{
+		{"ok", 1},
+		{"cursor", bson.D{
+			{"id", id},
+			{"ns", ns},
+			{identifier, arr},
+		}},
+	}
+}
+
+// CommandErrorResponse creates a response for a command that failed with ce.
+func CommandErrorResponse(ce CommandError) bson.D {
+	res := bson.D{
+		{"ok", 0},
+		{"code", ce.Code},
+		{"errmsg", ce.Message},
+		{"codeName", ce.Name},
+	}
+	if len(ce.Labels) > 0 {
+		labels := make(bson.A, 0, len(ce.Labels))
+		for _, label := range ce.Labels {
+			labels = append(labels, label)
+		}
+		res = append(res, bson.E{Key: "errorLabels", Value: labels})
+	}
+	return res
+}
+
+// WriteErrorsResponse creates a response for a write command that wrote n
+// documents and failed to write others with the given write errors.
+func WriteErrorsResponse(n int, writeErrors ...WriteError) bson.D {
+	arr := make(bson.A, 0, len(writeErrors))
+	for _, we := range writeErrors {
+		arr = append(arr, bson.D{
+			{"index", we.Index},
+			{"code", we.Code},
+			{"errmsg", we.Message},
+		})
+	}
+
+	return bson.D{
+		{"ok", 1},
+		{"n", n},
+		{"writeErrors", arr},
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 )
 
 // AggregateOptions represents arguments that can be used to configure an
@@ -27,6 +29,10 @@ type AggregateOptions struct {
 	Hint                     any
 	Let                      any
 	Custom                   bson.M
+	TimeoutMode              *TimeoutMode
+	CurrentLifetime          *CurrentLifetime
+	ReadConcern              *readconcern.ReadConcern
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -113,6 +119,34 @@ func (ao *AggregateOptionsBuilder) SetMaxAwaitTime(d time.Duration) *AggregateOp
 	return ao
 }
 
+// SetTimeoutMode sets the value for the TimeoutMode field. Specifies how the client-level timeout or context
+// deadline applies to the getMore commands run by the returned cursor. The default is TimeoutModeFirstBatch. If this
+// is set, timeout errors returned by Aggregate and the cursor are wrapped in a mongo.CursorTimeoutError that reports
+// the mode.
+func (ao *AggregateOptionsBuilder) SetTimeoutMode(mode TimeoutMode) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.TimeoutMode = &mode
+
+		return nil
+	})
+
+	return ao
+}
+
+// SetCurrentLifetime sets the value for the CurrentLifetime field. Specifies how long the Current field of the
+// returned cursor remains valid. The default is CurrentUntilNext, which requires callers to copy documents they
+// retain after advancing the cursor, such as with Cursor.CurrentCopy. CurrentUntilClose copies each batch instead so
+// that documents can be retained without copying.
+func (ao *AggregateOptionsBuilder) SetCurrentLifetime(lifetime CurrentLifetime) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.CurrentLifetime = &lifetime
+
+		return nil
+	})
+
+	return ao
+}
+
 // SetComment sets the value for the Comment field. Specifies a string or document that will be included in
 // server logs, profiling logs, and currentOp queries to help trace the operation. The default is nil,
 // which means that no comment will be included in the logs.
@@ -126,6 +160,37 @@ func (ao *AggregateOptionsBuilder) SetComment(comment any) *AggregateOptionsBuil
 	return ao
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection or database. It must not be set for operations in a transaction,
+// which use the write concern of the transaction. The default value is nil,
+// which means the write concern of the collection or database is used. The
+// write concern only applies if the pipeline ends with an $out or $merge stage.
+func (ao *AggregateOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return ao
+}
+
+// SetReadConcern sets the value for the ReadConcern field. Specifies the read
+// concern for the operation, overriding the read concern of the collection or
+// database. It must not be set for operations in a transaction, which use the
+// read concern of the transaction. The default value is nil, which means the
+// read concern of the collection or database is used.
+func (ao *AggregateOptionsBuilder) SetReadConcern(rc *readconcern.ReadConcern) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.ReadConcern = rc
+
+		return nil
+	})
+
+	return ao
+}
+
 // SetHint sets the value for the Hint field. Specifies the index to use for the aggregation. This should
 // either be the index name as a string or the index specification as a document. The hint does not apply to
 // $lookup and $graphLookup aggregation stages. The driver will return an error if the hint parameter
@@ -42,6 +42,12 @@ type AutoEncryptionOptions struct {
 	EncryptedFieldsMap    map[string]any
 	BypassQueryAnalysis   *bool
 	KeyExpiration         *time.Duration
+
+	CryptSharedLibPath       *string
+	CryptSharedLibRequired   *bool
+	CryptSharedLibMinVersion *string
+
+	CustomKMSProviders map[string]CustomKMSProvider
 }
 
 // AutoEncryption creates a new AutoEncryptionOptions configured with default values.
@@ -80,6 +86,17 @@ func (a *AutoEncryptionOptions) SetKmsProviders(providers map[string]map[string]
 	return a
 }
 
+// SetCustomKMSProvider registers a KMS provider named "local:<name>" whose master key is wrappedKey unwrapped by
+// provider. See KMSProvider for details. The name must not conflict with a provider set with SetKmsProviders.
+func (a *AutoEncryptionOptions) SetCustomKMSProvider(name string, provider KMSProvider, wrappedKey []byte) *AutoEncryptionOptions {
+	if a.CustomKMSProviders == nil {
+		a.CustomKMSProviders = make(map[string]CustomKMSProvider)
+	}
+	a.CustomKMSProviders[name] = CustomKMSProvider{Provider: provider, WrappedKey: wrappedKey}
+
+	return a
+}
+
 // SetSchemaMap specifies a map from namespace to local schema document. Schemas supplied in the schemaMap only apply
 // to configuring automatic encryption for Client-Side Field Level Encryption. Other validation rules in the JSON schema
 // will not be enforced by the driver and will result in an error.
@@ -126,7 +143,8 @@ func (a *AutoEncryptionOptions) SetBypassAutoEncryption(bypass bool) *AutoEncryp
 //
 // "cryptSharedLibRequired" - If set to true, Client creation will return an error if the
 // crypt_shared library is not loaded. If unset or set to false, Client creation will not return an
-// error if the crypt_shared library is not loaded. The default is unset. Must be a bool.
+// error if the crypt_shared library is not loaded. The default is unset. Must be a bool. See also
+// SetCryptSharedLibRequired.
 //
 // "cryptSharedLibPath" - The crypt_shared library override path. This must be the path to the
 // crypt_shared dynamic library file (for example, a .so, .dll, or .dylib file), not the directory
@@ -135,7 +153,8 @@ func (a *AutoEncryptionOptions) SetBypassAutoEncryption(bypass bool) *AutoEncryp
 // component is the literal string "$ORIGIN", the "$ORIGIN" component will be replaced by the
 // absolute path to the directory containing the linked libmongocrypt library. Setting an override
 // path disables the default system library search path. If an override path is specified but the
-// crypt_shared library cannot be loaded, Client creation will return an error. Must be a string.
+// crypt_shared library cannot be loaded, Client creation will return an error. Must be a string. See also
+// SetCryptSharedLibPath.
 func (a *AutoEncryptionOptions) SetExtraOptions(extraOpts map[string]any) *AutoEncryptionOptions {
 	a.ExtraOptions = extraOpts
 
@@ -174,3 +193,32 @@ func (a *AutoEncryptionOptions) SetKeyExpiration(expiration time.Duration) *Auto
 
 	return a
 }
+
+// SetCryptSharedLibPath specifies the path to the crypt_shared dynamic library file (for example, a .so, .dll, or
+// .dylib file), not the directory that contains it. Setting a path disables the default system library search path,
+// and Client creation will return an error if the library cannot be loaded from it. See SetExtraOptions for how
+// relative paths are resolved. This takes precedence over the "cryptSharedLibPath" extra option.
+func (a *AutoEncryptionOptions) SetCryptSharedLibPath(path string) *AutoEncryptionOptions {
+	a.CryptSharedLibPath = &path
+
+	return a
+}
+
+// SetCryptSharedLibRequired specifies whether Client creation should return an error if the crypt_shared library is
+// not loaded, instead of falling back to mongocryptd. This takes precedence over the "cryptSharedLibRequired" extra
+// option. The default is false.
+func (a *AutoEncryptionOptions) SetCryptSharedLibRequired(required bool) *AutoEncryptionOptions {
+	a.CryptSharedLibRequired = &required
+
+	return a
+}
+
+// SetCryptSharedLibMinVersion specifies the minimum version of the crypt_shared library to accept, in the form
+// "major.minor.patch" (for example, "7.0.0"). If a crypt_shared library older than this version is loaded, Client
+// creation will return an error. This option does not require the library to be loaded; use SetCryptSharedLibRequired
+// for that.
+func (a *AutoEncryptionOptions) SetCryptSharedLibMinVersion(version string) *AutoEncryptionOptions {
+	a.CryptSharedLibMinVersion = &version
+
+	return a
+}
@@ -6,7 +6,10 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+import (
+	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+)
 
 // DefaultOrdered is the default value for the Ordered option in BulkWriteOptions.
 var DefaultOrdered = true
@@ -20,6 +23,7 @@ type BulkWriteOptions struct {
 	Comment                  any
 	Ordered                  *bool
 	Let                      any
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -59,6 +63,21 @@ func (b *BulkWriteOptionsBuilder) SetComment(comment any) *BulkWriteOptionsBuild
 	return b
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (b *BulkWriteOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *BulkWriteOptionsBuilder {
+	b.Opts = append(b.Opts, func(opts *BulkWriteOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return b
+}
+
 // SetOrdered sets the value for the Ordered field. If true, no writes will be executed after one fails.
 // The default value is true.
 func (b *BulkWriteOptionsBuilder) SetOrdered(ordered bool) *BulkWriteOptionsBuilder {
@@ -28,6 +28,7 @@ type ChangeStreamOptions struct {
 	StartAfter               any
 	Custom                   bson.M
 	CustomPipeline           bson.M
+	Heartbeat                func(AwaitHeartbeat)
 }
 
 // ChangeStreamOptionsBuilder contains options to configure change stream
@@ -111,6 +112,18 @@ func (cso *ChangeStreamOptionsBuilder) SetMaxAwaitTime(d time.Duration) *ChangeS
 	return cso
 }
 
+// SetHeartbeat sets the value for the Heartbeat field. Heartbeat is called each time a getMore returns an empty batch
+// while the change stream is still alive. Long-running change stream processors can use it to distinguish a stream
+// with no new events from one that is stuck, and to export liveness metrics. The callback is run synchronously by
+// Next and TryNext and must not use the change stream.
+func (cso *ChangeStreamOptionsBuilder) SetHeartbeat(fn func(AwaitHeartbeat)) *ChangeStreamOptionsBuilder {
+	cso.Opts = append(cso.Opts, func(opts *ChangeStreamOptions) error {
+		opts.Heartbeat = fn
+		return nil
+	})
+	return cso
+}
+
 // SetResumeAfter sets the value for the ResumeAfter field. Specifies a document specifying the logical starting
 // point for the change stream. Only changes corresponding to an oplog entry immediately after the resume token
 // will be returned. If this is specified, StartAtOperationTime and StartAfter must not be set.
@@ -0,0 +1,139 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import "time"
+
+// CircuitBreakerOptions represents a circuit breaker that avoids servers that
+// are unhealthy but still pass heartbeats, such as a mongos that times out or
+// returns errors for most operations. The Client tracks the error rate and
+// average latency of the commands run on each server over a window. A server
+// whose error rate or latency exceeds the configured thresholds is tripped for
+// a cooldown period, during which server selection avoids it. The server is
+// restored when the cooldown expires.
+//
+// Only network errors and errors that are retryable by retryable reads, such
+// as NotWritablePrimary and ShutdownInProgress, count as failures. The
+// latency of getMore commands is not tracked because it includes the time
+// that tailable cursors wait for new documents.
+//
+// Tripped and restored servers are reported by the ServerTripped and
+// ServerRestored callbacks of the event.ServerMonitor set with
+// ClientOptions.SetServerMonitor.
+//
+// See corresponding setter methods for documentation.
+type CircuitBreakerOptions struct {
+	Window               *time.Duration
+	MinOperations        *int
+	FailureRateThreshold *float64
+	LatencyThreshold     *time.Duration
+	Cooldown             *time.Duration
+	Quarantine           *bool
+}
+
+// CircuitBreaker creates a new CircuitBreakerOptions instance.
+func CircuitBreaker() *CircuitBreakerOptions {
+	return &CircuitBreakerOptions{}
+}
+
+// SetWindow specifies the period over which the error rate and latency of a
+// server are measured. Each window starts with no recorded operations. It must
+// be positive. The default is 10 seconds.
+func (cb *CircuitBreakerOptions) SetWindow(d time.Duration) *CircuitBreakerOptions {
+	cb.Window = &d
+
+	return cb
+}
+
+// SetMinOperations specifies the number of operations that must be recorded
+// for a server in the current window before it can be tripped, so that a few
+// failures on a lightly used server do not trip it. It must be at least 1. The
+// default is 20.
+func (cb *CircuitBreakerOptions) SetMinOperations(n int) *CircuitBreakerOptions {
+	cb.MinOperations = &n
+
+	return cb
+}
+
+// SetFailureRateThreshold specifies the fraction of failed operations, between
+// 0 and 1, at which a server is tripped. The default is 0.5.
+func (cb *CircuitBreakerOptions) SetFailureRateThreshold(rate float64) *CircuitBreakerOptions {
+	cb.FailureRateThreshold = &rate
+
+	return cb
+}
+
+// SetLatencyThreshold specifies the average operation latency at which a
+// server is tripped. It must not be negative. The default is 0, which means
+// that servers are not tripped because of their latency.
+func (cb *CircuitBreakerOptions) SetLatencyThreshold(d time.Duration) *CircuitBreakerOptions {
+	cb.LatencyThreshold = &d
+
+	return cb
+}
+
+// SetCooldown specifies how long a tripped server is avoided before it is
+// restored. It must be positive. The default is 30 seconds.
+func (cb *CircuitBreakerOptions) SetCooldown(d time.Duration) *CircuitBreakerOptions {
+	cb.Cooldown = &d
+
+	return cb
+}
+
+// SetQuarantine specifies whether tripped servers are excluded from server
+// selection. If false, tripped servers are only selected if no other suitable
+// server is available. If true, tripped servers are never selected, so
+// operations wait for another server or for the cooldown to expire, until
+// the server selection timeout. The default is false.
+func (cb *CircuitBreakerOptions) SetQuarantine(b bool) *CircuitBreakerOptions {
+	cb.Quarantine = &b
+
+	return cb
+}
+
+// Validate returns an error if the circuit breaker options are invalid.
+func (cb *CircuitBreakerOptions) Validate() error {
+	if cb.Window != nil && *cb.Window <= 0 {
+		return InvalidValueError{
+			Option:  "Window",
+			Value:   *cb.Window,
+			Message: "circuit breaker window must be positive",
+		}
+	}
+	if cb.MinOperations != nil && *cb.MinOperations < 1 {
+		return InvalidValueError{
+			Option:  "MinOperations",
+			Value:   *cb.MinOperations,
+			Min:     1,
+			Message: "circuit breaker min operations must be at least 1",
+		}
+	}
+	if rate := cb.FailureRateThreshold; rate != nil && (*rate <= 0 || *rate > 1) {
+		return InvalidValueError{
+			Option:  "FailureRateThreshold",
+			Value:   *rate,
+			Max:     1.0,
+			Message: "circuit breaker failure rate threshold must be greater than 0 and at most 1",
+		}
+	}
+	if cb.LatencyThreshold != nil && *cb.LatencyThreshold < 0 {
+		return InvalidValueError{
+			Option:  "LatencyThreshold",
+			Value:   *cb.LatencyThreshold,
+			Min:     time.Duration(0),
+			Message: "circuit breaker latency threshold must not be negative",
+		}
+	}
+	if cb.Cooldown != nil && *cb.Cooldown <= 0 {
+		return InvalidValueError{
+			Option:  "Cooldown",
+			Value:   *cb.Cooldown,
+			Message: "circuit breaker cooldown must be positive",
+		}
+	}
+	return nil
+}
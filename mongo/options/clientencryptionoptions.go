@@ -24,6 +24,8 @@ type ClientEncryptionOptions struct {
 	TLSConfig         map[string]*tls.Config
 	HTTPClient        *http.Client
 	KeyExpiration     *time.Duration
+
+	CustomKMSProviders map[string]CustomKMSProvider
 }
 
 // ClientEncryptionOptionsBuilder contains options to configure client
@@ -94,6 +96,26 @@ func (c *ClientEncryptionOptionsBuilder) SetKeyExpiration(expiration time.Durati
 	return c
 }
 
+// SetCustomKMSProvider registers a KMS provider named "local:<name>" whose
+// master key is wrappedKey unwrapped by provider. See KMSProvider for details.
+// The name must not conflict with a provider set with SetKmsProviders.
+func (c *ClientEncryptionOptionsBuilder) SetCustomKMSProvider(
+	name string,
+	provider KMSProvider,
+	wrappedKey []byte,
+) *ClientEncryptionOptionsBuilder {
+	c.Opts = append(c.Opts, func(opts *ClientEncryptionOptions) error {
+		if opts.CustomKMSProviders == nil {
+			opts.CustomKMSProviders = make(map[string]CustomKMSProvider)
+		}
+		opts.CustomKMSProviders[name] = CustomKMSProvider{Provider: provider, WrappedKey: wrappedKey}
+
+		return nil
+	})
+
+	return c
+}
+
 // BuildTLSConfig specifies tls.Config options for each KMS provider to use to configure TLS on all connections created
 // to the KMS provider. The input map should contain a mapping from each KMS provider to a document containing the necessary
 // options, as follows:
@@ -127,7 +149,11 @@ func BuildTLSConfig(tlsOpts map[string]any) (*tls.Config, error) {
 		case "tlsCertificateKeyFile", "sslClientCertificateKeyFile":
 			clientCertPath, ok := tlsOpts[name].(string)
 			if !ok {
-				return nil, fmt.Errorf("expected %q value to be of type string, got %T", name, tlsOpts[name])
+				return nil, InvalidValueError{
+					Option:  name,
+					Value:   tlsOpts[name],
+					Message: fmt.Sprintf("expected %q value to be of type string, got %T", name, tlsOpts[name]),
+				}
 			}
 			// apply custom key file password if found, otherwise use empty string
 			if keyPwd, found := tlsOpts["tlsCertificateKeyFilePassword"].(string); found {
@@ -142,11 +168,19 @@ func BuildTLSConfig(tlsOpts map[string]any) (*tls.Config, error) {
 		case "tlsCAFile", "sslCertificateAuthorityFile":
 			caPath, ok := tlsOpts[name].(string)
 			if !ok {
-				return nil, fmt.Errorf("expected %q value to be of type string, got %T", name, tlsOpts[name])
+				return nil, InvalidValueError{
+					Option:  name,
+					Value:   tlsOpts[name],
+					Message: fmt.Sprintf("expected %q value to be of type string, got %T", name, tlsOpts[name]),
+				}
 			}
 			err = addCACertFromFile(cfg, caPath)
 		default:
-			return nil, fmt.Errorf("unrecognized TLS option %v", name)
+			return nil, InvalidValueError{
+				Option:  "tlsOpts",
+				Value:   name,
+				Message: fmt.Sprintf("unrecognized TLS option %v", name),
+			}
 		}
 
 		if err != nil {
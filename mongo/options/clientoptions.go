@@ -18,7 +18,9 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"net/url"
 	"reflect"
+	"runtime"
 	"strings"
 	"time"
 
@@ -27,6 +29,9 @@ import (
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/httputil"
 	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/mongo/audit"
+	"go.mongodb.org/mongo-driver/v2/mongo/fault"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
@@ -65,6 +70,35 @@ type ContextDialer interface {
 	DialContext(ctx context.Context, network, address string) (net.Conn, error)
 }
 
+// Transport is an interface that can be implemented by types that carry MongoDB wire protocol messages to and from
+// servers over a custom transport, such as a QUIC tunnel, an in-memory pipe to an embedded test server, or a
+// user-space network stack. It should be used to provide a custom transport when configuring a Client.
+//
+// Dial should return a connection to the server at the provided address, which is one of the hosts configured for or
+// discovered by the Client (e.g. "localhost:27017"). The driver runs the MongoDB handshake, authentication, and
+// compression over the connection as it would over TCP, but does not apply TLS, so the transport is responsible for
+// securing messages if required.
+type Transport interface {
+	Dial(ctx context.Context, address string) (TransportConn, error)
+}
+
+// TransportConn is a connection created by a Transport that transfers whole wire protocol messages, each starting
+// with the standard message header.
+//
+// WriteMessage sends a message to the server and must not retain wm after returning. ReadMessage returns the next
+// message from the server. Servers can send multiple messages in response to a single request, so ReadMessage may
+// be called several times after a WriteMessage. If the context passed to either method expires, the method should
+// return promptly with the context's error. A message that arrives after ReadMessage returned because its context
+// expired should be returned by the next call to ReadMessage.
+//
+// The driver does not call WriteMessage or ReadMessage concurrently with another call to the same method, but Close
+// may be called concurrently with either, in which case pending calls should return promptly.
+type TransportConn interface {
+	WriteMessage(ctx context.Context, wm []byte) error
+	ReadMessage(ctx context.Context) ([]byte, error)
+	Close() error
+}
+
 // Credential can be used to provide authentication options when configuring a Client.
 //
 // AuthMechanism: the mechanism to use for authentication. Supported values include "SCRAM-SHA-256", "SCRAM-SHA-1",
@@ -107,6 +141,17 @@ type ContextDialer interface {
 // PasswordSet: For GSSAPI, this must be true if a password is specified, even if the password is the empty string, and
 // false if no password is specified, indicating that the password should be taken from the context of the running
 // process. For other mechanisms, this field is ignored.
+//
+// OIDCMachineCallback: a callback that returns an access token for the MONGODB-OIDC machine workflow. A token
+// provider that carries its own state can pass one of its methods as the callback.
+//
+// OIDCHumanCallback: a callback that returns an access token for the MONGODB-OIDC human workflow.
+//
+// For all MONGODB-OIDC workflows, the driver caches the returned access token and shares it across all pooled
+// connections. If the returned OIDCCredential has an ExpiresAt time, the driver requests a new token shortly before
+// that time instead of waiting for the server to reject the expired token: when a tenth of the lifetime of the token
+// remains, but no earlier than 5 minutes before it expires. If the server requests reauthentication, the cached token
+// is invalidated and a new one is requested once for all affected connections.
 type Credential struct {
 	AuthMechanism           string
 	AuthMechanismProperties map[string]string
@@ -181,6 +226,11 @@ type BSONOptions struct {
 	// empty BSON binary values instead of BSON null.
 	NilByteSliceAsEmpty bool
 
+	// Float32SliceAsVector causes the driver to marshal Go float32 slices as
+	// BSON binary vectors (subtype 9) instead of BSON arrays of doubles, which
+	// is a more compact representation for vector embeddings.
+	Float32SliceAsVector bool
+
 	// OmitZeroStruct causes the driver to consider the zero value for a struct
 	// (e.g. MyStruct{}) as empty and omit it from the marshaled BSON when the
 	// "omitempty" struct tag option or the "OmitEmpty" field is set.
@@ -210,6 +260,31 @@ type BSONOptions struct {
 	// "any" or "map[string]any".
 	DefaultDocumentM bool
 
+	// DisallowUnknownFields causes the driver to return an error when
+	// unmarshaling a BSON document that contains a field that does not map to
+	// a field of the destination Go struct.
+	DisallowUnknownFields bool
+
+	// IntegersAsInt64 causes the driver to unmarshal BSON "int32" values as Go
+	// int64 values. This behavior is restricted to data typed as "any" or
+	// "map[string]any".
+	IntegersAsInt64 bool
+
+	// MaxInternedStrings causes the driver to intern the BSON "string" values
+	// that it unmarshals into Go string types, up to the given number of
+	// distinct values, so that repeated values share memory. The documents of
+	// a Cursor share the interned values, which substantially reduces the
+	// memory held by large result sets with low-cardinality string fields.
+	// Values longer than 256 bytes are not interned. The default value is 0,
+	// which disables interning.
+	MaxInternedStrings int
+
+	// NumbersAsJSONNumber causes the driver to unmarshal BSON "int32",
+	// "int64", and "double" values as json.Number values. This behavior is
+	// restricted to data typed as "any" or "map[string]any" and takes
+	// precedence over IntegersAsInt64.
+	NumbersAsJSONNumber bool
+
 	// ObjectIDAsHexString causes the Decoder to decode object IDs to their hex
 	// representation.
 	ObjectIDAsHexString bool
@@ -244,14 +319,18 @@ type DriverInfo struct {
 // documentation.
 type ClientOptions struct {
 	AppName                  *string
+	AuditSink                audit.Sink
 	Auth                     *Credential
 	AutoEncryptionOptions    *AutoEncryptionOptions
+	CircuitBreaker           *CircuitBreakerOptions
+	CommandInterceptors      []*CommandInterceptor
 	ConnectTimeout           *time.Duration
 	Compressors              []string
 	Dialer                   ContextDialer
 	Direct                   *bool
 	DisableOCSPEndpointCheck *bool
 	DriverInfo               *DriverInfo
+	FaultInjector            fault.Injector
 	HeartbeatInterval        *time.Duration
 	Hosts                    []string
 	HTTPClient               *http.Client
@@ -262,14 +341,21 @@ type ClientOptions struct {
 	MaxPoolSize              *uint64
 	MinPoolSize              *uint64
 	MaxConnecting            *uint64
+	MemoryLimit              *MemoryLimitOptions
+	OCSPCache                OCSPCache
+	OCSPFailureMode          *string
+	OCSPHTTPClient           *http.Client
+	Policy                   *PolicyOptions
 	PoolMonitor              *event.PoolMonitor
 	Monitor                  *event.CommandMonitor
 	ServerMonitor            *event.ServerMonitor
 	ReadConcern              *readconcern.ReadConcern
 	ReadPreference           *readpref.ReadPref
+	ReadOnly                 *bool
 	BSONOptions              *BSONOptions
 	Registry                 *bson.Registry
 	ReplicaSet               *string
+	RetryPolicy              *RetryPolicyOptions
 	RetryReads               *bool
 	RetryWrites              *bool
 	ServerAPIOptions         *ServerAPIOptions
@@ -279,6 +365,9 @@ type ClientOptions struct {
 	SRVServiceName           *string
 	Timeout                  *time.Duration
 	TLSConfig                *tls.Config
+	Transport                Transport
+	UnixSocketHosts          map[string]string
+	WireMonitor              *event.WireMonitor
 	WriteConcern             *writeconcern.WriteConcern
 	ZlibLevel                *int
 	ZstdLevel                *int
@@ -540,22 +629,38 @@ func (c *ClientOptions) Validate() error {
 	// URI is used.
 	if c.Direct != nil && *c.Direct {
 		if len(c.Hosts) > 1 {
-			return errors.New("a direct connection cannot be made if multiple hosts are specified")
+			return ConflictingOptionsError{
+				Options: []string{"directConnection", "hosts"},
+				Message: "a direct connection cannot be made if multiple hosts are specified",
+			}
 		}
 		if c.connString != nil && c.connString.Scheme == connstring.SchemeMongoDBSRV {
-			return errors.New("a direct connection cannot be made if an SRV URI is used")
+			return ConflictingOptionsError{
+				Options: []string{"directConnection", "srv"},
+				Message: "a direct connection cannot be made if an SRV URI is used",
+			}
 		}
 	}
 
 	if c.HeartbeatInterval != nil && *c.HeartbeatInterval < (500*time.Millisecond) {
-		return fmt.Errorf("heartbeatFrequencyMS must exceed the minimum heartbeat interval of 500ms, got heartbeatFrequencyMS=%q",
-			*c.HeartbeatInterval)
+		return InvalidValueError{
+			Option: "heartbeatFrequencyMS",
+			Value:  *c.HeartbeatInterval,
+			Min:    500 * time.Millisecond,
+			Message: fmt.Sprintf("heartbeatFrequencyMS must exceed the minimum heartbeat interval of 500ms, got heartbeatFrequencyMS=%q",
+				*c.HeartbeatInterval),
+		}
 	}
 
 	if c.MaxPoolSize != nil && c.MinPoolSize != nil && *c.MaxPoolSize != 0 &&
 		*c.MinPoolSize > *c.MaxPoolSize {
-		return fmt.Errorf("minPoolSize must be less than or equal to maxPoolSize, got minPoolSize=%d maxPoolSize=%d",
-			*c.MinPoolSize, *c.MaxPoolSize)
+		return InvalidValueError{
+			Option: "minPoolSize",
+			Value:  *c.MinPoolSize,
+			Max:    *c.MaxPoolSize,
+			Message: fmt.Sprintf("minPoolSize must be less than or equal to maxPoolSize, got minPoolSize=%d maxPoolSize=%d",
+				*c.MinPoolSize, *c.MaxPoolSize),
+		}
 	}
 
 	// verify server API version if ServerAPIOptions are passed in.
@@ -568,86 +673,249 @@ func (c *ClientOptions) Validate() error {
 	// Validation for load-balanced mode.
 	if c.LoadBalanced != nil && *c.LoadBalanced {
 		if len(c.Hosts) > 1 {
-			return connstring.ErrLoadBalancedWithMultipleHosts
+			return ConflictingOptionsError{
+				Options: []string{"loadBalanced", "hosts"},
+				Err:     connstring.ErrLoadBalancedWithMultipleHosts,
+			}
 		}
 		if c.ReplicaSet != nil {
-			return connstring.ErrLoadBalancedWithReplicaSet
+			return ConflictingOptionsError{
+				Options: []string{"loadBalanced", "replicaSet"},
+				Err:     connstring.ErrLoadBalancedWithReplicaSet,
+			}
 		}
 		if c.Direct != nil && *c.Direct {
-			return connstring.ErrLoadBalancedWithDirectConnection
+			return ConflictingOptionsError{
+				Options: []string{"loadBalanced", "directConnection"},
+				Err:     connstring.ErrLoadBalancedWithDirectConnection,
+			}
 		}
 	}
 
 	// Validation for srvMaxHosts.
 	if c.SRVMaxHosts != nil && *c.SRVMaxHosts > 0 {
 		if c.ReplicaSet != nil {
-			return connstring.ErrSRVMaxHostsWithReplicaSet
+			return ConflictingOptionsError{
+				Options: []string{"srvMaxHosts", "replicaSet"},
+				Err:     connstring.ErrSRVMaxHostsWithReplicaSet,
+			}
 		}
 		if c.LoadBalanced != nil && *c.LoadBalanced {
-			return connstring.ErrSRVMaxHostsWithLoadBalanced
+			return ConflictingOptionsError{
+				Options: []string{"srvMaxHosts", "loadBalanced"},
+				Err:     connstring.ErrSRVMaxHostsWithLoadBalanced,
+			}
 		}
 	}
 
 	if mode := c.ServerMonitoringMode; mode != nil && !connstring.IsValidServerMonitoringMode(*mode) {
-		return fmt.Errorf("invalid server monitoring mode: %q", *mode)
+		return InvalidValueError{
+			Option:  "serverMonitoringMode",
+			Value:   *mode,
+			Allowed: []any{ServerMonitoringModeAuto, ServerMonitoringModePoll, ServerMonitoringModeStream},
+			Message: fmt.Sprintf("invalid server monitoring mode: %q", *mode),
+		}
+	}
+
+	if mode := c.OCSPFailureMode; mode != nil && *mode != OCSPFailureModeSoft && *mode != OCSPFailureModeHard {
+		return InvalidValueError{
+			Option:  "ocspFailureMode",
+			Value:   *mode,
+			Allowed: []any{OCSPFailureModeSoft, OCSPFailureModeHard},
+			Message: fmt.Sprintf("invalid OCSP failure mode: %q", *mode),
+		}
+	}
+
+	if c.Transport != nil {
+		if c.Dialer != nil {
+			return ConflictingOptionsError{
+				Options: []string{"Transport", "Dialer"},
+				Message: "cannot set both Transport and Dialer, only one may be specified",
+			}
+		}
+		if c.TLSConfig != nil {
+			return ConflictingOptionsError{
+				Options: []string{"Transport", "TLSConfig"},
+				Message: "TLS cannot be enabled when a Transport is specified",
+			}
+		}
+	}
+
+	if err := c.validateUnixSockets(); err != nil {
+		return err
+	}
+
+	if c.RetryPolicy != nil {
+		if err := c.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.CircuitBreaker != nil {
+		if err := c.CircuitBreaker.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.MemoryLimit != nil {
+		if err := c.MemoryLimit.Validate(); err != nil {
+			return err
+		}
 	}
 
 	if to := c.Timeout; to != nil && *to < 0 {
-		return fmt.Errorf(`invalid value %q for "Timeout": value must be positive`, *to)
+		return InvalidValueError{
+			Option:  "Timeout",
+			Value:   *to,
+			Min:     time.Duration(0),
+			Message: fmt.Sprintf(`invalid value %q for "Timeout": value must be positive`, *to),
+		}
 	}
 
 	// OIDC Validation
 	if c.Auth != nil && c.Auth.AuthMechanism == auth.MongoDBOIDC {
-		if c.Auth.Password != "" {
-			return fmt.Errorf("password must not be set for the %s auth mechanism", auth.MongoDBOIDC)
+		if err := c.validateOIDC(); err != nil {
+			return err
 		}
-		if c.Auth.OIDCMachineCallback != nil && c.Auth.OIDCHumanCallback != nil {
-			return fmt.Errorf("cannot set both OIDCMachineCallback and OIDCHumanCallback, only one may be specified")
+	}
+
+	return nil
+}
+
+// validateOIDC validates the credential of the MONGODB-OIDC auth mechanism.
+func (c *ClientOptions) validateOIDC() error {
+	if c.Auth.Password != "" {
+		return ConflictingOptionsError{
+			Options: []string{"Password", "AuthMechanism"},
+			Message: fmt.Sprintf("password must not be set for the %s auth mechanism", auth.MongoDBOIDC),
 		}
-		if c.Auth.OIDCHumanCallback == nil && c.Auth.AuthMechanismProperties[auth.AllowedHostsProp] != "" {
-			return fmt.Errorf("cannot specify ALLOWED_HOSTS without an OIDCHumanCallback")
+	}
+	if c.Auth.OIDCMachineCallback != nil && c.Auth.OIDCHumanCallback != nil {
+		return ConflictingOptionsError{
+			Options: []string{"OIDCMachineCallback", "OIDCHumanCallback"},
+			Message: "cannot set both OIDCMachineCallback and OIDCHumanCallback, only one may be specified",
 		}
-		if c.Auth.OIDCMachineCallback == nil && c.Auth.OIDCHumanCallback == nil && c.Auth.AuthMechanismProperties[auth.EnvironmentProp] == "" {
-			return errors.New("must specify at least one of OIDCMachineCallback, OIDCHumanCallback, or ENVIRONMENT authMechanismProperty")
+	}
+	if c.Auth.OIDCHumanCallback == nil && c.Auth.AuthMechanismProperties[auth.AllowedHostsProp] != "" {
+		return MissingOptionError{
+			Options: []string{"OIDCHumanCallback"},
+			Message: "cannot specify ALLOWED_HOSTS without an OIDCHumanCallback",
 		}
+	}
+	if c.Auth.OIDCMachineCallback == nil && c.Auth.OIDCHumanCallback == nil &&
+		c.Auth.AuthMechanismProperties[auth.EnvironmentProp] == "" {
+		return MissingOptionError{
+			Options: []string{"OIDCMachineCallback", "OIDCHumanCallback", auth.EnvironmentProp},
+			Message: "must specify at least one of OIDCMachineCallback, OIDCHumanCallback, or ENVIRONMENT authMechanismProperty",
+		}
+	}
 
-		// Return an error if an unsupported authMechanismProperty is specified
-		// for MONGODB-OIDC.
-		for prop := range c.Auth.AuthMechanismProperties {
-			switch prop {
-			case auth.AllowedHostsProp, auth.EnvironmentProp, auth.ResourceProp:
-			default:
-				return fmt.Errorf("auth mechanism property %q is not valid for MONGODB-OIDC", prop)
+	// Return an error if an unsupported authMechanismProperty is specified
+	// for MONGODB-OIDC.
+	for prop := range c.Auth.AuthMechanismProperties {
+		switch prop {
+		case auth.AllowedHostsProp, auth.EnvironmentProp, auth.ResourceProp:
+		default:
+			return InvalidValueError{
+				Option:  "authMechanismProperties",
+				Value:   prop,
+				Allowed: []any{auth.AllowedHostsProp, auth.EnvironmentProp, auth.ResourceProp},
+				Message: fmt.Sprintf("auth mechanism property %q is not valid for MONGODB-OIDC", prop),
 			}
 		}
+	}
 
-		if env, ok := c.Auth.AuthMechanismProperties[auth.EnvironmentProp]; ok {
-			switch env {
-			case auth.GCPEnvironmentValue, auth.AzureEnvironmentValue:
-				if c.Auth.AuthMechanismProperties[auth.ResourceProp] == "" {
-					return fmt.Errorf("%q must be set for the %s %q", auth.ResourceProp, env, auth.EnvironmentProp)
-				}
-				fallthrough
-			case auth.K8SEnvironmentValue:
-				if c.Auth.OIDCMachineCallback != nil {
-					return fmt.Errorf("OIDCMachineCallback cannot be specified with the %s %q", env, auth.EnvironmentProp)
-				}
-				if c.Auth.OIDCHumanCallback != nil {
-					return fmt.Errorf("OIDCHumanCallback cannot be specified with the %s %q", env, auth.EnvironmentProp)
-				}
-			case auth.TestEnvironmentValue:
-				if c.Auth.AuthMechanismProperties[auth.ResourceProp] != "" {
-					return fmt.Errorf("%q must not be set for the %s %q", auth.ResourceProp, env, auth.EnvironmentProp)
-				}
-				if c.Auth.Username != "" {
-					return fmt.Errorf("must not specify username for %s %q", env, auth.EnvironmentProp)
-				}
-			default:
-				return fmt.Errorf("the %s %q is not supported for MONGODB-OIDC", env, auth.EnvironmentProp)
+	env, ok := c.Auth.AuthMechanismProperties[auth.EnvironmentProp]
+	if !ok {
+		return nil
+	}
+	conflict := func(option string) error {
+		return ConflictingOptionsError{
+			Options: []string{option, auth.EnvironmentProp},
+			Message: fmt.Sprintf("%s cannot be specified with the %s %q", option, env, auth.EnvironmentProp),
+		}
+	}
+	switch env {
+	case auth.GCPEnvironmentValue, auth.AzureEnvironmentValue:
+		if c.Auth.AuthMechanismProperties[auth.ResourceProp] == "" {
+			return MissingOptionError{
+				Options: []string{auth.ResourceProp},
+				Message: fmt.Sprintf("%q must be set for the %s %q", auth.ResourceProp, env, auth.EnvironmentProp),
+			}
+		}
+		fallthrough
+	case auth.K8SEnvironmentValue:
+		if c.Auth.OIDCMachineCallback != nil {
+			return conflict("OIDCMachineCallback")
+		}
+		if c.Auth.OIDCHumanCallback != nil {
+			return conflict("OIDCHumanCallback")
+		}
+	case auth.TestEnvironmentValue:
+		if c.Auth.AuthMechanismProperties[auth.ResourceProp] != "" {
+			return ConflictingOptionsError{
+				Options: []string{auth.ResourceProp, auth.EnvironmentProp},
+				Message: fmt.Sprintf("%q must not be set for the %s %q", auth.ResourceProp, env, auth.EnvironmentProp),
+			}
+		}
+		if c.Auth.Username != "" {
+			return ConflictingOptionsError{
+				Options: []string{"Username", auth.EnvironmentProp},
+				Message: fmt.Sprintf("must not specify username for %s %q", env, auth.EnvironmentProp),
+			}
+		}
+	default:
+		return InvalidValueError{
+			Option: auth.EnvironmentProp,
+			Value:  env,
+			Allowed: []any{auth.GCPEnvironmentValue, auth.AzureEnvironmentValue, auth.K8SEnvironmentValue,
+				auth.TestEnvironmentValue},
+			Message: fmt.Sprintf("the %s %q is not supported for MONGODB-OIDC", env, auth.EnvironmentProp),
+		}
+	}
+	return nil
+}
+
+// validateUnixSockets validates the Unix domain socket hosts and mappings.
+func (c *ClientOptions) validateUnixSockets() error {
+	validatePath := func(path string) error {
+		if strings.HasPrefix(path, "@") && runtime.GOOS != "linux" {
+			return InvalidValueError{
+				Option:  "UnixSocketHosts",
+				Value:   path,
+				Message: fmt.Sprintf("abstract Unix domain socket %q is only supported on Linux", path),
 			}
 		}
+		return nil
 	}
 
+	for _, host := range c.Hosts {
+		if err := validatePath(host); err != nil {
+			return err
+		}
+	}
+
+	if len(c.UnixSocketHosts) == 0 {
+		return nil
+	}
+	if c.Transport != nil {
+		return ConflictingOptionsError{
+			Options: []string{"Transport", "UnixSocketHosts"},
+			Message: "cannot set both Transport and UnixSocketHosts, only one may be specified",
+		}
+	}
+	for host, path := range c.UnixSocketHosts {
+		if address.Address(path).Network() != "unix" {
+			return InvalidValueError{
+				Option:  "UnixSocketHosts",
+				Value:   path,
+				Message: fmt.Sprintf("invalid Unix domain socket path %q for host %q", path, host),
+			}
+		}
+		if err := validatePath(path); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -684,6 +952,15 @@ func (c *ClientOptions) SetAppName(s string) *ClientOptions {
 	return c
 }
 
+// SetAuditSink specifies an audit.Sink that receives an audit.Record for every write operation run through the
+// Client, such as inserts, updates, deletes, findAndModify operations, bulk writes, and collection drops. The default
+// is nil, which means no audit records are emitted. See the audit package documentation for more information.
+func (c *ClientOptions) SetAuditSink(sink audit.Sink) *ClientOptions {
+	c.AuditSink = sink
+
+	return c
+}
+
 // SetAuth specifies a Credential containing options for configuring authentication. See the options.Credential
 // documentation for more information about Credential fields. The default is an empty Credential, meaning no
 // authentication will be configured.
@@ -693,6 +970,24 @@ func (c *ClientOptions) SetAuth(auth Credential) *ClientOptions {
 	return c
 }
 
+// SetCircuitBreaker specifies a CircuitBreakerOptions instance that makes server selection avoid servers with a high
+// error rate or latency. See the options.CircuitBreakerOptions documentation for more information. The default is nil,
+// which means that servers are only avoided when their heartbeats fail.
+func (c *ClientOptions) SetCircuitBreaker(cb *CircuitBreakerOptions) *ClientOptions {
+	c.CircuitBreaker = cb
+
+	return c
+}
+
+// SetCommandInterceptors specifies the interceptors of the commands sent by the Client and the replies to them. The
+// commands are passed through the interceptors in the given order, and the replies in the reverse order. Nil
+// interceptors are ignored. See the options.CommandInterceptor documentation for more information. The default is nil.
+func (c *ClientOptions) SetCommandInterceptors(interceptors ...*CommandInterceptor) *ClientOptions {
+	c.CommandInterceptors = interceptors
+
+	return c
+}
+
 // SetCompressors sets the compressors that can be used when communicating with a server. Valid values are:
 //
 // 1. "snappy"
@@ -733,6 +1028,16 @@ func (c *ClientOptions) SetDialer(d ContextDialer) *ClientOptions {
 	return c
 }
 
+// SetTransport specifies a custom Transport to be used to create new connections to the server instead of dialing
+// TCP or Unix domain socket connections. A Transport cannot be used together with a Dialer or TLS; the transport is
+// responsible for establishing and securing connections. The default is nil, meaning that the Client dials servers
+// with the Dialer.
+func (c *ClientOptions) SetTransport(t Transport) *ClientOptions {
+	c.Transport = t
+
+	return c
+}
+
 // SetDirect specifies whether or not a direct connect should be made. If set to true, the driver will only connect to
 // the host provided in the URI and will not discover other hosts in the cluster. This can also be set through the
 // "directConnection" URI option. This option cannot be set to true if multiple hosts are specified, either through
@@ -774,6 +1079,47 @@ func (c *ClientOptions) SetHosts(s []string) *ClientOptions {
 	return c
 }
 
+// SetUnixSocket specifies that the Client connects to a single server through the Unix domain socket at path. The
+// path is used as-is, so it must not be URL-encoded as it would be in a URI; a URL-encoded path such as
+// "%2Ftmp%2Fmongodb-27017.sock" is decoded. On Linux, a path starting with "@" refers to a socket in the abstract
+// namespace, e.g. "@mongodb" for the abstract socket named "mongodb".
+//
+// This replaces any hosts set by ApplyURI or SetHosts. To connect to a replica set whose members are reachable
+// through local sockets, use SetUnixSocketHosts instead.
+func (c *ClientOptions) SetUnixSocket(path string) *ClientOptions {
+	if !strings.HasPrefix(path, "/") && !strings.HasPrefix(path, "@") {
+		unescaped, err := url.PathUnescape(path)
+		if err != nil {
+			c.err = InvalidValueError{
+				Option:  "UnixSocketHosts",
+				Value:   path,
+				Message: fmt.Sprintf("invalid Unix domain socket path %q: %v", path, err),
+				Err:     err,
+			}
+			return c
+		}
+		path = unescaped
+	}
+	c.Hosts = []string{path}
+
+	return c
+}
+
+// SetUnixSocketHosts specifies Unix domain sockets through which servers are reached, keyed by the host and port the
+// servers are known by, e.g. {"db1.example.com:27017": "/var/run/mongodb/db1.sock"}. The keys are matched against the
+// configured hosts and the hosts discovered from the deployment, such as replica set members, so that a replica set
+// can be monitored and used through local sockets while keeping its configured host names. Servers that are not in
+// the map are dialed over TCP.
+//
+// The values must be socket paths as accepted by SetUnixSocket. A Unix socket mapping cannot be used with a Transport.
+// The default is nil, meaning that only hosts that are themselves socket paths are dialed through Unix domain
+// sockets.
+func (c *ClientOptions) SetUnixSocketHosts(sockets map[string]string) *ClientOptions {
+	c.UnixSocketHosts = sockets
+
+	return c
+}
+
 // SetLoadBalanced specifies whether or not the MongoDB deployment is hosted behind a load balancer. This can also be
 // set through the "loadBalanced" URI option. The driver will error during Client configuration if this option is set
 // to true and one of the following conditions are met:
@@ -844,6 +1190,15 @@ func (c *ClientOptions) SetMaxConnecting(u uint64) *ClientOptions {
 	return c
 }
 
+// SetMemoryLimit specifies a MemoryLimitOptions instance that makes the Client account for the memory it holds and
+// delay new operations when it exceeds a soft limit. See the options.MemoryLimitOptions documentation for more
+// information. The default is nil, which means that memory is not accounted.
+func (c *ClientOptions) SetMemoryLimit(ml *MemoryLimitOptions) *ClientOptions {
+	c.MemoryLimit = ml
+
+	return c
+}
+
 // SetPoolMonitor specifies a PoolMonitor to receive connection pool events. See the event.PoolMonitor documentation
 // for more information about the structure of the monitor and events that can be received.
 func (c *ClientOptions) SetPoolMonitor(m *event.PoolMonitor) *ClientOptions {
@@ -867,6 +1222,17 @@ func (c *ClientOptions) SetServerMonitor(m *event.ServerMonitor) *ClientOptions
 	return c
 }
 
+// SetWireMonitor specifies a monitor that receives a copy of every wire message sent and received on the connections
+// of the Client, after OP_COMPRESSED messages are decompressed and before replies are decoded. It is intended for
+// packet-level debugging and replay tooling. The messages are not redacted, so they contain credentials and the
+// documents of sensitive commands. See the event.WireMonitor documentation for more information. The default is nil,
+// which means that wire messages are not copied.
+func (c *ClientOptions) SetWireMonitor(m *event.WireMonitor) *ClientOptions {
+	c.WireMonitor = m
+
+	return c
+}
+
 // SetReadConcern specifies the read concern to use for read operations. A read concern level can also be set through
 // the "readConcernLevel" URI option (e.g. "readConcernLevel=majority"). The default is nil, meaning the server will use
 // its configured default.
@@ -937,6 +1303,15 @@ func (c *ClientOptions) SetRetryWrites(b bool) *ClientOptions {
 	return c
 }
 
+// SetRetryPolicy specifies a RetryPolicyOptions instance that customizes which errors are retried by retryable reads
+// and writes, how many times they are retried, and the backoff between attempts. See the options.RetryPolicyOptions
+// documentation for more information. The default is to retry retryable errors once, immediately.
+func (c *ClientOptions) SetRetryPolicy(p *RetryPolicyOptions) *ClientOptions {
+	c.RetryPolicy = p
+
+	return c
+}
+
 // SetRetryReads specifies whether supported read operations should be retried once on certain errors, such as network
 // errors.
 //
@@ -1007,6 +1382,89 @@ func (c *ClientOptions) SetTLSConfig(cfg *tls.Config) *ClientOptions {
 	return c
 }
 
+// SetTLSCertificates adds client certificates to present to the server when making a TLS connection. This enables
+// TLS with an otherwise default configuration if no TLS configuration has been set. It is the in-memory equivalent of
+// the "tlsCertificateKeyFile" URI option and can be used to supply the certificate for MONGODB-X509 authentication.
+// If Credential.Username is empty when using MONGODB-X509, the server derives the username from the certificate
+// subject.
+//
+// The TLS configuration set by SetTLSConfig, if any, is copied before being modified. A later call to SetTLSConfig or
+// ApplyURI with TLS options replaces the certificates set by this method.
+func (c *ClientOptions) SetTLSCertificates(certs ...tls.Certificate) *ClientOptions {
+	cfg := c.cloneTLSConfig()
+	cfg.Certificates = append(cfg.Certificates, certs...)
+	c.TLSConfig = cfg
+
+	return c
+}
+
+// SetTLSCertificateKeyPEM adds a client certificate and private key from PEM-encoded data to present to the server
+// when making a TLS connection. The data must contain at least one certificate and one private key in the format
+// accepted by the "tlsCertificateKeyFile" URI option. If the private key is encrypted, password is used to decrypt
+// it. Any error parsing the data is returned by Validate.
+//
+// See SetTLSCertificates for how this interacts with other TLS options.
+func (c *ClientOptions) SetTLSCertificateKeyPEM(data []byte, password string) *ClientOptions {
+	if c.err != nil {
+		return c
+	}
+
+	cfg := c.cloneTLSConfig()
+	if _, err := addClientCertFromBytes(cfg, data, password); err != nil {
+		c.err = fmt.Errorf("error parsing TLS certificate key PEM: %w", err)
+		return c
+	}
+	c.TLSConfig = cfg
+
+	return c
+}
+
+// SetTLSRootCAs specifies the set of certificate authorities to trust when making a TLS connection. This enables
+// TLS with an otherwise default configuration if no TLS configuration has been set. It is the in-memory equivalent of
+// the "tlsCAFile" URI option and replaces any certificate authorities that were previously configured.
+//
+// See SetTLSCertificates for how this interacts with other TLS options.
+func (c *ClientOptions) SetTLSRootCAs(pool *x509.CertPool) *ClientOptions {
+	cfg := c.cloneTLSConfig()
+	cfg.RootCAs = pool
+	c.TLSConfig = cfg
+
+	return c
+}
+
+// SetTLSCAPEM adds PEM-encoded certificate authorities to trust when making a TLS connection. The data must contain
+// at least one valid certificate. Any error parsing the data is returned by Validate.
+//
+// See SetTLSCertificates for how this interacts with other TLS options.
+func (c *ClientOptions) SetTLSCAPEM(data []byte) *ClientOptions {
+	if c.err != nil {
+		return c
+	}
+
+	cfg := c.cloneTLSConfig()
+	if cfg.RootCAs == nil {
+		cfg.RootCAs = x509.NewCertPool()
+	} else {
+		cfg.RootCAs = cfg.RootCAs.Clone()
+	}
+	if !cfg.RootCAs.AppendCertsFromPEM(data) {
+		c.err = errors.New("the specified CA PEM data does not contain any valid certificates")
+		return c
+	}
+	c.TLSConfig = cfg
+
+	return c
+}
+
+// cloneTLSConfig returns a copy of the TLS configuration, or a new configuration if none is set, so that
+// configurations passed to SetTLSConfig are not modified.
+func (c *ClientOptions) cloneTLSConfig() *tls.Config {
+	if c.TLSConfig == nil {
+		return new(tls.Config)
+	}
+	return c.TLSConfig.Clone()
+}
+
 // SetHTTPClient specifies the http.Client to be used for any HTTP requests.
 //
 // This should only be used to set custom HTTP client configurations. By default, the connection will use an httputil.DefaultHTTPClient.
@@ -1079,6 +1537,52 @@ func (c *ClientOptions) SetDisableOCSPEndpointCheck(disableCheck bool) *ClientOp
 	return c
 }
 
+// SetOCSPHTTPClient specifies the http.Client to use for requests to OCSP responders. This can be used to route OCSP
+// requests through a proxy or to configure timeouts independently of other HTTP requests made by the driver. The
+// default is the client set by SetHTTPClient.
+func (c *ClientOptions) SetOCSPHTTPClient(client *http.Client) *ClientOptions {
+	c.OCSPHTTPClient = client
+
+	return c
+}
+
+// SetOCSPCache specifies an OCSPCache used to store OCSP responses. Responses from the cache are used before
+// contacting OCSP responders, and responses from responders and stapled responses are added to the cache. The default
+// is an in-memory cache shared by all connections created by the Client.
+func (c *ClientOptions) SetOCSPCache(cache OCSPCache) *ClientOptions {
+	c.OCSPCache = cache
+
+	return c
+}
+
+// SetOCSPFailureMode specifies whether a TLS connection should fail if the revocation status of the server's
+// certificate cannot be determined. Valid values are OCSPFailureModeSoft and OCSPFailureModeHard. OCSP verification
+// is not performed if tlsInsecure or tlsAllowInvalidCertificates is set. The default is OCSPFailureModeSoft.
+func (c *ClientOptions) SetOCSPFailureMode(mode string) *ClientOptions {
+	c.OCSPFailureMode = &mode
+
+	return c
+}
+
+// SetPolicy specifies a PolicyOptions instance that restricts the read and write concerns used by the Client. If the
+// write concern of the Client violates the policy, creating the Client fails. See the options.PolicyOptions
+// documentation for more information.
+func (c *ClientOptions) SetPolicy(p *PolicyOptions) *ClientOptions {
+	c.Policy = p
+
+	return c
+}
+
+// SetReadOnly specifies whether the Client rejects all write operations. If true, write operations, including
+// Aggregate with a $out or $merge stage and RunCommand and RunCommandCursor with any command that is not a known read
+// command, return a mongo.ReadOnlyError without contacting the server. This can be used to guard against accidental writes from
+// reporting services or clients of disaster recovery replicas. The default is false.
+func (c *ClientOptions) SetReadOnly(readOnly bool) *ClientOptions {
+	c.ReadOnly = &readOnly
+
+	return c
+}
+
 // SetServerAPIOptions specifies a ServerAPIOptions instance used to configure the API version sent to the server
 // when running commands. See the options.ServerAPIOptions documentation for more information about the supported
 // options.
@@ -1125,6 +1629,15 @@ func (c *ClientOptions) SetDriverInfo(info *DriverInfo) *ClientOptions {
 	return c
 }
 
+// SetFaultInjector specifies a fault.Injector that can delay or fail operations before they are sent to the server,
+// for testing the resilience of applications. This should not be set in production. See the fault package
+// documentation for more information.
+func (c *ClientOptions) SetFaultInjector(injector fault.Injector) *ClientOptions {
+	c.FaultInjector = injector
+
+	return c
+}
+
 // addCACertFromFile adds a root CA certificate to the configuration given a path
 // to the containing file.
 func addCACertFromFile(cfg *tls.Config, file string) error {
@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"os"
 	"reflect"
+	"runtime"
 	"testing"
 	"time"
 
@@ -79,10 +80,13 @@ func TestClientOptions(t *testing.T) {
 			{"ServerSelectionTimeout", (*ClientOptions).SetServerSelectionTimeout, 5 * time.Second, "ServerSelectionTimeout", true},
 			{"Direct", (*ClientOptions).SetDirect, true, "Direct", true},
 			{"TLSConfig", (*ClientOptions).SetTLSConfig, &tls.Config{}, "TLSConfig", false},
+			{"Transport", (*ClientOptions).SetTransport, testTransport{Num: 12345}, "Transport", true},
+			{"UnixSocketHosts", (*ClientOptions).SetUnixSocketHosts, map[string]string{"db1:27017": "/tmp/db1.sock"}, "UnixSocketHosts", true},
 			{"WriteConcern", (*ClientOptions).SetWriteConcern, writeconcern.Majority(), "WriteConcern", false},
 			{"ZlibLevel", (*ClientOptions).SetZlibLevel, 6, "ZlibLevel", true},
 			{"DisableOCSPEndpointCheck", (*ClientOptions).SetDisableOCSPEndpointCheck, true, "DisableOCSPEndpointCheck", true},
 			{"LoadBalanced", (*ClientOptions).SetLoadBalanced, true, "LoadBalanced", true},
+			{"OCSPFailureMode", (*ClientOptions).SetOCSPFailureMode, OCSPFailureModeHard, "OCSPFailureMode", true},
 		}
 
 		opt1, opt2, optResult := Client(), Client(), Client()
@@ -242,7 +246,113 @@ func TestClientOptions(t *testing.T) {
 
 				tc.opts.SetLoadBalanced(true)
 				err = tc.opts.Validate()
-				assert.Equal(t, tc.err, err, "expected error %v when loadBalanced=true, got %v", tc.err, err)
+				assert.ErrorIs(t, err, tc.err)
+				assert.ErrorIs(t, err, ErrInvalidOption)
+			})
+		}
+	})
+	t.Run("transport validation", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			opts *ClientOptions
+			err  error
+		}{
+			{
+				"with Dialer",
+				Client().SetDialer(testDialer{}),
+				errors.New("cannot set both Transport and Dialer, only one may be specified"),
+			},
+			{
+				"with TLS in URI",
+				Client().ApplyURI("mongodb://localhost/?tls=true"),
+				errors.New("TLS cannot be enabled when a Transport is specified"),
+			},
+			{
+				"with TLSConfig",
+				Client().SetTLSConfig(&tls.Config{}),
+				errors.New("TLS cannot be enabled when a Transport is specified"),
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := tc.opts.Validate()
+				assert.Nil(t, err, "Validate error without a Transport: %v", err)
+
+				err = tc.opts.SetTransport(testTransport{}).Validate()
+				assertValidationError(t, tc.err, err)
+			})
+		}
+	})
+	t.Run("SetUnixSocket", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			path string
+			want string
+		}{
+			{"path", "/tmp/mongodb-27017.sock", "/tmp/mongodb-27017.sock"},
+			{"path with percent sign", "/tmp/mongo%db.sock", "/tmp/mongo%db.sock"},
+			{"encoded path", "%2Ftmp%2Fmongodb-27017.sock", "/tmp/mongodb-27017.sock"},
+			{"abstract socket", "@mongodb", "@mongodb"},
+			{"encoded abstract socket", "%40mongodb", "@mongodb"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				opts := Client().ApplyURI("mongodb://localhost:27017,localhost:27018").SetUnixSocket(tc.path)
+				assert.Equal(t, []string{tc.want}, opts.Hosts, "unexpected hosts")
+			})
+		}
+
+		err := Client().SetUnixSocket("%zz.sock").Validate()
+		assert.Error(t, err, "expected an error for an invalid encoding")
+	})
+	t.Run("unix socket validation", func(t *testing.T) {
+		abstractErr := func(path string) error {
+			if runtime.GOOS == "linux" {
+				return nil
+			}
+			return fmt.Errorf("abstract Unix domain socket %q is only supported on Linux", path)
+		}
+
+		testCases := []struct {
+			name string
+			opts *ClientOptions
+			err  error
+		}{
+			{
+				"socket host",
+				Client().SetUnixSocket("/tmp/mongodb-27017.sock"),
+				nil,
+			},
+			{
+				"abstract socket host",
+				Client().SetUnixSocket("@mongodb"),
+				abstractErr("@mongodb"),
+			},
+			{
+				"socket mapping",
+				Client().SetUnixSocketHosts(map[string]string{"db1:27017": "/tmp/db1.sock"}),
+				nil,
+			},
+			{
+				"abstract socket mapping",
+				Client().SetUnixSocketHosts(map[string]string{"db1:27017": "@db1"}),
+				abstractErr("@db1"),
+			},
+			{
+				"mapping to TCP address",
+				Client().SetUnixSocketHosts(map[string]string{"db1:27017": "localhost:27017"}),
+				errors.New(`invalid Unix domain socket path "localhost:27017" for host "db1:27017"`),
+			},
+			{
+				"socket mapping with Transport",
+				Client().SetUnixSocketHosts(map[string]string{"db1:27017": "/tmp/db1.sock"}).SetTransport(testTransport{}),
+				errors.New("cannot set both Transport and UnixSocketHosts, only one may be specified"),
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := tc.opts.Validate()
+				assertValidationError(t, tc.err, err)
 			})
 		}
 	})
@@ -296,7 +406,72 @@ func TestClientOptions(t *testing.T) {
 		for _, tc := range testCases {
 			t.Run(tc.name, func(t *testing.T) {
 				err := tc.opts.Validate()
-				assert.Equal(t, tc.err, err)
+				assertValidationError(t, tc.err, err)
+			})
+		}
+	})
+	t.Run("circuit breaker validation", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			cb   *CircuitBreakerOptions
+			err  error
+		}{
+			{"default", CircuitBreaker(), nil},
+			{
+				"valid",
+				CircuitBreaker().SetWindow(time.Second).SetMinOperations(1).SetFailureRateThreshold(1).
+					SetLatencyThreshold(0).SetCooldown(time.Second),
+				nil,
+			},
+			{"zero window", CircuitBreaker().SetWindow(0), errors.New("circuit breaker window must be positive")},
+			{
+				"zero min operations",
+				CircuitBreaker().SetMinOperations(0),
+				errors.New("circuit breaker min operations must be at least 1"),
+			},
+			{
+				"zero failure rate",
+				CircuitBreaker().SetFailureRateThreshold(0),
+				errors.New("circuit breaker failure rate threshold must be greater than 0 and at most 1"),
+			},
+			{
+				"failure rate above 1",
+				CircuitBreaker().SetFailureRateThreshold(1.5),
+				errors.New("circuit breaker failure rate threshold must be greater than 0 and at most 1"),
+			},
+			{
+				"negative latency threshold",
+				CircuitBreaker().SetLatencyThreshold(-time.Second),
+				errors.New("circuit breaker latency threshold must not be negative"),
+			},
+			{"zero cooldown", CircuitBreaker().SetCooldown(0), errors.New("circuit breaker cooldown must be positive")},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := Client().SetCircuitBreaker(tc.cb).Validate()
+				assertValidationError(t, tc.err, err)
+			})
+		}
+	})
+	t.Run("memory limit validation", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			ml   *MemoryLimitOptions
+			err  error
+		}{
+			{"default", MemoryLimit(), nil},
+			{"valid", MemoryLimit().SetSoftLimit(1).SetMaxWait(0), nil},
+			{"zero soft limit", MemoryLimit().SetSoftLimit(0), errors.New("memory soft limit must be positive")},
+			{
+				"negative max wait",
+				MemoryLimit().SetMaxWait(-time.Second),
+				errors.New("memory limit max wait must not be negative"),
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := Client().SetMemoryLimit(tc.ml).Validate()
+				assertValidationError(t, tc.err, err)
 			})
 		}
 	})
@@ -330,7 +505,7 @@ func TestClientOptions(t *testing.T) {
 		for _, tc := range testCases {
 			t.Run(tc.name, func(t *testing.T) {
 				err := tc.opts.Validate()
-				assert.Equal(t, tc.err, err, "expected error %v, got %v", tc.err, err)
+				assertValidationError(t, tc.err, err)
 			})
 		}
 	})
@@ -355,7 +530,12 @@ func TestClientOptions(t *testing.T) {
 
 				tc.opts.SetSRVMaxHosts(2)
 				err = tc.opts.Validate()
-				assert.Equal(t, tc.err, err, "expected error %v when srvMaxHosts > 0, got %v", tc.err, err)
+				if tc.err == nil {
+					assert.NoError(t, err)
+					return
+				}
+				assert.ErrorIs(t, err, tc.err)
+				assert.ErrorIs(t, err, ErrInvalidOption)
 			})
 		}
 	})
@@ -390,7 +570,7 @@ func TestClientOptions(t *testing.T) {
 				t.Parallel()
 
 				err := tc.opts.Validate()
-				assert.Equal(t, tc.err, err, "want error %v, got error %v", tc.err, err)
+				assertValidationError(t, tc.err, err)
 			})
 		}
 	})
@@ -436,7 +616,7 @@ func TestClientOptions(t *testing.T) {
 				t.Parallel()
 
 				err := tc.opts.Validate()
-				assert.Equal(t, tc.err, err, "expected error %v, got %v", tc.err, err)
+				assertValidationError(t, tc.err, err)
 			})
 		}
 	})
@@ -521,10 +701,76 @@ func TestClientOptions(t *testing.T) {
 				t.Parallel()
 
 				err := tc.opts.Validate()
-				assert.Equal(t, tc.err, err, "want error %v, got error %v", tc.err, err)
+				assertValidationError(t, tc.err, err)
 			})
 		}
 	})
+	t.Run("OCSP failure mode validation", func(t *testing.T) {
+		err := Client().SetOCSPFailureMode(OCSPFailureModeHard).Validate()
+		assert.Nil(t, err, "Validate error: %v", err)
+
+		err = Client().SetOCSPFailureMode("strict").Validate()
+		assertValidationError(t, errors.New(`invalid OCSP failure mode: "strict"`), err)
+	})
+	t.Run("in-memory TLS credentials", func(t *testing.T) {
+		t.Run("certificate key PEM", func(t *testing.T) {
+			opts := Client().SetTLSCertificateKeyPEM(readFile(t, "testdata/certificate.pem"), "passphrase")
+			assert.Nil(t, opts.Validate(), "Validate error: %v", opts.Validate())
+			assert.NotNil(t, opts.TLSConfig, "expected TLSConfig to be set")
+			assert.Equal(t, 1, len(opts.TLSConfig.Certificates), "expected 1 certificate")
+		})
+		t.Run("certificate key PEM without password", func(t *testing.T) {
+			opts := Client().SetTLSCertificateKeyPEM(readFile(t, "testdata/certificate.pem"), "")
+			want := fmt.Errorf("error parsing TLS certificate key PEM: %w", errors.New("no password provided to decrypt private key"))
+			assert.Equal(t, want, opts.Validate())
+		})
+		t.Run("certificates", func(t *testing.T) {
+			cert, err := tls.LoadX509KeyPair("testdata/nopass/cert.pem", "testdata/nopass/key.pem")
+			assert.Nil(t, err, "LoadX509KeyPair error: %v", err)
+
+			opts := Client().SetTLSCertificates(cert)
+			assert.Equal(t, 1, len(opts.TLSConfig.Certificates), "expected 1 certificate")
+		})
+		t.Run("CA PEM", func(t *testing.T) {
+			opts := Client().SetTLSCAPEM(readFile(t, "testdata/ca.pem"))
+			assert.Nil(t, opts.Validate(), "Validate error: %v", opts.Validate())
+			want := &tls.Config{RootCAs: createCertPool(t, "testdata/ca.pem")}
+			assert.True(t, compareTLSConfig(want, opts.TLSConfig), "expected TLS config %v, got %v", want, opts.TLSConfig)
+		})
+		t.Run("empty CA PEM", func(t *testing.T) {
+			opts := Client().SetTLSCAPEM(readFile(t, "testdata/empty-ca.pem"))
+			want := errors.New("the specified CA PEM data does not contain any valid certificates")
+			assert.Equal(t, want, opts.Validate())
+		})
+		t.Run("root CAs", func(t *testing.T) {
+			pool := createCertPool(t, "testdata/ca.pem")
+			opts := Client().SetTLSRootCAs(pool)
+			assert.Equal(t, pool, opts.TLSConfig.RootCAs, "expected RootCAs to be set")
+		})
+		t.Run("does not modify TLSConfig", func(t *testing.T) {
+			cfg := &tls.Config{ServerName: "example.com"}
+			cert, err := tls.LoadX509KeyPair("testdata/nopass/cert.pem", "testdata/nopass/key.pem")
+			assert.Nil(t, err, "LoadX509KeyPair error: %v", err)
+
+			opts := Client().SetTLSConfig(cfg).SetTLSCertificates(cert)
+			assert.Equal(t, 0, len(cfg.Certificates), "expected original TLSConfig to be unmodified")
+			assert.Equal(t, "example.com", opts.TLSConfig.ServerName, "expected ServerName to be preserved")
+			assert.Equal(t, 1, len(opts.TLSConfig.Certificates), "expected 1 certificate")
+		})
+	})
+}
+
+// assertValidationError asserts that err is nil if want is nil, and otherwise
+// that err is an options validation error with the same message as want.
+func assertValidationError(t *testing.T, want, err error) {
+	t.Helper()
+
+	if want == nil {
+		assert.NoError(t, err)
+		return
+	}
+	assert.EqualError(t, err, want.Error())
+	assert.ErrorIs(t, err, ErrInvalidOption)
 }
 
 type nonDefaultTransport struct{}
@@ -577,6 +823,14 @@ func (testDialer) DialContext(context.Context, string, string) (net.Conn, error)
 	return nil, nil
 }
 
+type testTransport struct {
+	Num int
+}
+
+func (testTransport) Dial(context.Context, string) (TransportConn, error) {
+	return nil, nil
+}
+
 func compareTLSConfig(cfg1, cfg2 *tls.Config) bool {
 	if cfg1 == nil && cfg2 == nil {
 		return true
@@ -0,0 +1,67 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// InterceptedCommand is a command that a Client is about to send.
+type InterceptedCommand struct {
+	// Name is the name of the command.
+	Name string
+
+	// Database is the database the command is run against.
+	Database string
+
+	// Command is the command document, including the fields added by the
+	// driver, such as lsid, $db, and $readPreference. An interceptor can set
+	// it to a different document to send that document instead.
+	Command bson.Raw
+}
+
+// InterceptedReply is the reply to a command sent by a Client.
+type InterceptedReply struct {
+	// Name is the name of the command.
+	Name string
+
+	// Database is the database the command was run against.
+	Database string
+
+	// Reply is the reply of the server. It is nil if no reply was received,
+	// for example because of a network error or because the command was an
+	// unacknowledged write.
+	Reply bson.Raw
+
+	// Err is the error of the command, if any. It can be inspected with the
+	// error helpers of the mongo package, such as mongo.IsNetworkError and
+	// mongo.ServerError.
+	Err error
+}
+
+// CommandInterceptor intercepts the commands sent by a Client and the replies
+// to them. Interceptors can be used to tag or audit commands, inject comments,
+// or reject commands that violate an application policy.
+//
+// Interceptors apply to the commands of operations run by the Client,
+// including retries, but not to the getMore and killCursors commands sent by
+// cursors and change streams, or to the commands used for connection
+// handshakes, authentication, and server monitoring. Both functions must be
+// safe for concurrent use.
+type CommandInterceptor struct {
+	// Command is called before each command is sent. If it returns an error,
+	// the command is not sent and the operation fails with the error.
+	Command func(context.Context, *InterceptedCommand) error
+
+	// Reply is called with the reply to each command before the reply is
+	// decoded into the result of the operation. If it returns an error, the
+	// operation fails with it instead of the error of the command, if any.
+	// Replies are inspected after they are published to the command monitor.
+	Reply func(context.Context, InterceptedReply) error
+}
@@ -6,18 +6,22 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+import (
+	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+)
 
 // CountOptions represents arguments that can be used to configure a
 // CountDocuments operation.
 //
 // See corresponding setter methods for documentation.
 type CountOptions struct {
-	Collation *Collation
-	Comment   any
-	Hint      any
-	Limit     *int64
-	Skip      *int64
+	Collation   *Collation
+	Comment     any
+	Hint        any
+	Limit       *int64
+	Skip        *int64
+	ReadConcern *readconcern.ReadConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -67,6 +71,21 @@ func (co *CountOptionsBuilder) SetComment(comment any) *CountOptionsBuilder {
 	return co
 }
 
+// SetReadConcern sets the value for the ReadConcern field. Specifies the read
+// concern for the operation, overriding the read concern of the collection. It
+// must not be set for operations in a transaction, which use the read concern
+// of the transaction. The default value is nil, which means the read concern of
+// the collection is used.
+func (co *CountOptionsBuilder) SetReadConcern(rc *readconcern.ReadConcern) *CountOptionsBuilder {
+	co.Opts = append(co.Opts, func(opts *CountOptions) error {
+		opts.ReadConcern = rc
+
+		return nil
+	})
+
+	return co
+}
+
 // SetHint sets the value for the Hint field. Specifies the index to use for the aggregation. This should
 // either be the index name as a string or the index specification as a document. The driver will return
 // an error if the hint parameter is a multi-key map. The default value is nil, which means that no hint
@@ -49,6 +49,14 @@ func (d *DefaultIndexOptionsBuilder) SetStorageEngine(storageEngine any) *Defaul
 	return d
 }
 
+// These are the granularities of time-series data accepted by SetGranularity
+// of TimeSeriesOptionsBuilder and ModifyTimeSeriesOptionsBuilder.
+const (
+	TimeSeriesGranularitySeconds = "seconds"
+	TimeSeriesGranularityMinutes = "minutes"
+	TimeSeriesGranularityHours   = "hours"
+)
+
 // TimeSeriesOptions specifies arguments on a time-series collection.
 //
 // See corresponding setter methods for documentation.
@@ -104,7 +112,8 @@ func (tso *TimeSeriesOptionsBuilder) SetMetaField(metaField string) *TimeSeriesO
 }
 
 // SetGranularity sets the value for Granularity. Granularity is the granularity of time-series data.
-// Allowed granularity options are "seconds", "minutes" and "hours". This field is optional.
+// Allowed granularity options are TimeSeriesGranularitySeconds, TimeSeriesGranularityMinutes, and
+// TimeSeriesGranularityHours. This field is optional.
 func (tso *TimeSeriesOptionsBuilder) SetGranularity(granularity string) *TimeSeriesOptionsBuilder {
 	tso.Opts = append(tso.Opts, func(opts *TimeSeriesOptions) error {
 		opts.Granularity = &granularity
@@ -117,7 +126,7 @@ func (tso *TimeSeriesOptionsBuilder) SetGranularity(granularity string) *TimeSer
 
 // SetBucketMaxSpan sets the value for BucketMaxSpan. BucketMaxSpan is the maximum range of time
 // values for a bucket. The time.Duration is rounded down to the nearest second and applied as
-// the command option: "bucketRoundingSeconds". This field is optional.
+// the command option: "bucketMaxSpanSeconds". This field is optional.
 func (tso *TimeSeriesOptionsBuilder) SetBucketMaxSpan(dur time.Duration) *TimeSeriesOptionsBuilder {
 	tso.Opts = append(tso.Opts, func(opts *TimeSeriesOptions) error {
 		opts.BucketMaxSpan = &dur
@@ -128,6 +137,21 @@ func (tso *TimeSeriesOptionsBuilder) SetBucketMaxSpan(dur time.Duration) *TimeSe
 	return tso
 }
 
+// SetBucketSpan sets both BucketMaxSpan and BucketRounding to dur, which the
+// server requires to be equal. The time.Duration is rounded down to the nearest
+// second. It must not be set together with Granularity. This field is
+// optional.
+func (tso *TimeSeriesOptionsBuilder) SetBucketSpan(dur time.Duration) *TimeSeriesOptionsBuilder {
+	tso.Opts = append(tso.Opts, func(opts *TimeSeriesOptions) error {
+		opts.BucketMaxSpan = &dur
+		opts.BucketRounding = &dur
+
+		return nil
+	})
+
+	return tso
+}
+
 // SetBucketRounding sets the value for BucketRounding. BucketRounding is used to determine the
 // minimum time boundary when opening a new bucket by rounding the first timestamp down to the next
 // multiple of this value. The time.Duration is rounded down to the nearest second and applied as
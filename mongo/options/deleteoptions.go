@@ -6,17 +6,21 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+import (
+	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+)
 
 // DeleteOneOptions represents arguments that can be used to configure DeleteOne
 // operations.
 //
 // See corresponding setter methods for documentation.
 type DeleteOneOptions struct {
-	Collation *Collation
-	Comment   any
-	Hint      any
-	Let       any
+	Collation    *Collation
+	Comment      any
+	Hint         any
+	Let          any
+	WriteConcern *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -66,6 +70,21 @@ func (do *DeleteOneOptionsBuilder) SetComment(comment any) *DeleteOneOptionsBuil
 	return do
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (do *DeleteOneOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *DeleteOneOptionsBuilder {
+	do.Opts = append(do.Opts, func(opts *DeleteOneOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return do
+}
+
 // SetHint sets the value for the Hint field. Specifies the index to use for the
 // operation. This should either be the index name as a string or the index
 // specification as a document. This option is only valid for MongoDB versions
@@ -104,10 +123,11 @@ func (do *DeleteOneOptionsBuilder) SetLet(let any) *DeleteOneOptionsBuilder {
 //
 // See corresponding setter methods for documentation.
 type DeleteManyOptions struct {
-	Collation *Collation
-	Comment   any
-	Hint      any
-	Let       any
+	Collation    *Collation
+	Comment      any
+	Hint         any
+	Let          any
+	WriteConcern *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -157,6 +177,21 @@ func (do *DeleteManyOptionsBuilder) SetComment(comment any) *DeleteManyOptionsBu
 	return do
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (do *DeleteManyOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *DeleteManyOptionsBuilder {
+	do.Opts = append(do.Opts, func(opts *DeleteManyOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return do
+}
+
 // SetHint sets the value for the Hint field. Specifies the index to use for the
 // operation. This should either be the index name as a string or the index
 // specification as a document. This option is only valid for MongoDB versions
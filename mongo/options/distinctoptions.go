@@ -6,16 +6,20 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+import (
+	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+)
 
 // DistinctOptions represents arguments that can be used to configure a Distinct
 // operation.
 //
 // See corresponding setter methods for documentation.
 type DistinctOptions struct {
-	Collation *Collation
-	Comment   any
-	Hint      any
+	Collation   *Collation
+	Comment     any
+	Hint        any
+	ReadConcern *readconcern.ReadConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -66,6 +70,21 @@ func (do *DistinctOptionsBuilder) SetComment(comment any) *DistinctOptionsBuilde
 	return do
 }
 
+// SetReadConcern sets the value for the ReadConcern field. Specifies the read
+// concern for the operation, overriding the read concern of the collection. It
+// must not be set for operations in a transaction, which use the read concern
+// of the transaction. The default value is nil, which means the read concern of
+// the collection is used.
+func (do *DistinctOptionsBuilder) SetReadConcern(rc *readconcern.ReadConcern) *DistinctOptionsBuilder {
+	do.Opts = append(do.Opts, func(opts *DistinctOptions) error {
+		opts.ReadConcern = rc
+
+		return nil
+	})
+
+	return do
+}
+
 // SetHint specifies the index to use for the operation. This should either be
 // the index name as a string or the index specification as a document. This
 // option is only valid for MongoDB versions >= 7.1. Previous server versions
@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
@@ -14,6 +16,7 @@ import (
 // QueryType is used for Queryable Encryption.
 const (
 	QueryTypeEquality string = "equality"
+	QueryTypeRange    string = "range"
 )
 
 // RangeOptions specifies index options for a Queryable Encryption field supporting "range" queries.
@@ -99,6 +102,26 @@ func (ro *RangeOptionsBuilder) SetPrecision(precision int32) *RangeOptionsBuilde
 	return ro
 }
 
+// RangeValue is the set of Go types that can be used as the bounds of a
+// Queryable Encryption "range" index. They correspond to the BSON types "int",
+// "long", "double", "decimal", and "date".
+type RangeValue interface {
+	int32 | int64 | float64 | bson.Decimal128 | bson.DateTime | time.Time
+}
+
+// RangeBounds creates a new RangeOptions instance with the range index minimum
+// and maximum set to min and max. Unlike SetMin and SetMax, both bounds are
+// guaranteed to have the same BSON type.
+func RangeBounds[T RangeValue](min, max T) *RangeOptionsBuilder {
+	return Range().SetMin(rangeRawValue(min)).SetMax(rangeRawValue(max))
+}
+
+func rangeRawValue[T RangeValue](v T) bson.RawValue {
+	// Marshaling cannot fail for any of the types allowed by RangeValue.
+	t, data, _ := bson.MarshalValue(v)
+	return bson.RawValue{Type: t, Value: data}
+}
+
 // EncryptOptions represents arguments to explicitly encrypt a value.
 //
 // See corresponding setter methods for documentation.
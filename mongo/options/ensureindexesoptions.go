@@ -0,0 +1,63 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+// EnsureIndexesOptions represents arguments that can be used to configure a
+// Client.EnsureIndexesForAll operation.
+//
+// See corresponding setter methods for documentation.
+type EnsureIndexesOptions struct {
+	Concurrency  *int
+	CommitQuorum any
+}
+
+// EnsureIndexesOptionsBuilder contains options to configure
+// Client.EnsureIndexesForAll operations. Each option can be set through setter
+// functions. See documentation for each setter function for an explanation of
+// the option.
+type EnsureIndexesOptionsBuilder struct {
+	Opts []func(*EnsureIndexesOptions) error
+}
+
+// EnsureIndexes creates a new EnsureIndexesOptions instance.
+func EnsureIndexes() *EnsureIndexesOptionsBuilder {
+	return &EnsureIndexesOptionsBuilder{}
+}
+
+// List returns a list of EnsureIndexesOptions setter functions.
+func (e *EnsureIndexesOptionsBuilder) List() []func(*EnsureIndexesOptions) error {
+	return e.Opts
+}
+
+// SetConcurrency sets the value for the Concurrency field. Specifies the
+// maximum number of namespaces whose indexes are created at the same time. It
+// must be at least 1. The default value is 8.
+func (e *EnsureIndexesOptionsBuilder) SetConcurrency(n int) *EnsureIndexesOptionsBuilder {
+	e.Opts = append(e.Opts, func(opts *EnsureIndexesOptions) error {
+		opts.Concurrency = &n
+
+		return nil
+	})
+
+	return e
+}
+
+// SetCommitQuorum sets the value for the CommitQuorum field. Specifies the
+// commit quorum of the createIndexes command run for each namespace: an int32
+// number of data-bearing voting members, "majority", "votingMembers", or the
+// name of a replica set tag. See CreateIndexesOptionsBuilder.SetCommitQuorumInt
+// for more information. The default value is nil, which means that the server
+// default is used.
+func (e *EnsureIndexesOptionsBuilder) SetCommitQuorum(quorum any) *EnsureIndexesOptionsBuilder {
+	e.Opts = append(e.Opts, func(opts *EnsureIndexesOptions) error {
+		opts.CommitQuorum = quorum
+
+		return nil
+	})
+
+	return e
+}
@@ -0,0 +1,151 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidOption is matched by every error returned when options fail
+// validation, so configuration layers can tell invalid configuration apart
+// from other errors with errors.Is. The error can be inspected further with
+// errors.As and the InvalidValueError, ConflictingOptionsError, and
+// MissingOptionError types.
+var ErrInvalidOption = errors.New("invalid option")
+
+// InvalidValueError is returned when an option is set to a value that is not
+// allowed.
+type InvalidValueError struct {
+	// Option is the name of the option, e.g. "heartbeatFrequencyMS".
+	Option string
+
+	// Value is the value the option was set to.
+	Value any
+
+	// Min and Max are the smallest and largest allowed values, or nil if the
+	// allowed values are not bounded.
+	Min any
+	Max any
+
+	// Allowed is the list of allowed values, or nil if the option does not
+	// have a fixed set of values.
+	Allowed []any
+
+	// Message describes the error. If empty, the message is generated from
+	// the other fields.
+	Message string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+// Error implements the error interface.
+func (e InvalidValueError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid value %v for option %q", e.Value, e.Option)
+	switch {
+	case e.Allowed != nil:
+		fmt.Fprintf(&b, ": must be one of %v", e.Allowed)
+	case e.Min != nil && e.Max != nil:
+		fmt.Fprintf(&b, ": must be between %v and %v", e.Min, e.Max)
+	case e.Min != nil:
+		fmt.Fprintf(&b, ": must be at least %v", e.Min)
+	case e.Max != nil:
+		fmt.Fprintf(&b, ": must be at most %v", e.Max)
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, ": %v", e.Err)
+	}
+	return b.String()
+}
+
+// Is returns true if target is ErrInvalidOption.
+func (e InvalidValueError) Is(target error) bool {
+	return target == ErrInvalidOption
+}
+
+// Unwrap returns the underlying error.
+func (e InvalidValueError) Unwrap() error {
+	return e.Err
+}
+
+// ConflictingOptionsError is returned when options that cannot be used
+// together are set.
+type ConflictingOptionsError struct {
+	// Options are the names of the conflicting options.
+	Options []string
+
+	// Message describes the error. If empty, the message is generated from
+	// Options.
+	Message string
+
+	// Err is the underlying error, if any, such as one of the connstring
+	// sentinel errors.
+	Err error
+}
+
+// Error implements the error interface.
+func (e ConflictingOptionsError) Error() string {
+	switch {
+	case e.Message != "":
+		return e.Message
+	case e.Err != nil:
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("options %s cannot be set together", quoteOptions(e.Options))
+}
+
+// Is returns true if target is ErrInvalidOption.
+func (e ConflictingOptionsError) Is(target error) bool {
+	return target == ErrInvalidOption
+}
+
+// Unwrap returns the underlying error.
+func (e ConflictingOptionsError) Unwrap() error {
+	return e.Err
+}
+
+// MissingOptionError is returned when a required option is not set.
+type MissingOptionError struct {
+	// Options are the names of the options of which at least one must be
+	// set.
+	Options []string
+
+	// Message describes the error. If empty, the message is generated from
+	// Options.
+	Message string
+}
+
+// Error implements the error interface.
+func (e MissingOptionError) Error() string {
+	switch {
+	case e.Message != "":
+		return e.Message
+	case len(e.Options) == 1:
+		return fmt.Sprintf("option %q is required", e.Options[0])
+	}
+	return fmt.Sprintf("one of options %s is required", quoteOptions(e.Options))
+}
+
+// Is returns true if target is ErrInvalidOption.
+func (e MissingOptionError) Is(target error) bool {
+	return target == ErrInvalidOption
+}
+
+func quoteOptions(options []string) string {
+	quoted := make([]string, len(options))
+	for i, opt := range options {
+		quoted[i] = fmt.Sprintf("%q", opt)
+	}
+	return strings.Join(quoted, ", ")
+}
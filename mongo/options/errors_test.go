@@ -0,0 +1,96 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/connstring"
+)
+
+func TestValidationErrors(t *testing.T) {
+	t.Run("messages", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			err  error
+			want string
+		}{
+			{
+				"value with min",
+				InvalidValueError{Option: "maxRetries", Value: 0, Min: 1},
+				`invalid value 0 for option "maxRetries": must be at least 1`,
+			},
+			{
+				"value with range",
+				InvalidValueError{Option: "zlibLevel", Value: 12, Min: -1, Max: 9},
+				`invalid value 12 for option "zlibLevel": must be between -1 and 9`,
+			},
+			{
+				"value with allowed values",
+				InvalidValueError{Option: "mode", Value: "x", Allowed: []any{"a", "b"}},
+				`invalid value x for option "mode": must be one of [a b]`,
+			},
+			{
+				"conflicting options",
+				ConflictingOptionsError{Options: []string{"a", "b"}},
+				`options "a", "b" cannot be set together`,
+			},
+			{
+				"missing option",
+				MissingOptionError{Options: []string{"a"}},
+				`option "a" is required`,
+			},
+			{
+				"missing one of options",
+				MissingOptionError{Options: []string{"a", "b"}},
+				`one of options "a", "b" is required`,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.EqualError(t, tc.err, tc.want)
+				assert.ErrorIs(t, tc.err, ErrInvalidOption)
+			})
+		}
+	})
+	t.Run("invalid value", func(t *testing.T) {
+		err := Client().SetHeartbeatInterval(10 * time.Millisecond).Validate()
+
+		var valueErr InvalidValueError
+		require.True(t, errors.As(err, &valueErr), "expected InvalidValueError, got %v", err)
+		assert.Equal(t, "heartbeatFrequencyMS", valueErr.Option)
+		assert.Equal(t, 10*time.Millisecond, valueErr.Value)
+		assert.Equal(t, 500*time.Millisecond, valueErr.Min)
+	})
+	t.Run("allowed values", func(t *testing.T) {
+		err := Client().SetServerMonitoringMode("invalid").Validate()
+
+		var valueErr InvalidValueError
+		require.True(t, errors.As(err, &valueErr), "expected InvalidValueError, got %v", err)
+		assert.Equal(t, "serverMonitoringMode", valueErr.Option)
+		assert.Equal(t, []any{ServerMonitoringModeAuto, ServerMonitoringModePoll, ServerMonitoringModeStream}, valueErr.Allowed)
+	})
+	t.Run("conflicting options", func(t *testing.T) {
+		err := Client().SetHosts([]string{"foo", "bar"}).SetLoadBalanced(true).Validate()
+
+		var conflictErr ConflictingOptionsError
+		require.True(t, errors.As(err, &conflictErr), "expected ConflictingOptionsError, got %v", err)
+		assert.Equal(t, []string{"loadBalanced", "hosts"}, conflictErr.Options)
+		assert.ErrorIs(t, err, connstring.ErrLoadBalancedWithMultipleHosts)
+	})
+	t.Run("missing option", func(t *testing.T) {
+		err := Client().SetAuth(Credential{AuthMechanism: "MONGODB-OIDC"}).Validate()
+
+		var missingErr MissingOptionError
+		require.True(t, errors.As(err, &missingErr), "expected MissingOptionError, got %v", err)
+		assert.Equal(t, []string{"OIDCMachineCallback", "OIDCHumanCallback", "ENVIRONMENT"}, missingErr.Options)
+	})
+}
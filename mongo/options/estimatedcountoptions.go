@@ -6,14 +6,18 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+import (
+	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+)
 
 // EstimatedDocumentCountOptions represents arguments that can be used to configure
 // an EstimatedDocumentCount operation.
 //
 // See corresponding setter methods for documentation.
 type EstimatedDocumentCountOptions struct {
-	Comment any
+	Comment     any
+	ReadConcern *readconcern.ReadConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -50,3 +54,18 @@ func (eco *EstimatedDocumentCountOptionsBuilder) SetComment(comment any) *Estima
 
 	return eco
 }
+
+// SetReadConcern sets the value for the ReadConcern field. Specifies the read
+// concern for the operation, overriding the read concern of the collection. It
+// must not be set for operations in a transaction, which use the read concern
+// of the transaction. The default value is nil, which means the read concern of
+// the collection is used.
+func (eco *EstimatedDocumentCountOptionsBuilder) SetReadConcern(rc *readconcern.ReadConcern) *EstimatedDocumentCountOptionsBuilder {
+	eco.Opts = append(eco.Opts, func(opts *EstimatedDocumentCountOptions) error {
+		opts.ReadConcern = rc
+
+		return nil
+	})
+
+	return eco
+}
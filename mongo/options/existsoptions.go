@@ -0,0 +1,75 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+// ExistsOptions represents arguments that can be used to configure an Exists
+// operation.
+//
+// See corresponding setter methods for documentation.
+type ExistsOptions struct {
+	Collation *Collation
+	Comment   any
+	Hint      any
+}
+
+// ExistsOptionsBuilder contains options to configure exists operations. Each
+// option can be set through setter functions. See documentation for each setter
+// function for an explanation of the option.
+type ExistsOptionsBuilder struct {
+	Opts []func(*ExistsOptions) error
+}
+
+// Exists creates a new ExistsOptions instance.
+func Exists() *ExistsOptionsBuilder {
+	return &ExistsOptionsBuilder{}
+}
+
+// List returns a list of ExistsOptions setter functions.
+func (eo *ExistsOptionsBuilder) List() []func(*ExistsOptions) error {
+	return eo.Opts
+}
+
+// SetCollation sets the value for the Collation field. Specifies a collation to
+// use for string comparisons during the operation. The default value is nil,
+// which means the default collation of the collection will be used.
+func (eo *ExistsOptionsBuilder) SetCollation(c *Collation) *ExistsOptionsBuilder {
+	eo.Opts = append(eo.Opts, func(opts *ExistsOptions) error {
+		opts.Collation = c
+
+		return nil
+	})
+
+	return eo
+}
+
+// SetComment sets the value for the Comment field. Specifies a string or document that will be included
+// in server logs, profiling logs, and currentOp queries to help trace the operation. The default is nil,
+// which means that no comment will be included in the logs.
+func (eo *ExistsOptionsBuilder) SetComment(comment any) *ExistsOptionsBuilder {
+	eo.Opts = append(eo.Opts, func(opts *ExistsOptions) error {
+		opts.Comment = comment
+
+		return nil
+	})
+
+	return eo
+}
+
+// SetHint sets the value for the Hint field. Specifies the index to use for the operation. This should
+// either be the index name as a string or the index specification as a document. If the filter only
+// refers to fields of the hinted index, the server can answer the query from the index without reading
+// any documents. The driver will return an error if the hint parameter is a multi-key map. The default
+// value is nil, which means that no hint will be sent.
+func (eo *ExistsOptionsBuilder) SetHint(h any) *ExistsOptionsBuilder {
+	eo.Opts = append(eo.Opts, func(opts *ExistsOptions) error {
+		opts.Hint = h
+
+		return nil
+	})
+
+	return eo
+}
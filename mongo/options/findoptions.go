@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 )
 
 // FindOptions represents arguments that can be used to configure a Find
@@ -37,6 +39,11 @@ type FindOptions struct {
 	Let             any
 	Limit           *int64
 	NoCursorTimeout *bool
+	TimeoutMode     *TimeoutMode
+	Heartbeat       func(AwaitHeartbeat)
+	CurrentLifetime *CurrentLifetime
+	Exhaust         *bool
+	ReadConcern     *readconcern.ReadConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -115,6 +122,21 @@ func (f *FindOptionsBuilder) SetComment(comment any) *FindOptionsBuilder {
 	return f
 }
 
+// SetReadConcern sets the value for the ReadConcern field. Specifies the read
+// concern for the operation, overriding the read concern of the collection. It
+// must not be set for operations in a transaction, which use the read concern
+// of the transaction. The default value is nil, which means the read concern of
+// the collection is used.
+func (f *FindOptionsBuilder) SetReadConcern(rc *readconcern.ReadConcern) *FindOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOptions) error {
+		opts.ReadConcern = rc
+
+		return nil
+	})
+
+	return f
+}
+
 // SetCursorType sets the value for the CursorType field. CursorType specifies the type of cursor
 // that should be created for the operation. The default is NonTailable, which means that the
 // cursor will be closed by the server when the last batch of documents is retrieved.
@@ -186,6 +208,42 @@ func (f *FindOptionsBuilder) SetMaxAwaitTime(d time.Duration) *FindOptionsBuilde
 	return f
 }
 
+// SetTimeoutMode sets the value for the TimeoutMode field. TimeoutMode specifies how the client-level timeout or
+// context deadline applies to the getMore commands run by the returned cursor. The default is TimeoutModeFirstBatch.
+// If this is set, timeout errors returned by Find and the cursor are wrapped in a mongo.CursorTimeoutError that reports
+// the mode.
+func (f *FindOptionsBuilder) SetTimeoutMode(mode TimeoutMode) *FindOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOptions) error {
+		opts.TimeoutMode = &mode
+		return nil
+	})
+	return f
+}
+
+// SetHeartbeat sets the value for the Heartbeat field. Heartbeat is called each time a getMore on the returned cursor
+// returns an empty batch while the cursor is still alive, which allows applications to distinguish a tailable cursor
+// that has no new documents from one that is stuck. The callback is run synchronously by Next and TryNext and must not
+// use the cursor. This option is only useful for tailable await cursors.
+func (f *FindOptionsBuilder) SetHeartbeat(fn func(AwaitHeartbeat)) *FindOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOptions) error {
+		opts.Heartbeat = fn
+		return nil
+	})
+	return f
+}
+
+// SetCurrentLifetime sets the value for the CurrentLifetime field. CurrentLifetime specifies how long the Current
+// field of the returned cursor remains valid. The default is CurrentUntilNext, which requires callers to copy
+// documents they retain after advancing the cursor, such as with Cursor.CurrentCopy. CurrentUntilClose copies each
+// batch instead so that documents can be retained without copying.
+func (f *FindOptionsBuilder) SetCurrentLifetime(lifetime CurrentLifetime) *FindOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOptions) error {
+		opts.CurrentLifetime = &lifetime
+		return nil
+	})
+	return f
+}
+
 // SetMin sets the value for the Min field. Min is a document specifying the inclusive lower bound
 // for a specific index. The default value is 0, which means that there is no minimum value.
 func (f *FindOptionsBuilder) SetMin(min any) *FindOptionsBuilder {
@@ -196,6 +254,24 @@ func (f *FindOptionsBuilder) SetMin(min any) *FindOptionsBuilder {
 	return f
 }
 
+// SetExhaust sets the value for the Exhaust field. If true, the returned cursor is an exhaust cursor: after the first
+// getMore, the server streams the remaining batches over the same connection without waiting for further getMore
+// commands, which reduces round trips when iterating large result sets. The default value is false.
+//
+// An exhaust cursor pins a connection. The connection used for the first getMore is checked out of the pool and is not
+// available to other operations until the cursor is exhausted or closed. Closing the cursor before it is exhausted, or
+// an error while reading a streamed batch, closes the pinned connection because the responses the server has already
+// sent cannot be discarded. Streamed batches are read without sending a getMore command, so command monitoring only
+// reports the first getMore. Servers that do not support exhaust cursors, such as mongos, return batches in response to
+// each getMore as usual.
+func (f *FindOptionsBuilder) SetExhaust(b bool) *FindOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOptions) error {
+		opts.Exhaust = &b
+		return nil
+	})
+	return f
+}
+
 // SetNoCursorTimeout sets the value for the NoCursorTimeout field. NoCursorTimeout specifies
 // whether the cursor created by the operation will not timeout after a period of inactivity.
 // The default value is false.
@@ -291,6 +367,7 @@ type FindOneOptions struct {
 	ShowRecordID        *bool
 	Skip                *int64
 	Sort                any
+	ReadConcern         *readconcern.ReadConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -346,6 +423,21 @@ func (f *FindOneOptionsBuilder) SetComment(comment any) *FindOneOptionsBuilder {
 	return f
 }
 
+// SetReadConcern sets the value for the ReadConcern field. Specifies the read
+// concern for the operation, overriding the read concern of the collection. It
+// must not be set for operations in a transaction, which use the read concern
+// of the transaction. The default value is nil, which means the read concern of
+// the collection is used.
+func (f *FindOneOptionsBuilder) SetReadConcern(rc *readconcern.ReadConcern) *FindOneOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOneOptions) error {
+		opts.ReadConcern = rc
+
+		return nil
+	})
+
+	return f
+}
+
 // SetHint sets the value for the Hint field. Specifies the index to use for the aggregation.
 // This should either be the index name as a string or the index specification as a document.
 // The driver will return an error if the hint parameter is a multi-key map. The default value
@@ -460,6 +552,7 @@ type FindOneAndReplaceOptions struct {
 	Upsert                   *bool
 	Hint                     any
 	Let                      any
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -523,6 +616,21 @@ func (f *FindOneAndReplaceOptionsBuilder) SetComment(comment any) *FindOneAndRep
 	return f
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (f *FindOneAndReplaceOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *FindOneAndReplaceOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOneAndReplaceOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return f
+}
+
 // SetProjection sets the value for the Projection field. Sets a document describing which fields
 // will be included in the document returned by the operation. The default value is nil, which
 // means all fields will be included.
@@ -625,6 +733,7 @@ type FindOneAndUpdateOptions struct {
 	Upsert                   *bool
 	Hint                     any
 	Let                      any
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -702,6 +811,21 @@ func (f *FindOneAndUpdateOptionsBuilder) SetComment(comment any) *FindOneAndUpda
 	return f
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (f *FindOneAndUpdateOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *FindOneAndUpdateOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOneAndUpdateOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return f
+}
+
 // SetProjection sets the value for the Projection field. Sets a document describing which fields
 // will be included in the document returned by the operation. The default value is nil, which
 // means all fields will be included.
@@ -794,12 +918,13 @@ func (f *FindOneAndUpdateOptionsBuilder) SetLet(let any) *FindOneAndUpdateOption
 //
 // See corresponding setter methods for documentation.
 type FindOneAndDeleteOptions struct {
-	Collation  *Collation
-	Comment    any
-	Projection any
-	Sort       any
-	Hint       any
-	Let        any
+	Collation    *Collation
+	Comment      any
+	Projection   any
+	Sort         any
+	Hint         any
+	Let          any
+	WriteConcern *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -849,6 +974,21 @@ func (f *FindOneAndDeleteOptionsBuilder) SetComment(comment any) *FindOneAndDele
 	return f
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (f *FindOneAndDeleteOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *FindOneAndDeleteOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOneAndDeleteOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return f
+}
+
 // SetProjection sets the value for the Projection field. Sets a document describing which fields
 // will be included in the document returned by the operation. The default value is nil, which
 // means all fields will be included.
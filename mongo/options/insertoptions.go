@@ -6,7 +6,10 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+import (
+	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+)
 
 // InsertOneOptions represents arguments that can be used to configure an InsertOne
 // operation.
@@ -15,6 +18,7 @@ import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
 type InsertOneOptions struct {
 	BypassDocumentValidation *bool
 	Comment                  any
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -59,6 +63,21 @@ func (ioo *InsertOneOptionsBuilder) SetComment(comment any) *InsertOneOptionsBui
 	return ioo
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (ioo *InsertOneOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *InsertOneOptionsBuilder {
+	ioo.Opts = append(ioo.Opts, func(opts *InsertOneOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return ioo
+}
+
 // InsertManyOptions represents arguments that can be used to configure an
 // InsertMany operation.
 //
@@ -67,6 +86,7 @@ type InsertManyOptions struct {
 	BypassDocumentValidation *bool
 	Comment                  any
 	Ordered                  *bool
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -120,6 +140,21 @@ func (imo *InsertManyOptionsBuilder) SetComment(comment any) *InsertManyOptionsB
 	return imo
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (imo *InsertManyOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *InsertManyOptionsBuilder {
+	imo.Opts = append(imo.Opts, func(opts *InsertManyOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return imo
+}
+
 // SetOrdered sets the value for the Ordered field. If true, no writes will be executed after
 // one fails. The default value is true.
 func (imo *InsertManyOptionsBuilder) SetOrdered(b bool) *InsertManyOptionsBuilder {
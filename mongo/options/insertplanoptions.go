@@ -0,0 +1,97 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+// InsertPlanOptions represents arguments that can be used to configure a
+// Collection.PlanInsertMany operation.
+//
+// See corresponding setter methods for documentation.
+type InsertPlanOptions struct {
+	Compressor        *string
+	MaxBatchCount     *int
+	MaxMessageSize    *int
+	MaxCompressedSize *int
+}
+
+// InsertPlanOptionsBuilder contains options to configure
+// Collection.PlanInsertMany operations. Each option can be set through setter
+// functions. See documentation for each setter function for an explanation of
+// the option.
+type InsertPlanOptionsBuilder struct {
+	Opts []func(*InsertPlanOptions) error
+}
+
+// InsertPlan creates a new InsertPlanOptions instance.
+func InsertPlan() *InsertPlanOptionsBuilder {
+	return &InsertPlanOptionsBuilder{}
+}
+
+// List returns a list of InsertPlanOptions setter functions.
+func (i *InsertPlanOptionsBuilder) List() []func(*InsertPlanOptions) error {
+	return i.Opts
+}
+
+// SetCompressor sets the value for the Compressor field. Specifies the
+// compressor used to estimate the compressed size of each batch: "snappy",
+// "zlib", or "zstd". It should be the compressor that the Client negotiates
+// with the server, which is the first compressor set with
+// ClientOptions.SetCompressors that the server supports. zlib and zstd use
+// their default compression levels. The default value is nil, which means that
+// the batches are not compressed.
+func (i *InsertPlanOptionsBuilder) SetCompressor(compressor string) *InsertPlanOptionsBuilder {
+	i.Opts = append(i.Opts, func(opts *InsertPlanOptions) error {
+		opts.Compressor = &compressor
+
+		return nil
+	})
+
+	return i
+}
+
+// SetMaxBatchCount sets the value for the MaxBatchCount field. Specifies the
+// maximum number of documents in a batch, which is reported by the server as
+// maxWriteBatchSize. It must be at least 1. The default value is 100,000.
+func (i *InsertPlanOptionsBuilder) SetMaxBatchCount(n int) *InsertPlanOptionsBuilder {
+	i.Opts = append(i.Opts, func(opts *InsertPlanOptions) error {
+		opts.MaxBatchCount = &n
+
+		return nil
+	})
+
+	return i
+}
+
+// SetMaxMessageSize sets the value for the MaxMessageSize field. Specifies the
+// maximum total size in bytes of the uncompressed documents of a batch, which
+// is reported by the server as maxMessageSizeBytes. The server applies this
+// limit to messages after decompressing them, so it is never exceeded,
+// regardless of the compressor. It must be at least 1. The default value is
+// 48,000,000.
+func (i *InsertPlanOptionsBuilder) SetMaxMessageSize(n int) *InsertPlanOptionsBuilder {
+	i.Opts = append(i.Opts, func(opts *InsertPlanOptions) error {
+		opts.MaxMessageSize = &n
+
+		return nil
+	})
+
+	return i
+}
+
+// SetMaxCompressedSize sets the value for the MaxCompressedSize field.
+// Specifies the maximum estimated size in bytes of the documents of a batch
+// after compression, for example to bound the amount of data sent over a slow
+// network in one message. It must be at least 1. The default value is
+// MaxMessageSize.
+func (i *InsertPlanOptionsBuilder) SetMaxCompressedSize(n int) *InsertPlanOptionsBuilder {
+	i.Opts = append(i.Opts, func(opts *InsertPlanOptions) error {
+		opts.MaxCompressedSize = &n
+
+		return nil
+	})
+
+	return i
+}
@@ -0,0 +1,41 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import "context"
+
+// KMSProvider is implemented by key management services that are not
+// supported natively for In-Use Encryption, such as the transit secrets engine
+// of HashiCorp Vault.
+//
+// libmongocrypt can only encrypt data keys with the built-in KMS providers, so
+// a custom KMS provider is used for envelope encryption of a local master key:
+// the application stores the master key only in wrapped form, as returned by
+// mongo.NewWrappedLocalMasterKey, and the driver calls Decrypt to unwrap it when
+// a Client or ClientEncryption is created. The unwrapped key is registered as
+// a named local KMS provider (see CustomKMSProvider) and is never persisted by
+// the driver.
+type KMSProvider interface {
+	// Encrypt encrypts plaintext and returns the ciphertext.
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+
+	// Decrypt decrypts a ciphertext that was returned by Encrypt.
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// CustomKMSProvider configures a KMS provider that is backed by a KMSProvider
+// implementation. A custom provider registered with the name "vault" is used
+// by passing "local:vault" as the KMS provider name to
+// ClientEncryption.CreateDataKey and related methods.
+type CustomKMSProvider struct {
+	// Provider is used to unwrap WrappedKey. It is required.
+	Provider KMSProvider
+
+	// WrappedKey is a 96-byte local master key encrypted by Provider. It is
+	// required.
+	WrappedKey []byte
+}
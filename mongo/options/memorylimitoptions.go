@@ -0,0 +1,72 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import "time"
+
+// MemoryLimitOptions represents the accounting of the memory held by a Client
+// and a soft limit on it. The Client accounts for the wire message buffers of
+// the commands in flight, the documents of the insert batches waiting to be
+// sent, and the batches held by open cursors. When the accounted memory
+// reaches the soft limit, new operations are delayed until enough memory is
+// released or until the maximum wait expires, after which they run anyway.
+// Operations that are already running are never delayed, so the accounted
+// memory can exceed the limit.
+//
+// The accounted memory and the number of delayed operations are reported by
+// Client.MemoryStats.
+//
+// See corresponding setter methods for documentation.
+type MemoryLimitOptions struct {
+	SoftLimit *int64
+	MaxWait   *time.Duration
+}
+
+// MemoryLimit creates a new MemoryLimitOptions instance.
+func MemoryLimit() *MemoryLimitOptions {
+	return &MemoryLimitOptions{}
+}
+
+// SetSoftLimit specifies the number of bytes of accounted memory at which new
+// operations are delayed. It must be positive. The default is 0, which means
+// that memory is accounted but operations are never delayed.
+func (ml *MemoryLimitOptions) SetSoftLimit(bytes int64) *MemoryLimitOptions {
+	ml.SoftLimit = &bytes
+
+	return ml
+}
+
+// SetMaxWait specifies the maximum time that a new operation is delayed while
+// the accounted memory is at or above the soft limit. It must not be negative.
+// An operation is delayed for less time if its context is done first, in which
+// case it fails with the error of the context. The default is 1 second. If it
+// is 0, operations are not delayed and are only counted as exceeding the limit.
+func (ml *MemoryLimitOptions) SetMaxWait(d time.Duration) *MemoryLimitOptions {
+	ml.MaxWait = &d
+
+	return ml
+}
+
+// Validate returns an error if the memory limit options are invalid.
+func (ml *MemoryLimitOptions) Validate() error {
+	if ml.SoftLimit != nil && *ml.SoftLimit <= 0 {
+		return InvalidValueError{
+			Option:  "SoftLimit",
+			Value:   *ml.SoftLimit,
+			Message: "memory soft limit must be positive",
+		}
+	}
+	if ml.MaxWait != nil && *ml.MaxWait < 0 {
+		return InvalidValueError{
+			Option:  "MaxWait",
+			Value:   *ml.MaxWait,
+			Min:     time.Duration(0),
+			Message: "memory limit max wait must not be negative",
+		}
+	}
+	return nil
+}
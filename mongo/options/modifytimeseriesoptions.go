@@ -0,0 +1,83 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import "time"
+
+// ModifyTimeSeriesOptions represents arguments that can be used to configure a
+// ModifyTimeSeries operation.
+//
+// See corresponding setter methods for documentation.
+type ModifyTimeSeriesOptions struct {
+	Granularity *string
+	BucketSpan  *time.Duration
+	ExpireAfter *time.Duration
+}
+
+// ModifyTimeSeriesOptionsBuilder contains options to modify a time-series
+// collection. Each option can be set through setter functions. See
+// documentation for each setter function for an explanation of the option.
+type ModifyTimeSeriesOptionsBuilder struct {
+	Opts []func(*ModifyTimeSeriesOptions) error
+}
+
+// ModifyTimeSeries creates a new ModifyTimeSeriesOptions instance.
+func ModifyTimeSeries() *ModifyTimeSeriesOptionsBuilder {
+	return &ModifyTimeSeriesOptionsBuilder{}
+}
+
+// List returns a list of ModifyTimeSeriesOptions setter functions.
+func (m *ModifyTimeSeriesOptionsBuilder) List() []func(*ModifyTimeSeriesOptions) error {
+	return m.Opts
+}
+
+// SetGranularity sets the value for the Granularity field. Granularity is the
+// new granularity of the time-series data, which must be coarser than the
+// current granularity. Allowed values are TimeSeriesGranularitySeconds,
+// TimeSeriesGranularityMinutes, and TimeSeriesGranularityHours. It must not be
+// set together with BucketSpan.
+func (m *ModifyTimeSeriesOptionsBuilder) SetGranularity(granularity string) *ModifyTimeSeriesOptionsBuilder {
+	m.Opts = append(m.Opts, func(opts *ModifyTimeSeriesOptions) error {
+		opts.Granularity = &granularity
+
+		return nil
+	})
+
+	return m
+}
+
+// SetBucketSpan sets the value for the BucketSpan field. BucketSpan is the new
+// maximum range of time values for a bucket, which is also used to round the
+// start of new buckets. It must be at least one second and must not be less
+// than the current bucket span. The time.Duration is rounded down to the
+// nearest second and applied as the command options "bucketMaxSpanSeconds" and
+// "bucketRoundingSeconds". It must not be set together with Granularity.
+//
+// This option is only valid for MongoDB versions >= 6.3.
+func (m *ModifyTimeSeriesOptionsBuilder) SetBucketSpan(dur time.Duration) *ModifyTimeSeriesOptionsBuilder {
+	m.Opts = append(m.Opts, func(opts *ModifyTimeSeriesOptions) error {
+		opts.BucketSpan = &dur
+
+		return nil
+	})
+
+	return m
+}
+
+// SetExpireAfter sets the value for the ExpireAfter field. ExpireAfter is the
+// time after which documents are automatically deleted. The time.Duration is
+// rounded down to the nearest second and applied as the command option
+// "expireAfterSeconds".
+func (m *ModifyTimeSeriesOptionsBuilder) SetExpireAfter(dur time.Duration) *ModifyTimeSeriesOptionsBuilder {
+	m.Opts = append(m.Opts, func(opts *ModifyTimeSeriesOptions) error {
+		opts.ExpireAfter = &dur
+
+		return nil
+	})
+
+	return m
+}
@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
@@ -40,6 +42,71 @@ const (
 	TailableAwait
 )
 
+// TimeoutMode specifies how the client-level timeout, or the deadline of the
+// context passed to the operation that creates a cursor, applies to the
+// commands run by the cursor. See TimeoutModeFirstBatch, TimeoutModeIteration,
+// and TimeoutModeCursorLifetime.
+type TimeoutMode string
+
+const (
+	// TimeoutModeFirstBatch applies the timeout only to the command that
+	// creates the cursor and returns the first batch. Each subsequent getMore
+	// is bounded only by the context passed to Next or TryNext. This is the
+	// default.
+	TimeoutModeFirstBatch TimeoutMode = "firstBatch"
+
+	// TimeoutModeIteration applies the client-level timeout separately to the
+	// command that creates the cursor and to each getMore round trip that does
+	// not already have a deadline from the context passed to Next or TryNext.
+	TimeoutModeIteration TimeoutMode = "iteration"
+
+	// TimeoutModeCursorLifetime applies a single deadline, computed when the
+	// cursor is created, to the command that creates the cursor and to every
+	// subsequent getMore. Closing the cursor is not bounded by the deadline, so
+	// the server-side cursor can still be killed after it expires.
+	TimeoutModeCursorLifetime TimeoutMode = "cursorLifetime"
+)
+
+// CurrentLifetime specifies how long the Current field of a cursor remains
+// valid, which determines whether the cursor reads documents directly from the
+// buffer holding each batch or copies each batch. See CurrentUntilNext and
+// CurrentUntilClose.
+type CurrentLifetime string
+
+const (
+	// CurrentUntilNext makes Current borrow the buffer holding the current
+	// batch, so Current is only valid until the next call to Next, TryNext, or
+	// Close, after which the buffer may be reused. No copies are made, so
+	// callers that only read or decode each document before advancing the
+	// cursor should use it. This is the default.
+	CurrentUntilNext CurrentLifetime = "untilNext"
+
+	// CurrentUntilClose makes the cursor copy each batch into memory that is
+	// never reused, so every document assigned to Current, and any slice of it,
+	// remains valid after the cursor advances and after it is closed. This
+	// avoids copying each document separately when documents are retained,
+	// at the cost of copying batches that are not.
+	CurrentUntilClose CurrentLifetime = "untilClose"
+)
+
+// AwaitHeartbeat describes a getMore on a tailable awaitData cursor or change
+// stream that returned no documents. It is passed to the heartbeat callback set
+// with FindOptionsBuilder.SetHeartbeat or ChangeStreamOptionsBuilder.SetHeartbeat
+// and indicates that the server-side cursor is still alive but no new documents
+// were available before MaxAwaitTime elapsed.
+type AwaitHeartbeat struct {
+	// CursorID is the ID of the server-side cursor.
+	CursorID int64
+
+	// Time is the time at which the empty batch was received.
+	Time time.Time
+
+	// ResumeToken is the resume token of the change stream after the empty
+	// batch, which reflects the post-batch resume token returned by the server.
+	// It is nil for tailable cursors.
+	ResumeToken bson.Raw
+}
+
 // ReturnDocument specifies whether a findAndUpdate operation should return the document as it was
 // before the update or as it is after the update.
 type ReturnDocument int8
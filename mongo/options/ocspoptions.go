@@ -0,0 +1,52 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import "time"
+
+const (
+	// OCSPFailureModeSoft indicates that the client will allow a TLS connection
+	// to continue if the revocation status of the server's certificate cannot be
+	// determined, for example because no OCSP responder is reachable. A
+	// certificate that is known to be revoked always fails the connection. This
+	// is the default mode.
+	OCSPFailureModeSoft = "soft"
+
+	// OCSPFailureModeHard indicates that the client will fail a TLS connection
+	// if the revocation status of the server's certificate cannot be determined
+	// from a stapled, cached, or responder-provided OCSP response.
+	OCSPFailureModeHard = "hard"
+)
+
+// OCSPResponse is the result of an OCSP check stored in an OCSPCache.
+type OCSPResponse struct {
+	// Revoked is true if the certificate was reported as revoked, or false if
+	// it was reported as good.
+	Revoked bool
+
+	// NextUpdate is the time at which the response expires.
+	NextUpdate time.Time
+}
+
+// OCSPCache is a store for OCSP responses. Supplying an OCSPCache allows
+// responses to be shared between clients or processes, for example by backing
+// it with a shared file or an external key-value service, so that OCSP
+// responders are not contacted once per process.
+//
+// Keys are opaque strings that uniquely identify a certificate. The client
+// only stores responses with a NextUpdate time and ignores expired responses.
+// Implementations must be safe for concurrent use.
+type OCSPCache interface {
+	// Get returns the response stored for key, or false if there is none.
+	Get(key string) (OCSPResponse, bool)
+
+	// Put stores the response for key, replacing any existing response.
+	Put(key string, response OCSPResponse)
+
+	// Delete removes the response stored for key, if any.
+	Delete(key string)
+}
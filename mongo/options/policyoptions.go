@@ -0,0 +1,96 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+// PolicyOptions represents a policy that restricts the namespaces and the read
+// and write concerns a Client can use. Platform teams can use a policy to
+// enforce organization rules, such as requiring acknowledged writes or
+// keeping a shared library from accessing other tenants' data, in every
+// application that creates its Client with it.
+//
+// The policy is checked against the write concern of the Client when it is
+// created, and against the namespace and the read and write concerns that
+// apply to each operation, including those configured on a Database,
+// Collection, or transaction, before the operation is sent to the server.
+// Operations that violate the policy fail with an error that wraps
+// mongo.ErrPolicyViolation.
+//
+// See corresponding setter methods for documentation.
+type PolicyOptions struct {
+	RejectUnacknowledgedWrites bool
+	RejectUnjournaledWrites    bool
+	RejectLocalReadConcern     []string
+	AllowedNamespaces          []string
+	DeniedNamespaces           []string
+}
+
+// Policy creates a new PolicyOptions instance that does not restrict anything.
+func Policy() *PolicyOptions {
+	return &PolicyOptions{}
+}
+
+// SetRejectUnacknowledgedWrites specifies whether write concerns that do not
+// request acknowledgment (i.e. w: 0) are rejected.
+func (p *PolicyOptions) SetRejectUnacknowledgedWrites(b bool) *PolicyOptions {
+	p.RejectUnacknowledgedWrites = b
+
+	return p
+}
+
+// SetRejectUnjournaledWrites specifies whether write concerns that explicitly
+// set journal to false are rejected.
+func (p *PolicyOptions) SetRejectUnjournaledWrites(b bool) *PolicyOptions {
+	p.RejectUnjournaledWrites = b
+
+	return p
+}
+
+// SetRejectLocalReadConcern specifies the namespaces for which the "local"
+// read concern is rejected. A namespace is either a database name, which
+// matches every collection in the database, a full "database.collection"
+// name, or "*", which matches every namespace. Operations that do not specify
+// a read concern are not rejected.
+func (p *PolicyOptions) SetRejectLocalReadConcern(namespaces ...string) *PolicyOptions {
+	p.RejectLocalReadConcern = namespaces
+
+	return p
+}
+
+// SetAllowedNamespaces specifies glob patterns for the namespaces that
+// operations are allowed to access. If set, operations on other namespaces are
+// rejected. Patterns use the syntax of path.Match and are matched against the
+// "database.collection" namespace of an operation, e.g. "tenant1.*" allows
+// every collection in the "tenant1" database. Operations on a database, such
+// as Database.Drop or Database.ListCollections, are allowed if the database
+// part of any pattern matches the database name.
+//
+// The collections that an aggregation pipeline reads from or writes to with
+// $lookup, $graphLookup, $unionWith, $out, and $merge stages are checked as
+// well. Client-level operations, such as Client.ListDatabases and
+// Client.Watch, are not restricted. Commands run with Database.RunCommand are
+// checked against the collection named by the first element of the command,
+// if it is a string, or against the database otherwise. On the "admin"
+// database, commands that take a namespace, such as renameCollection and
+// shardCollection, are checked against it, known read commands such as ping
+// are not restricted, and other commands are rejected.
+func (p *PolicyOptions) SetAllowedNamespaces(patterns ...string) *PolicyOptions {
+	p.AllowedNamespaces = patterns
+
+	return p
+}
+
+// SetDeniedNamespaces specifies glob patterns for the namespaces that
+// operations are not allowed to access, using the same syntax as
+// SetAllowedNamespaces. Denied namespaces take precedence over allowed
+// namespaces. Operations on a database are rejected if a pattern matches the
+// database name, or matches every collection in the database (e.g.
+// "tenant2.*").
+func (p *PolicyOptions) SetDeniedNamespaces(patterns ...string) *PolicyOptions {
+	p.DeniedNamespaces = patterns
+
+	return p
+}
@@ -6,7 +6,10 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+import (
+	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+)
 
 // ReplaceOptions represents arguments that can be used to configure a ReplaceOne
 // operation.
@@ -20,6 +23,7 @@ type ReplaceOptions struct {
 	Upsert                   *bool
 	Let                      any
 	Sort                     any
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -83,6 +87,21 @@ func (ro *ReplaceOptionsBuilder) SetComment(comment any) *ReplaceOptionsBuilder
 	return ro
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (ro *ReplaceOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *ReplaceOptionsBuilder {
+	ro.Opts = append(ro.Opts, func(opts *ReplaceOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return ro
+}
+
 // SetHint sets the value for the Hint field. Specifies the index to use for the
 // operation. This should either be the index name as a string or the index
 // specification as a document. This option is only valid for MongoDB versions
@@ -0,0 +1,113 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import "time"
+
+// RetryInfo describes an error returned by an attempt of a retryable
+// operation.
+type RetryInfo struct {
+	// Err is the error returned by the attempt. It can be inspected with the
+	// error helpers of the mongo package, such as mongo.IsNetworkError and
+	// mongo.ServerError.
+	Err error
+
+	// Write is true if the operation is a write.
+	Write bool
+
+	// Retryable is true if the driver retries Err by default.
+	Retryable bool
+}
+
+// RetryPolicyOptions represents a policy that customizes how a Client retries
+// operations. By default, retryable reads and writes are retried once,
+// immediately, on the errors listed in the retryable reads and retryable
+// writes specifications. A policy can retry additional errors, such as
+// application-specific error codes, retry more than once, and wait between
+// attempts.
+//
+// The policy only applies to operations that are retryable, so it has no
+// effect if retryable reads or writes are disabled with SetRetryReads or
+// SetRetryWrites, and writes are only retried on deployments that support
+// retryable writes. Operations run with a Timeout on the Client or a deadline
+// on their Context retry until the deadline regardless of MaxRetries.
+//
+// See corresponding setter methods for documentation.
+type RetryPolicyOptions struct {
+	Classifier     func(RetryInfo) bool
+	MaxRetries     *int
+	InitialBackoff *time.Duration
+	MaxBackoff     *time.Duration
+}
+
+// RetryPolicy creates a new RetryPolicyOptions instance that retries like the
+// default policy.
+func RetryPolicy() *RetryPolicyOptions {
+	return &RetryPolicyOptions{}
+}
+
+// SetClassifier specifies a function that decides whether an error is retried.
+// The function is called with the error of each failed attempt and must return
+// true to retry it. The Retryable field of RetryInfo is the default decision,
+// so a classifier that retries additional errors should return true if
+// Retryable is true. The function must be safe for concurrent use. Errors are
+// classified with the default rules if no classifier is set.
+func (r *RetryPolicyOptions) SetClassifier(fn func(RetryInfo) bool) *RetryPolicyOptions {
+	r.Classifier = fn
+
+	return r
+}
+
+// SetMaxRetries specifies the maximum number of times each command of an
+// operation is retried. It must be at least 1. The default is 1.
+func (r *RetryPolicyOptions) SetMaxRetries(n int) *RetryPolicyOptions {
+	r.MaxRetries = &n
+
+	return r
+}
+
+// SetBackoff specifies the delay before retrying. The delay before the first
+// retry is initial, and it doubles for each subsequent retry up to max. A random
+// jitter of up to half of the delay is subtracted from each delay, so that
+// clients that fail at the same time do not retry at the same time. Both values
+// must not be negative, and max must not be less than initial. The default is
+// to retry immediately.
+func (r *RetryPolicyOptions) SetBackoff(initial, max time.Duration) *RetryPolicyOptions {
+	r.InitialBackoff = &initial
+	r.MaxBackoff = &max
+
+	return r
+}
+
+// Validate returns an error if the policy is invalid.
+func (r *RetryPolicyOptions) Validate() error {
+	if r.MaxRetries != nil && *r.MaxRetries < 1 {
+		return InvalidValueError{
+			Option:  "MaxRetries",
+			Value:   *r.MaxRetries,
+			Min:     1,
+			Message: "retry policy max retries must be at least 1",
+		}
+	}
+	if r.InitialBackoff != nil && *r.InitialBackoff < 0 {
+		return InvalidValueError{
+			Option:  "InitialBackoff",
+			Value:   *r.InitialBackoff,
+			Min:     time.Duration(0),
+			Message: "retry policy initial backoff must not be negative",
+		}
+	}
+	if r.MaxBackoff != nil && r.InitialBackoff != nil && *r.MaxBackoff < *r.InitialBackoff {
+		return InvalidValueError{
+			Option:  "MaxBackoff",
+			Value:   *r.MaxBackoff,
+			Min:     *r.InitialBackoff,
+			Message: "retry policy max backoff must not be less than initial backoff",
+		}
+	}
+	return nil
+}
@@ -13,6 +13,8 @@ package options
 type RewrapManyDataKeyOptions struct {
 	Provider  *string
 	MasterKey any
+	BatchSize *int32
+	Progress  func(rewrapped, total int)
 }
 
 // RewrapManyDataKeyOptionsBuilder contains options to configure rewraping a
@@ -55,3 +57,28 @@ func (rmdko *RewrapManyDataKeyOptionsBuilder) SetMasterKey(masterKey any) *Rewra
 
 	return rmdko
 }
+
+// SetBatchSize sets the value for the BatchSize field. BatchSize is the maximum number of data keys rewrapped and
+// written to the key vault per round trip. If set, the matching data keys are rewrapped in batches so that a failure
+// only affects the current batch. If omitted, all matching data keys are rewrapped at once.
+func (rmdko *RewrapManyDataKeyOptionsBuilder) SetBatchSize(batchSize int32) *RewrapManyDataKeyOptionsBuilder {
+	rmdko.Opts = append(rmdko.Opts, func(opts *RewrapManyDataKeyOptions) error {
+		opts.BatchSize = &batchSize
+
+		return nil
+	})
+
+	return rmdko
+}
+
+// SetProgress sets the value for the Progress field. Progress is called after each batch of data keys is written to
+// the key vault with the number of data keys rewrapped so far and the total number of matching data keys.
+func (rmdko *RewrapManyDataKeyOptionsBuilder) SetProgress(progress func(rewrapped, total int)) *RewrapManyDataKeyOptionsBuilder {
+	rmdko.Opts = append(rmdko.Opts, func(opts *RewrapManyDataKeyOptions) error {
+		opts.Progress = progress
+
+		return nil
+	})
+
+	return rmdko
+}
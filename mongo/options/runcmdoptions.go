@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 )
 
@@ -16,6 +18,10 @@ import (
 // See corresponding setter methods for documentation.
 type RunCmdOptions struct {
 	ReadPreference *readpref.ReadPref
+	BatchSize      *int32
+	MaxAwaitTime   *time.Duration
+	Comment        any
+	Retryable      *bool
 }
 
 // RunCmdOptionsBuilder contains options to configure runCommand operations.
@@ -47,3 +53,63 @@ func (rc *RunCmdOptionsBuilder) SetReadPreference(rp *readpref.ReadPref) *RunCmd
 
 	return rc
 }
+
+// SetBatchSize sets value for the BatchSize field. Specifies the maximum number of documents
+// returned by each getMore command run by the cursor returned by Database.RunCommandCursor. It
+// does not affect the first batch, which is configured by the command itself. This option is
+// ignored by Database.RunCommand. The default value is nil, which means that the server
+// default is used.
+func (rc *RunCmdOptionsBuilder) SetBatchSize(i int32) *RunCmdOptionsBuilder {
+	rc.Opts = append(rc.Opts, func(opts *RunCmdOptions) error {
+		opts.BatchSize = &i
+
+		return nil
+	})
+
+	return rc
+}
+
+// SetMaxAwaitTime sets value for the MaxAwaitTime field. Specifies the maximum amount of time
+// the server waits for new documents on each getMore command run by the cursor returned by
+// Database.RunCommandCursor. It is only valid for tailable awaitData cursors. This option is
+// ignored by Database.RunCommand. The default value is nil, which means that the server
+// default is used.
+func (rc *RunCmdOptionsBuilder) SetMaxAwaitTime(d time.Duration) *RunCmdOptionsBuilder {
+	rc.Opts = append(rc.Opts, func(opts *RunCmdOptions) error {
+		opts.MaxAwaitTime = &d
+
+		return nil
+	})
+
+	return rc
+}
+
+// SetComment sets value for the Comment field. Specifies a comment attached to each getMore
+// command run by the cursor returned by Database.RunCommandCursor, which can be used to
+// identify them in server logs. To comment the initial command, include a comment field in
+// the command itself. This option is ignored by Database.RunCommand. The default value is nil,
+// which means that no comment is sent.
+func (rc *RunCmdOptionsBuilder) SetComment(comment any) *RunCmdOptionsBuilder {
+	rc.Opts = append(rc.Opts, func(opts *RunCmdOptions) error {
+		opts.Comment = comment
+
+		return nil
+	})
+
+	return rc
+}
+
+// SetRetryable sets value for the Retryable field. Specifies whether the command is idempotent
+// and can be retried like a retryable read: if the command fails with a retryable error, a server
+// is selected again and the command is retried once. Only commands that have the same effect when
+// run more than once should be marked retryable. The command is not retried if retryable reads
+// are disabled on the Client or the command runs in a transaction. The default value is false.
+func (rc *RunCmdOptionsBuilder) SetRetryable(b bool) *RunCmdOptionsBuilder {
+	rc.Opts = append(rc.Opts, func(opts *RunCmdOptions) error {
+		opts.Retryable = &b
+
+		return nil
+	})
+
+	return rc
+}
@@ -0,0 +1,63 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import "go.mongodb.org/mongo-driver/v2/mongo/readpref"
+
+// RunCommandsOptions represents arguments that can be used to configure a
+// Database.RunCommands operation.
+//
+// See corresponding setter methods for documentation.
+type RunCommandsOptions struct {
+	ReadPreference *readpref.ReadPref
+	StopOnError    *bool
+}
+
+// RunCommandsOptionsBuilder contains options to configure Database.RunCommands
+// operations. Each option can be set through setter functions. See
+// documentation for each setter function for an explanation of the option.
+type RunCommandsOptionsBuilder struct {
+	Opts []func(*RunCommandsOptions) error
+}
+
+// RunCommands creates a new RunCommandsOptions instance.
+func RunCommands() *RunCommandsOptionsBuilder {
+	return &RunCommandsOptionsBuilder{}
+}
+
+// List returns a list of RunCommandsOptions setter functions.
+func (rc *RunCommandsOptionsBuilder) List() []func(*RunCommandsOptions) error {
+	return rc.Opts
+}
+
+// SetReadPreference sets value for the ReadPreference field. Specifies the read
+// preference used to select the server that all of the commands are run on.
+// The default value is nil, which means that the primary read preference will
+// be used.
+func (rc *RunCommandsOptionsBuilder) SetReadPreference(rp *readpref.ReadPref) *RunCommandsOptionsBuilder {
+	rc.Opts = append(rc.Opts, func(opts *RunCommandsOptions) error {
+		opts.ReadPreference = rp
+
+		return nil
+	})
+
+	return rc
+}
+
+// SetStopOnError sets value for the StopOnError field. If true, no more
+// commands are run after a command fails. The default value is false, which
+// means that every command is run regardless of the errors of the previous
+// commands.
+func (rc *RunCommandsOptionsBuilder) SetStopOnError(b bool) *RunCommandsOptionsBuilder {
+	rc.Opts = append(rc.Opts, func(opts *RunCommandsOptions) error {
+		opts.StopOnError = &b
+
+		return nil
+	})
+
+	return rc
+}
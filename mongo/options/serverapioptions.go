@@ -62,5 +62,10 @@ func (sav ServerAPIVersion) Validate() error {
 	if sav == ServerAPIVersion1 {
 		return nil
 	}
-	return fmt.Errorf("api version %q not supported; this driver version only supports API version \"1\"", sav)
+	return InvalidValueError{
+		Option:  "ServerAPIVersion",
+		Value:   sav,
+		Allowed: []any{ServerAPIVersion1},
+		Message: fmt.Sprintf("api version %q not supported; this driver version only supports API version \"1\"", sav),
+	}
 }
@@ -6,6 +6,8 @@
 
 package options
 
+import "go.mongodb.org/mongo-driver/v2/bson"
+
 // DefaultCausalConsistency is the default value for the CausalConsistency option.
 var DefaultCausalConsistency = true
 
@@ -16,6 +18,7 @@ type SessionOptions struct {
 	CausalConsistency         *bool
 	DefaultTransactionOptions *TransactionOptionsBuilder
 	Snapshot                  *bool
+	SnapshotTime              *bson.Timestamp
 }
 
 // SessionOptionsBuilder represents functional options that configure a Sessionopts.
@@ -69,3 +72,18 @@ func (s *SessionOptionsBuilder) SetSnapshot(b bool) *SessionOptionsBuilder {
 	})
 	return s
 }
+
+// SetSnapshotTime sets the value for the SnapshotTime field. If set, all read operations
+// performed with this session will read from the snapshot at the given cluster time instead
+// of a snapshot chosen by the server for the first read. This allows multiple sessions, or
+// sessions in different processes, to read a consistent view of the data at a known point in
+// time, for example one obtained from Session.SnapshotTime. The cluster time must still be
+// within the server's snapshot history window. This option can only be set if Snapshot is
+// set to true.
+func (s *SessionOptionsBuilder) SetSnapshotTime(t bson.Timestamp) *SessionOptionsBuilder {
+	s.Opts = append(s.Opts, func(opts *SessionOptions) error {
+		opts.SnapshotTime = &t
+		return nil
+	})
+	return s
+}
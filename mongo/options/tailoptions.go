@@ -0,0 +1,104 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import "time"
+
+// TailOptions represents arguments that can be used to configure a Tail
+// operation.
+//
+// See corresponding setter methods for documentation.
+type TailOptions struct {
+	BatchSize    *int32
+	Comment      any
+	MaxAwaitTime *time.Duration
+	ResumeField  *string
+	StartAfter   any
+}
+
+// TailOptionsBuilder contains options to configure tail operations. Each
+// option can be set through setter functions. See documentation for each setter
+// function for an explanation of the option.
+type TailOptionsBuilder struct {
+	Opts []func(*TailOptions) error
+}
+
+// Tail creates a new TailOptions instance.
+func Tail() *TailOptionsBuilder {
+	return &TailOptionsBuilder{}
+}
+
+// List returns a list of TailOptions setter functions.
+func (to *TailOptionsBuilder) List() []func(*TailOptions) error {
+	return to.Opts
+}
+
+// SetBatchSize sets the value for the BatchSize field. Specifies the maximum number of documents to be included in
+// each batch returned by the server. The default value is nil, which means that the server default is used.
+func (to *TailOptionsBuilder) SetBatchSize(i int32) *TailOptionsBuilder {
+	to.Opts = append(to.Opts, func(opts *TailOptions) error {
+		opts.BatchSize = &i
+
+		return nil
+	})
+
+	return to
+}
+
+// SetComment sets the value for the Comment field. Specifies a string or document that will be included
+// in server logs, profiling logs, and currentOp queries to help trace the operation. The default is nil,
+// which means that no comment will be included in the logs.
+func (to *TailOptionsBuilder) SetComment(comment any) *TailOptionsBuilder {
+	to.Opts = append(to.Opts, func(opts *TailOptions) error {
+		opts.Comment = comment
+
+		return nil
+	})
+
+	return to
+}
+
+// SetMaxAwaitTime sets the value for the MaxAwaitTime field. Specifies the maximum amount of time for the server to
+// wait for new documents before responding to a getMore with an empty batch. The default value is nil, which means
+// that the server default of one second is used.
+func (to *TailOptionsBuilder) SetMaxAwaitTime(d time.Duration) *TailOptionsBuilder {
+	to.Opts = append(to.Opts, func(opts *TailOptions) error {
+		opts.MaxAwaitTime = &d
+
+		return nil
+	})
+
+	return to
+}
+
+// SetResumeField sets the value for the ResumeField field. Specifies the name of the field used to resume the cursor
+// after the last document it returned. The field must be present in every document and its values must increase in
+// insertion order. The default value is "_id", which is suitable for documents with driver-generated ObjectIDs. When
+// tailing the oplog, this should be set to "ts".
+func (to *TailOptionsBuilder) SetResumeField(field string) *TailOptionsBuilder {
+	to.Opts = append(to.Opts, func(opts *TailOptions) error {
+		opts.ResumeField = &field
+
+		return nil
+	})
+
+	return to
+}
+
+// SetStartAfter sets the value for the StartAfter field. Specifies a value of the resume field; only documents with
+// a greater value are returned. This can be used with the value returned by TailableCursor.ResumeValue to continue
+// tailing from where a previous cursor stopped. The default value is nil, which means that all documents in the
+// collection that match the filter are returned.
+func (to *TailOptionsBuilder) SetStartAfter(val any) *TailOptionsBuilder {
+	to.Opts = append(to.Opts, func(opts *TailOptions) error {
+		opts.StartAfter = val
+
+		return nil
+	})
+
+	return to
+}
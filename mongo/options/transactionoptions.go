@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
@@ -20,6 +22,31 @@ type TransactionOptions struct {
 	ReadConcern    *readconcern.ReadConcern
 	ReadPreference *readpref.ReadPref
 	WriteConcern   *writeconcern.WriteConcern
+
+	// The following options are only used by Session.WithTransaction.
+	MaxAttempts     *int
+	RetryBackoff    *time.Duration
+	MaxRetryBackoff *time.Duration
+	RetryTimeout    *time.Duration
+	OnRetry         func(TransactionRetry)
+}
+
+// TransactionRetry describes a retry performed by Session.WithTransaction. It
+// is passed to the callback set with TransactionOptionsBuilder.SetOnRetry.
+type TransactionRetry struct {
+	// Attempt is the number of the attempt that is about to start. The first
+	// retry is attempt 2.
+	Attempt int
+
+	// Commit is true if only the commitTransaction command is retried, and
+	// false if the whole transaction, including the callback, is retried.
+	Commit bool
+
+	// Err is the error that caused the retry.
+	Err error
+
+	// Backoff is the time WithTransaction waits before the attempt.
+	Backoff time.Duration
 }
 
 // TransactionOptionsBuilder contains arguments to configure count operations.
@@ -77,3 +104,61 @@ func (t *TransactionOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcer
 
 	return t
 }
+
+// SetMaxAttempts sets the value for the MaxAttempts field. Specifies the maximum number of
+// attempts made by Session.WithTransaction, counting each run of the callback and each retry
+// of commitTransaction. Once the limit is reached, the last error is returned. The default
+// value is nil, which means that the number of attempts is only bounded by the retry timeout.
+// This option is ignored by Session.StartTransaction.
+func (t *TransactionOptionsBuilder) SetMaxAttempts(n int) *TransactionOptionsBuilder {
+	t.Opts = append(t.Opts, func(opts *TransactionOptions) error {
+		opts.MaxAttempts = &n
+
+		return nil
+	})
+
+	return t
+}
+
+// SetRetryBackoff sets the values for the RetryBackoff and MaxRetryBackoff fields. Specifies
+// that Session.WithTransaction waits before each retry for a random duration between zero and
+// an exponentially growing limit, starting at base and doubling with each retry up to max.
+// Randomizing the wait prevents concurrent transactions that conflict with each other from
+// retrying in lockstep. The default is to retry without waiting. This option is ignored by
+// Session.StartTransaction.
+func (t *TransactionOptionsBuilder) SetRetryBackoff(base, max time.Duration) *TransactionOptionsBuilder {
+	t.Opts = append(t.Opts, func(opts *TransactionOptions) error {
+		opts.RetryBackoff = &base
+		opts.MaxRetryBackoff = &max
+
+		return nil
+	})
+
+	return t
+}
+
+// SetRetryTimeout sets the value for the RetryTimeout field. Specifies the time after which
+// Session.WithTransaction stops retrying and returns the last error. The default value is 120
+// seconds. This option is ignored by Session.StartTransaction.
+func (t *TransactionOptionsBuilder) SetRetryTimeout(d time.Duration) *TransactionOptionsBuilder {
+	t.Opts = append(t.Opts, func(opts *TransactionOptions) error {
+		opts.RetryTimeout = &d
+
+		return nil
+	})
+
+	return t
+}
+
+// SetOnRetry sets the value for the OnRetry field. Specifies a callback that Session.WithTransaction
+// calls before each retry, which can be used to log or count retries. The callback is run
+// synchronously before the backoff. This option is ignored by Session.StartTransaction.
+func (t *TransactionOptionsBuilder) SetOnRetry(fn func(TransactionRetry)) *TransactionOptionsBuilder {
+	t.Opts = append(t.Opts, func(opts *TransactionOptions) error {
+		opts.OnRetry = fn
+
+		return nil
+	})
+
+	return t
+}
@@ -6,7 +6,10 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+import (
+	"go.mongodb.org/mongo-driver/v2/internal/optionsutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+)
 
 // UpdateOneOptions represents arguments that can be used to configure UpdateOne
 // operations.
@@ -21,6 +24,7 @@ type UpdateOneOptions struct {
 	Upsert                   *bool
 	Let                      any
 	Sort                     any
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -98,6 +102,21 @@ func (uo *UpdateOneOptionsBuilder) SetComment(comment any) *UpdateOneOptionsBuil
 	return uo
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (uo *UpdateOneOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *UpdateOneOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateOneOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return uo
+}
+
 // SetHint sets the value for the Hint field. Specifies the index to use for the
 // operation. This should either be the index name as a string or the index
 // specification as a document. This option is only valid for MongoDB versions
@@ -170,6 +189,7 @@ type UpdateManyOptions struct {
 	Hint                     any
 	Upsert                   *bool
 	Let                      any
+	WriteConcern             *writeconcern.WriteConcern
 
 	// Deprecated: This option is for internal use only and should not be set. It may be changed or removed in any
 	// release.
@@ -247,6 +267,21 @@ func (uo *UpdateManyOptionsBuilder) SetComment(comment any) *UpdateManyOptionsBu
 	return uo
 }
 
+// SetWriteConcern sets the value for the WriteConcern field. Specifies the
+// write concern for the operation, overriding the write concern of the
+// collection. It must not be set for operations in a transaction, which use
+// the write concern of the transaction. The default value is nil, which means
+// the write concern of the collection is used.
+func (uo *UpdateManyOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcern) *UpdateManyOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateManyOptions) error {
+		opts.WriteConcern = wc
+
+		return nil
+	})
+
+	return uo
+}
+
 // SetHint sets the value for the Hint field. Specifies the index to use for the
 // operation. This should either be the index name as a string or the index
 // specification as a document. This option is only valid for MongoDB versions
@@ -0,0 +1,145 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
+)
+
+// PinnedServer describes the mongos or load balancer that a cursor or
+// transaction is pinned to. All subsequent commands for a pinned cursor or
+// transaction are routed to the same server, so long-lived pins can cause
+// uneven load across the mongos instances of a sharded cluster.
+type PinnedServer struct {
+	// Address is the address of the server.
+	Address string
+
+	// LoadBalanced is true if the deployment is behind a load balancer, in which
+	// case a single connection to the load balancer is pinned and cannot be used
+	// by other operations until the pin is released.
+	LoadBalanced bool
+
+	// ServiceID is the ID of the backend service behind the load balancer that
+	// the pinned connection is connected to. It is only set if LoadBalanced is
+	// true. Comparing the service IDs of pinned cursors and transactions shows
+	// how they are distributed across the backends.
+	ServiceID *bson.ObjectID
+
+	// ConnectionID is the driver-generated ID of the pinned connection. It is
+	// only set if LoadBalanced is true and matches the connection IDs reported
+	// by connection pool and command monitoring events.
+	ConnectionID int64
+
+	// Since is the time at which the pin was established.
+	Since time.Time
+}
+
+// Duration returns how long the pin has been held.
+func (p PinnedServer) Duration() time.Duration {
+	return time.Since(p.Since)
+}
+
+// cursorServerDescriber is implemented by batch cursors that can report the
+// server they were established on.
+type cursorServerDescriber interface {
+	ServerDescription() description.Server
+}
+
+// cursorConnectionPinner is implemented by batch cursors that can report the
+// connection they are pinned to.
+type cursorConnectionPinner interface {
+	PinnedConnection() mnet.Describer
+}
+
+// pinnedConnection sets the service and connection IDs of p from conn.
+func (p *PinnedServer) pinnedConnection(conn mnet.Describer) {
+	p.ServiceID = conn.Description().ServiceID
+	p.ConnectionID = conn.DriverConnectionID()
+}
+
+// PinnedServer returns the mongos or load balancer that the cursor is pinned
+// to. The cursor is pinned from the time it is created until it is exhausted
+// or closed. It returns false if the cursor is not open or the deployment is
+// not a sharded cluster or load balanced.
+func (c *Cursor) PinnedServer() (PinnedServer, bool) {
+	if c.bc.ID() == 0 {
+		return PinnedServer{}, false
+	}
+	describer, ok := c.bc.(cursorServerDescriber)
+	if !ok {
+		return PinnedServer{}, false
+	}
+
+	desc := describer.ServerDescription()
+	switch desc.Kind {
+	case description.ServerKindMongos, description.ServerKindLoadBalancer:
+	default:
+		return PinnedServer{}, false
+	}
+	pinned := PinnedServer{
+		Address:      desc.Addr.String(),
+		LoadBalanced: desc.Kind == description.ServerKindLoadBalancer,
+		Since:        c.createdAt,
+	}
+	if pinner, ok := c.bc.(cursorConnectionPinner); ok && pinned.LoadBalanced {
+		if conn := pinner.PinnedConnection(); conn != nil {
+			pinned.pinnedConnection(conn)
+		}
+	}
+	return pinned, true
+}
+
+// IdleTime returns the time elapsed since the cursor last received a batch of
+// documents from the server, or since it was created if no getMore has been
+// run. Documents that are consumed from an already fetched batch do not reset
+// the idle time.
+func (c *Cursor) IdleTime() time.Duration {
+	return time.Since(c.lastServerContact)
+}
+
+// CloseIfIdle closes the cursor if it is open and its IdleTime is at least
+// idle, releasing the server-side cursor and any pinned connection. It reports
+// whether the cursor was closed. CloseIfIdle is intended for background
+// cleanup of cursors that an application has stopped iterating; like all
+// Cursor methods, it must not be called concurrently with other methods on the
+// same Cursor.
+func (c *Cursor) CloseIfIdle(ctx context.Context, idle time.Duration) (bool, error) {
+	if c.bc.ID() == 0 || c.IdleTime() < idle {
+		return false, nil
+	}
+	return true, c.Close(ctx)
+}
+
+// PinnedServer returns the mongos or load balancer that the session's current
+// transaction is pinned to. A transaction is pinned by its first operation.
+// The pin is released when the next transaction starts or when an operation
+// runs on the session after the transaction has been committed or aborted. It
+// returns false if the session is not pinned.
+func (s *Session) PinnedServer() (PinnedServer, bool) {
+	cs := s.clientSession
+	switch {
+	case cs.PinnedConnection != nil:
+		pinned := PinnedServer{
+			Address:      cs.PinnedConnection.Address().String(),
+			LoadBalanced: true,
+			Since:        cs.PinnedTime,
+		}
+		pinned.pinnedConnection(cs.PinnedConnection)
+		return pinned, true
+	case cs.PinnedServerAddr != nil:
+		return PinnedServer{
+			Address: cs.PinnedServerAddr.String(),
+			Since:   cs.PinnedTime,
+		}, true
+	}
+	return PinnedServer{}, false
+}
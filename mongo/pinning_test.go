@@ -0,0 +1,193 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/session"
+)
+
+type describedBatchCursor struct {
+	*testBatchCursor
+	desc description.Server
+}
+
+func (dbc *describedBatchCursor) ServerDescription() description.Server {
+	return dbc.desc
+}
+
+// pinnedTestConnection is a load-balanced connection with a service ID. The
+// embedded interface is nil, so only the overridden methods can be called.
+type pinnedTestConnection struct {
+	session.LoadBalancedTransactionConnection
+	serviceID bson.ObjectID
+	id        int64
+}
+
+func (ptc *pinnedTestConnection) Description() description.Server {
+	return description.Server{Kind: description.ServerKindLoadBalancer, ServiceID: &ptc.serviceID}
+}
+
+func (ptc *pinnedTestConnection) DriverConnectionID() int64 {
+	return ptc.id
+}
+
+func (ptc *pinnedTestConnection) Address() address.Address {
+	return "lb:27017"
+}
+
+type pinnedBatchCursor struct {
+	*describedBatchCursor
+	conn mnet.Describer
+}
+
+func (pbc *pinnedBatchCursor) PinnedConnection() mnet.Describer {
+	return pbc.conn
+}
+
+func TestCursorPinnedServer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		kind description.ServerKind
+		want bool
+		lb   bool
+	}{
+		{"mongos", description.ServerKindMongos, true, false},
+		{"load balancer", description.ServerKindLoadBalancer, true, true},
+		{"replica set member", description.ServerKindRSSecondary, false, false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			bc := &describedBatchCursor{
+				testBatchCursor: newTestBatchCursor(2, 1),
+				desc:            description.Server{Addr: address.Address("mongos1:27017"), Kind: tc.kind},
+			}
+			cursor, err := newCursor(bc, nil, nil)
+			require.NoError(t, err)
+
+			pinned, ok := cursor.PinnedServer()
+			assert.Equal(t, tc.want, ok)
+			if !tc.want {
+				return
+			}
+			assert.Equal(t, "mongos1:27017", pinned.Address)
+			assert.Equal(t, tc.lb, pinned.LoadBalanced)
+			assert.Equal(t, cursor.createdAt, pinned.Since)
+		})
+	}
+
+	t.Run("load balanced connection", func(t *testing.T) {
+		t.Parallel()
+
+		conn := &pinnedTestConnection{serviceID: bson.NewObjectID(), id: 7}
+		bc := &pinnedBatchCursor{
+			describedBatchCursor: &describedBatchCursor{
+				testBatchCursor: newTestBatchCursor(2, 1),
+				desc:            description.Server{Addr: "lb:27017", Kind: description.ServerKindLoadBalancer},
+			},
+			conn: conn,
+		}
+		cursor, err := newCursor(bc, nil, nil)
+		require.NoError(t, err)
+
+		pinned, ok := cursor.PinnedServer()
+		require.True(t, ok)
+		assert.True(t, pinned.LoadBalanced)
+		require.NotNil(t, pinned.ServiceID)
+		assert.Equal(t, conn.serviceID, *pinned.ServiceID)
+		assert.Equal(t, int64(7), pinned.ConnectionID)
+	})
+	t.Run("exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		bc := &describedBatchCursor{
+			testBatchCursor: newTestBatchCursor(0, 0),
+			desc:            description.Server{Addr: address.Address("mongos1:27017"), Kind: description.ServerKindMongos},
+		}
+		cursor, err := newCursor(bc, nil, nil)
+		require.NoError(t, err)
+
+		_, ok := cursor.PinnedServer()
+		assert.False(t, ok)
+	})
+}
+
+func TestCursorCloseIfIdle(t *testing.T) {
+	t.Parallel()
+
+	bc := newTestBatchCursor(2, 1)
+	cursor, err := newCursor(bc, nil, nil)
+	require.NoError(t, err)
+
+	closed, err := cursor.CloseIfIdle(context.Background(), time.Hour)
+	require.NoError(t, err)
+	assert.False(t, closed)
+	assert.False(t, bc.closed)
+
+	cursor.lastServerContact = time.Now().Add(-2 * time.Hour)
+	assert.GreaterOrEqual(t, cursor.IdleTime(), 2*time.Hour)
+
+	closed, err = cursor.CloseIfIdle(context.Background(), time.Hour)
+	require.NoError(t, err)
+	assert.True(t, closed)
+	assert.True(t, bc.closed)
+
+	// Iterating resets the idle time.
+	cursor, err = newCursor(newTestBatchCursor(2, 1), nil, nil)
+	require.NoError(t, err)
+	cursor.lastServerContact = time.Now().Add(-2 * time.Hour)
+	require.True(t, cursor.Next(context.Background()))
+	assert.Less(t, cursor.IdleTime(), time.Hour)
+}
+
+func TestSessionPinnedServer(t *testing.T) {
+	t.Parallel()
+
+	sess := &Session{clientSession: &session.Client{}}
+	_, ok := sess.PinnedServer()
+	assert.False(t, ok)
+
+	addr := address.Address("mongos2:27017")
+	pinnedAt := time.Now().Add(-time.Minute)
+	sess.clientSession.PinnedServerAddr = &addr
+	sess.clientSession.PinnedTime = pinnedAt
+
+	pinned, ok := sess.PinnedServer()
+	require.True(t, ok)
+	assert.Equal(t, PinnedServer{Address: "mongos2:27017", Since: pinnedAt}, pinned)
+	assert.GreaterOrEqual(t, pinned.Duration(), time.Minute)
+
+	conn := &pinnedTestConnection{serviceID: bson.NewObjectID(), id: 3}
+	sess.clientSession.PinnedServerAddr = nil
+	sess.clientSession.PinnedConnection = conn
+
+	pinned, ok = sess.PinnedServer()
+	require.True(t, ok)
+	want := PinnedServer{
+		Address:      "lb:27017",
+		LoadBalanced: true,
+		ServiceID:    &conn.serviceID,
+		ConnectionID: 3,
+		Since:        pinnedAt,
+	}
+	assert.Equal(t, want, pinned)
+}
@@ -0,0 +1,191 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import "go.mongodb.org/mongo-driver/v2/bson"
+
+// TimeUnit is a unit of time accepted by date expressions and time-based
+// stages such as $dateTrunc, $dateAdd, and $densify.
+type TimeUnit string
+
+// These are the time units supported by the server.
+const (
+	Millisecond TimeUnit = "millisecond"
+	Second      TimeUnit = "second"
+	Minute      TimeUnit = "minute"
+	Hour        TimeUnit = "hour"
+	Day         TimeUnit = "day"
+	Week        TimeUnit = "week"
+	Month       TimeUnit = "month"
+	Quarter     TimeUnit = "quarter"
+	Year        TimeUnit = "year"
+)
+
+// Valid reports whether u is a time unit recognized by the server.
+func (u TimeUnit) Valid() bool {
+	switch u {
+	case Millisecond, Second, Minute, Hour, Day, Week, Month, Quarter, Year:
+		return true
+	}
+	return false
+}
+
+// Weekday is a day of the week accepted by the startOfWeek argument of date
+// expressions.
+type Weekday string
+
+// These are the weekdays supported by the server.
+const (
+	Sunday    Weekday = "sunday"
+	Monday    Weekday = "monday"
+	Tuesday   Weekday = "tuesday"
+	Wednesday Weekday = "wednesday"
+	Thursday  Weekday = "thursday"
+	Friday    Weekday = "friday"
+	Saturday  Weekday = "saturday"
+)
+
+// checkUnit returns an error if u is not a valid TimeUnit.
+func checkUnit(op string, u TimeUnit) error {
+	if !u.Valid() {
+		return InvalidArgumentError{Operator: op, Argument: "unit", Reason: "unknown time unit " + string(u)}
+	}
+	return nil
+}
+
+// DateTruncArgs are the arguments to a $dateTrunc expression. Date and Unit
+// are required.
+type DateTruncArgs struct {
+	Date        any
+	Unit        TimeUnit
+	BinSize     any
+	Timezone    any
+	StartOfWeek Weekday // Only valid when Unit is Week.
+}
+
+// DateTrunc returns a $dateTrunc expression.
+func DateTrunc(args DateTruncArgs) Expr {
+	if args.Date == nil {
+		return invalid(InvalidArgumentError{Operator: "$dateTrunc", Argument: "date", Reason: "must not be nil"})
+	}
+	if err := checkUnit("$dateTrunc", args.Unit); err != nil {
+		return invalid(err)
+	}
+	var startOfWeek any
+	if args.StartOfWeek != "" {
+		if args.Unit != Week {
+			return invalid(InvalidArgumentError{
+				Operator: "$dateTrunc",
+				Argument: "startOfWeek",
+				Reason:   "may only be set when unit is week",
+			})
+		}
+		startOfWeek = string(args.StartOfWeek)
+	}
+
+	return named("$dateTrunc", bson.D{
+		{Key: "date", Value: args.Date},
+		{Key: "unit", Value: string(args.Unit)},
+		{Key: "binSize", Value: args.BinSize},
+		{Key: "timezone", Value: args.Timezone},
+		{Key: "startOfWeek", Value: startOfWeek},
+	})
+}
+
+// dateArith builds a $dateAdd or $dateSubtract expression.
+func dateArith(op string, startDate any, unit TimeUnit, amount any, timezone any) Expr {
+	if startDate == nil || amount == nil {
+		return invalid(InvalidArgumentError{Operator: op, Argument: "startDate/amount", Reason: "must not be nil"})
+	}
+	if err := checkUnit(op, unit); err != nil {
+		return invalid(err)
+	}
+	return named(op, bson.D{
+		{Key: "startDate", Value: startDate},
+		{Key: "unit", Value: string(unit)},
+		{Key: "amount", Value: amount},
+		{Key: "timezone", Value: timezone},
+	})
+}
+
+// DateAdd returns a $dateAdd expression. If timezone is nil, UTC is used.
+func DateAdd(startDate any, unit TimeUnit, amount any, timezone any) Expr {
+	return dateArith("$dateAdd", startDate, unit, amount, timezone)
+}
+
+// DateSubtract returns a $dateSubtract expression. If timezone is nil, UTC is
+// used.
+func DateSubtract(startDate any, unit TimeUnit, amount any, timezone any) Expr {
+	return dateArith("$dateSubtract", startDate, unit, amount, timezone)
+}
+
+// DateDiffArgs are the arguments to a $dateDiff expression. StartDate,
+// EndDate, and Unit are required.
+type DateDiffArgs struct {
+	StartDate   any
+	EndDate     any
+	Unit        TimeUnit
+	Timezone    any
+	StartOfWeek Weekday // Only valid when Unit is Week.
+}
+
+// DateDiff returns a $dateDiff expression.
+func DateDiff(args DateDiffArgs) Expr {
+	if args.StartDate == nil || args.EndDate == nil {
+		return invalid(InvalidArgumentError{Operator: "$dateDiff", Argument: "startDate/endDate", Reason: "must not be nil"})
+	}
+	if err := checkUnit("$dateDiff", args.Unit); err != nil {
+		return invalid(err)
+	}
+	var startOfWeek any
+	if args.StartOfWeek != "" {
+		if args.Unit != Week {
+			return invalid(InvalidArgumentError{
+				Operator: "$dateDiff",
+				Argument: "startOfWeek",
+				Reason:   "may only be set when unit is week",
+			})
+		}
+		startOfWeek = string(args.StartOfWeek)
+	}
+
+	return named("$dateDiff", bson.D{
+		{Key: "startDate", Value: args.StartDate},
+		{Key: "endDate", Value: args.EndDate},
+		{Key: "unit", Value: string(args.Unit)},
+		{Key: "timezone", Value: args.Timezone},
+		{Key: "startOfWeek", Value: startOfWeek},
+	})
+}
+
+// DateToString returns a $dateToString expression. If format or timezone are
+// nil, the server defaults are used.
+func DateToString(date, format, timezone any) Expr {
+	if date == nil {
+		return invalid(InvalidArgumentError{Operator: "$dateToString", Argument: "date", Reason: "must not be nil"})
+	}
+	return named("$dateToString", bson.D{
+		{Key: "date", Value: date},
+		{Key: "format", Value: format},
+		{Key: "timezone", Value: timezone},
+	})
+}
+
+// YearOf returns a $year expression.
+func YearOf(date any) Expr { return unary("$year", date) }
+
+// MonthOf returns a $month expression.
+func MonthOf(date any) Expr { return unary("$month", date) }
+
+// DayOfMonth returns a $dayOfMonth expression.
+func DayOfMonth(date any) Expr { return unary("$dayOfMonth", date) }
+
+// DayOfWeek returns a $dayOfWeek expression.
+func DayOfWeek(date any) Expr { return unary("$dayOfWeek", date) }
+
+// HourOf returns an $hour expression.
+func HourOf(date any) Expr { return unary("$hour", date) }
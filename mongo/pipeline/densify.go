@@ -0,0 +1,214 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// DensifyBounds specifies the range a $densify stage fills. Create one with
+// FullBounds, PartitionBounds, or ExplicitBounds.
+type DensifyBounds struct {
+	mode  string
+	lower any
+	upper any
+}
+
+// FullBounds returns bounds spanning the minimum and maximum values of the
+// densified field across the whole collection.
+func FullBounds() DensifyBounds {
+	return DensifyBounds{mode: "full"}
+}
+
+// PartitionBounds returns bounds spanning the minimum and maximum values of
+// the densified field within each partition.
+func PartitionBounds() DensifyBounds {
+	return DensifyBounds{mode: "partition"}
+}
+
+// ExplicitBounds returns bounds from lower (inclusive) to upper (exclusive).
+// Both must be numbers when DensifyArgs.Unit is empty, or dates (time.Time or
+// bson.DateTime) when it is set.
+func ExplicitBounds(lower, upper any) DensifyBounds {
+	return DensifyBounds{mode: "explicit", lower: lower, upper: upper}
+}
+
+// DensifyArgs are the arguments to a $densify stage.
+type DensifyArgs struct {
+	// Field is the field to densify. It is required and must not begin with
+	// "$".
+	Field string
+
+	// PartitionByFields are the fields used to group documents before
+	// densifying. It is optional.
+	PartitionByFields []string
+
+	// Step is the amount to increment the field by for each generated
+	// document. It is required and must be a positive number.
+	Step any
+
+	// Unit is the time unit of Step. It must be set when Field contains dates
+	// and must be empty when Field contains numbers.
+	Unit TimeUnit
+
+	// Bounds is the range to densify. It is required.
+	Bounds DensifyBounds
+}
+
+// Densify returns a $densify stage. Invalid arguments, such as a non-positive
+// step or date bounds without a unit, are reported when the stage is
+// marshaled.
+//
+// For more information about $densify, see
+// https://www.mongodb.com/docs/manual/reference/operator/aggregation/densify/
+func Densify(args DensifyArgs) bson.D {
+	spec, err := densifySpec(args)
+	if err != nil {
+		return bson.D{{Key: "$densify", Value: invalid(err)}}
+	}
+	return bson.D{{Key: "$densify", Value: spec}}
+}
+
+func densifySpec(args DensifyArgs) (bson.D, error) {
+	const op = "$densify"
+
+	if err := checkFieldName(op, "field", args.Field); err != nil {
+		return nil, err
+	}
+	for _, f := range args.PartitionByFields {
+		if err := checkFieldName(op, "partitionByFields", f); err != nil {
+			return nil, err
+		}
+		if f == args.Field {
+			return nil, InvalidArgumentError{Operator: op, Argument: "partitionByFields", Reason: "must not contain the densified field"}
+		}
+	}
+
+	step, ok := numberValue(args.Step)
+	if !ok || step <= 0 {
+		return nil, InvalidArgumentError{Operator: op, Argument: "step", Reason: "must be a positive number"}
+	}
+	if args.Unit != "" {
+		if err := checkUnit(op, args.Unit); err != nil {
+			return nil, err
+		}
+		if _, isInt := integerValue(args.Step); !isInt {
+			return nil, InvalidArgumentError{Operator: op, Argument: "step", Reason: "must be an integer when unit is set"}
+		}
+	}
+
+	var bounds any
+	switch args.Bounds.mode {
+	case "full", "partition":
+		bounds = args.Bounds.mode
+		if args.Bounds.mode == "partition" && len(args.PartitionByFields) == 0 {
+			return nil, InvalidArgumentError{Operator: op, Argument: "bounds", Reason: `"partition" bounds require partitionByFields`}
+		}
+	case "explicit":
+		if err := checkDensifyBounds(args.Bounds.lower, args.Bounds.upper, args.Unit != ""); err != nil {
+			return nil, err
+		}
+		bounds = bson.A{args.Bounds.lower, args.Bounds.upper}
+	default:
+		return nil, InvalidArgumentError{Operator: op, Argument: "bounds", Reason: "must be set"}
+	}
+
+	rng := bson.D{{Key: "step", Value: args.Step}}
+	if args.Unit != "" {
+		rng = append(rng, bson.E{Key: "unit", Value: string(args.Unit)})
+	}
+	rng = append(rng, bson.E{Key: "bounds", Value: bounds})
+
+	doc := bson.D{{Key: "field", Value: args.Field}}
+	if len(args.PartitionByFields) > 0 {
+		doc = append(doc, bson.E{Key: "partitionByFields", Value: args.PartitionByFields})
+	}
+	return append(doc, bson.E{Key: "range", Value: rng}), nil
+}
+
+func checkDensifyBounds(lower, upper any, dates bool) error {
+	const op = "$densify"
+
+	if dates {
+		lo, okLo := dateValue(lower)
+		hi, okHi := dateValue(upper)
+		if !okLo || !okHi {
+			return InvalidArgumentError{Operator: op, Argument: "bounds", Reason: "must be dates when unit is set"}
+		}
+		if !lo.Before(hi) {
+			return InvalidArgumentError{Operator: op, Argument: "bounds", Reason: "lower bound must be before upper bound"}
+		}
+		return nil
+	}
+
+	lo, okLo := numberValue(lower)
+	hi, okHi := numberValue(upper)
+	if !okLo || !okHi {
+		return InvalidArgumentError{Operator: op, Argument: "bounds", Reason: "must be numbers when unit is not set"}
+	}
+	if lo >= hi {
+		return InvalidArgumentError{Operator: op, Argument: "bounds", Reason: fmt.Sprintf("lower bound %v must be less than upper bound %v", lower, upper)}
+	}
+	return nil
+}
+
+// checkFieldName returns an error if name is empty or is a field path
+// expression rather than a field name.
+func checkFieldName(op, arg, name string) error {
+	if name == "" {
+		return InvalidArgumentError{Operator: op, Argument: arg, Reason: "field names must not be empty"}
+	}
+	if strings.HasPrefix(name, "$") {
+		return InvalidArgumentError{Operator: op, Argument: arg, Reason: fmt.Sprintf(`field name %q must not begin with "$"`, name)}
+	}
+	return nil
+}
+
+// numberValue converts a Go numeric value to a float64.
+func numberValue(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// integerValue converts a Go integer value to an int64.
+func integerValue(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	}
+	return 0, false
+}
+
+// dateValue converts a time.Time or bson.DateTime to a time.Time.
+func dateValue(v any) (time.Time, bool) {
+	switch d := v.(type) {
+	case time.Time:
+		return d, true
+	case bson.DateTime:
+		return d.Time(), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,143 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestDensify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("dates", func(t *testing.T) {
+		t.Parallel()
+
+		stage := Densify(DensifyArgs{
+			Field:             "ts",
+			PartitionByFields: []string{"sensor"},
+			Step:              1,
+			Unit:              Hour,
+			Bounds: ExplicitBounds(
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			),
+		})
+
+		want := `{"x": {"$densify": {` +
+			`"field": "ts",` +
+			`"partitionByFields": ["sensor"],` +
+			`"range": {"step": {"$numberInt":"1"},"unit": "hour",` +
+			`"bounds": [{"$date":{"$numberLong":"1735689600000"}},{"$date":{"$numberLong":"1735776000000"}}]}}}}`
+		assert.Equal(t, want, marshalDoc(t, stage))
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+		t.Parallel()
+
+		stage := Densify(DensifyArgs{
+			Field:  "altitude",
+			Step:   0.5,
+			Bounds: FullBounds(),
+		})
+
+		want := `{"x": {"$densify": {"field": "altitude","range": {"step": {"$numberDouble":"0.5"},"bounds": "full"}}}}`
+		assert.Equal(t, want, marshalDoc(t, stage))
+	})
+}
+
+func TestDensifyValidation(t *testing.T) {
+	t.Parallel()
+
+	start := bson.NewDateTimeFromTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := bson.NewDateTimeFromTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name string
+		args DensifyArgs
+		want error
+	}{
+		{
+			name: "missing field",
+			args: DensifyArgs{Step: 1, Bounds: FullBounds()},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "field", Reason: "field names must not be empty"},
+		},
+		{
+			name: "field path",
+			args: DensifyArgs{Field: "$ts", Step: 1, Bounds: FullBounds()},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "field", Reason: `field name "$ts" must not begin with "$"`},
+		},
+		{
+			name: "partition by densified field",
+			args: DensifyArgs{Field: "ts", PartitionByFields: []string{"ts"}, Step: 1, Bounds: FullBounds()},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "partitionByFields", Reason: "must not contain the densified field"},
+		},
+		{
+			name: "non-positive step",
+			args: DensifyArgs{Field: "v", Step: 0, Bounds: FullBounds()},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "step", Reason: "must be a positive number"},
+		},
+		{
+			name: "fractional step with unit",
+			args: DensifyArgs{Field: "ts", Step: 1.5, Unit: Hour, Bounds: FullBounds()},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "step", Reason: "must be an integer when unit is set"},
+		},
+		{
+			name: "invalid unit",
+			args: DensifyArgs{Field: "ts", Step: 1, Unit: "fortnight", Bounds: FullBounds()},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "unit", Reason: "unknown time unit fortnight"},
+		},
+		{
+			name: "missing bounds",
+			args: DensifyArgs{Field: "v", Step: 1},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "bounds", Reason: "must be set"},
+		},
+		{
+			name: "partition bounds without partition fields",
+			args: DensifyArgs{Field: "v", Step: 1, Bounds: PartitionBounds()},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "bounds", Reason: `"partition" bounds require partitionByFields`},
+		},
+		{
+			name: "date bounds without unit",
+			args: DensifyArgs{Field: "ts", Step: 1, Bounds: ExplicitBounds(start, end)},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "bounds", Reason: "must be numbers when unit is not set"},
+		},
+		{
+			name: "numeric bounds with unit",
+			args: DensifyArgs{Field: "ts", Step: 1, Unit: Day, Bounds: ExplicitBounds(0, 10)},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "bounds", Reason: "must be dates when unit is set"},
+		},
+		{
+			name: "reversed date bounds",
+			args: DensifyArgs{Field: "ts", Step: 1, Unit: Day, Bounds: ExplicitBounds(end, start)},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "bounds", Reason: "lower bound must be before upper bound"},
+		},
+		{
+			name: "empty numeric bounds",
+			args: DensifyArgs{Field: "v", Step: 1, Bounds: ExplicitBounds(5, 5)},
+			want: InvalidArgumentError{Operator: "$densify", Argument: "bounds", Reason: "lower bound 5 must be less than upper bound 5"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := densifySpec(tc.args)
+			assert.Equal(t, tc.want, err)
+
+			_, err = bson.Marshal(Densify(tc.args))
+			require.Error(t, err, "expected marshal error")
+		})
+	}
+}
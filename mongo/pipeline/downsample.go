@@ -0,0 +1,173 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// DownsampleOutput is a single output field of a downsampling pipeline.
+type DownsampleOutput struct {
+	// Field is the name of the output field. It is required.
+	Field string
+
+	// Accumulator is the $group accumulator that combines the values of each
+	// bucket, such as Avg or Max. It is required.
+	Accumulator Expr
+}
+
+// DownsampleArgs are the arguments to Downsample.
+type DownsampleArgs struct {
+	// TimeField is the field containing the time of each document, such as the
+	// timeField of a time-series collection. It is required.
+	TimeField string
+
+	// MetaField is the field identifying the series of each document, such as
+	// the metaField of a time-series collection. If set, each series is
+	// downsampled separately. It is optional.
+	MetaField string
+
+	// Unit and BinSize are the size of the time buckets. Unit is required. If
+	// BinSize is zero, buckets are one unit long.
+	Unit    TimeUnit
+	BinSize int
+
+	// Timezone is the timezone used to compute bucket boundaries. If nil, UTC
+	// is used.
+	Timezone any
+
+	// StartOfWeek is the first day of week buckets. It may only be set when
+	// Unit is Week.
+	StartOfWeek Weekday
+
+	// Output is the list of fields to compute for each bucket. It must not be
+	// empty.
+	Output []DownsampleOutput
+}
+
+// Downsample returns the stages of a pipeline that groups documents into time
+// buckets with $dateTrunc and computes the outputs for each bucket and series
+// with $group. The documents it returns have TimeField set to the start of the
+// bucket, MetaField set to the series if MetaField is set, and the output
+// fields, and are sorted by series and time. For example, hourly averages of
+// sensor readings:
+//
+//	stages := pipeline.Downsample(pipeline.DownsampleArgs{
+//		TimeField: "ts",
+//		MetaField: "sensor",
+//		Unit:      pipeline.Hour,
+//		Output: []pipeline.DownsampleOutput{
+//			{Field: "temp", Accumulator: pipeline.Avg("$temp")},
+//			{Field: "maxTemp", Accumulator: pipeline.Max("$temp")},
+//		},
+//	})
+//	cursor, err := coll.Aggregate(ctx, stages)
+//
+// The stages may be preceded by a $match stage to select the time range and
+// followed by a $merge stage to store the result. Invalid arguments are
+// reported when the stages are marshaled.
+func Downsample(args DownsampleArgs) []bson.D {
+	stages, err := downsampleStages(args)
+	if err != nil {
+		return []bson.D{{{Key: "$group", Value: invalid(err)}}}
+	}
+	return stages
+}
+
+func downsampleStages(args DownsampleArgs) ([]bson.D, error) {
+	const op = "$group"
+
+	if err := checkFieldName(op, "timeField", args.TimeField); err != nil {
+		return nil, err
+	}
+	if args.MetaField != "" {
+		if err := checkFieldName(op, "metaField", args.MetaField); err != nil {
+			return nil, err
+		}
+		if args.MetaField == args.TimeField {
+			return nil, InvalidArgumentError{Operator: op, Argument: "metaField", Reason: "must not be the same as timeField"}
+		}
+	}
+	if args.BinSize < 0 {
+		return nil, InvalidArgumentError{Operator: "$dateTrunc", Argument: "binSize", Reason: "must not be negative"}
+	}
+	if len(args.Output) == 0 {
+		return nil, InvalidArgumentError{Operator: op, Argument: "output", Reason: "must contain at least one field"}
+	}
+
+	var binSize any
+	if args.BinSize > 0 {
+		binSize = args.BinSize
+	}
+	bucket := DateTrunc(DateTruncArgs{
+		Date:        "$" + args.TimeField,
+		Unit:        args.Unit,
+		BinSize:     binSize,
+		Timezone:    args.Timezone,
+		StartOfWeek: args.StartOfWeek,
+	})
+	if err := bucket.Err(); err != nil {
+		return nil, err
+	}
+
+	id := bson.D{{Key: "time", Value: bucket}}
+	project := bson.D{{Key: "_id", Value: 0}, {Key: args.TimeField, Value: "$_id.time"}}
+	sort := bson.D{{Key: args.TimeField, Value: 1}}
+	if args.MetaField != "" {
+		id = append(id, bson.E{Key: "meta", Value: "$" + args.MetaField})
+		project = append(project, bson.E{Key: args.MetaField, Value: "$_id.meta"})
+		sort = append(bson.D{{Key: args.MetaField, Value: 1}}, sort...)
+	}
+
+	group := bson.D{{Key: "_id", Value: id}}
+	seen := make(map[string]struct{}, len(args.Output))
+	for _, out := range args.Output {
+		if err := checkOutputField(args, out.Field); err != nil {
+			return nil, err
+		}
+		if _, ok := seen[out.Field]; ok {
+			return nil, InvalidArgumentError{Operator: op, Argument: "output", Reason: fmt.Sprintf("duplicate field %q", out.Field)}
+		}
+		seen[out.Field] = struct{}{}
+
+		if err := out.Accumulator.Err(); err != nil {
+			return nil, err
+		}
+		name := operatorName(out.Accumulator)
+		if _, windowOnly := windowOperatorRules[name]; name == "" || windowOnly {
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: "must be a $group accumulator expression"}
+		}
+		group = append(group, bson.E{Key: out.Field, Value: out.Accumulator})
+		project = append(project, bson.E{Key: out.Field, Value: 1})
+	}
+
+	return []bson.D{
+		{{Key: "$group", Value: group}},
+		{{Key: "$project", Value: project}},
+		{{Key: "$sort", Value: sort}},
+	}, nil
+}
+
+// checkOutputField returns an error if name cannot be used as an output field
+// of a downsampling pipeline.
+func checkOutputField(args DownsampleArgs, name string) error {
+	const op = "$group"
+
+	if err := checkFieldName(op, "output", name); err != nil {
+		return err
+	}
+	if strings.Contains(name, ".") {
+		return InvalidArgumentError{Operator: op, Argument: "output", Reason: fmt.Sprintf("field name %q must not contain \".\"", name)}
+	}
+	if name == "_id" || name == args.TimeField || name == args.MetaField {
+		return InvalidArgumentError{Operator: op, Argument: "output", Reason: fmt.Sprintf("field name %q is reserved", name)}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestDownsample(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with meta field", func(t *testing.T) {
+		t.Parallel()
+
+		stages := Downsample(DownsampleArgs{
+			TimeField: "ts",
+			MetaField: "sensor",
+			Unit:      Minute,
+			BinSize:   15,
+			Output: []DownsampleOutput{
+				{Field: "temp", Accumulator: Avg("$temp")},
+				{Field: "maxTemp", Accumulator: Max("$temp")},
+			},
+		})
+
+		want := `{"x": [` +
+			`{"$group": {"_id": {"time": {"$dateTrunc": {"date": "$ts","unit": "minute","binSize": {"$numberInt":"15"}}},"meta": "$sensor"},` +
+			`"temp": {"$avg": "$temp"},"maxTemp": {"$max": "$temp"}}},` +
+			`{"$project": {"_id": {"$numberInt":"0"},"ts": "$_id.time","sensor": "$_id.meta","temp": {"$numberInt":"1"},"maxTemp": {"$numberInt":"1"}}},` +
+			`{"$sort": {"sensor": {"$numberInt":"1"},"ts": {"$numberInt":"1"}}}]}`
+		assert.Equal(t, want, marshalDoc(t, stages))
+	})
+	t.Run("without meta field", func(t *testing.T) {
+		t.Parallel()
+
+		stages := Downsample(DownsampleArgs{
+			TimeField:   "ts",
+			Unit:        Week,
+			StartOfWeek: Monday,
+			Output:      []DownsampleOutput{{Field: "n", Accumulator: Count()}},
+		})
+
+		want := `{"x": [` +
+			`{"$group": {"_id": {"time": {"$dateTrunc": {"date": "$ts","unit": "week","startOfWeek": "monday"}}},"n": {"$count": {}}}},` +
+			`{"$project": {"_id": {"$numberInt":"0"},"ts": "$_id.time","n": {"$numberInt":"1"}}},` +
+			`{"$sort": {"ts": {"$numberInt":"1"}}}]}`
+		assert.Equal(t, want, marshalDoc(t, stages))
+	})
+}
+
+func TestDownsampleValidation(t *testing.T) {
+	t.Parallel()
+
+	output := []DownsampleOutput{{Field: "v", Accumulator: Avg("$v")}}
+
+	testCases := []struct {
+		name string
+		args DownsampleArgs
+		want error
+	}{
+		{
+			name: "no time field",
+			args: DownsampleArgs{Unit: Hour, Output: output},
+			want: InvalidArgumentError{Operator: "$group", Argument: "timeField", Reason: "field names must not be empty"},
+		},
+		{
+			name: "meta field is time field",
+			args: DownsampleArgs{TimeField: "ts", MetaField: "ts", Unit: Hour, Output: output},
+			want: InvalidArgumentError{Operator: "$group", Argument: "metaField", Reason: "must not be the same as timeField"},
+		},
+		{
+			name: "unknown unit",
+			args: DownsampleArgs{TimeField: "ts", Unit: "fortnight", Output: output},
+			want: InvalidArgumentError{Operator: "$dateTrunc", Argument: "unit", Reason: "unknown time unit fortnight"},
+		},
+		{
+			name: "negative bin size",
+			args: DownsampleArgs{TimeField: "ts", Unit: Hour, BinSize: -1, Output: output},
+			want: InvalidArgumentError{Operator: "$dateTrunc", Argument: "binSize", Reason: "must not be negative"},
+		},
+		{
+			name: "empty output",
+			args: DownsampleArgs{TimeField: "ts", Unit: Hour},
+			want: InvalidArgumentError{Operator: "$group", Argument: "output", Reason: "must contain at least one field"},
+		},
+		{
+			name: "reserved output field",
+			args: DownsampleArgs{TimeField: "ts", Unit: Hour, Output: []DownsampleOutput{{Field: "ts", Accumulator: Max("$ts")}}},
+			want: InvalidArgumentError{Operator: "$group", Argument: "output", Reason: `field name "ts" is reserved`},
+		},
+		{
+			name: "dotted output field",
+			args: DownsampleArgs{TimeField: "ts", Unit: Hour, Output: []DownsampleOutput{{Field: "a.b", Accumulator: Max("$v")}}},
+			want: InvalidArgumentError{Operator: "$group", Argument: "output", Reason: `field name "a.b" must not contain "."`},
+		},
+		{
+			name: "duplicate output field",
+			args: DownsampleArgs{TimeField: "ts", Unit: Hour, Output: append(output, output...)},
+			want: InvalidArgumentError{Operator: "$group", Argument: "output", Reason: `duplicate field "v"`},
+		},
+		{
+			name: "window operator",
+			args: DownsampleArgs{TimeField: "ts", Unit: Hour, Output: []DownsampleOutput{{Field: "r", Accumulator: Rank()}}},
+			want: InvalidArgumentError{Operator: "$group", Argument: "r", Reason: "must be a $group accumulator expression"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := downsampleStages(tc.args)
+			assert.Equal(t, tc.want, err)
+
+			_, err = bson.Marshal(bson.D{{Key: "x", Value: Downsample(tc.args)}})
+			require.Error(t, err, "expected marshal error")
+		})
+	}
+}
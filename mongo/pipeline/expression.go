@@ -0,0 +1,446 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package pipeline provides typed builders for MongoDB aggregation expressions
+// and pipeline stages.
+//
+// Expressions built with this package implement bson.ValueMarshaler, so they
+// can be used anywhere a value is accepted in a bson.D, including inside
+// mongo.Pipeline stages. Validation errors, such as an incorrect operand count,
+// are recorded on the expression and returned when it is marshaled or by
+// calling Err.
+//
+// Example usage:
+//
+//	mongo.Pipeline{
+//		pipeline.MatchExpr(pipeline.Gt(pipeline.Field("spent"), pipeline.Field("budget"))),
+//		{{"$project", bson.D{
+//			{"day", pipeline.DateTrunc(pipeline.DateTruncArgs{Date: "$ts", Unit: pipeline.Day})},
+//		}}},
+//	}
+//
+// For more information about aggregation expressions, see
+// https://www.mongodb.com/docs/manual/meta/aggregation-quick-reference/#expressions
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Expr is an aggregation expression. The zero value is not a valid
+// expression; use the constructor functions in this package to create one.
+type Expr struct {
+	val any
+	err error
+}
+
+var _ bson.ValueMarshaler = Expr{}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface. It returns
+// any error recorded while the expression was built.
+func (e Expr) MarshalBSONValue() (byte, []byte, error) {
+	if e.err != nil {
+		return 0, nil, e.err
+	}
+	if e.val == nil {
+		return 0, nil, ErrEmptyExpr
+	}
+
+	typ, data, err := bson.MarshalValue(e.val)
+	return byte(typ), data, err
+}
+
+// Err returns the first validation error recorded while building the
+// expression or any of its operands, or nil if the expression is valid.
+func (e Expr) Err() error {
+	if e.err == nil && e.val == nil {
+		return ErrEmptyExpr
+	}
+	return e.err
+}
+
+// Value returns the BSON-marshalable representation of the expression.
+func (e Expr) Value() any {
+	return e.val
+}
+
+// ErrEmptyExpr is returned when a zero-value Expr is marshaled.
+var ErrEmptyExpr = errors.New("pipeline: empty expression")
+
+// OperandCountError is returned when an operator is given an unsupported
+// number of operands.
+type OperandCountError struct {
+	Operator string
+	Got      int
+	Min      int
+	Max      int // -1 means unbounded
+}
+
+// Error implements the error interface.
+func (e OperandCountError) Error() string {
+	var want string
+	switch {
+	case e.Min == e.Max:
+		want = fmt.Sprintf("exactly %d", e.Min)
+	case e.Max < 0:
+		want = fmt.Sprintf("at least %d", e.Min)
+	default:
+		want = fmt.Sprintf("between %d and %d", e.Min, e.Max)
+	}
+	return fmt.Sprintf("pipeline: %s requires %s operand(s), got %d", e.Operator, want, e.Got)
+}
+
+// InvalidArgumentError is returned when an operator argument has an invalid
+// value.
+type InvalidArgumentError struct {
+	Operator string
+	Argument string
+	Reason   string
+}
+
+// Error implements the error interface.
+func (e InvalidArgumentError) Error() string {
+	return fmt.Sprintf("pipeline: invalid %q argument to %s: %s", e.Argument, e.Operator, e.Reason)
+}
+
+// errOf returns the first error recorded on any Expr in vals.
+func errOf(vals ...any) error {
+	for _, v := range vals {
+		switch t := v.(type) {
+		case Expr:
+			if t.err != nil {
+				return t.err
+			}
+		case bson.A:
+			if err := errOf(t...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// invalid returns an Expr that records err.
+func invalid(err error) Expr {
+	return Expr{err: err}
+}
+
+// operator builds {op: [args...]} after checking that the number of operands
+// is within [minArgs, maxArgs]. A negative maxArgs means unbounded.
+func operator(op string, minArgs, maxArgs int, args []any) Expr {
+	if len(args) < minArgs || (maxArgs >= 0 && len(args) > maxArgs) {
+		return invalid(OperandCountError{Operator: op, Got: len(args), Min: minArgs, Max: maxArgs})
+	}
+	if err := errOf(args...); err != nil {
+		return invalid(err)
+	}
+
+	arr := make(bson.A, len(args))
+	copy(arr, args)
+	return Expr{val: bson.D{{Key: op, Value: arr}}}
+}
+
+// unary builds {op: arg}.
+func unary(op string, arg any) Expr {
+	if err := errOf(arg); err != nil {
+		return invalid(err)
+	}
+	return Expr{val: bson.D{{Key: op, Value: arg}}}
+}
+
+// named builds {op: {k1: v1, ...}}, omitting elements with nil values.
+func named(op string, elems bson.D) Expr {
+	doc := make(bson.D, 0, len(elems))
+	for _, e := range elems {
+		if e.Value == nil {
+			continue
+		}
+		if err := errOf(e.Value); err != nil {
+			return invalid(err)
+		}
+		doc = append(doc, e)
+	}
+	return Expr{val: bson.D{{Key: op, Value: doc}}}
+}
+
+// Field returns an expression referencing the given field path. A leading "$"
+// is added if it is not already present.
+func Field(path string) Expr {
+	if path == "" || path == "$" {
+		return invalid(InvalidArgumentError{Operator: "Field", Argument: "path", Reason: "must not be empty"})
+	}
+	if !strings.HasPrefix(path, "$") {
+		path = "$" + path
+	}
+	return Expr{val: path}
+}
+
+// Var returns an expression referencing the given variable, such as "ROOT"
+// or a variable bound by $let, $map, or $filter. A leading "$$" is added if
+// it is not already present.
+func Var(name string) Expr {
+	name = strings.TrimPrefix(name, "$$")
+	if name == "" {
+		return invalid(InvalidArgumentError{Operator: "Var", Argument: "name", Reason: "must not be empty"})
+	}
+	return Expr{val: "$$" + name}
+}
+
+// Literal returns an expression that evaluates to v without parsing it as an
+// expression. Use it for strings that begin with "$" or documents that would
+// otherwise be interpreted as operators.
+func Literal(v any) Expr {
+	return unary("$literal", v)
+}
+
+// Raw wraps an arbitrary BSON-marshalable value, such as a bson.D containing
+// an operator this package does not provide a constructor for.
+func Raw(v any) Expr {
+	if v == nil {
+		return invalid(InvalidArgumentError{Operator: "Raw", Argument: "v", Reason: "must not be nil"})
+	}
+	return Expr{val: v}
+}
+
+// Op builds an expression for an arbitrary operator with positional operands,
+// for operators this package does not provide a constructor for.
+func Op(name string, args ...any) Expr {
+	if !strings.HasPrefix(name, "$") {
+		return invalid(InvalidArgumentError{Operator: name, Argument: "name", Reason: `operator names must begin with "$"`})
+	}
+	return operator(name, 0, -1, args)
+}
+
+// MatchExpr returns a $match stage that filters documents using the given
+// expression through the $expr query operator.
+func MatchExpr(e Expr) bson.D {
+	return bson.D{{Key: "$match", Value: bson.D{{Key: "$expr", Value: e}}}}
+}
+
+// Arithmetic operators.
+
+// Add returns an $add expression.
+func Add(args ...any) Expr { return operator("$add", 1, -1, args) }
+
+// Subtract returns a $subtract expression.
+func Subtract(a, b any) Expr { return operator("$subtract", 2, 2, []any{a, b}) }
+
+// Multiply returns a $multiply expression.
+func Multiply(args ...any) Expr { return operator("$multiply", 1, -1, args) }
+
+// Divide returns a $divide expression.
+func Divide(dividend, divisor any) Expr { return operator("$divide", 2, 2, []any{dividend, divisor}) }
+
+// Mod returns a $mod expression.
+func Mod(dividend, divisor any) Expr { return operator("$mod", 2, 2, []any{dividend, divisor}) }
+
+// Pow returns a $pow expression.
+func Pow(base, exponent any) Expr { return operator("$pow", 2, 2, []any{base, exponent}) }
+
+// Abs returns an $abs expression.
+func Abs(n any) Expr { return unary("$abs", n) }
+
+// Ceil returns a $ceil expression.
+func Ceil(n any) Expr { return unary("$ceil", n) }
+
+// Floor returns a $floor expression.
+func Floor(n any) Expr { return unary("$floor", n) }
+
+// Sqrt returns a $sqrt expression.
+func Sqrt(n any) Expr { return unary("$sqrt", n) }
+
+// Round returns a $round expression. At most one place argument may be given.
+func Round(n any, place ...any) Expr { return operator("$round", 1, 2, append([]any{n}, place...)) }
+
+// Trunc returns a $trunc expression. At most one place argument may be given.
+func Trunc(n any, place ...any) Expr { return operator("$trunc", 1, 2, append([]any{n}, place...)) }
+
+// Comparison and boolean operators.
+
+// Eq returns an $eq expression.
+func Eq(a, b any) Expr { return operator("$eq", 2, 2, []any{a, b}) }
+
+// Ne returns a $ne expression.
+func Ne(a, b any) Expr { return operator("$ne", 2, 2, []any{a, b}) }
+
+// Gt returns a $gt expression.
+func Gt(a, b any) Expr { return operator("$gt", 2, 2, []any{a, b}) }
+
+// Gte returns a $gte expression.
+func Gte(a, b any) Expr { return operator("$gte", 2, 2, []any{a, b}) }
+
+// Lt returns an $lt expression.
+func Lt(a, b any) Expr { return operator("$lt", 2, 2, []any{a, b}) }
+
+// Lte returns an $lte expression.
+func Lte(a, b any) Expr { return operator("$lte", 2, 2, []any{a, b}) }
+
+// Cmp returns a $cmp expression.
+func Cmp(a, b any) Expr { return operator("$cmp", 2, 2, []any{a, b}) }
+
+// And returns an $and expression.
+func And(args ...any) Expr { return operator("$and", 1, -1, args) }
+
+// Or returns an $or expression.
+func Or(args ...any) Expr { return operator("$or", 1, -1, args) }
+
+// Not returns a $not expression.
+func Not(arg any) Expr { return operator("$not", 1, 1, []any{arg}) }
+
+// Conditional operators.
+
+// Cond returns a $cond expression.
+func Cond(ifExpr, thenExpr, elseExpr any) Expr {
+	return operator("$cond", 3, 3, []any{ifExpr, thenExpr, elseExpr})
+}
+
+// IfNull returns an $ifNull expression. The last argument is the replacement
+// value used when all preceding arguments evaluate to null or are missing.
+func IfNull(args ...any) Expr { return operator("$ifNull", 2, -1, args) }
+
+// SwitchBranch is a single case of a $switch expression.
+type SwitchBranch struct {
+	Case any
+	Then any
+}
+
+// Switch returns a $switch expression. If def is nil, no default is set and
+// the server returns an error when no branch matches.
+func Switch(branches []SwitchBranch, def any) Expr {
+	if len(branches) == 0 {
+		return invalid(OperandCountError{Operator: "$switch", Got: 0, Min: 1, Max: -1})
+	}
+
+	arr := make(bson.A, 0, len(branches))
+	for _, b := range branches {
+		if b.Case == nil || b.Then == nil {
+			return invalid(InvalidArgumentError{Operator: "$switch", Argument: "branches", Reason: "each branch requires both Case and Then"})
+		}
+		if err := errOf(b.Case, b.Then); err != nil {
+			return invalid(err)
+		}
+		arr = append(arr, bson.D{{Key: "case", Value: b.Case}, {Key: "then", Value: b.Then}})
+	}
+	return named("$switch", bson.D{{Key: "branches", Value: arr}, {Key: "default", Value: def}})
+}
+
+// String operators.
+
+// Concat returns a $concat expression.
+func Concat(args ...any) Expr { return operator("$concat", 1, -1, args) }
+
+// ToLower returns a $toLower expression.
+func ToLower(s any) Expr { return unary("$toLower", s) }
+
+// ToUpper returns a $toUpper expression.
+func ToUpper(s any) Expr { return unary("$toUpper", s) }
+
+// StrLenCP returns a $strLenCP expression.
+func StrLenCP(s any) Expr { return unary("$strLenCP", s) }
+
+// SubstrCP returns a $substrCP expression.
+func SubstrCP(s, index, count any) Expr { return operator("$substrCP", 3, 3, []any{s, index, count}) }
+
+// Split returns a $split expression.
+func Split(s, delimiter any) Expr { return operator("$split", 2, 2, []any{s, delimiter}) }
+
+// Trim returns a $trim expression. If chars is nil, whitespace is trimmed.
+func Trim(input, chars any) Expr {
+	return named("$trim", bson.D{{Key: "input", Value: input}, {Key: "chars", Value: chars}})
+}
+
+// RegexMatch returns a $regexMatch expression. If options is nil, no options
+// are sent.
+func RegexMatch(input, regex, options any) Expr {
+	return named("$regexMatch", bson.D{
+		{Key: "input", Value: input},
+		{Key: "regex", Value: regex},
+		{Key: "options", Value: options},
+	})
+}
+
+// Array operators.
+
+// ArrayElemAt returns an $arrayElemAt expression.
+func ArrayElemAt(array, index any) Expr { return operator("$arrayElemAt", 2, 2, []any{array, index}) }
+
+// ConcatArrays returns a $concatArrays expression.
+func ConcatArrays(arrays ...any) Expr { return operator("$concatArrays", 1, -1, arrays) }
+
+// In returns an $in aggregation expression that reports whether value is an
+// element of array.
+func In(value, array any) Expr { return operator("$in", 2, 2, []any{value, array}) }
+
+// Size returns a $size expression.
+func Size(array any) Expr { return unary("$size", array) }
+
+// Slice returns a $slice expression. It accepts either (array, n) or
+// (array, position, n).
+func Slice(array any, args ...any) Expr {
+	return operator("$slice", 2, 3, append([]any{array}, args...))
+}
+
+// Filter returns a $filter expression. If as is empty, the server default of
+// "this" is used. If limit is nil, all matching elements are returned.
+func Filter(input any, as string, cond any, limit any) Expr {
+	if cond == nil {
+		return invalid(InvalidArgumentError{Operator: "$filter", Argument: "cond", Reason: "must not be nil"})
+	}
+	var asVal any
+	if as != "" {
+		asVal = as
+	}
+	return named("$filter", bson.D{
+		{Key: "input", Value: input},
+		{Key: "as", Value: asVal},
+		{Key: "cond", Value: cond},
+		{Key: "limit", Value: limit},
+	})
+}
+
+// Map returns a $map expression. If as is empty, the server default of
+// "this" is used.
+func Map(input any, as string, in any) Expr {
+	if in == nil {
+		return invalid(InvalidArgumentError{Operator: "$map", Argument: "in", Reason: "must not be nil"})
+	}
+	var asVal any
+	if as != "" {
+		asVal = as
+	}
+	return named("$map", bson.D{{Key: "input", Value: input}, {Key: "as", Value: asVal}, {Key: "in", Value: in}})
+}
+
+// Reduce returns a $reduce expression.
+func Reduce(input, initialValue, in any) Expr {
+	if initialValue == nil || in == nil {
+		return invalid(InvalidArgumentError{Operator: "$reduce", Argument: "initialValue/in", Reason: "must not be nil"})
+	}
+	return named("$reduce", bson.D{
+		{Key: "input", Value: input},
+		{Key: "initialValue", Value: initialValue},
+		{Key: "in", Value: in},
+	})
+}
+
+// Variable operators.
+
+// Let returns a $let expression binding vars for use in the in expression.
+func Let(vars bson.D, in any) Expr {
+	if len(vars) == 0 {
+		return invalid(InvalidArgumentError{Operator: "$let", Argument: "vars", Reason: "must define at least one variable"})
+	}
+	for _, v := range vars {
+		if err := errOf(v.Value); err != nil {
+			return invalid(err)
+		}
+	}
+	return named("$let", bson.D{{Key: "vars", Value: vars}, {Key: "in", Value: in}})
+}
@@ -0,0 +1,155 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+// marshalDoc marshals v into a document and returns its relaxed extended JSON
+// form for comparison.
+func marshalDoc(t *testing.T, v any) string {
+	t.Helper()
+
+	b, err := bson.Marshal(bson.D{{Key: "x", Value: v}})
+	require.NoError(t, err, "Marshal error")
+
+	return bson.Raw(b).String()
+}
+
+func TestExpr(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		expr Expr
+		want string
+	}{
+		{
+			name: "field",
+			expr: Field("a.b"),
+			want: `{"x": "$a.b"}`,
+		},
+		{
+			name: "variable",
+			expr: Var("ROOT"),
+			want: `{"x": "$$ROOT"}`,
+		},
+		{
+			name: "nested arithmetic",
+			expr: Add(Field("a"), Multiply("$b", int32(2))),
+			want: `{"x": {"$add": ["$a",{"$multiply": ["$b",{"$numberInt":"2"}]}]}}`,
+		},
+		{
+			name: "cond",
+			expr: Cond(Gte("$qty", int32(250)), int32(30), int32(20)),
+			want: `{"x": {"$cond": [{"$gte": ["$qty",{"$numberInt":"250"}]},{"$numberInt":"30"},{"$numberInt":"20"}]}}`,
+		},
+		{
+			name: "switch with default",
+			expr: Switch([]SwitchBranch{{Case: Eq("$a", int32(1)), Then: "one"}}, "other"),
+			want: `{"x": {"$switch": {"branches": [{"case": {"$eq": ["$a",{"$numberInt":"1"}]},"then": "one"}],"default": "other"}}}`,
+		},
+		{
+			name: "filter omits unset arguments",
+			expr: Filter("$items", "", Gt("$$this.price", int32(10)), nil),
+			want: `{"x": {"$filter": {"input": "$items","cond": {"$gt": ["$$this.price",{"$numberInt":"10"}]}}}}`,
+		},
+		{
+			name: "dateTrunc",
+			expr: DateTrunc(DateTruncArgs{Date: "$ts", Unit: Week, StartOfWeek: Monday}),
+			want: `{"x": {"$dateTrunc": {"date": "$ts","unit": "week","startOfWeek": "monday"}}}`,
+		},
+		{
+			name: "literal",
+			expr: Literal("$notAField"),
+			want: `{"x": {"$literal": "$notAField"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.NoError(t, tc.expr.Err(), "Err error")
+			assert.Equal(t, tc.want, marshalDoc(t, tc.expr))
+		})
+	}
+}
+
+func TestExprValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		expr Expr
+		want error
+	}{
+		{
+			name: "too few operands",
+			expr: IfNull("$a"),
+			want: OperandCountError{Operator: "$ifNull", Got: 1, Min: 2, Max: -1},
+		},
+		{
+			name: "too many operands",
+			expr: Round("$a", int32(1), int32(2)),
+			want: OperandCountError{Operator: "$round", Got: 3, Min: 1, Max: 2},
+		},
+		{
+			name: "empty switch",
+			expr: Switch(nil, nil),
+			want: OperandCountError{Operator: "$switch", Got: 0, Min: 1, Max: -1},
+		},
+		{
+			name: "error propagates from operand",
+			expr: Add(int32(1), Subtract(Op("$foo"), Round("$a", 1, 2, 3))),
+			want: OperandCountError{Operator: "$round", Got: 4, Min: 1, Max: 2},
+		},
+		{
+			name: "invalid unit",
+			expr: DateTrunc(DateTruncArgs{Date: "$ts", Unit: "fortnight"}),
+			want: InvalidArgumentError{Operator: "$dateTrunc", Argument: "unit", Reason: "unknown time unit fortnight"},
+		},
+		{
+			name: "startOfWeek requires week unit",
+			expr: DateTrunc(DateTruncArgs{Date: "$ts", Unit: Day, StartOfWeek: Monday}),
+			want: InvalidArgumentError{Operator: "$dateTrunc", Argument: "startOfWeek", Reason: "may only be set when unit is week"},
+		},
+		{
+			name: "zero value",
+			expr: Expr{},
+			want: ErrEmptyExpr,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, tc.expr.Err())
+
+			_, err := bson.Marshal(bson.D{{Key: "x", Value: tc.expr}})
+			assert.True(t, errors.Is(err, tc.want), "expected marshal error %v, got %v", tc.want, err)
+		})
+	}
+}
+
+func TestMatchExpr(t *testing.T) {
+	t.Parallel()
+
+	stage := MatchExpr(Gt(Field("spent"), Field("budget")))
+	assert.Equal(t, `{"x": {"$match": {"$expr": {"$gt": ["$spent","$budget"]}}}}`, marshalDoc(t, stage))
+}
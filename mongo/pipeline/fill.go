@@ -0,0 +1,135 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// FillMethod is a method used by a $fill stage to compute missing values.
+type FillMethod string
+
+// These are the fill methods supported by the server.
+const (
+	// FillLinear fills missing values using linear interpolation between the
+	// surrounding non-null values.
+	FillLinear FillMethod = "linear"
+
+	// FillLocf fills missing values with the last non-null value.
+	FillLocf FillMethod = "locf"
+)
+
+// FillOutput is a single output field of a $fill stage. Exactly one of Value
+// and Method must be set.
+type FillOutput struct {
+	Field  string
+	Value  any
+	Method FillMethod
+}
+
+// FillArgs are the arguments to a $fill stage.
+type FillArgs struct {
+	// PartitionBy is an expression used to group documents before filling. It
+	// must not be set together with PartitionByFields.
+	PartitionBy any
+
+	// PartitionByFields are the fields used to group documents before filling.
+	// It must not be set together with PartitionBy.
+	PartitionByFields []string
+
+	// SortBy is the sort order within each partition. It is required when any
+	// output uses a Method.
+	SortBy bson.D
+
+	// Output is the list of fields to fill. It must not be empty.
+	Output []FillOutput
+}
+
+// Fill returns a $fill stage. Invalid arguments, such as a fill method without
+// a sortBy, are reported when the stage is marshaled.
+//
+// For more information about $fill, see
+// https://www.mongodb.com/docs/manual/reference/operator/aggregation/fill/
+func Fill(args FillArgs) bson.D {
+	spec, err := fillSpec(args)
+	if err != nil {
+		return bson.D{{Key: "$fill", Value: invalid(err)}}
+	}
+	return bson.D{{Key: "$fill", Value: spec}}
+}
+
+func fillSpec(args FillArgs) (bson.D, error) {
+	const op = "$fill"
+
+	if args.PartitionBy != nil && len(args.PartitionByFields) > 0 {
+		return nil, InvalidArgumentError{Operator: op, Argument: "partitionBy", Reason: "must not be set together with partitionByFields"}
+	}
+	if err := errOf(args.PartitionBy); err != nil {
+		return nil, err
+	}
+	for _, f := range args.PartitionByFields {
+		if err := checkFieldName(op, "partitionByFields", f); err != nil {
+			return nil, err
+		}
+	}
+	if len(args.Output) == 0 {
+		return nil, InvalidArgumentError{Operator: op, Argument: "output", Reason: "must contain at least one field"}
+	}
+
+	seen := make(map[string]struct{}, len(args.Output))
+	output := make(bson.D, 0, len(args.Output))
+	for _, out := range args.Output {
+		if err := checkFieldName(op, "output", out.Field); err != nil {
+			return nil, err
+		}
+		if _, ok := seen[out.Field]; ok {
+			return nil, InvalidArgumentError{Operator: op, Argument: "output", Reason: fmt.Sprintf("duplicate field %q", out.Field)}
+		}
+		seen[out.Field] = struct{}{}
+
+		switch {
+		case out.Value != nil && out.Method != "":
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: "value and method must not both be set"}
+		case out.Value != nil:
+			if err := errOf(out.Value); err != nil {
+				return nil, err
+			}
+			output = append(output, bson.E{Key: out.Field, Value: bson.D{{Key: "value", Value: out.Value}}})
+		case out.Method == FillLinear || out.Method == FillLocf:
+			if len(args.SortBy) == 0 {
+				return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: fmt.Sprintf("method %q requires sortBy", out.Method)}
+			}
+			if out.Method == FillLinear {
+				if len(args.SortBy) != 1 {
+					return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: `method "linear" requires sortBy on exactly one field`}
+				}
+				if args.SortBy[0].Key == out.Field {
+					return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: `method "linear" cannot fill the sortBy field`}
+				}
+			}
+			output = append(output, bson.E{Key: out.Field, Value: bson.D{{Key: "method", Value: string(out.Method)}}})
+		case out.Method != "":
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: fmt.Sprintf("unknown method %q", out.Method)}
+		default:
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: "one of value or method must be set"}
+		}
+	}
+
+	doc := bson.D{}
+	if args.PartitionBy != nil {
+		doc = append(doc, bson.E{Key: "partitionBy", Value: args.PartitionBy})
+	}
+	if len(args.PartitionByFields) > 0 {
+		doc = append(doc, bson.E{Key: "partitionByFields", Value: args.PartitionByFields})
+	}
+	if len(args.SortBy) > 0 {
+		doc = append(doc, bson.E{Key: "sortBy", Value: args.SortBy})
+	}
+	return append(doc, bson.E{Key: "output", Value: output}), nil
+}
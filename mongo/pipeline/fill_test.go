@@ -0,0 +1,119 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestFill(t *testing.T) {
+	t.Parallel()
+
+	stage := Fill(FillArgs{
+		PartitionByFields: []string{"sensor"},
+		SortBy:            bson.D{{Key: "ts", Value: 1}},
+		Output: []FillOutput{
+			{Field: "temp", Method: FillLinear},
+			{Field: "status", Method: FillLocf},
+			{Field: "count", Value: 0},
+		},
+	})
+
+	want := `{"x": {"$fill": {` +
+		`"partitionByFields": ["sensor"],` +
+		`"sortBy": {"ts": {"$numberInt":"1"}},` +
+		`"output": {"temp": {"method": "linear"},"status": {"method": "locf"},"count": {"value": {"$numberInt":"0"}}}}}}`
+	assert.Equal(t, want, marshalDoc(t, stage))
+}
+
+func TestFillValidation(t *testing.T) {
+	t.Parallel()
+
+	sortBy := bson.D{{Key: "ts", Value: 1}}
+
+	testCases := []struct {
+		name string
+		args FillArgs
+		want error
+	}{
+		{
+			name: "empty output",
+			args: FillArgs{},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "output", Reason: "must contain at least one field"},
+		},
+		{
+			name: "partitionBy and partitionByFields",
+			args: FillArgs{
+				PartitionBy:       "$sensor",
+				PartitionByFields: []string{"sensor"},
+				Output:            []FillOutput{{Field: "v", Value: 0}},
+			},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "partitionBy", Reason: "must not be set together with partitionByFields"},
+		},
+		{
+			name: "duplicate output",
+			args: FillArgs{Output: []FillOutput{{Field: "v", Value: 0}, {Field: "v", Value: 1}}},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "output", Reason: `duplicate field "v"`},
+		},
+		{
+			name: "value and method",
+			args: FillArgs{SortBy: sortBy, Output: []FillOutput{{Field: "v", Value: 0, Method: FillLocf}}},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "v", Reason: "value and method must not both be set"},
+		},
+		{
+			name: "neither value nor method",
+			args: FillArgs{Output: []FillOutput{{Field: "v"}}},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "v", Reason: "one of value or method must be set"},
+		},
+		{
+			name: "unknown method",
+			args: FillArgs{SortBy: sortBy, Output: []FillOutput{{Field: "v", Method: "nearest"}}},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "v", Reason: `unknown method "nearest"`},
+		},
+		{
+			name: "locf without sortBy",
+			args: FillArgs{Output: []FillOutput{{Field: "v", Method: FillLocf}}},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "v", Reason: `method "locf" requires sortBy`},
+		},
+		{
+			name: "linear with multiple sort keys",
+			args: FillArgs{
+				SortBy: bson.D{{Key: "a", Value: 1}, {Key: "b", Value: 1}},
+				Output: []FillOutput{{Field: "v", Method: FillLinear}},
+			},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "v", Reason: `method "linear" requires sortBy on exactly one field`},
+		},
+		{
+			name: "linear on sort field",
+			args: FillArgs{SortBy: sortBy, Output: []FillOutput{{Field: "ts", Method: FillLinear}}},
+			want: InvalidArgumentError{Operator: "$fill", Argument: "ts", Reason: `method "linear" cannot fill the sortBy field`},
+		},
+		{
+			name: "invalid value expression",
+			args: FillArgs{Output: []FillOutput{{Field: "v", Value: Add()}}},
+			want: OperandCountError{Operator: "$add", Got: 0, Min: 1, Max: -1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := fillSpec(tc.args)
+			assert.Equal(t, tc.want, err)
+
+			_, err = bson.Marshal(Fill(tc.args))
+			require.Error(t, err, "expected marshal error")
+		})
+	}
+}
@@ -0,0 +1,433 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// ServerCapabilities describes the server a pipeline will be sent to.
+type ServerCapabilities struct {
+	// MaxWireVersion is the maxWireVersion reported by the server in its "hello"
+	// response. If it is zero, stages are not checked against the server
+	// version.
+	MaxWireVersion int32
+}
+
+// LintIssue is a single problem found by Lint.
+type LintIssue struct {
+	// Stage is the index of the stage in the pipeline.
+	Stage int
+
+	// Name is the name of the stage, such as "$match".
+	Name string
+
+	// Message describes the problem.
+	Message string
+}
+
+// Error implements the error interface.
+func (li LintIssue) Error() string {
+	if li.Name == "" {
+		return fmt.Sprintf("stage %d: %s", li.Stage, li.Message)
+	}
+	return fmt.Sprintf("stage %d (%s): %s", li.Stage, li.Name, li.Message)
+}
+
+// LintError is returned by Lint when one or more problems are found.
+type LintError struct {
+	Issues []LintIssue
+}
+
+// Error implements the error interface.
+func (le LintError) Error() string {
+	msgs := make([]string, 0, len(le.Issues))
+	for _, issue := range le.Issues {
+		msgs = append(msgs, issue.Error())
+	}
+	return "invalid pipeline: " + strings.Join(msgs, "; ")
+}
+
+// stageInfo describes the constraints on a pipeline stage.
+type stageInfo struct {
+	minWireVersion int32
+	since          string // server version that introduced the stage
+	first          bool   // must be the first stage
+	last           bool   // must be the last stage
+}
+
+// knownStages lists the aggregation stages known to Lint.
+var knownStages = map[string]stageInfo{
+	"$addFields":                   {},
+	"$bucket":                      {},
+	"$bucketAuto":                  {},
+	"$changeStream":                {first: true},
+	"$changeStreamSplitLargeEvent": {minWireVersion: 21, since: "7.0", last: true},
+	"$collStats":                   {first: true},
+	"$count":                       {},
+	"$currentOp":                   {first: true},
+	"$densify":                     {minWireVersion: 14, since: "5.1"},
+	"$documents":                   {minWireVersion: 14, since: "5.1", first: true},
+	"$facet":                       {},
+	"$fill":                        {minWireVersion: 16, since: "5.3"},
+	"$geoNear":                     {first: true},
+	"$graphLookup":                 {},
+	"$group":                       {},
+	"$indexStats":                  {first: true},
+	"$limit":                       {},
+	"$listLocalSessions":           {first: true},
+	"$listClusterCatalog":          {minWireVersion: 26, since: "8.1", first: true},
+	"$listSampledQueries":          {minWireVersion: 21, since: "7.0", first: true},
+	"$listSearchIndexes":           {minWireVersion: 21, since: "7.0", first: true},
+	"$listSessions":                {first: true},
+	"$lookup":                      {},
+	"$match":                       {},
+	"$merge":                       {minWireVersion: 8, since: "4.2", last: true},
+	"$out":                         {last: true},
+	"$planCacheStats":              {minWireVersion: 8, since: "4.2", first: true},
+	"$project":                     {},
+	"$querySettings":               {minWireVersion: 25, since: "8.0", first: true},
+	"$queryStats":                  {minWireVersion: 21, since: "7.0.12", first: true},
+	"$rankFusion":                  {minWireVersion: 26, since: "8.1"},
+	"$redact":                      {},
+	"$replaceRoot":                 {},
+	"$replaceWith":                 {minWireVersion: 8, since: "4.2"},
+	"$sample":                      {},
+	"$scoreFusion":                 {minWireVersion: 27, since: "8.2"},
+	"$search":                      {first: true},
+	"$searchMeta":                  {first: true},
+	"$set":                         {minWireVersion: 8, since: "4.2"},
+	"$setWindowFields":             {minWireVersion: 13, since: "5.0"},
+	"$shardedDataDistribution":     {minWireVersion: 17, since: "6.0", first: true},
+	"$skip":                        {},
+	"$sort":                        {},
+	"$sortByCount":                 {},
+	"$unionWith":                   {minWireVersion: 9, since: "4.4"},
+	"$unset":                       {minWireVersion: 8, since: "4.2"},
+	"$unwind":                      {},
+	"$vectorSearch":                {minWireVersion: 21, since: "7.0", first: true},
+}
+
+// Lint checks stages for common mistakes before the pipeline is sent to the
+// server. It reports:
+//
+//   - stages that are not documents with exactly one key
+//   - unknown stage names
+//   - invalid stages created with the builders in this package
+//   - stages that must be first or last, such as $changeStream or $out, in
+//     the wrong position
+//   - $search, $searchMeta, and $vectorSearch stages that are not the first
+//     stage of a $lookup or $unionWith pipeline, or that are inside $facet
+//   - $match stages that filter on fields removed by an earlier $project
+//   - stages that are not supported by the server described by caps
+//
+// Lint returns nil if no problems are found, or a LintError otherwise. A
+// mongo.Pipeline can be passed directly as stages.
+func Lint(stages []bson.D, caps ServerCapabilities) error {
+	l := linter{caps: caps, n: len(stages)}
+	for i, stage := range stages {
+		l.stage(i, stage)
+	}
+	if len(l.issues) == 0 {
+		return nil
+	}
+	return LintError{Issues: l.issues}
+}
+
+type linter struct {
+	caps   ServerCapabilities
+	n      int
+	issues []LintIssue
+
+	// fields is the set of fields known to exist after the stages seen so
+	// far. It is nil if they are unknown.
+	fields *fieldSet
+}
+
+func (l *linter) report(i int, name, format string, args ...any) {
+	l.issues = append(l.issues, LintIssue{Stage: i, Name: name, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) stage(i int, stage bson.D) {
+	if len(stage) != 1 {
+		l.report(i, "", "stage must be a document with exactly one key, got %d", len(stage))
+		l.fields = nil
+		return
+	}
+
+	name, spec := stage[0].Key, stage[0].Value
+	info, ok := knownStages[name]
+	if !ok {
+		l.report(i, name, "unknown stage")
+		l.fields = nil
+		return
+	}
+	if err := errOf(spec); err != nil {
+		l.report(i, name, "%v", err)
+	}
+	if info.first && i != 0 {
+		l.report(i, name, "must be the first stage in the pipeline")
+	}
+	if info.last && i != l.n-1 {
+		l.report(i, name, "must be the last stage in the pipeline")
+	}
+	if info.minWireVersion > 0 && l.caps.MaxWireVersion > 0 && l.caps.MaxWireVersion < info.minWireVersion {
+		l.report(i, name, "requires server version %s or later", info.since)
+	}
+	l.checkSubPipelines(i, name, spec)
+
+	switch name {
+	case "$project":
+		l.fields = projectFields(spec)
+	case "$addFields", "$set":
+		if l.fields != nil {
+			for _, key := range docKeys(spec) {
+				l.fields.add(key)
+			}
+		}
+	case "$match":
+		l.checkMatch(i, spec)
+	case "$limit", "$skip", "$sort", "$sample":
+		// These stages do not change the shape of documents.
+	default:
+		l.fields = nil
+	}
+}
+
+func (l *linter) checkMatch(i int, spec any) {
+	if l.fields == nil {
+		return
+	}
+	for _, path := range matchPaths(spec) {
+		if !l.fields.has(path) {
+			l.report(i, "$match", "field %q was removed by an earlier $project", path)
+		}
+	}
+}
+
+// searchStages are the stages that must be the first stage of any pipeline,
+// including the pipelines of $lookup and $unionWith, and cannot be used inside
+// $facet.
+var searchStages = map[string]bool{
+	"$search":       true,
+	"$searchMeta":   true,
+	"$vectorSearch": true,
+}
+
+// checkSubPipelines reports misplaced search stages in the pipelines nested in
+// a $facet, $lookup, or $unionWith stage.
+func (l *linter) checkSubPipelines(i int, name string, spec any) {
+	switch name {
+	case "$facet":
+		doc, _ := asDoc(spec)
+		for _, e := range doc {
+			for _, stage := range stagesOf(e.Value) {
+				if key := stageName(stage); searchStages[key] {
+					l.report(i, name, "%s cannot be used inside $facet", key)
+				}
+			}
+		}
+	case "$lookup", "$unionWith":
+		doc, _ := asDoc(spec)
+		for _, e := range doc {
+			if e.Key != "pipeline" {
+				continue
+			}
+			for j, stage := range stagesOf(e.Value) {
+				if key := stageName(stage); searchStages[key] && j != 0 {
+					l.report(i, name, "%s must be the first stage in the %s pipeline", key, name)
+				}
+			}
+		}
+	}
+}
+
+// stagesOf converts a nested pipeline, such as a bson.A of bson.D or a
+// mongo.Pipeline, to a []bson.D. Stages that are not bson.D are skipped.
+func stagesOf(v any) []bson.D {
+	if arr, ok := v.(bson.A); ok {
+		stages := make([]bson.D, 0, len(arr))
+		for _, elem := range arr {
+			if stage, ok := asDoc(elem); ok {
+				stages = append(stages, stage)
+			}
+		}
+		return stages
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || !rv.Type().ConvertibleTo(tStages) {
+		return nil
+	}
+	return rv.Convert(tStages).Interface().([]bson.D)
+}
+
+var tStages = reflect.TypeOf([]bson.D(nil))
+
+// stageName returns the name of a stage, or "" if it does not have exactly
+// one key.
+func stageName(stage bson.D) string {
+	if len(stage) != 1 {
+		return ""
+	}
+	return stage[0].Key
+}
+
+// fieldSet records the fields included or excluded by a $project stage.
+type fieldSet struct {
+	include bool
+	paths   []string
+}
+
+// add records that path exists.
+func (fs *fieldSet) add(path string) {
+	if fs.include {
+		fs.paths = append(fs.paths, path)
+		return
+	}
+	paths := fs.paths[:0]
+	for _, p := range fs.paths {
+		if p != path {
+			paths = append(paths, p)
+		}
+	}
+	fs.paths = paths
+}
+
+// has reports whether path may exist after the projection.
+func (fs *fieldSet) has(path string) bool {
+	for _, p := range fs.paths {
+		related := p == path || strings.HasPrefix(path, p+".")
+		if fs.include && (related || strings.HasPrefix(p, path+".")) {
+			return true
+		}
+		if !fs.include && related {
+			return false
+		}
+	}
+	return !fs.include
+}
+
+// projectFields returns the fields that exist after a $project stage with the
+// given specification, or nil if they cannot be determined.
+func projectFields(spec any) *fieldSet {
+	doc, ok := asDoc(spec)
+	if !ok {
+		return nil
+	}
+
+	fs := &fieldSet{}
+	keepID := true
+	var included, excluded []string
+	for _, e := range doc {
+		if e.Key == "_id" {
+			keepID = truthy(e.Value)
+			continue
+		}
+		if excludes(e.Value) {
+			excluded = append(excluded, e.Key)
+		} else {
+			included = append(included, e.Key)
+		}
+	}
+
+	switch {
+	case len(included) > 0:
+		fs.include = true
+		fs.paths = included
+		if keepID {
+			fs.paths = append(fs.paths, "_id")
+		}
+	case len(excluded) > 0:
+		fs.paths = excluded
+		if !keepID {
+			fs.paths = append(fs.paths, "_id")
+		}
+	default:
+		// Only _id was specified.
+		if keepID {
+			return nil
+		}
+		fs.paths = []string{"_id"}
+	}
+	return fs
+}
+
+// excludes reports whether a $project value excludes a field.
+func excludes(v any) bool {
+	switch t := v.(type) {
+	case bool:
+		return !t
+	case int, int32, int64, float32, float64:
+		n, _ := numberValue(t)
+		return n == 0
+	}
+	return false
+}
+
+// truthy reports whether a $project value for _id keeps the field.
+func truthy(v any) bool {
+	return !excludes(v)
+}
+
+// matchPaths returns the field paths filtered on by a $match specification.
+// Paths inside $expr and other operators are not included.
+func matchPaths(spec any) []string {
+	doc, ok := asDoc(spec)
+	if !ok {
+		return nil
+	}
+
+	var paths []string
+	for _, e := range doc {
+		switch e.Key {
+		case "$and", "$or", "$nor":
+			if arr, ok := e.Value.(bson.A); ok {
+				for _, sub := range arr {
+					paths = append(paths, matchPaths(sub)...)
+				}
+			}
+		default:
+			if !strings.HasPrefix(e.Key, "$") {
+				paths = append(paths, e.Key)
+			}
+		}
+	}
+	return paths
+}
+
+// docKeys returns the top-level keys of a document.
+func docKeys(v any) []string {
+	doc, _ := asDoc(v)
+	keys := make([]string, 0, len(doc))
+	for _, e := range doc {
+		keys = append(keys, e.Key)
+	}
+	return keys
+}
+
+// asDoc converts a bson.D or bson.M to a bson.D. The keys of a bson.M are
+// sorted so that results are deterministic.
+func asDoc(v any) (bson.D, bool) {
+	switch t := v.(type) {
+	case bson.D:
+		return t, true
+	case bson.M:
+		doc := make(bson.D, 0, len(t))
+		for k, v := range t {
+			doc = append(doc, bson.E{Key: k, Value: v})
+		}
+		sort.Slice(doc, func(i, j int) bool { return doc[i].Key < doc[j].Key })
+		return doc, true
+	}
+	return nil, false
+}
@@ -0,0 +1,220 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		stages []bson.D
+		caps   ServerCapabilities
+		want   []LintIssue
+	}{
+		{
+			name: "valid",
+			stages: []bson.D{
+				{{Key: "$match", Value: bson.D{{Key: "status", Value: "A"}}}},
+				{{Key: "$project", Value: bson.D{{Key: "status", Value: 1}, {Key: "total", Value: "$amount"}}}},
+				{{Key: "$set", Value: bson.D{{Key: "doubled", Value: Multiply("$total", 2)}}}},
+				{{Key: "$match", Value: bson.D{{Key: "doubled", Value: bson.D{{Key: "$gt", Value: 10}}}}}},
+				{{Key: "$merge", Value: "out"}},
+			},
+			caps: ServerCapabilities{MaxWireVersion: 21},
+		},
+		{
+			name: "unknown stage",
+			stages: []bson.D{
+				{{Key: "$matches", Value: bson.D{}}},
+			},
+			want: []LintIssue{{Stage: 0, Name: "$matches", Message: "unknown stage"}},
+		},
+		{
+			name: "multiple keys",
+			stages: []bson.D{
+				{{Key: "$match", Value: bson.D{}}, {Key: "$limit", Value: 1}},
+			},
+			want: []LintIssue{{Stage: 0, Message: "stage must be a document with exactly one key, got 2"}},
+		},
+		{
+			name: "out not last",
+			stages: []bson.D{
+				{{Key: "$out", Value: "coll"}},
+				{{Key: "$limit", Value: 1}},
+			},
+			want: []LintIssue{{Stage: 0, Name: "$out", Message: "must be the last stage in the pipeline"}},
+		},
+		{
+			name: "changeStream not first",
+			stages: []bson.D{
+				{{Key: "$match", Value: bson.D{}}},
+				{{Key: "$changeStream", Value: bson.D{}}},
+			},
+			want: []LintIssue{{Stage: 1, Name: "$changeStream", Message: "must be the first stage in the pipeline"}},
+		},
+		{
+			name: "match on field removed by inclusion projection",
+			stages: []bson.D{
+				{{Key: "$project", Value: bson.D{{Key: "a", Value: 1}, {Key: "_id", Value: 0}}}},
+				{{Key: "$sort", Value: bson.D{{Key: "a", Value: 1}}}},
+				{{Key: "$match", Value: bson.D{
+					{Key: "a.b", Value: 1},
+					{Key: "$or", Value: bson.A{bson.D{{Key: "b", Value: 1}}, bson.D{{Key: "_id", Value: 1}}}},
+				}}},
+			},
+			want: []LintIssue{
+				{Stage: 2, Name: "$match", Message: `field "b" was removed by an earlier $project`},
+				{Stage: 2, Name: "$match", Message: `field "_id" was removed by an earlier $project`},
+			},
+		},
+		{
+			name: "match on field removed by exclusion projection",
+			stages: []bson.D{
+				{{Key: "$project", Value: bson.M{"secret": 0}}},
+				{{Key: "$match", Value: bson.M{"secret.key": "x", "public": 1}}},
+			},
+			want: []LintIssue{{Stage: 1, Name: "$match", Message: `field "secret.key" was removed by an earlier $project`}},
+		},
+		{
+			name: "field restored after exclusion",
+			stages: []bson.D{
+				{{Key: "$project", Value: bson.D{{Key: "a", Value: false}}}},
+				{{Key: "$addFields", Value: bson.D{{Key: "a", Value: 1}}}},
+				{{Key: "$match", Value: bson.D{{Key: "a", Value: 1}}}},
+			},
+		},
+		{
+			name: "shape unknown after group",
+			stages: []bson.D{
+				{{Key: "$project", Value: bson.D{{Key: "a", Value: 1}}}},
+				{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$a"}, {Key: "n", Value: Count()}}}},
+				{{Key: "$match", Value: bson.D{{Key: "n", Value: bson.D{{Key: "$gt", Value: 1}}}}}},
+			},
+		},
+		{
+			name: "newer server stages",
+			stages: []bson.D{
+				{{Key: "$rankFusion", Value: bson.D{{Key: "input", Value: bson.D{{Key: "pipelines", Value: bson.D{
+					{Key: "text", Value: bson.A{Search(SearchArgs{Operator: bson.D{{Key: "exists", Value: bson.D{}}}})}},
+				}}}}}}},
+				{{Key: "$limit", Value: 10}},
+			},
+			caps: ServerCapabilities{MaxWireVersion: 26},
+		},
+		{
+			name: "newer server stages without server version",
+			stages: []bson.D{
+				{{Key: "$queryStats", Value: bson.D{}}},
+				{{Key: "$scoreFusion", Value: bson.D{}}},
+			},
+		},
+		{
+			name: "newer server stages unsupported by server",
+			stages: []bson.D{
+				{{Key: "$listClusterCatalog", Value: bson.D{}}},
+				{{Key: "$scoreFusion", Value: bson.D{}}},
+			},
+			caps: ServerCapabilities{MaxWireVersion: 25},
+			want: []LintIssue{
+				{Stage: 0, Name: "$listClusterCatalog", Message: "requires server version 8.1 or later"},
+				{Stage: 1, Name: "$scoreFusion", Message: "requires server version 8.2 or later"},
+			},
+		},
+		{
+			name: "unsupported by server",
+			stages: []bson.D{
+				{{Key: "$setWindowFields", Value: bson.D{}}},
+				{{Key: "$unionWith", Value: "other"}},
+			},
+			caps: ServerCapabilities{MaxWireVersion: 8},
+			want: []LintIssue{
+				{Stage: 0, Name: "$setWindowFields", Message: "requires server version 5.0 or later"},
+				{Stage: 1, Name: "$unionWith", Message: "requires server version 4.4 or later"},
+			},
+		},
+		{
+			name: "search stages",
+			stages: []bson.D{
+				VectorSearch(VectorSearchArgs{Index: "v", Path: "embedding", QueryVector: []float64{1}, Limit: 1, Exact: true}),
+				{{Key: "$unionWith", Value: bson.D{
+					{Key: "coll", Value: "other"},
+					{Key: "pipeline", Value: []bson.D{Search(SearchArgs{Operator: bson.D{{Key: "exists", Value: bson.D{}}}})}},
+				}}},
+			},
+			caps: ServerCapabilities{MaxWireVersion: 21},
+		},
+		{
+			name: "search not first",
+			stages: []bson.D{
+				{{Key: "$match", Value: bson.D{}}},
+				Search(SearchArgs{Operator: bson.D{{Key: "exists", Value: bson.D{}}}}),
+			},
+			want: []LintIssue{{Stage: 1, Name: "$search", Message: "must be the first stage in the pipeline"}},
+		},
+		{
+			name: "search in nested pipelines",
+			stages: []bson.D{
+				{{Key: "$lookup", Value: bson.D{
+					{Key: "from", Value: "other"},
+					{Key: "pipeline", Value: bson.A{
+						bson.D{{Key: "$match", Value: bson.D{}}},
+						bson.D{{Key: "$vectorSearch", Value: bson.D{}}},
+					}},
+					{Key: "as", Value: "matches"},
+				}}},
+				{{Key: "$facet", Value: bson.D{
+					{Key: "meta", Value: bson.A{bson.D{{Key: "$searchMeta", Value: bson.D{}}}}},
+				}}},
+			},
+			want: []LintIssue{
+				{Stage: 0, Name: "$lookup", Message: "$vectorSearch must be the first stage in the $lookup pipeline"},
+				{Stage: 1, Name: "$facet", Message: "$searchMeta cannot be used inside $facet"},
+			},
+		},
+		{
+			name: "invalid builder stage",
+			stages: []bson.D{
+				Fill(FillArgs{}),
+			},
+			want: []LintIssue{{Stage: 0, Name: "$fill", Message: `pipeline: invalid "output" argument to $fill: must contain at least one field`}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Lint(tc.stages, tc.caps)
+			if tc.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, LintError{Issues: tc.want}, err)
+		})
+	}
+}
+
+func TestLintError(t *testing.T) {
+	t.Parallel()
+
+	err := LintError{Issues: []LintIssue{
+		{Stage: 0, Message: "stage must be a document with exactly one key, got 0"},
+		{Stage: 2, Name: "$out", Message: "must be the last stage in the pipeline"},
+	}}
+	want := "invalid pipeline: stage 0: stage must be a document with exactly one key, got 0; " +
+		"stage 2 ($out): must be the last stage in the pipeline"
+	assert.Equal(t, want, err.Error())
+}
@@ -0,0 +1,228 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// maxNumCandidates is the largest numCandidates value accepted by
+// $vectorSearch.
+const maxNumCandidates = 10000
+
+// SearchArgs are the arguments to a $search or $searchMeta stage. These
+// stages must be the first stage of a pipeline; Lint reports them anywhere
+// else.
+type SearchArgs struct {
+	// Index is the name of the Atlas Search index. If it is empty, the index
+	// named "default" is used.
+	Index string
+
+	// Operator is the search operator or collector, such as
+	// {"text": {"query": "coffee", "path": "title"}} or
+	// {"compound": {...}}. It is required and must have exactly one key.
+	Operator bson.D
+
+	// Count configures counting of matching documents, e.g.
+	// {"type": "total"}. It is optional.
+	Count any
+
+	// Highlight configures highlighting of matching terms, e.g.
+	// {"path": "title"}. It is optional and not supported by $searchMeta.
+	Highlight any
+
+	// Sort is the sort order of the results. It is optional and not supported
+	// by $searchMeta.
+	Sort bson.D
+
+	// ReturnStoredSource returns only the fields stored on the search index
+	// instead of doing a full document lookup. It is not supported by
+	// $searchMeta.
+	ReturnStoredSource bool
+
+	// ScoreDetails adds the details of each document's score to its metadata.
+	// It is not supported by $searchMeta.
+	ScoreDetails bool
+}
+
+// Search returns a $search stage. Invalid arguments, such as a missing
+// operator, are reported when the stage is marshaled.
+//
+// For more information about $search, see
+// https://www.mongodb.com/docs/atlas/atlas-search/aggregation-stages/search/
+func Search(args SearchArgs) bson.D {
+	spec, err := searchSpec("$search", args)
+	if err != nil {
+		return bson.D{{Key: "$search", Value: invalid(err)}}
+	}
+	return bson.D{{Key: "$search", Value: spec}}
+}
+
+// SearchMeta returns a $searchMeta stage, which returns metadata such as the
+// count of matching documents instead of the documents themselves. Invalid
+// arguments are reported when the stage is marshaled.
+//
+// For more information about $searchMeta, see
+// https://www.mongodb.com/docs/atlas/atlas-search/aggregation-stages/searchMeta/
+func SearchMeta(args SearchArgs) bson.D {
+	spec, err := searchSpec("$searchMeta", args)
+	if err != nil {
+		return bson.D{{Key: "$searchMeta", Value: invalid(err)}}
+	}
+	return bson.D{{Key: "$searchMeta", Value: spec}}
+}
+
+func searchSpec(op string, args SearchArgs) (bson.D, error) {
+	if len(args.Operator) != 1 {
+		return nil, InvalidArgumentError{
+			Operator: op,
+			Argument: "operator",
+			Reason:   fmt.Sprintf("must have exactly one key, got %d", len(args.Operator)),
+		}
+	}
+	if err := errOf(args.Operator[0].Value, args.Count, args.Highlight); err != nil {
+		return nil, err
+	}
+	if op == "$searchMeta" {
+		switch {
+		case args.Highlight != nil:
+			return nil, InvalidArgumentError{Operator: op, Argument: "highlight", Reason: "not supported by $searchMeta"}
+		case len(args.Sort) > 0:
+			return nil, InvalidArgumentError{Operator: op, Argument: "sort", Reason: "not supported by $searchMeta"}
+		case args.ReturnStoredSource:
+			return nil, InvalidArgumentError{Operator: op, Argument: "returnStoredSource", Reason: "not supported by $searchMeta"}
+		case args.ScoreDetails:
+			return nil, InvalidArgumentError{Operator: op, Argument: "scoreDetails", Reason: "not supported by $searchMeta"}
+		}
+	}
+
+	doc := bson.D{}
+	if args.Index != "" {
+		doc = append(doc, bson.E{Key: "index", Value: args.Index})
+	}
+	doc = append(doc, args.Operator[0])
+	if args.Count != nil {
+		doc = append(doc, bson.E{Key: "count", Value: args.Count})
+	}
+	if args.Highlight != nil {
+		doc = append(doc, bson.E{Key: "highlight", Value: args.Highlight})
+	}
+	if len(args.Sort) > 0 {
+		doc = append(doc, bson.E{Key: "sort", Value: args.Sort})
+	}
+	if args.ReturnStoredSource {
+		doc = append(doc, bson.E{Key: "returnStoredSource", Value: true})
+	}
+	if args.ScoreDetails {
+		doc = append(doc, bson.E{Key: "scoreDetails", Value: true})
+	}
+	return doc, nil
+}
+
+// VectorSearchArgs are the arguments to a $vectorSearch stage. This stage
+// must be the first stage of a pipeline; Lint reports it anywhere else.
+type VectorSearchArgs struct {
+	// Index is the name of the Atlas Vector Search index. It is required.
+	Index string
+
+	// Path is the indexed vector field to search. It is required.
+	Path string
+
+	// QueryVector is the vector to search for, such as a []float64, a
+	// []float32, or a bson.Vector. Its number of dimensions must match the
+	// index. It is required.
+	QueryVector any
+
+	// NumCandidates is the number of nearest neighbors to consider in an
+	// approximate (ANN) search. It is required unless Exact is set, must be at
+	// least Limit, and at most 10000.
+	NumCandidates int
+
+	// Limit is the number of documents to return. It is required and must be
+	// positive.
+	Limit int
+
+	// Exact runs an exact nearest neighbor (ENN) search instead of an
+	// approximate one. NumCandidates must not be set when Exact is set.
+	Exact bool
+
+	// Filter is a match expression on the indexed filter fields used to
+	// pre-filter documents. It is optional.
+	Filter any
+}
+
+// VectorSearch returns a $vectorSearch stage. Invalid arguments, such as a
+// numCandidates less than the limit, are reported when the stage is
+// marshaled.
+//
+// For more information about $vectorSearch, see
+// https://www.mongodb.com/docs/atlas/atlas-vector-search/vector-search-stage/
+func VectorSearch(args VectorSearchArgs) bson.D {
+	spec, err := vectorSearchSpec(args)
+	if err != nil {
+		return bson.D{{Key: "$vectorSearch", Value: invalid(err)}}
+	}
+	return bson.D{{Key: "$vectorSearch", Value: spec}}
+}
+
+func vectorSearchSpec(args VectorSearchArgs) (bson.D, error) {
+	const op = "$vectorSearch"
+
+	if args.Index == "" {
+		return nil, InvalidArgumentError{Operator: op, Argument: "index", Reason: "must not be empty"}
+	}
+	if err := checkFieldName(op, "path", args.Path); err != nil {
+		return nil, err
+	}
+	if args.QueryVector == nil {
+		return nil, InvalidArgumentError{Operator: op, Argument: "queryVector", Reason: "must not be nil"}
+	}
+	if args.Limit <= 0 {
+		return nil, InvalidArgumentError{Operator: op, Argument: "limit", Reason: "must be positive"}
+	}
+	if args.Exact {
+		if args.NumCandidates != 0 {
+			return nil, InvalidArgumentError{Operator: op, Argument: "numCandidates", Reason: "must not be set when exact is true"}
+		}
+	} else {
+		if args.NumCandidates < args.Limit {
+			return nil, InvalidArgumentError{
+				Operator: op,
+				Argument: "numCandidates",
+				Reason:   fmt.Sprintf("must be at least the limit %d, got %d", args.Limit, args.NumCandidates),
+			}
+		}
+		if args.NumCandidates > maxNumCandidates {
+			return nil, InvalidArgumentError{
+				Operator: op,
+				Argument: "numCandidates",
+				Reason:   fmt.Sprintf("must be at most %d, got %d", maxNumCandidates, args.NumCandidates),
+			}
+		}
+	}
+	if err := errOf(args.Filter); err != nil {
+		return nil, err
+	}
+
+	doc := bson.D{
+		{Key: "index", Value: args.Index},
+		{Key: "path", Value: args.Path},
+		{Key: "queryVector", Value: args.QueryVector},
+	}
+	if args.Exact {
+		doc = append(doc, bson.E{Key: "exact", Value: true})
+	} else {
+		doc = append(doc, bson.E{Key: "numCandidates", Value: args.NumCandidates})
+	}
+	doc = append(doc, bson.E{Key: "limit", Value: args.Limit})
+	if args.Filter != nil {
+		doc = append(doc, bson.E{Key: "filter", Value: args.Filter})
+	}
+	return doc, nil
+}
@@ -0,0 +1,244 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("search", func(t *testing.T) {
+		t.Parallel()
+
+		stage := Search(SearchArgs{
+			Index: "movies",
+			Operator: bson.D{{Key: "text", Value: bson.D{
+				{Key: "query", Value: "baseball"},
+				{Key: "path", Value: "plot"},
+			}}},
+			Highlight:    bson.D{{Key: "path", Value: "plot"}},
+			Sort:         bson.D{{Key: "year", Value: -1}},
+			ScoreDetails: true,
+		})
+
+		want := `{"x": {"$search": {"index": "movies",` +
+			`"text": {"query": "baseball","path": "plot"},` +
+			`"highlight": {"path": "plot"},` +
+			`"sort": {"year": {"$numberInt":"-1"}},` +
+			`"scoreDetails": true}}}`
+		assert.Equal(t, want, marshalDoc(t, stage))
+	})
+
+	t.Run("searchMeta", func(t *testing.T) {
+		t.Parallel()
+
+		stage := SearchMeta(SearchArgs{
+			Operator: bson.D{{Key: "exists", Value: bson.D{{Key: "path", Value: "year"}}}},
+			Count:    bson.D{{Key: "type", Value: "total"}},
+		})
+
+		want := `{"x": {"$searchMeta": {"exists": {"path": "year"},"count": {"type": "total"}}}}`
+		assert.Equal(t, want, marshalDoc(t, stage))
+	})
+}
+
+func TestSearchValidation(t *testing.T) {
+	t.Parallel()
+
+	text := bson.D{{Key: "text", Value: bson.D{{Key: "query", Value: "q"}, {Key: "path", Value: "p"}}}}
+
+	testCases := []struct {
+		name string
+		op   string
+		args SearchArgs
+		want error
+	}{
+		{
+			name: "missing operator",
+			op:   "$search",
+			args: SearchArgs{Index: "default"},
+			want: InvalidArgumentError{Operator: "$search", Argument: "operator", Reason: "must have exactly one key, got 0"},
+		},
+		{
+			name: "multiple operators",
+			op:   "$search",
+			args: SearchArgs{Operator: append(text, bson.E{Key: "exists", Value: bson.D{}})},
+			want: InvalidArgumentError{Operator: "$search", Argument: "operator", Reason: "must have exactly one key, got 2"},
+		},
+		{
+			name: "searchMeta with highlight",
+			op:   "$searchMeta",
+			args: SearchArgs{Operator: text, Highlight: bson.D{{Key: "path", Value: "p"}}},
+			want: InvalidArgumentError{Operator: "$searchMeta", Argument: "highlight", Reason: "not supported by $searchMeta"},
+		},
+		{
+			name: "searchMeta with sort",
+			op:   "$searchMeta",
+			args: SearchArgs{Operator: text, Sort: bson.D{{Key: "year", Value: 1}}},
+			want: InvalidArgumentError{Operator: "$searchMeta", Argument: "sort", Reason: "not supported by $searchMeta"},
+		},
+		{
+			name: "invalid expression",
+			op:   "$search",
+			args: SearchArgs{Operator: bson.D{{Key: "text", Value: Add()}}},
+			want: OperandCountError{Operator: "$add", Got: 0, Min: 1, Max: -1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := searchSpec(tc.op, tc.args)
+			assert.Equal(t, tc.want, err)
+
+			stage := Search(tc.args)
+			if tc.op == "$searchMeta" {
+				stage = SearchMeta(tc.args)
+			}
+			_, err = bson.Marshal(stage)
+			require.Error(t, err, "expected marshal error")
+		})
+	}
+}
+
+func TestVectorSearch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("approximate", func(t *testing.T) {
+		t.Parallel()
+
+		stage := VectorSearch(VectorSearchArgs{
+			Index:         "vector_index",
+			Path:          "embedding",
+			QueryVector:   []float64{0.5, -1},
+			NumCandidates: 100,
+			Limit:         10,
+			Filter:        bson.D{{Key: "year", Value: bson.D{{Key: "$gt", Value: 2000}}}},
+		})
+
+		want := `{"x": {"$vectorSearch": {"index": "vector_index","path": "embedding",` +
+			`"queryVector": [{"$numberDouble":"0.5"},{"$numberDouble":"-1.0"}],` +
+			`"numCandidates": {"$numberInt":"100"},"limit": {"$numberInt":"10"},` +
+			`"filter": {"year": {"$gt": {"$numberInt":"2000"}}}}}}`
+		assert.Equal(t, want, marshalDoc(t, stage))
+	})
+
+	t.Run("bson.Vector", func(t *testing.T) {
+		t.Parallel()
+
+		stage := VectorSearch(VectorSearchArgs{
+			Index:         "vector_index",
+			Path:          "embedding",
+			QueryVector:   bson.NewVector([]float32{1}),
+			NumCandidates: 10,
+			Limit:         1,
+		})
+
+		want := `{"x": {"$vectorSearch": {"index": "vector_index","path": "embedding",` +
+			`"queryVector": {"$binary":{"base64":"JwAAAIA/","subType":"09"}},` +
+			`"numCandidates": {"$numberInt":"10"},"limit": {"$numberInt":"1"}}}}`
+		assert.Equal(t, want, marshalDoc(t, stage))
+	})
+
+	t.Run("exact", func(t *testing.T) {
+		t.Parallel()
+
+		stage := VectorSearch(VectorSearchArgs{
+			Index:       "vector_index",
+			Path:        "embedding",
+			QueryVector: []float64{1},
+			Limit:       5,
+			Exact:       true,
+		})
+
+		want := `{"x": {"$vectorSearch": {"index": "vector_index","path": "embedding",` +
+			`"queryVector": [{"$numberDouble":"1.0"}],"exact": true,"limit": {"$numberInt":"5"}}}}`
+		assert.Equal(t, want, marshalDoc(t, stage))
+	})
+}
+
+func TestVectorSearchValidation(t *testing.T) {
+	t.Parallel()
+
+	valid := VectorSearchArgs{
+		Index:         "vector_index",
+		Path:          "embedding",
+		QueryVector:   []float64{1},
+		NumCandidates: 100,
+		Limit:         10,
+	}
+	with := func(fn func(*VectorSearchArgs)) VectorSearchArgs {
+		args := valid
+		fn(&args)
+		return args
+	}
+
+	testCases := []struct {
+		name string
+		args VectorSearchArgs
+		want error
+	}{
+		{
+			name: "missing index",
+			args: with(func(a *VectorSearchArgs) { a.Index = "" }),
+			want: InvalidArgumentError{Operator: "$vectorSearch", Argument: "index", Reason: "must not be empty"},
+		},
+		{
+			name: "missing path",
+			args: with(func(a *VectorSearchArgs) { a.Path = "" }),
+			want: InvalidArgumentError{Operator: "$vectorSearch", Argument: "path", Reason: "field names must not be empty"},
+		},
+		{
+			name: "missing query vector",
+			args: with(func(a *VectorSearchArgs) { a.QueryVector = nil }),
+			want: InvalidArgumentError{Operator: "$vectorSearch", Argument: "queryVector", Reason: "must not be nil"},
+		},
+		{
+			name: "non-positive limit",
+			args: with(func(a *VectorSearchArgs) { a.Limit = 0 }),
+			want: InvalidArgumentError{Operator: "$vectorSearch", Argument: "limit", Reason: "must be positive"},
+		},
+		{
+			name: "numCandidates below limit",
+			args: with(func(a *VectorSearchArgs) { a.NumCandidates = 5 }),
+			want: InvalidArgumentError{Operator: "$vectorSearch", Argument: "numCandidates", Reason: "must be at least the limit 10, got 5"},
+		},
+		{
+			name: "numCandidates above maximum",
+			args: with(func(a *VectorSearchArgs) { a.NumCandidates = 10001 }),
+			want: InvalidArgumentError{Operator: "$vectorSearch", Argument: "numCandidates", Reason: "must be at most 10000, got 10001"},
+		},
+		{
+			name: "numCandidates with exact",
+			args: with(func(a *VectorSearchArgs) { a.Exact = true }),
+			want: InvalidArgumentError{Operator: "$vectorSearch", Argument: "numCandidates", Reason: "must not be set when exact is true"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := vectorSearchSpec(tc.args)
+			assert.Equal(t, tc.want, err)
+
+			_, err = bson.Marshal(VectorSearch(tc.args))
+			require.Error(t, err, "expected marshal error")
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"fmt"
+	"math"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// These are the special window bound values. They may be used as either the
+// lower or upper bound of a documents or range window.
+const (
+	Unbounded = "unbounded"
+	Current   = "current"
+)
+
+// Window specifies the documents a window operator is applied to within a
+// partition. Create one with DocumentsWindow or RangeWindow.
+type Window struct {
+	kind  string // "documents" or "range"
+	lower any
+	upper any
+	unit  TimeUnit
+}
+
+// DocumentsWindow returns a window whose bounds are document positions
+// relative to the current document. Each bound must be an integer, Unbounded,
+// or Current.
+func DocumentsWindow(lower, upper any) *Window {
+	return &Window{kind: "documents", lower: lower, upper: upper}
+}
+
+// RangeWindow returns a window whose bounds are values of the sortBy field
+// relative to the current document's value. Each bound must be a number,
+// Unbounded, or Current. If unit is set, the bounds are time offsets and the
+// sortBy field must be a date.
+func RangeWindow(lower, upper any, unit TimeUnit) *Window {
+	return &Window{kind: "range", lower: lower, upper: upper, unit: unit}
+}
+
+// boundValue returns the numeric position of a window bound, treating
+// "current" as 0 and "unbounded" as negative or positive infinity depending on
+// whether it is the lower or upper bound. The third return value is false if
+// the order of b cannot be determined, such as for a bson.Decimal128.
+func boundValue(b any, lower, integerOnly bool) (float64, bool, bool) {
+	switch v := b.(type) {
+	case string:
+		switch v {
+		case Unbounded:
+			if lower {
+				return math.Inf(-1), true, true
+			}
+			return math.Inf(1), true, true
+		case Current:
+			return 0, true, true
+		}
+	case int:
+		return float64(v), true, true
+	case int32:
+		return float64(v), true, true
+	case int64:
+		return float64(v), true, true
+	case float64:
+		return v, !integerOnly, true
+	case bson.Decimal128:
+		return 0, !integerOnly, false
+	}
+	return 0, false, false
+}
+
+func (w *Window) validate() error {
+	integerOnly := w.kind == "documents"
+	lo, okLo, ordLo := boundValue(w.lower, true, integerOnly)
+	hi, okHi, ordHi := boundValue(w.upper, false, integerOnly)
+	if !okLo || !okHi {
+		reason := `bounds must be numbers, "unbounded", or "current"`
+		if integerOnly {
+			reason = `bounds must be integers, "unbounded", or "current"`
+		}
+		return InvalidArgumentError{Operator: "$setWindowFields", Argument: w.kind, Reason: reason}
+	}
+	if ordLo && ordHi && lo > hi {
+		return InvalidArgumentError{
+			Operator: "$setWindowFields",
+			Argument: w.kind,
+			Reason:   fmt.Sprintf("lower bound %v is greater than upper bound %v", w.lower, w.upper),
+		}
+	}
+	if w.unit != "" {
+		if err := checkUnit("$setWindowFields", w.unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Window) document() bson.D {
+	doc := bson.D{{Key: w.kind, Value: bson.A{w.lower, w.upper}}}
+	if w.unit != "" {
+		doc = append(doc, bson.E{Key: "unit", Value: string(w.unit)})
+	}
+	return doc
+}
+
+// WindowOutput is a single output field of a $setWindowFields stage.
+type WindowOutput struct {
+	// Field is the name of the output field. It is required.
+	Field string
+
+	// Operator is the window operator, such as Sum or Rank. It is required.
+	Operator Expr
+
+	// Window is the window the operator is applied to. If nil, the operator is
+	// applied to the whole partition. Rank, DenseRank, DocumentNumber, Shift,
+	// Locf, LinearFill, and ExpMovingAvg do not accept a window.
+	Window *Window
+}
+
+// SetWindowFieldsArgs are the arguments to a $setWindowFields stage.
+type SetWindowFieldsArgs struct {
+	// PartitionBy is an expression used to group documents into partitions. If
+	// nil, the whole collection is a single partition.
+	PartitionBy any
+
+	// SortBy is the sort order within each partition. It is required by
+	// order-dependent operators and range windows.
+	SortBy bson.D
+
+	// Output is the list of fields to compute. It must not be empty.
+	Output []WindowOutput
+}
+
+// windowOperatorRules describes the constraints on window-only operators.
+var windowOperatorRules = map[string]struct {
+	requiresSort  bool
+	singleSortKey bool
+	noWindow      bool
+	needsWindow   bool
+}{
+	"$rank":           {requiresSort: true, singleSortKey: true, noWindow: true},
+	"$denseRank":      {requiresSort: true, singleSortKey: true, noWindow: true},
+	"$documentNumber": {requiresSort: true, noWindow: true},
+	"$shift":          {requiresSort: true, noWindow: true},
+	"$locf":           {requiresSort: true, noWindow: true},
+	"$linearFill":     {requiresSort: true, singleSortKey: true, noWindow: true},
+	"$expMovingAvg":   {requiresSort: true, noWindow: true},
+	"$derivative":     {requiresSort: true, singleSortKey: true, needsWindow: true},
+	"$integral":       {requiresSort: true, singleSortKey: true},
+}
+
+// operatorName returns the name of the top-level operator of e, or "" if e is
+// not an operator expression.
+func operatorName(e Expr) string {
+	if d, ok := e.val.(bson.D); ok && len(d) == 1 {
+		return d[0].Key
+	}
+	return ""
+}
+
+// SetWindowFields returns a $setWindowFields stage. Invalid arguments, such as
+// a rank operator without a sortBy or a range window with a non-numeric bound,
+// are reported when the stage is marshaled.
+func SetWindowFields(args SetWindowFieldsArgs) bson.D {
+	spec, err := setWindowFieldsSpec(args)
+	if err != nil {
+		return bson.D{{Key: "$setWindowFields", Value: invalid(err)}}
+	}
+	return bson.D{{Key: "$setWindowFields", Value: spec}}
+}
+
+func setWindowFieldsSpec(args SetWindowFieldsArgs) (bson.D, error) {
+	const op = "$setWindowFields"
+
+	if len(args.Output) == 0 {
+		return nil, InvalidArgumentError{Operator: op, Argument: "output", Reason: "must contain at least one field"}
+	}
+	if err := errOf(args.PartitionBy); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(args.Output))
+	output := make(bson.D, 0, len(args.Output))
+	for _, out := range args.Output {
+		if out.Field == "" {
+			return nil, InvalidArgumentError{Operator: op, Argument: "output", Reason: "field names must not be empty"}
+		}
+		if _, ok := seen[out.Field]; ok {
+			return nil, InvalidArgumentError{Operator: op, Argument: "output", Reason: fmt.Sprintf("duplicate field %q", out.Field)}
+		}
+		seen[out.Field] = struct{}{}
+
+		if err := out.Operator.Err(); err != nil {
+			return nil, err
+		}
+		name := operatorName(out.Operator)
+		if name == "" {
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: "operator must be a window operator expression"}
+		}
+
+		rule := windowOperatorRules[name]
+		if rule.requiresSort && len(args.SortBy) == 0 {
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: name + " requires sortBy"}
+		}
+		if rule.singleSortKey && len(args.SortBy) > 1 {
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: name + " requires sortBy on exactly one field"}
+		}
+		if rule.noWindow && out.Window != nil {
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: name + " does not accept a window"}
+		}
+		if rule.needsWindow && out.Window == nil {
+			return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: name + " requires a window"}
+		}
+
+		spec := append(bson.D{}, out.Operator.val.(bson.D)...)
+		if w := out.Window; w != nil {
+			if err := w.validate(); err != nil {
+				return nil, err
+			}
+			if w.kind == "range" && len(args.SortBy) != 1 {
+				return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: "range windows require sortBy on exactly one field"}
+			}
+			if w.kind == "documents" && len(args.SortBy) == 0 && !(w.lower == Unbounded && w.upper == Unbounded) {
+				return nil, InvalidArgumentError{Operator: op, Argument: out.Field, Reason: "bounded documents windows require sortBy"}
+			}
+			spec = append(spec, bson.E{Key: "window", Value: w.document()})
+		}
+		output = append(output, bson.E{Key: out.Field, Value: spec})
+	}
+
+	doc := bson.D{}
+	if args.PartitionBy != nil {
+		doc = append(doc, bson.E{Key: "partitionBy", Value: args.PartitionBy})
+	}
+	if len(args.SortBy) > 0 {
+		doc = append(doc, bson.E{Key: "sortBy", Value: args.SortBy})
+	}
+	return append(doc, bson.E{Key: "output", Value: output}), nil
+}
+
+// Accumulator and window operators.
+
+// Sum returns a $sum accumulator.
+func Sum(arg any) Expr { return unary("$sum", arg) }
+
+// Avg returns an $avg accumulator.
+func Avg(arg any) Expr { return unary("$avg", arg) }
+
+// Min returns a $min accumulator.
+func Min(arg any) Expr { return unary("$min", arg) }
+
+// Max returns a $max accumulator.
+func Max(arg any) Expr { return unary("$max", arg) }
+
+// First returns a $first accumulator.
+func First(arg any) Expr { return unary("$first", arg) }
+
+// Last returns a $last accumulator.
+func Last(arg any) Expr { return unary("$last", arg) }
+
+// Push returns a $push accumulator.
+func Push(arg any) Expr { return unary("$push", arg) }
+
+// AddToSet returns an $addToSet accumulator.
+func AddToSet(arg any) Expr { return unary("$addToSet", arg) }
+
+// StdDevPop returns a $stdDevPop accumulator.
+func StdDevPop(arg any) Expr { return unary("$stdDevPop", arg) }
+
+// StdDevSamp returns a $stdDevSamp accumulator.
+func StdDevSamp(arg any) Expr { return unary("$stdDevSamp", arg) }
+
+// Count returns a $count accumulator.
+func Count() Expr { return unary("$count", bson.D{}) }
+
+// CovariancePop returns a $covariancePop window operator.
+func CovariancePop(a, b any) Expr { return operator("$covariancePop", 2, 2, []any{a, b}) }
+
+// CovarianceSamp returns a $covarianceSamp window operator.
+func CovarianceSamp(a, b any) Expr { return operator("$covarianceSamp", 2, 2, []any{a, b}) }
+
+// Rank returns a $rank window operator.
+func Rank() Expr { return unary("$rank", bson.D{}) }
+
+// DenseRank returns a $denseRank window operator.
+func DenseRank() Expr { return unary("$denseRank", bson.D{}) }
+
+// DocumentNumber returns a $documentNumber window operator.
+func DocumentNumber() Expr { return unary("$documentNumber", bson.D{}) }
+
+// Locf returns a $locf window operator.
+func Locf(arg any) Expr { return unary("$locf", arg) }
+
+// LinearFill returns a $linearFill window operator.
+func LinearFill(arg any) Expr { return unary("$linearFill", arg) }
+
+// Shift returns a $shift window operator. If def is nil, null is used for
+// positions outside the partition.
+func Shift(output any, by int, def any) Expr {
+	if output == nil {
+		return invalid(InvalidArgumentError{Operator: "$shift", Argument: "output", Reason: "must not be nil"})
+	}
+	return named("$shift", bson.D{{Key: "output", Value: output}, {Key: "by", Value: by}, {Key: "default", Value: def}})
+}
+
+// Derivative returns a $derivative window operator. If unit is empty, the
+// sortBy field is treated as a number.
+func Derivative(input any, unit TimeUnit) Expr { return rateOperator("$derivative", input, unit) }
+
+// Integral returns an $integral window operator. If unit is empty, the sortBy
+// field is treated as a number.
+func Integral(input any, unit TimeUnit) Expr { return rateOperator("$integral", input, unit) }
+
+func rateOperator(op string, input any, unit TimeUnit) Expr {
+	if input == nil {
+		return invalid(InvalidArgumentError{Operator: op, Argument: "input", Reason: "must not be nil"})
+	}
+	var u any
+	if unit != "" {
+		if err := checkUnit(op, unit); err != nil {
+			return invalid(err)
+		}
+		if unit == Month || unit == Quarter || unit == Year {
+			return invalid(InvalidArgumentError{Operator: op, Argument: "unit", Reason: "must be week or smaller"})
+		}
+		u = string(unit)
+	}
+	return named(op, bson.D{{Key: "input", Value: input}, {Key: "unit", Value: u}})
+}
+
+// ExpMovingAvgN returns an $expMovingAvg window operator weighted by the
+// number of historical documents n.
+func ExpMovingAvgN(input any, n int) Expr {
+	if n <= 0 {
+		return invalid(InvalidArgumentError{Operator: "$expMovingAvg", Argument: "N", Reason: "must be a positive integer"})
+	}
+	return named("$expMovingAvg", bson.D{{Key: "input", Value: input}, {Key: "N", Value: n}})
+}
+
+// ExpMovingAvgAlpha returns an $expMovingAvg window operator with the given
+// exponential decay value, which must be between 0 and 1 exclusive.
+func ExpMovingAvgAlpha(input any, alpha float64) Expr {
+	if alpha <= 0 || alpha >= 1 {
+		return invalid(InvalidArgumentError{Operator: "$expMovingAvg", Argument: "alpha", Reason: "must be between 0 and 1 exclusive"})
+	}
+	return named("$expMovingAvg", bson.D{{Key: "input", Value: input}, {Key: "alpha", Value: alpha}})
+}
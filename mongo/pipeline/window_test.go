@@ -0,0 +1,149 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestSetWindowFields(t *testing.T) {
+	t.Parallel()
+
+	stage := SetWindowFields(SetWindowFieldsArgs{
+		PartitionBy: "$state",
+		SortBy:      bson.D{{Key: "orderDate", Value: 1}},
+		Output: []WindowOutput{
+			{
+				Field:    "cumulativeQuantity",
+				Operator: Sum("$quantity"),
+				Window:   DocumentsWindow(Unbounded, Current),
+			},
+			{
+				Field:    "last30Days",
+				Operator: Avg("$quantity"),
+				Window:   RangeWindow(int32(-30), int32(0), Day),
+			},
+			{
+				Field:    "rank",
+				Operator: Rank(),
+			},
+		},
+	})
+
+	want := `{"x": {"$setWindowFields": {` +
+		`"partitionBy": "$state",` +
+		`"sortBy": {"orderDate": {"$numberInt":"1"}},` +
+		`"output": {` +
+		`"cumulativeQuantity": {"$sum": "$quantity","window": {"documents": ["unbounded","current"]}},` +
+		`"last30Days": {"$avg": "$quantity","window": {"range": [{"$numberInt":"-30"},{"$numberInt":"0"}],"unit": "day"}},` +
+		`"rank": {"$rank": {}}}}}}`
+	assert.Equal(t, want, marshalDoc(t, stage))
+}
+
+func TestSetWindowFieldsValidation(t *testing.T) {
+	t.Parallel()
+
+	sortBy := bson.D{{Key: "ts", Value: 1}}
+
+	testCases := []struct {
+		name string
+		args SetWindowFieldsArgs
+		want error
+	}{
+		{
+			name: "empty output",
+			args: SetWindowFieldsArgs{},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "output", Reason: "must contain at least one field"},
+		},
+		{
+			name: "duplicate output",
+			args: SetWindowFieldsArgs{Output: []WindowOutput{
+				{Field: "a", Operator: Sum(1)},
+				{Field: "a", Operator: Sum(2)},
+			}},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "output", Reason: `duplicate field "a"`},
+		},
+		{
+			name: "rank without sortBy",
+			args: SetWindowFieldsArgs{Output: []WindowOutput{{Field: "r", Operator: Rank()}}},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "r", Reason: "$rank requires sortBy"},
+		},
+		{
+			name: "rank with window",
+			args: SetWindowFieldsArgs{
+				SortBy: sortBy,
+				Output: []WindowOutput{{Field: "r", Operator: Rank(), Window: DocumentsWindow(-1, 1)}},
+			},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "r", Reason: "$rank does not accept a window"},
+		},
+		{
+			name: "derivative without window",
+			args: SetWindowFieldsArgs{
+				SortBy: sortBy,
+				Output: []WindowOutput{{Field: "d", Operator: Derivative("$v", Hour)}},
+			},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "d", Reason: "$derivative requires a window"},
+		},
+		{
+			name: "range window with multiple sort keys",
+			args: SetWindowFieldsArgs{
+				SortBy: bson.D{{Key: "a", Value: 1}, {Key: "b", Value: 1}},
+				Output: []WindowOutput{{Field: "s", Operator: Sum("$v"), Window: RangeWindow(-1, 1, "")}},
+			},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "s", Reason: "range windows require sortBy on exactly one field"},
+		},
+		{
+			name: "documents window with fractional bound",
+			args: SetWindowFieldsArgs{
+				SortBy: sortBy,
+				Output: []WindowOutput{{Field: "s", Operator: Sum("$v"), Window: DocumentsWindow(-1.5, 0)}},
+			},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "documents", Reason: `bounds must be integers, "unbounded", or "current"`},
+		},
+		{
+			name: "lower bound greater than upper bound",
+			args: SetWindowFieldsArgs{
+				SortBy: sortBy,
+				Output: []WindowOutput{{Field: "s", Operator: Sum("$v"), Window: DocumentsWindow(Current, -2)}},
+			},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "documents", Reason: "lower bound current is greater than upper bound -2"},
+		},
+		{
+			name: "bounded documents window without sortBy",
+			args: SetWindowFieldsArgs{
+				Output: []WindowOutput{{Field: "s", Operator: Sum("$v"), Window: DocumentsWindow(-1, 1)}},
+			},
+			want: InvalidArgumentError{Operator: "$setWindowFields", Argument: "s", Reason: "bounded documents windows require sortBy"},
+		},
+		{
+			name: "invalid operator",
+			args: SetWindowFieldsArgs{
+				SortBy: sortBy,
+				Output: []WindowOutput{{Field: "e", Operator: ExpMovingAvgAlpha("$v", 2)}},
+			},
+			want: InvalidArgumentError{Operator: "$expMovingAvg", Argument: "alpha", Reason: "must be between 0 and 1 exclusive"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := setWindowFieldsSpec(tc.args)
+			assert.Equal(t, tc.want, err)
+
+			_, err = bson.Marshal(SetWindowFields(tc.args))
+			require.Error(t, err, "expected marshal error")
+		})
+	}
+}
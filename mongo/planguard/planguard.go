@@ -0,0 +1,223 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package planguard detects query plan regressions by explaining registered
+// query shapes and reporting those that are answered with a collection scan.
+//
+// A Guard is typically checked once at application startup, or from a health
+// check endpoint, so that a dropped or missing index becomes a deploy-time
+// failure instead of a production slowdown:
+//
+//	guard := planguard.New(client, 10_000)
+//	guard.Register(planguard.Shape{
+//		Name:       "orders by customer",
+//		Database:   "shop",
+//		Collection: "orders",
+//		Filter:     bson.D{{"customerId", 0}},
+//		Sort:       bson.D{{"createdAt", -1}},
+//	})
+//	if err := guard.Check(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// Only the field names and structure of a shape's filter and sort matter for
+// plan selection, so placeholder values can be used.
+package planguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Shape is a query shape whose winning plan is checked by a Guard.
+type Shape struct {
+	// Name identifies the shape in errors. If empty, the namespace is used.
+	Name string
+
+	// Database and Collection are the namespace the query runs against. Both
+	// are required.
+	Database   string
+	Collection string
+
+	// Filter is the query filter. If nil, an empty filter is used.
+	Filter any
+
+	// Sort is the sort specification. It is optional.
+	Sort any
+}
+
+func (s Shape) String() string {
+	ns := s.Database + "." + s.Collection
+	if s.Name == "" {
+		return ns
+	}
+	return fmt.Sprintf("%q on %s", s.Name, ns)
+}
+
+// Violation describes a shape whose winning plan scans a collection that is
+// above the Guard's size threshold.
+type Violation struct {
+	Shape Shape
+
+	// Documents is the estimated number of documents in the collection.
+	Documents int64
+
+	// WinningPlan is the winning plan reported by explain.
+	WinningPlan bson.Raw
+}
+
+// RegressionError is returned by Guard.Check when one or more shapes use a
+// collection scan.
+type RegressionError struct {
+	Violations []Violation
+}
+
+// Error implements the error interface.
+func (e RegressionError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, fmt.Sprintf("%s uses a collection scan on %d documents", v.Shape, v.Documents))
+	}
+	return "query plan regression: " + strings.Join(msgs, "; ")
+}
+
+// Guard explains registered query shapes and reports those answered with a
+// collection scan. A Guard is safe for concurrent use.
+type Guard struct {
+	client       *mongo.Client
+	minDocuments int64
+
+	mu     sync.Mutex
+	shapes []Shape
+}
+
+// New creates a Guard that uses client to explain queries. Collection scans
+// are only reported for collections with an estimated document count of at
+// least minDocuments, so small lookup collections that are cheaper to scan
+// than to index do not cause failures.
+func New(client *mongo.Client, minDocuments int64) *Guard {
+	return &Guard{client: client, minDocuments: minDocuments}
+}
+
+// Register adds shapes to the set checked by Check.
+func (g *Guard) Register(shapes ...Shape) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.shapes = append(g.shapes, shapes...)
+}
+
+// Check explains every registered shape. It returns a RegressionError if any
+// shape's winning plan contains a collection scan on a collection with at
+// least the configured number of documents, or another error if a shape is
+// invalid or a command fails.
+func (g *Guard) Check(ctx context.Context) error {
+	g.mu.Lock()
+	shapes := make([]Shape, len(g.shapes))
+	copy(shapes, g.shapes)
+	g.mu.Unlock()
+
+	var violations []Violation
+	for _, shape := range shapes {
+		v, err := g.check(ctx, shape)
+		if err != nil {
+			return fmt.Errorf("error checking query shape %s: %w", shape, err)
+		}
+		if v != nil {
+			violations = append(violations, *v)
+		}
+	}
+	if len(violations) > 0 {
+		return RegressionError{Violations: violations}
+	}
+	return nil
+}
+
+func (g *Guard) check(ctx context.Context, shape Shape) (*Violation, error) {
+	if shape.Database == "" || shape.Collection == "" {
+		return nil, errors.New("database and collection must be set")
+	}
+
+	db := g.client.Database(shape.Database)
+	count, err := db.Collection(shape.Collection).EstimatedDocumentCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count < g.minDocuments {
+		return nil, nil
+	}
+
+	filter := shape.Filter
+	if filter == nil {
+		filter = bson.D{}
+	}
+	find := bson.D{{Key: "find", Value: shape.Collection}, {Key: "filter", Value: filter}}
+	if shape.Sort != nil {
+		find = append(find, bson.E{Key: "sort", Value: shape.Sort})
+	}
+	cmd := bson.D{{Key: "explain", Value: find}, {Key: "verbosity", Value: "queryPlanner"}}
+
+	res, err := db.RunCommand(ctx, cmd).Raw()
+	if err != nil {
+		return nil, err
+	}
+	plan, err := winningPlan(res)
+	if err != nil {
+		return nil, err
+	}
+	if !hasCollScan(plan) {
+		return nil, nil
+	}
+	return &Violation{Shape: shape, Documents: count, WinningPlan: plan}, nil
+}
+
+// winningPlan returns the queryPlanner.winningPlan document from an explain
+// response.
+func winningPlan(res bson.Raw) (bson.Raw, error) {
+	val, err := res.LookupErr("queryPlanner", "winningPlan")
+	if err != nil {
+		return nil, fmt.Errorf("explain response does not contain queryPlanner.winningPlan: %w", err)
+	}
+	plan, ok := val.DocumentOK()
+	if !ok {
+		return nil, fmt.Errorf("expected queryPlanner.winningPlan to be a document, got %s", val.Type)
+	}
+	return plan, nil
+}
+
+// hasCollScan reports whether any stage in plan, including the input stages of
+// other stages and the plans of individual shards, is a COLLSCAN.
+func hasCollScan(plan bson.Raw) bool {
+	elems, err := plan.Elements()
+	if err != nil {
+		return false
+	}
+	for _, elem := range elems {
+		val := elem.Value()
+		if elem.Key() == "stage" {
+			if stage, ok := val.StringValueOK(); ok && stage == "COLLSCAN" {
+				return true
+			}
+		}
+		switch val.Type {
+		case bson.TypeEmbeddedDocument:
+			if hasCollScan(val.Document()) {
+				return true
+			}
+		case bson.TypeArray:
+			if hasCollScan(bson.Raw(val.Array())) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package planguard
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestHasCollScan(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		res  string
+		want bool
+	}{
+		{
+			name: "index scan",
+			res:  `{"queryPlanner": {"winningPlan": {"stage": "FETCH", "inputStage": {"stage": "IXSCAN", "indexName": "a_1"}}}}`,
+			want: false,
+		},
+		{
+			name: "collection scan",
+			res:  `{"queryPlanner": {"winningPlan": {"stage": "COLLSCAN", "direction": "forward"}}}`,
+			want: true,
+		},
+		{
+			name: "nested collection scan",
+			res:  `{"queryPlanner": {"winningPlan": {"stage": "SORT", "inputStage": {"stage": "COLLSCAN"}}}}`,
+			want: true,
+		},
+		{
+			name: "slot based execution",
+			res:  `{"queryPlanner": {"winningPlan": {"queryPlan": {"stage": "COLLSCAN"}, "slotBasedPlan": {"stages": "..."}}}}`,
+			want: true,
+		},
+		{
+			name: "sharded",
+			res: `{"queryPlanner": {"winningPlan": {"stage": "SHARD_MERGE", "shards": [` +
+				`{"shardName": "a", "winningPlan": {"stage": "IXSCAN"}},` +
+				`{"shardName": "b", "winningPlan": {"stage": "COLLSCAN"}}]}}}`,
+			want: true,
+		},
+		{
+			name: "rejected collection scan",
+			res: `{"queryPlanner": {"winningPlan": {"stage": "IXSCAN"}, ` +
+				`"rejectedPlans": [{"stage": "COLLSCAN"}]}}`,
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var res bson.Raw
+			err := bson.UnmarshalExtJSON([]byte(tc.res), false, &res)
+			require.NoError(t, err)
+
+			plan, err := winningPlan(res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, hasCollScan(plan))
+		})
+	}
+}
+
+func TestWinningPlanMissing(t *testing.T) {
+	t.Parallel()
+
+	res, err := bson.Marshal(bson.D{{Key: "ok", Value: 1}})
+	require.NoError(t, err)
+
+	_, err = winningPlan(res)
+	assert.Error(t, err)
+}
+
+func TestRegressionError(t *testing.T) {
+	t.Parallel()
+
+	err := RegressionError{Violations: []Violation{
+		{Shape: Shape{Name: "orders by customer", Database: "shop", Collection: "orders"}, Documents: 50000},
+		{Shape: Shape{Database: "shop", Collection: "events"}, Documents: 20000},
+	}}
+	want := `query plan regression: "orders by customer" on shop.orders uses a collection scan on 50000 documents; ` +
+		`shop.events uses a collection scan on 20000 documents`
+	assert.Equal(t, want, err.Error())
+}
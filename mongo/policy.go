@@ -0,0 +1,409 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/session"
+)
+
+// ErrPolicyViolation is wrapped by the errors returned when a Client is created
+// or an operation is run with a namespace, read concern, or write concern that
+// violates the policy configured with options.ClientOptions.SetPolicy.
+var ErrPolicyViolation = errors.New("client policy violation")
+
+func policyError(ns, reason string) error {
+	if ns == "" {
+		return fmt.Errorf("%w: %s", ErrPolicyViolation, reason)
+	}
+	return fmt.Errorf("%w: %s on namespace %q", ErrPolicyViolation, reason, ns)
+}
+
+// checkNamespacePolicy returns an error if the policy of the Client does not
+// allow operations on ns, which is either a "database.collection" namespace or
+// a database name. Client-level operations, which have an empty ns, are always
+// allowed.
+func (c *Client) checkNamespacePolicy(ns string) error {
+	if c.policy == nil || ns == "" {
+		return nil
+	}
+
+	for _, pattern := range c.policy.DeniedNamespaces {
+		if globMatches(pattern, ns, true) {
+			return policyError(ns, "access is denied")
+		}
+	}
+	if len(c.policy.AllowedNamespaces) == 0 {
+		return nil
+	}
+	for _, pattern := range c.policy.AllowedNamespaces {
+		if globMatches(pattern, ns, false) {
+			return nil
+		}
+	}
+	return policyError(ns, "access is not allowed")
+}
+
+// restrictsNamespaces reports whether the policy of the Client allows or denies
+// namespaces.
+func (c *Client) restrictsNamespaces() bool {
+	return c.policy != nil && (len(c.policy.AllowedNamespaces) > 0 || len(c.policy.DeniedNamespaces) > 0)
+}
+
+// checkReadOnly returns a ReadOnlyError if the Client is read-only. It must be
+// called by every operation that writes to ns.
+func (c *Client) checkReadOnly(op, ns string) error {
+	if !c.readOnly {
+		return nil
+	}
+	return ReadOnlyError{Operation: op, Namespace: ns}
+}
+
+// readCommands contains the names of the commands that RunCommand and
+// RunCommandCursor allow if the Client is read-only. Every other command is
+// rejected, since it may write.
+var readCommands = map[string]struct{}{
+	"aggregate":           {},
+	"buildInfo":           {},
+	"buildinfo":           {},
+	"collStats":           {},
+	"connectionStatus":    {},
+	"count":               {},
+	"currentOp":           {},
+	"dataSize":            {},
+	"dbHash":              {},
+	"dbStats":             {},
+	"distinct":            {},
+	"endSessions":         {},
+	"explain":             {},
+	"find":                {},
+	"getCmdLineOpts":      {},
+	"getDefaultRWConcern": {},
+	"getLog":              {},
+	"getMore":             {},
+	"getParameter":        {},
+	"hello":               {},
+	"hostInfo":            {},
+	"isMaster":            {},
+	"ismaster":            {},
+	"killCursors":         {},
+	"listCollections":     {},
+	"listCommands":        {},
+	"listDatabases":       {},
+	"listIndexes":         {},
+	"listSearchIndexes":   {},
+	"listShards":          {},
+	"ping":                {},
+	"replSetGetConfig":    {},
+	"replSetGetStatus":    {},
+	"rolesInfo":           {},
+	"serverStatus":        {},
+	"startSession":        {},
+	"usersInfo":           {},
+	"whatsmyuri":          {},
+}
+
+// isReadCommand reports whether cmd is a known read command. An aggregate
+// command is only a read command if its pipeline does not end with a $out or
+// $merge stage.
+func isReadCommand(cmd bsoncore.Document) bool {
+	name := commandName(cmd)
+	if _, ok := readCommands[name]; !ok {
+		return false
+	}
+	if name != "aggregate" {
+		return true
+	}
+
+	pipeline, ok := cmd.Lookup("pipeline").ArrayOK()
+	if !ok {
+		return true
+	}
+	values, _ := pipeline.Values()
+	if len(values) == 0 {
+		return true
+	}
+	if lastStage, ok := values[len(values)-1].DocumentOK(); ok {
+		if elem, err := lastStage.IndexErr(0); err == nil && (elem.Key() == "$out" || elem.Key() == "$merge") {
+			return false
+		}
+	}
+	return true
+}
+
+// globMatches reports whether the glob pattern matches ns. If ns is a database
+// name, the database part of the pattern is matched against it instead; if
+// whole is true, the pattern must also match every collection in the database.
+func globMatches(pattern, ns string, whole bool) bool {
+	if ok, _ := path.Match(pattern, ns); ok {
+		return true
+	}
+	if strings.Contains(ns, ".") {
+		return false
+	}
+
+	dbPattern, collPattern, found := strings.Cut(pattern, ".")
+	if !found || (whole && collPattern != "*") {
+		return false
+	}
+	ok, _ := path.Match(dbPattern, ns)
+	return ok
+}
+
+// checkWritePolicy returns an error if the namespace or the write concern that
+// applies to an operation on ns violates the policy of the Client. If a
+// transaction is running, the write concern of the transaction applies instead
+// of wc.
+func (c *Client) checkWritePolicy(sess *session.Client, ns string, wc *writeconcern.WriteConcern) error {
+	if c.policy == nil {
+		return nil
+	}
+	if err := c.checkNamespacePolicy(ns); err != nil {
+		return err
+	}
+	if sess.TransactionRunning() {
+		wc = sess.CurrentWc
+	}
+	if wc == nil {
+		return nil
+	}
+
+	if c.policy.RejectUnacknowledgedWrites && !wc.Acknowledged() {
+		return policyError(ns, "unacknowledged write concern (w: 0) is not allowed")
+	}
+	if c.policy.RejectUnjournaledWrites && wc.Journal != nil && !*wc.Journal {
+		return policyError(ns, "write concern with journal: false is not allowed")
+	}
+	return nil
+}
+
+// checkReadPolicy returns an error if the namespace or the read concern that
+// applies to an operation on ns violates the policy of the Client. If a
+// transaction is running, the read concern of the transaction applies instead
+// of rc.
+func (c *Client) checkReadPolicy(sess *session.Client, ns string, rc *readconcern.ReadConcern) error {
+	if c.policy == nil {
+		return nil
+	}
+	if err := c.checkNamespacePolicy(ns); err != nil {
+		return err
+	}
+	if sess.TransactionRunning() {
+		rc = sess.CurrentRc
+	}
+	if rc == nil || rc.Level != readconcern.Local().Level {
+		return nil
+	}
+
+	for _, pattern := range c.policy.RejectLocalReadConcern {
+		if namespaceMatches(pattern, ns) {
+			return policyError(ns, `read concern "local" is not allowed`)
+		}
+	}
+	return nil
+}
+
+// namespaceMatches reports whether ns matches pattern, which is either "*", a
+// database name, or a full "database.collection" namespace.
+func namespaceMatches(pattern, ns string) bool {
+	if pattern == "*" || pattern == ns {
+		return true
+	}
+	return !strings.Contains(pattern, ".") && strings.HasPrefix(ns, pattern+".")
+}
+
+// adminNamespaceFields contains, for the commands run on the "admin" database
+// that operate on other databases or collections, the fields that hold their
+// full namespaces or database names.
+var adminNamespaceFields = map[string][]string{
+	"analyzeShardKey":          {"analyzeShardKey"},
+	"balancerCollectionStatus": {"balancerCollectionStatus"},
+	"clearJumboFlag":           {"clearJumboFlag"},
+	"configureQueryAnalyzer":   {"configureQueryAnalyzer"},
+	"enableSharding":           {"enableSharding"},
+	"mergeChunks":              {"mergeChunks"},
+	"moveChunk":                {"moveChunk"},
+	"moveCollection":           {"moveCollection"},
+	"movePrimary":              {"movePrimary"},
+	"refineCollectionShardKey": {"refineCollectionShardKey"},
+	"renameCollection":         {"renameCollection", "to"},
+	"reshardCollection":        {"reshardCollection"},
+	"shardCollection":          {"shardCollection"},
+	"split":                    {"split"},
+	"unshardCollection":        {"unshardCollection"},
+	"updateZoneKeyRange":       {"updateZoneKeyRange"},
+}
+
+// runCommandNamespace returns the namespace of a command run on db, using the
+// first element of the command as the collection name if it is a string. For
+// the commands in adminNamespaceFields run on the "admin" database, the first
+// element holds the full namespace instead.
+func runCommandNamespace(db string, cmd bsoncore.Document) string {
+	elem, err := cmd.IndexErr(0)
+	if err != nil {
+		return db
+	}
+	coll, ok := elem.Value().StringValueOK()
+	if !ok || coll == "" {
+		return db
+	}
+	if _, ok := adminNamespaceFields[elem.Key()]; ok && db == "admin" {
+		return coll
+	}
+	return db + "." + coll
+}
+
+// checkCommandPolicy returns an error if a namespace that cmd, run on db with
+// RunCommand or RunCommandCursor, accesses, or the write concern or read
+// concern set in cmd, violates the policy of the Client.
+// Commands run on the "admin" database that neither access a collection nor
+// are known read commands are rejected if the policy restricts namespaces,
+// since the namespaces they access cannot be determined.
+func (c *Client) checkCommandPolicy(sess *session.Client, db string, cmd bsoncore.Document) error {
+	if c.policy == nil {
+		return nil
+	}
+
+	name := commandName(cmd)
+	var namespaces []string
+	if fields, ok := adminNamespaceFields[name]; ok && db == "admin" {
+		for _, field := range fields {
+			if ns, ok := cmd.Lookup(field).StringValueOK(); ok && ns != "" {
+				namespaces = append(namespaces, ns)
+			}
+		}
+	} else if ns := runCommandNamespace(db, cmd); ns != "admin" {
+		namespaces = append(namespaces, ns)
+	} else if _, ok := readCommands[name]; !ok && c.restrictsNamespaces() {
+		return policyError("", fmt.Sprintf("command %q on the admin database is not allowed", name))
+	}
+
+	if pipeline, ok := cmd.Lookup("pipeline").ArrayOK(); ok {
+		namespaces = append(namespaces, pipelineNamespaces(db, pipeline)...)
+	}
+	for _, ns := range namespaces {
+		if err := c.checkNamespacePolicy(ns); err != nil {
+			return err
+		}
+	}
+
+	var ns string
+	if len(namespaces) > 0 {
+		ns = namespaces[0]
+	}
+	wc, rc := commandConcerns(cmd)
+	if err := c.checkWritePolicy(sess, ns, wc); err != nil {
+		return err
+	}
+	return c.checkReadPolicy(sess, ns, rc)
+}
+
+// commandConcerns returns the write concern and the read concern set in the
+// "writeConcern" and "readConcern" fields of cmd, or nil for the fields that
+// are not set.
+func commandConcerns(cmd bsoncore.Document) (*writeconcern.WriteConcern, *readconcern.ReadConcern) {
+	var wc *writeconcern.WriteConcern
+	if doc, ok := cmd.Lookup("writeConcern").DocumentOK(); ok {
+		wc = &writeconcern.WriteConcern{}
+		if w, ok := doc.Lookup("w").AsInt64OK(); ok {
+			wc.W = int(w)
+		} else if w, ok := doc.Lookup("w").StringValueOK(); ok {
+			wc.W = w
+		}
+		if j, ok := doc.Lookup("j").BooleanOK(); ok {
+			wc.Journal = &j
+		}
+	}
+
+	var rc *readconcern.ReadConcern
+	if doc, ok := cmd.Lookup("readConcern").DocumentOK(); ok {
+		rc = &readconcern.ReadConcern{}
+		rc.Level, _ = doc.Lookup("level").StringValueOK()
+	}
+	return wc, rc
+}
+
+// pipelineNamespaces returns the namespaces of the collections that the stages
+// of an aggregation pipeline run on db read from or write to, including the
+// stages of nested pipelines.
+func pipelineNamespaces(db string, pipeline bsoncore.Array) []string {
+	var namespaces []string
+	stages, _ := pipeline.Values()
+	for _, stage := range stages {
+		stageDoc, ok := stage.DocumentOK()
+		if !ok {
+			continue
+		}
+		elem, err := stageDoc.IndexErr(0)
+		if err != nil {
+			continue
+		}
+
+		val := elem.Value()
+		switch elem.Key() {
+		case "$lookup", "$graphLookup":
+			if doc, ok := val.DocumentOK(); ok {
+				namespaces = appendStageNamespace(namespaces, db, doc.Lookup("from"), "coll")
+			}
+		case "$unionWith":
+			namespaces = appendStageNamespace(namespaces, db, val, "coll")
+		case "$out":
+			namespaces = appendStageNamespace(namespaces, db, val, "coll")
+		case "$merge":
+			if into, ok := val.DocumentOK(); ok {
+				val = into.Lookup("into")
+			}
+			namespaces = appendStageNamespace(namespaces, db, val, "coll")
+		case "$facet":
+			facets, ok := val.DocumentOK()
+			if !ok {
+				continue
+			}
+			facetValues, _ := facets.Values()
+			for _, facet := range facetValues {
+				if facetPipeline, ok := facet.ArrayOK(); ok {
+					namespaces = append(namespaces, pipelineNamespaces(db, facetPipeline)...)
+				}
+			}
+		}
+
+		if doc, ok := val.DocumentOK(); ok {
+			if nested, ok := doc.Lookup("pipeline").ArrayOK(); ok {
+				namespaces = append(namespaces, pipelineNamespaces(db, nested)...)
+			}
+		}
+	}
+	return namespaces
+}
+
+// appendStageNamespace appends the namespace named by val, the target of an
+// aggregation stage run on db, to namespaces. val is either a collection name
+// or a document with an optional "db" field and a collection name in collKey.
+func appendStageNamespace(namespaces []string, db string, val bsoncore.Value, collKey string) []string {
+	if coll, ok := val.StringValueOK(); ok {
+		return append(namespaces, db+"."+coll)
+	}
+	doc, ok := val.DocumentOK()
+	if !ok {
+		return namespaces
+	}
+	coll, ok := doc.Lookup(collKey).StringValueOK()
+	if !ok {
+		return namespaces
+	}
+	if targetDB, ok := doc.Lookup("db").StringValueOK(); ok {
+		db = targetDB
+	}
+	return append(namespaces, db+"."+coll)
+}
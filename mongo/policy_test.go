@@ -0,0 +1,303 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+func TestClientPolicy(t *testing.T) {
+	policy := options.Policy().
+		SetRejectUnacknowledgedWrites(true).
+		SetRejectUnjournaledWrites(true).
+		SetRejectLocalReadConcern("billing", "app.users")
+
+	t.Run("client write concern", func(t *testing.T) {
+		_, err := newClient(options.Client().SetWriteConcern(writeconcern.Unacknowledged()).SetPolicy(policy))
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+
+		journal := false
+		_, err = newClient(options.Client().SetWriteConcern(&writeconcern.WriteConcern{W: 1, Journal: &journal}).SetPolicy(policy))
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+
+		_, err = newClient(options.Client().SetWriteConcern(writeconcern.Majority()).SetPolicy(policy))
+		assert.NoError(t, err)
+	})
+	t.Run("operations", func(t *testing.T) {
+		client := setupClient(options.Client().ApplyURI("mongodb://localhost:27017").SetPolicy(policy))
+		ctx := context.Background()
+
+		coll := client.Database("app").Collection("orders", options.Collection().SetWriteConcern(writeconcern.Unacknowledged()))
+		_, err := coll.InsertOne(ctx, bson.D{{Key: "x", Value: 1}})
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		_, err = coll.DeleteMany(ctx, bson.D{})
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		err = coll.FindOneAndDelete(ctx, bson.D{}).Err()
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+
+		err = client.Database("app").RunCommand(ctx, bson.D{
+			{Key: "insert", Value: "orders"},
+			{Key: "documents", Value: bson.A{bson.D{}}},
+			{Key: "writeConcern", Value: bson.D{{Key: "w", Value: 0}}},
+		}).Err()
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		err = client.Database("app").RunCommand(ctx, bson.D{
+			{Key: "find", Value: "users"},
+			{Key: "readConcern", Value: bson.D{{Key: "level", Value: "local"}}},
+		}).Err()
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+
+		users := client.Database("app").Collection("users", options.Collection().SetReadConcern(readconcern.Local()))
+		_, err = users.Find(ctx, bson.D{})
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		_, err = users.CountDocuments(ctx, bson.D{})
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+
+		invoices := client.Database("billing", options.Database().SetReadConcern(readconcern.Local())).Collection("invoices")
+		_, err = invoices.Aggregate(ctx, Pipeline{})
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		_, err = invoices.Watch(ctx, Pipeline{})
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+	})
+	t.Run("transaction options", func(t *testing.T) {
+		client := setupClient(options.Client().ApplyURI("mongodb://localhost:27017").SetPolicy(policy))
+
+		sess, err := client.StartSession()
+		require.NoError(t, err)
+		defer sess.EndSession(context.Background())
+
+		journal := false
+		txnOpts := options.Transaction().SetWriteConcern(&writeconcern.WriteConcern{W: "majority", Journal: &journal})
+		err = sess.StartTransaction(txnOpts)
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		assert.False(t, sess.clientSession.TransactionRunning(), "expected no transaction to be running")
+
+		journaled := false
+		defaultOpts := options.Transaction().SetWriteConcern(&writeconcern.WriteConcern{W: 1, Journal: &journaled})
+		defaultSess, err := client.StartSession(options.Session().SetDefaultTransactionOptions(defaultOpts))
+		require.NoError(t, err)
+		defer defaultSess.EndSession(context.Background())
+		err = defaultSess.StartTransaction()
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		assert.False(t, defaultSess.clientSession.TransactionRunning(), "expected no transaction to be running")
+
+		require.NoError(t, sess.StartTransaction(options.Transaction().SetReadConcern(readconcern.Local())))
+		ctx := NewSessionContext(context.Background(), sess)
+		_, err = client.Database("app").Collection("users").Find(ctx, bson.D{})
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+	})
+}
+
+func TestNamespacePolicy(t *testing.T) {
+	policy := options.Policy().
+		SetAllowedNamespaces("tenant1.*", "shared.config").
+		SetDeniedNamespaces("tenant1.secrets")
+	client := setupClient(options.Client().ApplyURI("mongodb://localhost:27017").SetPolicy(policy))
+	ctx := context.Background()
+
+	testCases := []struct {
+		ns      string
+		allowed bool
+	}{
+		{"tenant1.orders", true},
+		{"tenant1", true},
+		{"shared.config", true},
+		{"shared", true},
+		{"tenant1.secrets", false},
+		{"tenant2.orders", false},
+		{"tenant2", false},
+		{"", true},
+	}
+	for _, tc := range testCases {
+		err := client.checkNamespacePolicy(tc.ns)
+		if tc.allowed {
+			assert.NoError(t, err, "expected %q to be allowed", tc.ns)
+		} else {
+			assert.ErrorIs(t, err, ErrPolicyViolation, "expected %q to be rejected", tc.ns)
+		}
+	}
+
+	_, err := client.Database("tenant2").Collection("orders").InsertOne(ctx, bson.D{{Key: "x", Value: 1}})
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	_, err = client.Database("tenant1").Collection("secrets").Find(ctx, bson.D{})
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	_, err = client.Database("tenant2").ListCollectionNames(ctx, bson.D{})
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	_, err = client.Database("tenant1").Collection("secrets").Indexes().List(ctx)
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	err = client.Database("tenant1").RunCommand(ctx, bson.D{{Key: "find", Value: "secrets"}}).Err()
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	err = client.Database("tenant2").Drop(ctx)
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+
+	tenant1 := client.Database("tenant1").Collection("orders")
+	_, err = tenant1.Aggregate(ctx, Pipeline{{{Key: "$lookup", Value: bson.D{
+		{Key: "from", Value: "secrets"},
+		{Key: "as", Value: "s"},
+	}}}})
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	_, err = tenant1.Aggregate(ctx, Pipeline{{{Key: "$merge", Value: bson.D{
+		{Key: "into", Value: bson.D{{Key: "db", Value: "tenant2"}, {Key: "coll", Value: "orders"}}},
+	}}}})
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	err = client.Database("tenant1").RunCommand(ctx, bson.D{
+		{Key: "aggregate", Value: "orders"},
+		{Key: "pipeline", Value: bson.A{bson.D{{Key: "$unionWith", Value: "secrets"}}}},
+		{Key: "cursor", Value: bson.D{}},
+	}).Err()
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+
+	admin := client.Database("admin")
+	err = admin.RunCommand(ctx, bson.D{
+		{Key: "renameCollection", Value: "tenant1.orders"},
+		{Key: "to", Value: "tenant2.orders"},
+	}).Err()
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	err = admin.RunCommand(ctx, bson.D{{Key: "shardCollection", Value: "tenant2.orders"}}).Err()
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	err = admin.RunCommand(ctx, bson.D{{Key: "fsync", Value: 1}}).Err()
+	assert.ErrorIs(t, err, ErrPolicyViolation)
+	assert.NoError(t, client.checkCommandPolicy(nil, "admin", bsoncore.NewDocumentBuilder().AppendInt32("ping", 1).Build()))
+	assert.NoError(t, client.checkCommandPolicy(nil, "admin", bsoncore.NewDocumentBuilder().
+		AppendString("renameCollection", "tenant1.orders").
+		AppendString("to", "tenant1.archive").
+		Build()))
+
+	denyAll := setupClient(options.Client().ApplyURI("mongodb://localhost:27017").
+		SetPolicy(options.Policy().SetDeniedNamespaces("tenant2.*")))
+	assert.ErrorIs(t, denyAll.checkNamespacePolicy("tenant2"), ErrPolicyViolation)
+	assert.NoError(t, denyAll.checkNamespacePolicy("tenant1.orders"))
+}
+
+func TestRunCommandNamespace(t *testing.T) {
+	find := bsoncore.NewDocumentBuilder().AppendString("find", "orders").Build()
+	ping := bsoncore.NewDocumentBuilder().AppendInt32("ping", 1).Build()
+
+	assert.Equal(t, "app.orders", runCommandNamespace("app", find))
+	assert.Equal(t, "app", runCommandNamespace("app", ping))
+	assert.Equal(t, "admin.orders", runCommandNamespace("admin", find))
+	assert.Equal(t, "admin", runCommandNamespace("admin", ping))
+
+	rename := bsoncore.NewDocumentBuilder().
+		AppendString("renameCollection", "app.orders").
+		AppendString("to", "app.archive").
+		Build()
+	assert.Equal(t, "app.orders", runCommandNamespace("admin", rename))
+}
+
+func TestPipelineNamespaces(t *testing.T) {
+	pipeline, err := bson.Marshal(bson.D{
+		{Key: "0", Value: bson.D{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "users"},
+			{Key: "pipeline", Value: bson.A{bson.D{{Key: "$unionWith", Value: bson.D{
+				{Key: "coll", Value: "admins"},
+			}}}}},
+		}}}},
+		{Key: "1", Value: bson.D{{Key: "$graphLookup", Value: bson.D{{Key: "from", Value: "edges"}}}}},
+		{Key: "2", Value: bson.D{{Key: "$facet", Value: bson.D{
+			{Key: "a", Value: bson.A{bson.D{{Key: "$unionWith", Value: "logs"}}}},
+		}}}},
+		{Key: "3", Value: bson.D{{Key: "$out", Value: bson.D{{Key: "db", Value: "archive"}, {Key: "coll", Value: "orders"}}}}},
+	})
+	require.NoError(t, err)
+
+	got := pipelineNamespaces("app", bsoncore.Array(pipeline))
+	want := []string{"app.users", "app.admins", "app.edges", "app.logs", "archive.orders"}
+	assert.Equal(t, want, got)
+
+	merge, err := bson.Marshal(bson.D{{Key: "0", Value: bson.D{{Key: "$merge", Value: "copy"}}}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app.copy"}, pipelineNamespaces("app", bsoncore.Array(merge)))
+}
+
+func TestNamespaceMatches(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		ns      string
+		want    bool
+	}{
+		{"*", "app.users", true},
+		{"app", "app.users", true},
+		{"app", "app", true},
+		{"app", "apps.users", false},
+		{"app.users", "app.users", true},
+		{"app.users", "app.orders", false},
+		{"app.users", "app", false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, namespaceMatches(tc.pattern, tc.ns), "namespaceMatches(%q, %q)", tc.pattern, tc.ns)
+	}
+}
+
+func TestReadOnlyClient(t *testing.T) {
+	client := setupClient(options.Client().
+		ApplyURI("mongodb://localhost:1").
+		SetServerSelectionTimeout(10 * time.Millisecond).
+		SetReadOnly(true))
+	ctx := context.Background()
+	db := client.Database("app")
+	coll := db.Collection("orders")
+
+	assertReadOnly := func(t *testing.T, err error, op, ns string) {
+		t.Helper()
+
+		var roErr ReadOnlyError
+		require.True(t, errors.As(err, &roErr), "expected ReadOnlyError, got %v", err)
+		assert.Equal(t, ReadOnlyError{Operation: op, Namespace: ns}, roErr)
+	}
+
+	_, err := coll.InsertOne(ctx, bson.D{{Key: "x", Value: 1}})
+	assertReadOnly(t, err, "insert", "app.orders")
+	_, err = coll.UpdateMany(ctx, bson.D{}, bson.D{{Key: "$set", Value: bson.D{{Key: "x", Value: 2}}}})
+	assertReadOnly(t, err, "update", "app.orders")
+	_, err = coll.DeleteOne(ctx, bson.D{})
+	assertReadOnly(t, err, "delete", "app.orders")
+	err = coll.FindOneAndDelete(ctx, bson.D{}).Err()
+	assertReadOnly(t, err, "findAndModify", "app.orders")
+	_, err = coll.BulkWrite(ctx, []WriteModel{NewInsertOneModel().SetDocument(bson.D{})})
+	assertReadOnly(t, err, "bulkWrite", "app.orders")
+	_, err = coll.Aggregate(ctx, Pipeline{{{Key: "$out", Value: "copy"}}})
+	assertReadOnly(t, err, "aggregate", "app.orders")
+	assertReadOnly(t, coll.Drop(ctx), "drop", "app.orders")
+	_, err = coll.Indexes().CreateOne(ctx, IndexModel{Keys: bson.D{{Key: "x", Value: 1}}})
+	assertReadOnly(t, err, "createIndexes", "app.orders")
+	assertReadOnly(t, db.CreateCollection(ctx, "logs"), "create", "app")
+	assertReadOnly(t, db.Drop(ctx), "dropDatabase", "app")
+	err = db.RunCommand(ctx, bson.D{{Key: "insert", Value: "orders"}}).Err()
+	assertReadOnly(t, err, "insert", "app.orders")
+	err = db.RunCommand(ctx, bson.D{{Key: "cloneCollectionAsCapped", Value: "orders"}}).Err()
+	assertReadOnly(t, err, "cloneCollectionAsCapped", "app.orders")
+	err = db.RunCommand(ctx, bson.D{
+		{Key: "aggregate", Value: "orders"},
+		{Key: "pipeline", Value: bson.A{bson.D{{Key: "$merge", Value: "copy"}}}},
+		{Key: "cursor", Value: bson.D{}},
+	}).Err()
+	assertReadOnly(t, err, "aggregate", "app.orders")
+
+	var roErr ReadOnlyError
+	_, err = coll.Find(ctx, bson.D{})
+	assert.False(t, errors.As(err, &roErr), "expected reads to be allowed, got %v", err)
+	err = db.RunCommand(ctx, bson.D{{Key: "ping", Value: 1}}).Err()
+	assert.False(t, errors.As(err, &roErr), "expected read commands to be allowed, got %v", err)
+	err = db.RunCommand(ctx, bson.D{
+		{Key: "aggregate", Value: "orders"},
+		{Key: "pipeline", Value: bson.A{bson.D{{Key: "$match", Value: bson.D{}}}}},
+		{Key: "cursor", Value: bson.D{}},
+	}).Err()
+	assert.False(t, errors.As(err, &roErr), "expected read aggregations to be allowed, got %v", err)
+}
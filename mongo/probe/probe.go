@@ -0,0 +1,246 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package probe periodically measures how long a write takes to be
+// acknowledged by a majority of replica set members. The latency of majority
+// writes grows when secondaries fall behind or become unavailable, so it gives
+// early warning of replication problems that will affect the write latency of
+// applications:
+//
+//	p, err := probe.Start(probe.Config{
+//		Collection: client.Database("monitoring").Collection("probe"),
+//		Interval:   5 * time.Second,
+//		OnSample: func(s probe.Sample) {
+//			majorityLatency.Observe(s.Latency.Seconds())
+//		},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer p.Stop()
+//
+// Each probe upserts a single small document in the probe collection, so the
+// collection should be dedicated to probing.
+package probe
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+)
+
+const (
+	defaultInterval = 10 * time.Second
+	defaultTimeout  = 5 * time.Second
+
+	// smoothing is the weight of a new sample in Stats.SmoothedLatency.
+	smoothing = 0.2
+)
+
+// Config configures a Probe.
+type Config struct {
+	// Collection is the collection probe documents are written to. It is
+	// required. The write concern of the collection is ignored; probes always
+	// use w:majority.
+	Collection *mongo.Collection
+
+	// ID is the _id of the document written by the Probe. The default is the
+	// host name, so that probes running in different processes do not
+	// contend for the same document.
+	ID any
+
+	// Interval is the time between the start of consecutive probes. The
+	// default is 10 seconds.
+	Interval time.Duration
+
+	// Timeout is the maximum time a probe may take. A probe that times out is
+	// recorded as a failed Sample. The default is 5 seconds.
+	Timeout time.Duration
+
+	// OnSample, if set, is called with each Sample after it is recorded. It
+	// is called from the goroutine that runs the probes, so it should not
+	// block.
+	OnSample func(Sample)
+}
+
+func (c *Config) validate() error {
+	if c.Collection == nil {
+		return errors.New("probe collection must be set")
+	}
+	if c.Interval < 0 || c.Timeout < 0 {
+		return errors.New("interval and timeout must not be negative")
+	}
+	return nil
+}
+
+// Sample is the result of a single probe.
+type Sample struct {
+	// Time is when the probe started.
+	Time time.Time
+
+	// Latency is the round-trip time of the majority write. If the probe
+	// failed, it is the time until the failure.
+	Latency time.Duration
+
+	// Err is the error returned by the write, or nil if it was acknowledged
+	// by a majority of members.
+	Err error
+}
+
+// Stats summarizes the samples recorded by a Probe.
+type Stats struct {
+	// Samples is the number of probes run.
+	Samples int64
+
+	// Failures is the number of probes that failed.
+	Failures int64
+
+	// Last is the most recent sample. It is the zero Sample if no probe has
+	// completed yet.
+	Last Sample
+
+	// SmoothedLatency is an exponentially weighted moving average of the
+	// latency of successful probes, which is less sensitive to outliers than
+	// the latency of the last sample.
+	SmoothedLatency time.Duration
+
+	// MaxLatency is the highest latency of a successful probe.
+	MaxLatency time.Duration
+}
+
+// Probe periodically writes to a collection with w:majority and records the
+// latency of each write. A Probe is safe for concurrent use.
+type Probe struct {
+	cfg   Config
+	write func(ctx context.Context) error
+
+	mu    sync.Mutex
+	stats Stats
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// Start validates cfg and starts a Probe that runs its first probe
+// immediately and then one probe per interval until Stop is called.
+func Start(cfg Config) (*Probe, error) {
+	p, err := newProbe(cfg)
+	if err != nil {
+		return nil, err
+	}
+	go p.run()
+	return p, nil
+}
+
+func newProbe(cfg Config) (*Probe, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultInterval
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.ID == nil {
+		host, err := os.Hostname()
+		if err != nil {
+			host = "probe"
+		}
+		cfg.ID = host
+	}
+
+	coll := cfg.Collection.Clone(options.Collection().SetWriteConcern(writeconcern.Majority()))
+	filter := bson.D{{"_id", cfg.ID}}
+	write := func(ctx context.Context) error {
+		update := bson.D{{"$set", bson.D{{"probedAt", bson.NewDateTimeFromTime(time.Now())}}}}
+		_, err := coll.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+		return err
+	}
+
+	return &Probe{
+		cfg:   cfg,
+		write: write,
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}, nil
+}
+
+func (p *Probe) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		p.Measure(context.Background())
+
+		select {
+		case <-ticker.C:
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// Measure runs a single probe immediately, records it, and returns it. The
+// probe is canceled if ctx is done or the timeout of the Probe elapses.
+func (p *Probe) Measure(ctx context.Context) Sample {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := p.write(ctx)
+	s := Sample{Time: start, Latency: time.Since(start), Err: err}
+
+	p.record(s)
+	if p.cfg.OnSample != nil {
+		p.cfg.OnSample(s)
+	}
+	return s
+}
+
+func (p *Probe) record(s Sample) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stats.Samples++
+	p.stats.Last = s
+	if s.Err != nil {
+		p.stats.Failures++
+		return
+	}
+
+	if p.stats.SmoothedLatency == 0 {
+		p.stats.SmoothedLatency = s.Latency
+	} else {
+		p.stats.SmoothedLatency += time.Duration(smoothing * float64(s.Latency-p.stats.SmoothedLatency))
+	}
+	if s.Latency > p.stats.MaxLatency {
+		p.stats.MaxLatency = s.Latency
+	}
+}
+
+// Stats returns a summary of the samples recorded so far.
+func (p *Probe) Stats() Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stats
+}
+
+// Stop stops running probes and waits for a probe in progress to complete.
+// It is safe to call Stop more than once.
+func (p *Probe) Stop() {
+	p.once.Do(func() { close(p.stop) })
+	<-p.done
+}
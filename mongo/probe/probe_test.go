@@ -0,0 +1,105 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package probe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func newTestCollection(t *testing.T) *mongo.Collection {
+	t.Helper()
+
+	client, err := mongo.Connect(options.Client().ApplyURI("mongodb://localhost:1"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Disconnect(context.Background()) })
+	return client.Database("db").Collection("probe")
+}
+
+func TestConfigValidation(t *testing.T) {
+	_, err := Start(Config{})
+	assert.Error(t, err)
+
+	_, err = Start(Config{Collection: newTestCollection(t), Interval: -time.Second})
+	assert.Error(t, err)
+}
+
+func TestProbe(t *testing.T) {
+	p, err := newProbe(Config{Collection: newTestCollection(t), ID: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, defaultInterval, p.cfg.Interval)
+	assert.Equal(t, defaultTimeout, p.cfg.Timeout)
+
+	latencies := []time.Duration{10 * time.Millisecond, 0, 20 * time.Millisecond}
+	var calls int
+	p.write = func(ctx context.Context) error {
+		defer func() { calls++ }()
+		if latencies[calls] == 0 {
+			return errors.New("write failed")
+		}
+		time.Sleep(latencies[calls])
+		return nil
+	}
+	var samples []Sample
+	p.cfg.OnSample = func(s Sample) { samples = append(samples, s) }
+
+	for range latencies {
+		p.Measure(context.Background())
+	}
+
+	stats := p.Stats()
+	assert.Equal(t, int64(3), stats.Samples)
+	assert.Equal(t, int64(1), stats.Failures)
+	assert.Equal(t, samples[2], stats.Last)
+	assert.EqualError(t, samples[1].Err, "write failed")
+	assert.GreaterOrEqual(t, stats.MaxLatency, 20*time.Millisecond)
+	assert.Greater(t, stats.SmoothedLatency, samples[0].Latency)
+	assert.Less(t, stats.SmoothedLatency, stats.MaxLatency)
+}
+
+func TestProbeTimeout(t *testing.T) {
+	p, err := newProbe(Config{Collection: newTestCollection(t), Timeout: 10 * time.Millisecond})
+	require.NoError(t, err)
+	p.write = func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	s := p.Measure(context.Background())
+	assert.ErrorIs(t, s.Err, context.DeadlineExceeded)
+	assert.Equal(t, int64(1), p.Stats().Failures)
+}
+
+func TestStartStop(t *testing.T) {
+	coll := newTestCollection(t)
+	sampled := make(chan Sample, 1)
+	p, err := Start(Config{
+		Collection: coll,
+		Timeout:    10 * time.Millisecond,
+		OnSample: func(s Sample) {
+			select {
+			case sampled <- s:
+			default:
+			}
+		},
+	})
+	require.NoError(t, err)
+
+	// There is no server, so the first probe fails when it times out.
+	s := <-sampled
+	assert.Error(t, s.Err)
+
+	p.Stop()
+	p.Stop()
+}
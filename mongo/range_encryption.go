@@ -0,0 +1,203 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// RangeField describes a Queryable Encryption field indexed for "range"
+// queries. It marshals to an entry of the "fields" array of an encryptedFields
+// document, which can be passed to CreateCollectionOptionsBuilder.SetEncryptedFields,
+// AutoEncryptionOptionsBuilder.SetEncryptedFieldsMap, or
+// ClientEncryption.CreateEncryptedCollection:
+//
+//	encryptedFields := bson.D{{"fields", bson.A{
+//		mongo.RangeField{
+//			Path:     "age",
+//			BSONType: "int",
+//			Range:    options.RangeBounds[int32](0, 200).SetSparsity(1),
+//		},
+//	}}}
+//
+// The same RangeOptionsBuilder should be passed to EncryptOptionsBuilder.SetRangeOptions
+// when explicitly encrypting values or expressions for the field.
+type RangeField struct {
+	// Path is the dotted path of the field. It is required.
+	Path string
+
+	// BSONType is the BSON type of the field. It must be one of "int", "long",
+	// "double", "decimal", or "date".
+	BSONType string
+
+	// KeyID is the _id of the data key used to encrypt the field. If nil, the
+	// keyId is null, which causes ClientEncryption.CreateEncryptedCollection to
+	// create a new data key.
+	KeyID *bson.Binary
+
+	// Contention is the contention factor of the field. If nil, the server
+	// default is used.
+	Contention *int64
+
+	// Range specifies the bounds and tuning options of the range index. It is
+	// optional, but the minimum and maximum are required by the server for
+	// "double" and "decimal" fields that set a precision.
+	Range *options.RangeOptionsBuilder
+}
+
+var rangeBSONTypes = map[string]bson.Type{
+	"int":     bson.TypeInt32,
+	"long":    bson.TypeInt64,
+	"double":  bson.TypeDouble,
+	"decimal": bson.TypeDecimal128,
+	"date":    bson.TypeDateTime,
+}
+
+// MarshalBSON implements the bson.Marshaler interface.
+func (f RangeField) MarshalBSON() ([]byte, error) {
+	if f.Path == "" {
+		return nil, errors.New("range field path must not be empty")
+	}
+	bsonType, ok := rangeBSONTypes[f.BSONType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported BSON type %q for range field %q", f.BSONType, f.Path)
+	}
+
+	queries := bson.D{{Key: "queryType", Value: options.QueryTypeRange}}
+	if f.Contention != nil {
+		queries = append(queries, bson.E{Key: "contention", Value: *f.Contention})
+	}
+	if f.Range != nil {
+		args, err := mongoutil.NewOptions[options.RangeOptions](f.Range)
+		if err != nil {
+			return nil, err
+		}
+		for _, bound := range []struct {
+			name string
+			val  *bson.RawValue
+		}{{"min", args.Min}, {"max", args.Max}} {
+			if bound.val == nil {
+				continue
+			}
+			if bound.val.Type != bsonType {
+				return nil, fmt.Errorf("range field %q has BSON type %q, but %s is of type %s",
+					f.Path, f.BSONType, bound.name, bound.val.Type)
+			}
+			queries = append(queries, bson.E{Key: bound.name, Value: *bound.val})
+		}
+		if args.Sparsity != nil {
+			queries = append(queries, bson.E{Key: "sparsity", Value: *args.Sparsity})
+		}
+		if args.Precision != nil {
+			queries = append(queries, bson.E{Key: "precision", Value: *args.Precision})
+		}
+		if args.TrimFactor != nil {
+			queries = append(queries, bson.E{Key: "trimFactor", Value: *args.TrimFactor})
+		}
+	}
+
+	var keyID any
+	if f.KeyID != nil {
+		keyID = *f.KeyID
+	}
+	return bson.Marshal(bson.D{
+		{Key: "path", Value: f.Path},
+		{Key: "bsonType", Value: f.BSONType},
+		{Key: "keyId", Value: keyID},
+		{Key: "queries", Value: queries},
+	})
+}
+
+// RangeQuery describes a range predicate on a Queryable Encryption field
+// indexed for "range" queries. The expressions it builds are intended to be
+// passed to ClientEncryption.EncryptExpression:
+//
+//	min, max := int32(30), int32(40)
+//	q := mongo.RangeQuery[int32]{Field: "age", Min: &min, Max: &max, MaxExclusive: true}
+//	expr, err := q.MatchExpression()
+//	...
+//	var filter bson.Raw
+//	err = ce.EncryptExpression(ctx, expr, &filter, opts)
+//
+// At least one of Min and Max must be set.
+type RangeQuery[T options.RangeValue] struct {
+	// Field is the dotted path of the field. It is required.
+	Field string
+
+	// Min is the lower bound of the range, or nil if the range has no lower
+	// bound. It is inclusive unless MinExclusive is true.
+	Min          *T
+	MinExclusive bool
+
+	// Max is the upper bound of the range, or nil if the range has no upper
+	// bound. It is inclusive unless MaxExclusive is true.
+	Max          *T
+	MaxExclusive bool
+}
+
+// MatchExpression returns the range predicate as a query filter of the form
+// {$and: [{<field>: {$gte: <min>}}, {<field>: {$lte: <max>}}]}.
+func (q RangeQuery[T]) MatchExpression() (bson.D, error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	var preds bson.A
+	q.each(func(op string, val T) {
+		preds = append(preds, bson.D{{Key: q.Field, Value: bson.D{{Key: op, Value: val}}}})
+	})
+	return bson.D{{Key: "$and", Value: preds}}, nil
+}
+
+// AggregateExpression returns the range predicate as an aggregation expression
+// of the form {$and: [{$gte: ["$<field>", <min>]}, {$lte: ["$<field>", <max>]}]},
+// which can be used in a $match stage with $expr.
+func (q RangeQuery[T]) AggregateExpression() (bson.D, error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	var preds bson.A
+	q.each(func(op string, val T) {
+		preds = append(preds, bson.D{{Key: op, Value: bson.A{"$" + q.Field, val}}})
+	})
+	return bson.D{{Key: "$and", Value: preds}}, nil
+}
+
+func (q RangeQuery[T]) validate() error {
+	if q.Field == "" {
+		return errors.New("range query field must not be empty")
+	}
+	if q.Min == nil && q.Max == nil {
+		return fmt.Errorf("range query on %q must set at least one of Min or Max", q.Field)
+	}
+	return nil
+}
+
+// each calls fn with the comparison operator and value of each bound that is
+// set.
+func (q RangeQuery[T]) each(fn func(op string, val T)) {
+	if q.Min != nil {
+		op := "$gte"
+		if q.MinExclusive {
+			op = "$gt"
+		}
+		fn(op, *q.Min)
+	}
+	if q.Max != nil {
+		op := "$lte"
+		if q.MaxExclusive {
+			op = "$lt"
+		}
+		fn(op, *q.Max)
+	}
+}
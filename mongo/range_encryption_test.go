@@ -0,0 +1,96 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestRangeField(t *testing.T) {
+	t.Parallel()
+
+	contention := int64(4)
+	testCases := []struct {
+		name  string
+		field RangeField
+		want  string
+	}{
+		{
+			name:  "no range options",
+			field: RangeField{Path: "age", BSONType: "int"},
+			want:  `{"path": "age","bsonType": "int","keyId": null,"queries": {"queryType": "range"}}`,
+		},
+		{
+			name: "all options",
+			field: RangeField{
+				Path:       "price",
+				BSONType:   "double",
+				KeyID:      &bson.Binary{Subtype: 4, Data: make([]byte, 16)},
+				Contention: &contention,
+				Range:      options.RangeBounds(0.0, 100.0).SetSparsity(2).SetPrecision(2).SetTrimFactor(1),
+			},
+			want: `{"path": "price","bsonType": "double",` +
+				`"keyId": {"$binary":{"base64":"AAAAAAAAAAAAAAAAAAAAAA==","subType":"04"}},` +
+				`"queries": {"queryType": "range","contention": {"$numberLong":"4"},` +
+				`"min": {"$numberDouble":"0.0"},"max": {"$numberDouble":"100.0"},` +
+				`"sparsity": {"$numberLong":"2"},"precision": {"$numberInt":"2"},"trimFactor": {"$numberInt":"1"}}}`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := bson.Marshal(tc.field)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, bson.Raw(doc).String())
+		})
+	}
+
+	invalid := []RangeField{
+		{BSONType: "int"},
+		{Path: "name", BSONType: "string"},
+		{Path: "age", BSONType: "long", Range: options.RangeBounds[int32](0, 200)},
+	}
+	for _, f := range invalid {
+		_, err := bson.Marshal(f)
+		assert.Error(t, err, "expected error marshaling %+v", f)
+	}
+}
+
+func TestRangeQuery(t *testing.T) {
+	t.Parallel()
+
+	min, max := int64(30), int64(40)
+	q := RangeQuery[int64]{Field: "age", Min: &min, Max: &max, MaxExclusive: true}
+
+	match, err := q.MatchExpression()
+	require.NoError(t, err)
+	want := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "age", Value: bson.D{{Key: "$gte", Value: int64(30)}}}},
+		bson.D{{Key: "age", Value: bson.D{{Key: "$lt", Value: int64(40)}}}},
+	}}}
+	assert.Equal(t, want, match)
+
+	agg, err := RangeQuery[int64]{Field: "age", Min: &min, MinExclusive: true}.AggregateExpression()
+	require.NoError(t, err)
+	want = bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "$gt", Value: bson.A{"$age", int64(30)}}},
+	}}}
+	assert.Equal(t, want, agg)
+
+	_, err = RangeQuery[int64]{Field: "age"}.MatchExpression()
+	assert.Error(t, err)
+	_, err = RangeQuery[int64]{Min: &min}.AggregateExpression()
+	assert.Error(t, err)
+}
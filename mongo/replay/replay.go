@@ -0,0 +1,169 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package replay records the nondeterministic choices made by the driver
+// during a test run so that they can be replayed exactly in a later run. This
+// makes failures of tests that depend on those choices reproducible:
+//
+//	func TestCheckout(t *testing.T) {
+//		if path := os.Getenv("REPLAY_LOG"); path != "" {
+//			log, err := replay.ReadFile(path)
+//			require.NoError(t, err)
+//			replayer, err := replay.Replay(log)
+//			require.NoError(t, err)
+//			defer func() { assert.NoError(t, replayer.Stop()) }()
+//		} else {
+//			recorder, err := replay.Record()
+//			require.NoError(t, err)
+//			defer func() {
+//				if log := recorder.Stop(); t.Failed() {
+//					_ = log.WriteFile("checkout.replay.json")
+//				}
+//			}()
+//		}
+//		// ...
+//	}
+//
+// The following inputs are recorded:
+//   - the servers chosen during server selection when multiple servers are
+//     suitable,
+//   - the random backoffs between the attempts of Session.WithTransaction,
+//   - the ObjectIDs generated by bson.NewObjectID, including the _id values
+//     generated for inserted documents.
+//
+// Recording and replaying apply to the whole process, so only one recording or
+// replay can be active at a time, and tests that record or replay must not run
+// in parallel with other tests that use the driver. Replaying only reproduces a
+// run if the test performs the same sequence of operations; timing-dependent
+// behaviour of the server and the network is not recorded.
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/replay"
+)
+
+// ErrActive is returned by Record and Replay if a recording or replay is
+// already active.
+var ErrActive = replay.ErrActive
+
+// ErrDiverged is wrapped by the error returned by Replayer.Stop if the driver
+// requested an input that differs from the recorded one, for example because
+// the test performed a different sequence of operations.
+var ErrDiverged = errors.New("replay diverged from the recorded log")
+
+// Log contains the nondeterministic inputs of a run in the order they were
+// used. It can be stored as JSON.
+type Log struct {
+	// ServerSelections contains the indexes of the servers chosen among the
+	// suitable servers during server selection.
+	ServerSelections []int64 `json:"serverSelections,omitempty"`
+
+	// RetryBackoffs contains the backoffs between the attempts of
+	// Session.WithTransaction.
+	RetryBackoffs []time.Duration `json:"retryBackoffs,omitempty"`
+
+	// ObjectIDs contains the ObjectIDs generated by bson.NewObjectID.
+	ObjectIDs []bson.ObjectID `json:"objectIds,omitempty"`
+}
+
+// ReadFile reads a Log written by Log.WriteFile.
+func ReadFile(name string) (*Log, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	log := new(Log)
+	if err := json.Unmarshal(data, log); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// WriteFile writes the Log to the named file as JSON.
+func (l *Log) WriteFile(name string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(name, data, 0o644)
+}
+
+// Recorder records the nondeterministic inputs of the driver.
+type Recorder struct {
+	s *replay.Session
+}
+
+// Record starts recording the nondeterministic inputs of the driver. It
+// returns ErrActive if a recording or replay is already active.
+func Record() (*Recorder, error) {
+	s, err := replay.Record()
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{s: s}, nil
+}
+
+// Stop stops the recording and returns the recorded inputs.
+func (r *Recorder) Stop() *Log {
+	log, _ := r.s.Stop()
+	return fromInternal(log)
+}
+
+// Replayer replays the nondeterministic inputs recorded in a Log.
+type Replayer struct {
+	s *replay.Session
+}
+
+// Replay starts replaying log, so that the driver uses the recorded inputs in
+// order instead of making new choices. It returns ErrActive if a recording or
+// replay is already active.
+func Replay(log *Log) (*Replayer, error) {
+	s, err := replay.Replay(toInternal(log))
+	if err != nil {
+		return nil, err
+	}
+	return &Replayer{s: s}, nil
+}
+
+// Stop stops the replay. It returns an error that wraps ErrDiverged if the
+// run diverged from the recorded log, in which case the driver made new
+// choices from the point of divergence on.
+func (r *Replayer) Stop() error {
+	if _, err := r.s.Stop(); err != nil {
+		return fmt.Errorf("%w: %v", ErrDiverged, err)
+	}
+	return nil
+}
+
+func toInternal(l *Log) replay.Log {
+	var log replay.Log
+	log.Ints[replay.ServerSelection] = l.ServerSelections
+	for _, d := range l.RetryBackoffs {
+		log.Ints[replay.RetryBackoff] = append(log.Ints[replay.RetryBackoff], int64(d))
+	}
+	for _, id := range l.ObjectIDs {
+		log.ObjectIDs = append(log.ObjectIDs, id)
+	}
+	return log
+}
+
+func fromInternal(log replay.Log) *Log {
+	l := &Log{ServerSelections: log.Ints[replay.ServerSelection]}
+	for _, d := range log.Ints[replay.RetryBackoff] {
+		l.RetryBackoffs = append(l.RetryBackoffs, time.Duration(d))
+	}
+	for _, id := range log.ObjectIDs {
+		l.ObjectIDs = append(l.ObjectIDs, id)
+	}
+	return l
+}
@@ -0,0 +1,90 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package replay
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/replay"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+// The tests in this file must not run in parallel because recording and
+// replaying apply to the whole process.
+
+func TestRecordAndReplay(t *testing.T) {
+	recorder, err := Record()
+	require.NoError(t, err)
+
+	_, err = Record()
+	assert.ErrorIs(t, err, ErrActive)
+
+	ids := []bson.ObjectID{bson.NewObjectID(), bson.NewObjectID()}
+	choice := replay.Intn(replay.ServerSelection, 3, func(int) int { return 2 })
+	backoff := replay.Int63n(replay.RetryBackoff, 100, func(int64) int64 { return 42 })
+	log := recorder.Stop()
+
+	want := &Log{
+		ServerSelections: []int64{2},
+		RetryBackoffs:    []time.Duration{42},
+		ObjectIDs:        ids,
+	}
+	assert.Equal(t, want, log)
+	assert.Equal(t, 2, choice)
+	assert.Equal(t, int64(42), backoff)
+
+	path := filepath.Join(t.TempDir(), "replay.json")
+	require.NoError(t, log.WriteFile(path))
+	log, err = ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, log)
+
+	replayer, err := Replay(log)
+	require.NoError(t, err)
+	assert.Equal(t, ids[0], bson.NewObjectID())
+	assert.Equal(t, ids[1], bson.NewObjectID())
+	assert.Equal(t, 2, replay.Intn(replay.ServerSelection, 3, func(int) int { return 0 }))
+	assert.Equal(t, int64(42), replay.Int63n(replay.RetryBackoff, 100, func(int64) int64 { return 7 }))
+	assert.NoError(t, replayer.Stop())
+
+	assert.NotEqual(t, ids[0], bson.NewObjectID(), "expected new ObjectIDs after the replay stopped")
+}
+
+func TestReplayDiverged(t *testing.T) {
+	testCases := []struct {
+		name string
+		log  *Log
+		run  func()
+	}{
+		{
+			name: "exhausted",
+			log:  &Log{},
+			run:  func() { bson.NewObjectID() },
+		},
+		{
+			name: "out of range",
+			log:  &Log{ServerSelections: []int64{5}},
+			run: func() {
+				got := replay.Intn(replay.ServerSelection, 2, func(int) int { return 1 })
+				assert.Equal(t, 1, got, "expected a new choice after diverging")
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			replayer, err := Replay(tc.log)
+			require.NoError(t, err)
+
+			tc.run()
+			assert.ErrorIs(t, replayer.Stop(), ErrDiverged)
+		})
+	}
+}
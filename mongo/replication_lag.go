@@ -0,0 +1,209 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+)
+
+// errorCodeUnauthorized is the server error code returned when the user is not
+// authorized to run a command.
+const errorCodeUnauthorized = 13
+
+// defaultReplicationLagTTL is how long a replication lag measurement is cached
+// if the Client does not have a heartbeat interval configured. It matches the
+// default heartbeat interval.
+const defaultReplicationLagTTL = 10 * time.Second
+
+// ReplicationLagSource identifies the data a ReplicationLagReport was computed
+// from.
+type ReplicationLagSource string
+
+// These constants are the possible sources of a ReplicationLagReport.
+const (
+	// ReplicationLagFromStatus indicates that lag was computed from the member
+	// optimes reported by the replSetGetStatus command.
+	ReplicationLagFromStatus ReplicationLagSource = "replSetGetStatus"
+
+	// ReplicationLagFromHeartbeats indicates that lag was estimated from the
+	// hello responses of the Client's background monitoring, as reported by
+	// Client.SecondaryStaleness. This is used if the user is not authorized to
+	// run replSetGetStatus.
+	ReplicationLagFromHeartbeats ReplicationLagSource = "hello"
+)
+
+// ReplicationLagReport describes how far each secondary of a replica set lags
+// behind the primary.
+type ReplicationLagReport struct {
+	// Primary is the address of the primary. It is empty if the replica set
+	// has no primary, in which case lag is measured relative to the secondary
+	// with the most recent write.
+	Primary string
+
+	// Lag is the replication lag of each secondary, keyed by address.
+	Lag map[string]time.Duration
+
+	// Source is the data the report was computed from.
+	Source ReplicationLagSource
+
+	// MeasuredAt is the time the report was computed.
+	MeasuredAt time.Time
+}
+
+// Max returns the largest lag of any secondary, or 0 if there are no
+// secondaries.
+func (r *ReplicationLagReport) Max() time.Duration {
+	var lag time.Duration
+	for _, l := range r.Lag {
+		if l > lag {
+			lag = l
+		}
+	}
+	return lag
+}
+
+// replicationLagCache holds the most recent ReplicationLagReport of a Client.
+type replicationLagCache struct {
+	mu     sync.Mutex
+	report *ReplicationLagReport
+}
+
+// ReplicationLag returns the replication lag of each secondary of the replica set the Client is connected to. Lag is
+// computed from the member optimes reported by the replSetGetStatus admin command, or estimated from the Client's
+// heartbeats if the user is not authorized to run that command.
+//
+// Reports are cached for the heartbeat interval of the Client, so ReplicationLag can be called on every request by
+// routing logic or health checks without sending a command each time. Concurrent calls share a single command. The
+// returned report may be modified by the caller.
+func (c *Client) ReplicationLag(ctx context.Context) (*ReplicationLagReport, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	c.replicationLag.mu.Lock()
+	defer c.replicationLag.mu.Unlock()
+
+	if r := c.replicationLag.report; r != nil && time.Since(r.MeasuredAt) < c.replicationLagTTL() {
+		return r.clone(), nil
+	}
+
+	opts := options.RunCmd().SetReadPreference(readpref.PrimaryPreferred())
+	status, err := c.Database("admin").RunCommand(ctx, bson.D{{"replSetGetStatus", 1}}, opts).Raw()
+	var report *ReplicationLagReport
+	var ce CommandError
+	switch {
+	case errors.As(err, &ce) && ce.HasErrorCode(errorCodeUnauthorized):
+		report = &ReplicationLagReport{
+			Lag:        c.SecondaryStaleness(),
+			Source:     ReplicationLagFromHeartbeats,
+			MeasuredAt: time.Now(),
+		}
+	case err != nil:
+		return nil, err
+	default:
+		report, err = replicationLagFromStatus(status, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.replicationLag.report = report
+	return report.clone(), nil
+}
+
+func (c *Client) replicationLagTTL() time.Duration {
+	if c.heartbeatInterval > 0 {
+		return c.heartbeatInterval
+	}
+	return defaultReplicationLagTTL
+}
+
+func (r *ReplicationLagReport) clone() *ReplicationLagReport {
+	cp := *r
+	cp.Lag = make(map[string]time.Duration, len(r.Lag))
+	for addr, lag := range r.Lag {
+		cp.Lag[addr] = lag
+	}
+	return &cp
+}
+
+// replicationLagFromStatus computes a ReplicationLagReport from a
+// replSetGetStatus response.
+func replicationLagFromStatus(status bson.Raw, now time.Time) (*ReplicationLagReport, error) {
+	const (
+		statePrimary   = 1
+		stateSecondary = 2
+	)
+
+	membersVal, err := status.LookupErr("members")
+	if err != nil {
+		return nil, fmt.Errorf("replSetGetStatus response has no members: %w", err)
+	}
+	members, ok := membersVal.ArrayOK()
+	if !ok {
+		return nil, fmt.Errorf("expected replSetGetStatus members to be an array, got %v", membersVal.Type)
+	}
+	values, err := members.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReplicationLagReport{
+		Lag:        make(map[string]time.Duration),
+		Source:     ReplicationLagFromStatus,
+		MeasuredAt: now,
+	}
+	var primaryOptime time.Time
+	secondaries := make(map[string]time.Time)
+	for _, v := range values {
+		member, ok := v.DocumentOK()
+		if !ok {
+			return nil, fmt.Errorf("expected replSetGetStatus member to be a document, got %v", v.Type)
+		}
+		name, _ := member.Lookup("name").StringValueOK()
+		state, _ := member.Lookup("state").AsInt64OK()
+		optime, ok := member.Lookup("optimeDate").TimeOK()
+		if !ok {
+			continue
+		}
+
+		switch state {
+		case statePrimary:
+			report.Primary = name
+			primaryOptime = optime
+		case stateSecondary:
+			secondaries[name] = optime
+		}
+	}
+
+	// Without a primary, lag is measured relative to the most recent write of
+	// any secondary, consistent with Client.SecondaryStaleness.
+	base := primaryOptime
+	if report.Primary == "" {
+		for _, optime := range secondaries {
+			if optime.After(base) {
+				base = optime
+			}
+		}
+	}
+	for name, optime := range secondaries {
+		lag := base.Sub(optime)
+		if lag < 0 {
+			lag = 0
+		}
+		report.Lag[name] = lag
+	}
+	return report, nil
+}
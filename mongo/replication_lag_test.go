@@ -0,0 +1,86 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestReplicationLag(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	member := func(name string, state int32, optime time.Time) bson.D {
+		return bson.D{
+			{"name", name},
+			{"state", state},
+			{"optimeDate", bson.NewDateTimeFromTime(optime)},
+		}
+	}
+	marshal := func(t *testing.T, members ...bson.D) bson.Raw {
+		t.Helper()
+		b, err := bson.Marshal(bson.D{{"ok", 1}, {"members", members}})
+		require.NoError(t, err)
+		return b
+	}
+
+	t.Run("with primary", func(t *testing.T) {
+		status := marshal(t,
+			member("a:27017", 1, base),
+			member("b:27017", 2, base.Add(-5*time.Second)),
+			member("c:27017", 2, base.Add(time.Second)),
+			member("d:27017", 7, base.Add(-time.Hour)),
+		)
+		report, err := replicationLagFromStatus(status, base)
+		require.NoError(t, err)
+		assert.Equal(t, "a:27017", report.Primary)
+		assert.Equal(t, ReplicationLagFromStatus, report.Source)
+		assert.Equal(t, map[string]time.Duration{
+			"b:27017": 5 * time.Second,
+			"c:27017": 0,
+		}, report.Lag)
+		assert.Equal(t, 5*time.Second, report.Max())
+	})
+	t.Run("without primary", func(t *testing.T) {
+		status := marshal(t,
+			member("b:27017", 2, base.Add(-5*time.Second)),
+			member("c:27017", 2, base.Add(-2*time.Second)),
+		)
+		report, err := replicationLagFromStatus(status, base)
+		require.NoError(t, err)
+		assert.Equal(t, "", report.Primary)
+		assert.Equal(t, map[string]time.Duration{
+			"b:27017": 3 * time.Second,
+			"c:27017": 0,
+		}, report.Lag)
+	})
+	t.Run("missing members", func(t *testing.T) {
+		b, err := bson.Marshal(bson.D{{"ok", 1}})
+		require.NoError(t, err)
+		_, err = replicationLagFromStatus(b, base)
+		assert.Error(t, err)
+	})
+	t.Run("cached", func(t *testing.T) {
+		client := setupClient()
+		client.replicationLag.report = &ReplicationLagReport{
+			Lag:        map[string]time.Duration{"b:27017": time.Second},
+			Source:     ReplicationLagFromStatus,
+			MeasuredAt: time.Now(),
+		}
+
+		report, err := client.ReplicationLag(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, time.Second, report.Lag["b:27017"])
+
+		report.Lag["b:27017"] = time.Hour
+		assert.Equal(t, time.Second, client.replicationLag.report.Lag["b:27017"])
+	})
+}
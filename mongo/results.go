@@ -7,6 +7,8 @@
 package mongo
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
@@ -88,6 +90,12 @@ type BulkWriteResult struct {
 	// Operation performed with an acknowledged write. Values for other fields may
 	// not be deterministic if the write operation was unacknowledged.
 	Acknowledged bool
+	// OperationTime is the operationTime reported by the server for the
+	// session used by the operation. It can be passed to
+	// Session.AdvanceOperationTime on another client or process to continue a
+	// causally consistent sequence of operations. It is nil if the write was
+	// unacknowledged or the deployment does not report operation times.
+	OperationTime *bson.Timestamp
 }
 
 // InsertOneResult is the result type returned by an InsertOne operation.
@@ -98,6 +106,12 @@ type InsertOneResult struct {
 	// Operation performed with an acknowledged write. Values for other fields may
 	// not be deterministic if the write operation was unacknowledged.
 	Acknowledged bool
+	// OperationTime is the operationTime reported by the server for the
+	// session used by the operation. It can be passed to
+	// Session.AdvanceOperationTime on another client or process to continue a
+	// causally consistent sequence of operations. It is nil if the write was
+	// unacknowledged or the deployment does not report operation times.
+	OperationTime *bson.Timestamp
 }
 
 // InsertManyResult is a result type returned by an InsertMany operation.
@@ -108,6 +122,12 @@ type InsertManyResult struct {
 	// Operation performed with an acknowledged write. Values for other fields may
 	// not be deterministic if the write operation was unacknowledged.
 	Acknowledged bool
+	// OperationTime is the operationTime reported by the server for the
+	// session used by the operation. It can be passed to
+	// Session.AdvanceOperationTime on another client or process to continue a
+	// causally consistent sequence of operations. It is nil if the write was
+	// unacknowledged or the deployment does not report operation times.
+	OperationTime *bson.Timestamp
 }
 
 // TODO(GODRIVER-2367): Remove the BSON struct tags on DeleteResult.
@@ -119,6 +139,12 @@ type DeleteResult struct {
 	// Operation performed with an acknowledged write. Values for other fields may
 	// not be deterministic if the write operation was unacknowledged.
 	Acknowledged bool
+	// OperationTime is the operationTime reported by the server for the
+	// session used by the operation. It can be passed to
+	// Session.AdvanceOperationTime on another client or process to continue a
+	// causally consistent sequence of operations. It is nil if the write was
+	// unacknowledged or the deployment does not report operation times.
+	OperationTime *bson.Timestamp
 }
 
 // RewrapManyDataKeyResult is the result of the bulk write operation used to update the key vault collection with
@@ -167,6 +193,12 @@ type UpdateResult struct {
 	// Operation performed with an acknowledged write. Values for other fields may
 	// not be deterministic if the write operation was unacknowledged.
 	Acknowledged bool
+	// OperationTime is the operationTime reported by the server for the
+	// session used by the operation. It can be passed to
+	// Session.AdvanceOperationTime on another client or process to continue a
+	// causally consistent sequence of operations. It is nil if the write was
+	// unacknowledged or the deployment does not report operation times.
+	OperationTime *bson.Timestamp
 }
 
 // IndexSpecification represents an index in a database. This type is returned by the IndexView.ListSpecifications
@@ -198,17 +230,33 @@ type IndexSpecification struct {
 
 	// The clustered index.
 	Clustered *bool
+
+	// If true, the index is hidden from the query planner.
+	Hidden *bool
+
+	// The filter document of a partial index, which only references documents that match the filter.
+	PartialFilterExpression bson.Raw
+
+	// The collation document of the index.
+	Collation bson.Raw
+
+	// The projection document of a wildcard index.
+	WildcardProjection bson.Raw
 }
 
 type indexListSpecificationResponse struct {
-	Name               string   `bson:"name"`
-	Namespace          string   `bson:"ns"`
-	KeysDocument       bson.Raw `bson:"key"`
-	Version            int32    `bson:"v"`
-	ExpireAfterSeconds *int32   `bson:"expireAfterSeconds"`
-	Sparse             *bool    `bson:"sparse"`
-	Unique             *bool    `bson:"unique"`
-	Clustered          *bool    `bson:"clustered"`
+	Name                    string   `bson:"name"`
+	Namespace               string   `bson:"ns"`
+	KeysDocument            bson.Raw `bson:"key"`
+	Version                 int32    `bson:"v"`
+	ExpireAfterSeconds      *int32   `bson:"expireAfterSeconds"`
+	Sparse                  *bool    `bson:"sparse"`
+	Unique                  *bool    `bson:"unique"`
+	Clustered               *bool    `bson:"clustered"`
+	Hidden                  *bool    `bson:"hidden"`
+	PartialFilterExpression bson.Raw `bson:"partialFilterExpression"`
+	Collation               bson.Raw `bson:"collation"`
+	WildcardProjection      bson.Raw `bson:"wildcardProjection"`
 }
 
 // CollectionSpecification represents a collection in a database. This type is returned by the
@@ -231,6 +279,68 @@ type CollectionSpecification struct {
 
 	// An IndexSpecification instance with details about the collection's _id index.
 	IDIndex IndexSpecification
+
+	// Whether the collection is capped, and the maximum size in bytes and maximum number of documents of a capped
+	// collection.
+	Capped       bool
+	SizeInBytes  *int64
+	MaxDocuments *int64
+
+	// The validator document of the collection, and how strictly and with which action it is applied.
+	Validator        bson.Raw
+	ValidationLevel  string
+	ValidationAction string
+
+	// The time-series options of a time-series collection.
+	TimeSeries *TimeSeriesSpecification
+
+	// The clustered index of a clustered collection. Collections that are implicitly clustered, such as time-series
+	// collections on some server versions, have an empty ClusteredIndexSpecification.
+	ClusteredIndex *ClusteredIndexSpecification
+
+	// The time after which documents are deleted from a time-series or clustered collection.
+	ExpireAfterSeconds *int64
+
+	// The source collection or view and the aggregation pipeline of a view.
+	ViewOn   string
+	Pipeline bson.Raw
+
+	// Whether change streams on the collection can include pre- and post-images of changed documents.
+	ChangeStreamPreAndPostImages bool
+}
+
+// TimeSeriesSpecification represents the time-series options of a collection. It is used in the
+// CollectionSpecification type.
+type TimeSeriesSpecification struct {
+	// The name of the field that contains the date of each document.
+	TimeField string
+
+	// The name of the field that contains the metadata of each document, or empty.
+	MetaField string
+
+	// The granularity of the time-series data, if the collection uses a granularity.
+	Granularity string
+
+	// The maximum time span of a bucket and the interval the start of a bucket is rounded to, if the collection
+	// uses custom bucketing.
+	BucketMaxSpan  *time.Duration
+	BucketRounding *time.Duration
+}
+
+// ClusteredIndexSpecification represents the clustered index of a collection. It is used in the
+// CollectionSpecification type.
+type ClusteredIndexSpecification struct {
+	// The index name.
+	Name string
+
+	// The keys specification document for the index.
+	KeysDocument bson.Raw
+
+	// Whether the index is unique.
+	Unique bool
+
+	// The index version.
+	Version int32
 }
 
 // DistinctResult represents an array of BSON data returned from an operation.
@@ -259,7 +369,7 @@ func (dr *DistinctResult) Decode(v any) error {
 			Data: dr.arr,
 		}).Build()
 
-	dec := getDecoder(doc, dr.bsonOpts, dr.reg)
+	dec := getDecoder(doc, dr.bsonOpts, dr.reg, nil)
 
 	return dec.Decode(&struct{ Arr any }{Arr: v})
 }
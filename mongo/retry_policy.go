@@ -0,0 +1,66 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"math/rand"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/replay"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+)
+
+// newRetryPolicy converts the retry policy options of a Client to the policy
+// applied by the driver, or returns nil if opts is nil.
+func newRetryPolicy(opts *options.RetryPolicyOptions) *driver.RetryPolicy {
+	if opts == nil {
+		return nil
+	}
+
+	policy := &driver.RetryPolicy{}
+	if classifier := opts.Classifier; classifier != nil {
+		policy.Classify = func(err error, write bool, retryable bool) bool {
+			return classifier(options.RetryInfo{Err: wrapErrors(err), Write: write, Retryable: retryable})
+		}
+	}
+	if opts.MaxRetries != nil {
+		policy.MaxRetries = *opts.MaxRetries
+	}
+	if opts.InitialBackoff != nil && *opts.InitialBackoff > 0 {
+		initial := *opts.InitialBackoff
+		maxBackoff := initial
+		if opts.MaxBackoff != nil {
+			maxBackoff = *opts.MaxBackoff
+		}
+		policy.Backoff = func(retry int) time.Duration {
+			return jitter(backoff(initial, maxBackoff, retry))
+		}
+	}
+	return policy
+}
+
+// backoff returns the delay before the given retry, starting at 1, which is
+// initial doubled for each previous retry and capped at maxBackoff.
+func backoff(initial, maxBackoff time.Duration, retry int) time.Duration {
+	d := initial
+	for i := 1; i < retry && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d
+}
+
+// jitter subtracts a random duration of up to half of d from d.
+func jitter(d time.Duration) time.Duration {
+	if half := int64(d / 2); half > 0 {
+		d -= time.Duration(replay.Int63n(replay.RetryBackoff, half+1, rand.Int63n))
+	}
+	return d
+}
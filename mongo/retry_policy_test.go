@@ -0,0 +1,99 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/replay"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+)
+
+func TestBackoff(t *testing.T) {
+	testCases := []struct {
+		retry int
+		want  time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 500 * time.Millisecond},
+		{50, 500 * time.Millisecond},
+	}
+	for _, tc := range testCases {
+		got := backoff(100*time.Millisecond, 500*time.Millisecond, tc.retry)
+		assert.Equal(t, tc.want, got, "wrong backoff for retry %d", tc.retry)
+	}
+
+	for i := 0; i < 100; i++ {
+		d := jitter(time.Second)
+		assert.True(t, d >= 500*time.Millisecond && d <= time.Second, "jitter out of range: %v", d)
+	}
+
+	var log replay.Log
+	log.Ints[replay.RetryBackoff] = []int64{int64(250 * time.Millisecond)}
+	sess, err := replay.Replay(log)
+	require.NoError(t, err, "Replay error")
+	assert.Equal(t, 750*time.Millisecond, jitter(time.Second), "expected the replayed jitter")
+	_, err = sess.Stop()
+	require.NoError(t, err, "Stop error")
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	assert.Nil(t, newRetryPolicy(nil))
+
+	var got options.RetryInfo
+	opts := options.RetryPolicy().
+		SetClassifier(func(info options.RetryInfo) bool {
+			got = info
+			return true
+		}).
+		SetMaxRetries(3).
+		SetBackoff(10*time.Millisecond, 40*time.Millisecond)
+
+	policy := newRetryPolicy(opts)
+	require.NotNil(t, policy, "expected a policy")
+	assert.Equal(t, 3, policy.MaxRetries)
+
+	err := driver.Error{Code: 11600, Message: "interrupted"}
+	assert.True(t, policy.Classify(err, true, false), "expected classifier result")
+	assert.True(t, got.Write, "expected write")
+	assert.False(t, got.Retryable, "expected not retryable by default")
+	_, ok := got.Err.(ServerError)
+	assert.True(t, ok, "expected error to be converted to a ServerError, got %T", got.Err)
+
+	d := policy.Backoff(5)
+	assert.True(t, d >= 20*time.Millisecond && d <= 40*time.Millisecond, "backoff out of range: %v", d)
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		opts   *options.RetryPolicyOptions
+		errStr string
+	}{
+		{"default", options.RetryPolicy(), ""},
+		{"max retries", options.RetryPolicy().SetMaxRetries(0), "retry policy max retries must be at least 1"},
+		{"negative backoff", options.RetryPolicy().SetBackoff(-1, 0), "retry policy initial backoff must not be negative"},
+		{"max below initial", options.RetryPolicy().SetBackoff(time.Second, time.Millisecond),
+			"retry policy max backoff must not be less than initial backoff"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := options.Client().SetRetryPolicy(tc.opts).Validate()
+			if tc.errStr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.errStr)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+)
+
+// CommandResult is the result of a command run by Database.RunCommands.
+type CommandResult struct {
+	// Name is the name of the command.
+	Name string
+
+	// Result is the response of the server. It is nil if the command could not
+	// be sent.
+	Result bson.Raw
+
+	// Err is the error returned by the command, if any.
+	Err error
+}
+
+// RunCommands runs a sequence of commands against the database on a single
+// connection. The server is selected and the connection is checked out once,
+// so scripts that run many administrative commands, such as provisioning
+// users, roles, and collections, do not pay for server selection and
+// connection checkout for each command.
+//
+// The commands are run in order. RunCommands returns a result for each command
+// that was run, in the same order. If StopOnError is set, no more commands are
+// run after the first failed command, so the last result contains the error.
+// RunCommands only returns an error if the commands could not be run at all,
+// for example because no server could be selected.
+//
+// The commands are not retried, and like RunCommand, RunCommands does not obey
+// the Database's read preference, read concern, or write concern. Each command
+// runs in its own implicit session unless ctx carries a session.
+//
+// The opts parameter can be used to specify options for this operation (see the
+// options.RunCommandsOptions documentation).
+func (db *Database) RunCommands(
+	ctx context.Context,
+	cmds []bson.D,
+	opts ...options.Lister[options.RunCommandsOptions],
+) ([]CommandResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	args, err := mongoutil.NewOptions[options.RunCommandsOptions](opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+	if len(cmds) == 0 {
+		return nil, errors.New("at least one command must be provided")
+	}
+
+	rp := readpref.Primary()
+	if args.ReadPreference != nil {
+		rp = args.ReadPreference
+	}
+	deployment, err := db.client.checkOutConnection(ctx, rp)
+	if err != nil {
+		return nil, err
+	}
+	defer deployment.Close(ctx)
+
+	cmdOpts := options.RunCmd().SetReadPreference(rp)
+
+	results := make([]CommandResult, 0, len(cmds))
+	for _, cmd := range cmds {
+		res := db.runCommandOnConnection(ctx, cmd, deployment, cmdOpts)
+		results = append(results, res)
+		if res.Err != nil && args.StopOnError != nil && *args.StopOnError {
+			break
+		}
+	}
+	return results, nil
+}
+
+// checkOutConnection selects a server with rp and checks out a connection to
+// it. The caller must close the returned deployment.
+func (c *Client) checkOutConnection(ctx context.Context, rp *readpref.ReadPref) (driver.ConnectionDeployment, error) {
+	selector := &serverselector.Composite{
+		Selectors: []description.ServerSelector{
+			&serverselector.ReadPref{ReadPref: rp},
+			&serverselector.Latency{Latency: c.localThreshold},
+		},
+	}
+	srv, err := c.deployment.SelectServer(ctx, selector)
+	if err != nil {
+		return driver.ConnectionDeployment{}, wrapErrors(err)
+	}
+	conn, err := srv.Connection(ctx)
+	if err != nil {
+		return driver.ConnectionDeployment{}, wrapErrors(err)
+	}
+	return driver.NewConnectionDeployment(srv, conn, c.deployment.Kind()), nil
+}
+
+// runCommandOnConnection runs cmd like RunCommand, but on the connection of
+// deployment.
+func (db *Database) runCommandOnConnection(
+	ctx context.Context,
+	cmd bson.D,
+	deployment driver.ConnectionDeployment,
+	opts *options.RunCmdOptionsBuilder,
+) CommandResult {
+	op, sess, runCmdDoc, err := db.processRunCommand(ctx, cmd, false, opts)
+	defer closeImplicitSession(sess)
+
+	var res CommandResult
+	if len(cmd) > 0 {
+		res.Name = cmd[0].Key
+	}
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	ns := runCommandNamespace(db.name, runCmdDoc)
+	err = db.client.runOperation(ctx, res.Name, ns, op.Deployment(deployment).Execute)
+	db.client.auditCommand(ctx, db.name, runCmdDoc, err)
+	_, res.Err = processWriteError(err)
+	if result := op.Result(); result != nil {
+		res.Result = bson.Raw(result)
+	}
+	return res
+}
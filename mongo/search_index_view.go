@@ -14,6 +14,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/audit"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
@@ -162,6 +163,12 @@ func (siv SearchIndexView) CreateMany(
 	if err != nil {
 		return nil, err
 	}
+	if err = siv.coll.client.checkNamespacePolicy(siv.coll.namespace()); err != nil {
+		return nil, err
+	}
+	if err = siv.coll.client.checkReadOnly("createSearchIndexes", siv.coll.namespace()); err != nil {
+		return nil, err
+	}
 
 	selector := makePinnedSelector(sess, siv.coll.writeSelector)
 
@@ -169,10 +176,13 @@ func (siv SearchIndexView) CreateMany(
 		Session(sess).CommandMonitor(siv.coll.client.monitor).
 		ServerSelector(selector).ClusterClock(siv.coll.client.clock).
 		Collection(siv.coll.name).Database(siv.coll.db.name).
-		Deployment(siv.coll.client.deployment).ServerAPI(siv.coll.client.serverAPI).
+		Deployment(siv.coll.client.deploymentFor(ctx)).RetryPolicy(siv.coll.client.retryPolicy).
+		CommandInterceptors(siv.coll.client.interceptors).MemoryAccountant(siv.coll.client.memoryAccountant()).
+		ServerAPI(siv.coll.client.serverAPI).
 		Timeout(siv.coll.client.timeout).Authenticator(siv.coll.client.authenticator)
 
-	err = op.Execute(ctx)
+	err = siv.coll.client.runOperation(ctx, "createSearchIndexes", siv.coll.namespace(), op.Execute)
+	siv.coll.client.emitAudit(ctx, audit.Record{Namespace: siv.coll.namespace(), Operation: "createSearchIndexes"}, err)
 	if err != nil {
 		_, err = processWriteError(err)
 		return nil, err
@@ -219,6 +229,12 @@ func (siv SearchIndexView) DropOne(
 	if err != nil {
 		return err
 	}
+	if err = siv.coll.client.checkNamespacePolicy(siv.coll.namespace()); err != nil {
+		return err
+	}
+	if err = siv.coll.client.checkReadOnly("dropSearchIndex", siv.coll.namespace()); err != nil {
+		return err
+	}
 
 	selector := makePinnedSelector(sess, siv.coll.writeSelector)
 
@@ -226,14 +242,17 @@ func (siv SearchIndexView) DropOne(
 		Session(sess).CommandMonitor(siv.coll.client.monitor).
 		ServerSelector(selector).ClusterClock(siv.coll.client.clock).
 		Collection(siv.coll.name).Database(siv.coll.db.name).
-		Deployment(siv.coll.client.deployment).ServerAPI(siv.coll.client.serverAPI).
+		Deployment(siv.coll.client.deploymentFor(ctx)).RetryPolicy(siv.coll.client.retryPolicy).
+		CommandInterceptors(siv.coll.client.interceptors).MemoryAccountant(siv.coll.client.memoryAccountant()).
+		ServerAPI(siv.coll.client.serverAPI).
 		Timeout(siv.coll.client.timeout).Authenticator(siv.coll.client.authenticator)
 
-	err = op.Execute(ctx)
+	err = siv.coll.client.runOperation(ctx, "dropSearchIndex", siv.coll.namespace(), op.Execute)
 	var de driver.Error
 	if errors.As(err, &de) && de.NamespaceNotFound() {
-		return nil
+		err = nil
 	}
+	siv.coll.client.emitAudit(ctx, audit.Record{Namespace: siv.coll.namespace(), Operation: "dropSearchIndex"}, err)
 	return err
 }
 
@@ -276,6 +295,12 @@ func (siv SearchIndexView) UpdateOne(
 	if err != nil {
 		return err
 	}
+	if err = siv.coll.client.checkNamespacePolicy(siv.coll.namespace()); err != nil {
+		return err
+	}
+	if err = siv.coll.client.checkReadOnly("updateSearchIndex", siv.coll.namespace()); err != nil {
+		return err
+	}
 
 	selector := makePinnedSelector(sess, siv.coll.writeSelector)
 
@@ -283,8 +308,12 @@ func (siv SearchIndexView) UpdateOne(
 		Session(sess).CommandMonitor(siv.coll.client.monitor).
 		ServerSelector(selector).ClusterClock(siv.coll.client.clock).
 		Collection(siv.coll.name).Database(siv.coll.db.name).
-		Deployment(siv.coll.client.deployment).ServerAPI(siv.coll.client.serverAPI).
+		Deployment(siv.coll.client.deploymentFor(ctx)).RetryPolicy(siv.coll.client.retryPolicy).
+		CommandInterceptors(siv.coll.client.interceptors).MemoryAccountant(siv.coll.client.memoryAccountant()).
+		ServerAPI(siv.coll.client.serverAPI).
 		Timeout(siv.coll.client.timeout).Authenticator(siv.coll.client.authenticator)
 
-	return op.Execute(ctx)
+	err = siv.coll.client.runOperation(ctx, "updateSearchIndex", siv.coll.namespace(), op.Execute)
+	siv.coll.client.emitAudit(ctx, audit.Record{Namespace: siv.coll.namespace(), Operation: "updateSearchIndex"}, err)
+	return err
 }
@@ -10,10 +10,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/internal/replay"
 	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
@@ -101,7 +103,11 @@ func (s *Session) EndSession(ctx context.Context) {
 
 // WithTransaction starts a transaction on this session and runs the fn
 // callback. Errors with the TransientTransactionError and
-// UnknownTransactionCommitResult labels are retried for up to 120 seconds.
+// UnknownTransactionCommitResult labels are retried for up to 120 seconds. The
+// retry timeout, the maximum number of attempts, a randomized exponential
+// backoff between attempts, and a callback invoked on every retry can be
+// configured with the RetryTimeout, MaxAttempts, RetryBackoff, and OnRetry
+// transaction options.
 // Inside the callback, the SessionContext must be used as the Context parameter
 // for any operations that should be part of the transaction. If the ctx
 // parameter already has a Session attached to it, it will be replaced by this
@@ -129,9 +135,11 @@ func (s *Session) WithTransaction(
 	fn func(ctx context.Context) (any, error),
 	opts ...options.Lister[options.TransactionOptions],
 ) (any, error) {
-	timeout := time.NewTimer(withTransactionTimeout)
-	defer timeout.Stop()
-	var err error
+	args, err := mongoutil.NewOptions[options.TransactionOptions](opts...)
+	if err != nil {
+		return nil, err
+	}
+	retrier := newTransactionRetrier(args)
 	for {
 		err = s.StartTransaction(opts...)
 		if err != nil {
@@ -146,13 +154,14 @@ func (s *Session) WithTransaction(
 				_ = s.AbortTransaction(newBackgroundContext(ctx))
 			}
 
-			select {
-			case <-timeout.C:
+			if retrier.expired() {
 				return nil, err
-			default:
 			}
 
 			if errorHasLabel(err, driver.TransientTransactionError) {
+				if !retrier.next(ctx, err, false) {
+					return nil, err
+				}
 				continue
 			}
 			return res, err
@@ -187,18 +196,22 @@ func (s *Session) WithTransaction(
 				return res, nil
 			}
 
-			select {
-			case <-timeout.C:
+			if retrier.expired() {
 				return res, err
-			default:
 			}
 
 			var cerr CommandError
 			if errors.As(err, &cerr) {
 				if cerr.HasErrorLabel(driver.UnknownTransactionCommitResult) && !cerr.IsMaxTimeMSExpiredError() {
+					if !retrier.next(ctx, err, true) {
+						return res, err
+					}
 					continue
 				}
 				if cerr.HasErrorLabel(driver.TransientTransactionError) {
+					if !retrier.next(ctx, err, false) {
+						return res, err
+					}
 					break CommitLoop
 				}
 			}
@@ -207,6 +220,99 @@ func (s *Session) WithTransaction(
 	}
 }
 
+// transactionRetrier tracks the attempts made by WithTransaction and decides
+// whether another attempt is allowed.
+type transactionRetrier struct {
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	onRetry     func(options.TransactionRetry)
+	deadline    time.Time
+	attempt     int
+}
+
+func newTransactionRetrier(args *options.TransactionOptions) *transactionRetrier {
+	timeout := withTransactionTimeout
+	if args.RetryTimeout != nil {
+		timeout = *args.RetryTimeout
+	}
+	r := &transactionRetrier{
+		onRetry:  args.OnRetry,
+		deadline: time.Now().Add(timeout),
+		attempt:  1,
+	}
+	if args.MaxAttempts != nil {
+		r.maxAttempts = *args.MaxAttempts
+	}
+	if args.RetryBackoff != nil {
+		r.backoff = *args.RetryBackoff
+	}
+	if args.MaxRetryBackoff != nil {
+		r.maxBackoff = *args.MaxRetryBackoff
+	}
+	return r
+}
+
+// expired reports whether the retry timeout has elapsed.
+func (r *transactionRetrier) expired() bool {
+	return !time.Now().Before(r.deadline)
+}
+
+// backoffFor returns the maximum time to wait before the given attempt: the
+// base backoff doubled for every retry before it, capped at the maximum
+// backoff.
+func (r *transactionRetrier) backoffFor(attempt int) time.Duration {
+	if r.backoff <= 0 {
+		return 0
+	}
+	limit := r.backoff
+	for i := 2; i < attempt; i++ {
+		if r.maxBackoff > 0 && limit >= r.maxBackoff {
+			break
+		}
+		limit *= 2
+	}
+	if r.maxBackoff > 0 && limit > r.maxBackoff {
+		limit = r.maxBackoff
+	}
+	return limit
+}
+
+// next reports whether another attempt may be made after err. If so, it calls
+// the OnRetry callback and waits for a random backoff. It returns false if the
+// maximum number of attempts has been made, or if ctx is done or the retry
+// timeout would elapse during the backoff.
+func (r *transactionRetrier) next(ctx context.Context, err error, commit bool) bool {
+	if r.maxAttempts > 0 && r.attempt >= r.maxAttempts {
+		return false
+	}
+	r.attempt++
+
+	var backoff time.Duration
+	if limit := r.backoffFor(r.attempt); limit > 0 {
+		backoff = time.Duration(replay.Int63n(replay.RetryBackoff, int64(limit)+1, rand.Int63n))
+	}
+	if backoff > 0 && time.Now().Add(backoff).After(r.deadline) {
+		return false
+	}
+
+	if r.onRetry != nil {
+		r.onRetry(options.TransactionRetry{Attempt: r.attempt, Commit: commit, Err: err, Backoff: backoff})
+	}
+	if backoff <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // StartTransaction starts a new transaction. This method returns an error if
 // there is already a transaction in-progress for this session.
 func (s *Session) StartTransaction(opts ...options.Lister[options.TransactionOptions]) error {
@@ -228,6 +334,12 @@ func (s *Session) StartTransaction(opts ...options.Lister[options.TransactionOpt
 		WriteConcern:   args.WriteConcern,
 	}
 
+	// The namespaces of the operations in the transaction are not known yet, so
+	// the transaction read concern is checked by each operation instead.
+	if err := s.client.checkWritePolicy(nil, "", s.clientSession.TransactionWriteConcern(coreOpts)); err != nil {
+		return err
+	}
+
 	return s.clientSession.StartTransaction(coreOpts)
 }
 
@@ -249,7 +361,9 @@ func (s *Session) AbortTransaction(ctx context.Context) error {
 
 	s.clientSession.Aborting = true
 	_ = operation.NewAbortTransaction().Session(s.clientSession).ClusterClock(s.client.clock).Database("admin").
-		Deployment(s.deployment).WriteConcern(s.clientSession.CurrentWc).ServerSelector(selector).
+		Deployment(s.client.deploymentFor(ctx)).RetryPolicy(s.client.retryPolicy).
+		CommandInterceptors(s.client.interceptors).MemoryAccountant(s.client.memoryAccountant()).
+		WriteConcern(s.clientSession.CurrentWc).ServerSelector(selector).
 		Retry(driver.RetryOncePerCommand).CommandMonitor(s.client.monitor).
 		RecoveryToken(bsoncore.Document(s.clientSession.RecoveryToken)).ServerAPI(s.client.serverAPI).
 		Authenticator(s.client.authenticator).Execute(ctx)
@@ -283,12 +397,15 @@ func (s *Session) CommitTransaction(ctx context.Context) error {
 
 	s.clientSession.Committing = true
 	op := operation.NewCommitTransaction().
-		Session(s.clientSession).ClusterClock(s.client.clock).Database("admin").Deployment(s.deployment).
+		Session(s.clientSession).ClusterClock(s.client.clock).Database("admin").
+		Deployment(s.client.deploymentFor(ctx)).RetryPolicy(s.client.retryPolicy).
+		CommandInterceptors(s.client.interceptors).
+		MemoryAccountant(s.client.memoryAccountant()).
 		WriteConcern(s.clientSession.CurrentWc).ServerSelector(selector).Retry(driver.RetryOncePerCommand).
 		CommandMonitor(s.client.monitor).RecoveryToken(bsoncore.Document(s.clientSession.RecoveryToken)).
 		ServerAPI(s.client.serverAPI).Authenticator(s.client.authenticator)
 
-	err = op.Execute(ctx)
+	err = s.client.runOperation(ctx, "commitTransaction", "", op.Execute)
 	// Return error without updating transaction state if it is a timeout, as the transaction has not
 	// actually been committed.
 	if IsTimeout(err) {
@@ -330,6 +447,21 @@ func (s *Session) AdvanceOperationTime(ts *bson.Timestamp) error {
 	return s.clientSession.AdvanceOperationTime(ts)
 }
 
+// SnapshotTime returns the cluster time of the snapshot read by a snapshot
+// session. It is the atClusterTime given by SetSnapshotTime or, if none was
+// given, the atClusterTime chosen by the server for the first read performed
+// with the session. It returns nil if the session is not a snapshot session or
+// no read has been performed yet. The returned time can be passed to
+// SessionOptionsBuilder.SetSnapshotTime to start another session that reads the
+// same snapshot.
+func (s *Session) SnapshotTime() *bson.Timestamp {
+	if !s.clientSession.Snapshot || s.clientSession.SnapshotTime == nil {
+		return nil
+	}
+	snapshotTime := *s.clientSession.SnapshotTime
+	return &snapshotTime
+}
+
 // Client is the Client associated with the session.
 func (s *Session) Client() *Client {
 	return s.client
@@ -344,3 +476,13 @@ func sessionFromContext(ctx context.Context) *session.Client {
 
 	return nil
 }
+
+// sessionOperationTime returns a copy of the operation time of sess, or nil if
+// sess is nil or has no operation time.
+func sessionOperationTime(sess *session.Client) *bson.Timestamp {
+	if sess == nil || sess.OperationTime == nil {
+		return nil
+	}
+	opTime := *sess.OperationTime
+	return &opTime
+}
@@ -29,6 +29,10 @@ type SingleResult struct {
 	bsonOpts *options.BSONOptions
 	reg      *bson.Registry
 
+	// upserted is true if the findAndModify operation that created the
+	// SingleResult inserted a new document.
+	upserted bool
+
 	// Operation performed with an acknowledged write. Values returned by
 	// SingleResult methods may not be deterministic if the write operation was
 	// unacknowledged and so should not be relied upon.
@@ -82,7 +86,7 @@ func (sr *SingleResult) Decode(v any) error {
 		return sr.err
 	}
 
-	dec := getDecoder(sr.rdr, sr.bsonOpts, sr.reg)
+	dec := getDecoder(sr.rdr, sr.bsonOpts, sr.reg, nil)
 
 	return dec.Decode(v)
 }
@@ -0,0 +1,309 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	defaultTailResumeField = "_id"
+
+	// tailRetryInterval is the time a TailableCursor waits before re-creating
+	// a cursor that was closed by the server without an error, such as when
+	// the filter matched no documents. It prevents sending find commands in a
+	// tight loop while the collection is empty.
+	tailRetryInterval = 500 * time.Millisecond
+
+	errorCappedPositionLost int32 = 136 // CappedPositionLost error code
+)
+
+var (
+	// ErrMissingResumeField indicates that a document returned by a TailableCursor does not contain the resume field.
+	ErrMissingResumeField = errors.New("cannot resume a tailable cursor when the resume field is missing")
+	// ErrTailableCursorClosed indicates that Next or TryNext was called after Close.
+	ErrTailableCursorClosed = errors.New("tailable cursor is closed")
+)
+
+// TailableCursor is a tailable await cursor over a capped collection that is
+// re-created when it dies. A TailableCursor remembers the value of the resume
+// field of the last document it returned. If the cursor is killed by the server,
+// for example with a CappedPositionLost or CursorNotFound error, or fails with a
+// network error, a new cursor is created that only returns documents with a
+// greater value of the resume field, similar to how a ChangeStream resumes.
+//
+// Documents that were overwritten in the capped collection before they were
+// read cannot be recovered, so a TailableCursor that falls far behind the
+// writers may skip documents when it resumes.
+//
+// A TailableCursor is not goroutine safe.
+type TailableCursor struct {
+	// Current contains the BSON bytes of the current document. This property is
+	// only valid until the next call to Next or TryNext. If continued access is
+	// required, a copy must be made.
+	Current bson.Raw
+
+	coll        *Collection
+	filter      any
+	findOpts    *options.FindOptionsBuilder
+	resumeField string
+	resumeValue bson.RawValue
+	cursor      *Cursor
+	err         error
+	closed      bool
+}
+
+// Tail creates a TailableCursor over the documents in the collection that
+// match filter. The collection must be a capped collection, such as the oplog.
+// For example, to tail the oplog after a previously saved position:
+//
+//	opts := options.Tail().SetResumeField("ts").SetStartAfter(lastTS)
+//	tc, err := oplog.Tail(ctx, bson.D{{"ns", "db.coll"}}, opts)
+//	if err != nil {
+//		return err
+//	}
+//	defer tc.Close(ctx)
+//	for tc.Next(ctx) {
+//		// Process tc.Current and save tc.ResumeValue().
+//	}
+//	return tc.Err()
+//
+// The filter parameter must be a document and cannot be nil. The opts parameter
+// can be used to specify options for the cursor (see the options.TailOptions
+// documentation).
+//
+// The initial find command is run before Tail returns, so errors such as an
+// invalid filter or a collection that is not capped are returned by Tail.
+func (coll *Collection) Tail(ctx context.Context, filter any,
+	opts ...options.Lister[options.TailOptions]) (*TailableCursor, error) {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if filter == nil {
+		return nil, ErrNilDocument
+	}
+
+	args, err := mongoutil.NewOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	tc := &TailableCursor{
+		coll:        coll,
+		filter:      filter,
+		findOpts:    options.Find().SetCursorType(options.TailableAwait),
+		resumeField: defaultTailResumeField,
+	}
+	if args.BatchSize != nil {
+		tc.findOpts.SetBatchSize(*args.BatchSize)
+	}
+	if args.Comment != nil {
+		tc.findOpts.SetComment(args.Comment)
+	}
+	if args.MaxAwaitTime != nil {
+		tc.findOpts.SetMaxAwaitTime(*args.MaxAwaitTime)
+	}
+	if args.ResumeField != nil {
+		tc.resumeField = *args.ResumeField
+	}
+	if args.StartAfter != nil {
+		val, err := marshalValue(args.StartAfter, coll.bsonOpts, coll.registry)
+		if err != nil {
+			return nil, err
+		}
+		tc.resumeValue = bson.RawValue{Type: bson.Type(val.Type), Value: val.Data}
+	}
+
+	if err := tc.open(ctx); err != nil {
+		return nil, err
+	}
+	return tc, nil
+}
+
+// open creates the underlying cursor, resuming after the last seen document if
+// there is one.
+func (tc *TailableCursor) open(ctx context.Context) error {
+	filter := tc.filter
+	if tc.resumeValue.Type != 0 {
+		filter = bson.D{{"$and", bson.A{
+			filter,
+			bson.D{{tc.resumeField, bson.D{{"$gt", tc.resumeValue}}}},
+		}}}
+	}
+
+	cursor, err := tc.coll.Find(ctx, filter, tc.findOpts)
+	if err != nil {
+		return err
+	}
+	tc.cursor = cursor
+	return nil
+}
+
+// ResumeValue returns the value of the resume field of the last document
+// returned by the cursor, or the StartAfter option if no document has been
+// returned. It can be passed to options.TailOptionsBuilder.SetStartAfter to
+// continue from the same position with a new TailableCursor.
+func (tc *TailableCursor) ResumeValue() bson.RawValue {
+	return tc.resumeValue
+}
+
+// Decode will unmarshal the current document into val and return any errors from the unmarshalling process without
+// any modification. If val is nil or is a typed nil, an error will be returned.
+func (tc *TailableCursor) Decode(val any) error {
+	dec := getDecoder(tc.Current, tc.coll.bsonOpts, tc.coll.registry, nil)
+	return dec.Decode(val)
+}
+
+// Err returns the last error seen by the cursor, or nil if no error has occurred. Errors that caused the cursor to be
+// re-created are not returned.
+func (tc *TailableCursor) Err() error {
+	return wrapErrors(tc.err)
+}
+
+// Close closes the cursor. Close is idempotent, and Next and TryNext return false after it has been called.
+func (tc *TailableCursor) Close(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	tc.closed = true
+	if tc.cursor == nil {
+		return nil
+	}
+	err := tc.cursor.Close(ctx)
+	tc.cursor = nil
+	return wrapErrors(err)
+}
+
+// Next gets the next document, re-creating the cursor if it dies. It returns
+// true if there were no errors and the next document is available.
+//
+// Next blocks until a document is available, a non-resumable error occurs, or
+// ctx expires. If ctx expires, the error will be set to ctx.Err(). If Next
+// returns false, subsequent calls will also return false.
+func (tc *TailableCursor) Next(ctx context.Context) bool {
+	return tc.next(ctx, false)
+}
+
+// TryNext attempts to get the next document, re-creating the cursor if it dies.
+// It returns true if there were no errors and the next document is available.
+//
+// TryNext returns false if no document is available yet, an error occurs, or
+// ctx expires. If TryNext returns false and Err returns nil, it is safe to call
+// TryNext again.
+func (tc *TailableCursor) TryNext(ctx context.Context) bool {
+	return tc.next(ctx, true)
+}
+
+func (tc *TailableCursor) next(ctx context.Context, nonBlocking bool) bool {
+	if tc.closed {
+		tc.err = ErrTailableCursorClosed
+		return false
+	}
+	if tc.err != nil {
+		return false
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for {
+		if tc.cursor == nil {
+			if tc.err = tc.open(ctx); tc.err != nil {
+				return false
+			}
+		}
+
+		var ok bool
+		if nonBlocking {
+			ok = tc.cursor.TryNext(ctx)
+		} else {
+			ok = tc.cursor.Next(ctx)
+		}
+		if ok {
+			return tc.setCurrent()
+		}
+
+		err := tc.cursor.Err()
+		if err == nil && tc.cursor.ID() != 0 {
+			// TryNext got an empty batch from a live cursor.
+			return false
+		}
+		if err != nil && (ctx.Err() != nil || !isResumableTailError(err)) {
+			tc.err = err
+			return false
+		}
+
+		// The cursor is dead, so it is closed and re-created. Closing is only
+		// best effort because the server may have already killed it.
+		_ = tc.cursor.Close(ctx)
+		tc.cursor = nil
+
+		if err != nil {
+			continue
+		}
+
+		// The server closed the cursor without an error, which happens when
+		// the find matched no documents, so wait before trying again.
+		if nonBlocking {
+			return false
+		}
+		timer := time.NewTimer(tailRetryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			tc.err = ctx.Err()
+			return false
+		case <-timer.C:
+		}
+	}
+}
+
+// setCurrent sets Current to the current document of the underlying cursor and
+// records its resume field.
+func (tc *TailableCursor) setCurrent() bool {
+	tc.Current = tc.cursor.Current
+
+	val, err := tc.Current.LookupErr(tc.resumeField)
+	if err != nil {
+		tc.err = fmt.Errorf("%w: %q", ErrMissingResumeField, tc.resumeField)
+		return false
+	}
+
+	// Copy the value because Current is only valid until the next call to
+	// Next or TryNext.
+	tc.resumeValue = bson.RawValue{Type: val.Type, Value: append([]byte(nil), val.Value...)}
+	return true
+}
+
+// isResumableTailError returns true if a TailableCursor should re-create its
+// cursor after err.
+func isResumableTailError(err error) bool {
+	if IsNetworkError(err) {
+		return true
+	}
+
+	var commandErr CommandError
+	if !errors.As(err, &commandErr) {
+		return false
+	}
+	switch commandErr.Code {
+	case errorCursorNotFound, errorCappedPositionLost:
+		return true
+	}
+	_, resumable := resumableChangeStreamErrors[commandErr.Code]
+	return resumable
+}
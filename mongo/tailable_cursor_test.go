@@ -0,0 +1,43 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+)
+
+func TestIsResumableTailError(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"cursor not found", CommandError{Code: 43}, true},
+		{"capped position lost", CommandError{Code: 136}, true},
+		{"not primary", CommandError{Code: 10107}, true},
+		{"network error", CommandError{Labels: []string{"NetworkError"}}, true},
+		{"other command error", CommandError{Code: 2}, false},
+		{"context canceled", context.Canceled, false},
+		{"other error", errors.New("error"), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isResumableTailError(tc.err))
+		})
+	}
+}
+
+func TestTailableCursorClosed(t *testing.T) {
+	tc := &TailableCursor{}
+	assert.NoError(t, tc.Close(context.Background()))
+	assert.False(t, tc.Next(context.Background()), "expected Next to return false after Close")
+	assert.ErrorIs(t, tc.Err(), ErrTailableCursorClosed)
+}
@@ -0,0 +1,137 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ModifyTimeSeries changes the granularity, bucket span, or expiration of a
+// time-series collection by running a collMod command. For example, to store
+// coarser buckets and delete documents after 30 days:
+//
+//	err := coll.ModifyTimeSeries(ctx, options.ModifyTimeSeries().
+//		SetGranularity(options.TimeSeriesGranularityHours).
+//		SetExpireAfter(30*24*time.Hour))
+//
+// The options are validated before the command is sent. The granularity and
+// bucket span can only be increased, which is checked by the server.
+//
+// For more information about the command, see
+// https://www.mongodb.com/docs/manual/reference/command/collMod/.
+func (coll *Collection) ModifyTimeSeries(
+	ctx context.Context,
+	opts ...options.Lister[options.ModifyTimeSeriesOptions],
+) error {
+	args, err := mongoutil.NewOptions(opts...)
+	if err != nil {
+		return err
+	}
+
+	var timeseries bson.D
+	switch {
+	case args.Granularity != nil && args.BucketSpan != nil:
+		return errors.New("time-series granularity and bucket span cannot both be set")
+	case args.Granularity != nil:
+		if err := validateGranularity(*args.Granularity); err != nil {
+			return err
+		}
+		timeseries = bson.D{{"granularity", *args.Granularity}}
+	case args.BucketSpan != nil:
+		if err := validateBucketSpan("bucket span", *args.BucketSpan); err != nil {
+			return err
+		}
+		secs := int64(*args.BucketSpan / time.Second)
+		timeseries = bson.D{{"bucketMaxSpanSeconds", secs}, {"bucketRoundingSeconds", secs}}
+	}
+
+	cmd := bson.D{{"collMod", coll.name}}
+	if timeseries != nil {
+		cmd = append(cmd, bson.E{"timeseries", timeseries})
+	}
+	if args.ExpireAfter != nil {
+		if *args.ExpireAfter < time.Second {
+			return fmt.Errorf("time-series expiration must be at least 1s, got %v", *args.ExpireAfter)
+		}
+		cmd = append(cmd, bson.E{"expireAfterSeconds", int64(*args.ExpireAfter / time.Second)})
+	}
+	if len(cmd) == 1 {
+		return errors.New("no time-series options to modify")
+	}
+	return coll.db.RunCommand(ctx, cmd).Err()
+}
+
+// validateTimeSeriesOptions checks the options of a time-series collection for
+// mistakes the server would otherwise reject with a less specific error.
+func validateTimeSeriesOptions(args *options.TimeSeriesOptions) error {
+	if args.TimeField == "" {
+		return errors.New("time-series time field is required")
+	}
+	if strings.HasPrefix(args.TimeField, "$") || strings.Contains(args.TimeField, ".") {
+		return fmt.Errorf("time-series time field %q must be a top-level field name", args.TimeField)
+	}
+	if meta := args.MetaField; meta != nil {
+		switch {
+		case *meta == "":
+			return errors.New("time-series meta field must not be empty")
+		case strings.HasPrefix(*meta, "$") || strings.Contains(*meta, "."):
+			return fmt.Errorf("time-series meta field %q must be a top-level field name", *meta)
+		case *meta == "_id":
+			return errors.New(`time-series meta field must not be "_id"`)
+		case *meta == args.TimeField:
+			return fmt.Errorf("time-series meta field must not be the same as the time field %q", args.TimeField)
+		}
+	}
+	if args.Granularity != nil {
+		if err := validateGranularity(*args.Granularity); err != nil {
+			return err
+		}
+	}
+
+	maxSpan, rounding := args.BucketMaxSpan, args.BucketRounding
+	if maxSpan == nil && rounding == nil {
+		return nil
+	}
+	if args.Granularity != nil {
+		return errors.New("time-series granularity cannot be set together with bucket max span or bucket rounding")
+	}
+	if maxSpan == nil || rounding == nil {
+		return errors.New("time-series bucket max span and bucket rounding must be set together")
+	}
+	if err := validateBucketSpan("bucket max span", *maxSpan); err != nil {
+		return err
+	}
+	if *maxSpan/time.Second != *rounding/time.Second {
+		return fmt.Errorf("time-series bucket max span %v and bucket rounding %v must be equal", *maxSpan, *rounding)
+	}
+	return nil
+}
+
+func validateGranularity(granularity string) error {
+	switch granularity {
+	case options.TimeSeriesGranularitySeconds, options.TimeSeriesGranularityMinutes, options.TimeSeriesGranularityHours:
+		return nil
+	}
+	return fmt.Errorf("time-series granularity must be %q, %q, or %q, got %q",
+		options.TimeSeriesGranularitySeconds, options.TimeSeriesGranularityMinutes, options.TimeSeriesGranularityHours,
+		granularity)
+}
+
+func validateBucketSpan(name string, span time.Duration) error {
+	if span < time.Second {
+		return fmt.Errorf("time-series %s must be at least 1s, got %v", name, span)
+	}
+	return nil
+}
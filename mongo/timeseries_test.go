@@ -0,0 +1,135 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestCreateTimeSeriesValidation(t *testing.T) {
+	db := setupDb("timeseries")
+
+	testCases := []struct {
+		name   string
+		opts   *options.TimeSeriesOptionsBuilder
+		errStr string
+	}{
+		{
+			name: "valid granularity",
+			opts: options.TimeSeries().SetTimeField("ts").SetMetaField("meta").
+				SetGranularity(options.TimeSeriesGranularityMinutes),
+		},
+		{
+			name: "valid bucket span",
+			opts: options.TimeSeries().SetTimeField("ts").SetBucketSpan(time.Hour),
+		},
+		{
+			name:   "no time field",
+			opts:   options.TimeSeries().SetMetaField("meta"),
+			errStr: "time-series time field is required",
+		},
+		{
+			name:   "nested time field",
+			opts:   options.TimeSeries().SetTimeField("a.ts"),
+			errStr: `time-series time field "a.ts" must be a top-level field name`,
+		},
+		{
+			name:   "meta field is _id",
+			opts:   options.TimeSeries().SetTimeField("ts").SetMetaField("_id"),
+			errStr: `time-series meta field must not be "_id"`,
+		},
+		{
+			name:   "meta field is time field",
+			opts:   options.TimeSeries().SetTimeField("ts").SetMetaField("ts"),
+			errStr: `time-series meta field must not be the same as the time field "ts"`,
+		},
+		{
+			name:   "unknown granularity",
+			opts:   options.TimeSeries().SetTimeField("ts").SetGranularity("days"),
+			errStr: `time-series granularity must be "seconds", "minutes", or "hours", got "days"`,
+		},
+		{
+			name: "granularity and bucket span",
+			opts: options.TimeSeries().SetTimeField("ts").SetGranularity(options.TimeSeriesGranularityHours).
+				SetBucketSpan(time.Hour),
+			errStr: "time-series granularity cannot be set together with bucket max span or bucket rounding",
+		},
+		{
+			name:   "bucket max span without rounding",
+			opts:   options.TimeSeries().SetTimeField("ts").SetBucketMaxSpan(time.Hour),
+			errStr: "time-series bucket max span and bucket rounding must be set together",
+		},
+		{
+			name:   "unequal bucket max span and rounding",
+			opts:   options.TimeSeries().SetTimeField("ts").SetBucketMaxSpan(time.Hour).SetBucketRounding(time.Minute),
+			errStr: "time-series bucket max span 1h0m0s and bucket rounding 1m0s must be equal",
+		},
+		{
+			name:   "bucket span below one second",
+			opts:   options.TimeSeries().SetTimeField("ts").SetBucketSpan(time.Millisecond),
+			errStr: "time-series bucket max span must be at least 1s, got 1ms",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := db.createCollectionOperation("coll", options.CreateCollection().SetTimeSeriesOptions(tc.opts))
+			if tc.errStr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.errStr)
+		})
+	}
+}
+
+func TestModifyTimeSeriesValidation(t *testing.T) {
+	coll := setupColl("timeseries")
+
+	testCases := []struct {
+		name   string
+		opts   *options.ModifyTimeSeriesOptionsBuilder
+		errStr string
+	}{
+		{
+			name:   "no options",
+			opts:   options.ModifyTimeSeries(),
+			errStr: "no time-series options to modify",
+		},
+		{
+			name: "granularity and bucket span",
+			opts: options.ModifyTimeSeries().SetGranularity(options.TimeSeriesGranularityHours).
+				SetBucketSpan(time.Hour),
+			errStr: "time-series granularity and bucket span cannot both be set",
+		},
+		{
+			name:   "unknown granularity",
+			opts:   options.ModifyTimeSeries().SetGranularity("weeks"),
+			errStr: `time-series granularity must be "seconds", "minutes", or "hours", got "weeks"`,
+		},
+		{
+			name:   "bucket span below one second",
+			opts:   options.ModifyTimeSeries().SetBucketSpan(0),
+			errStr: "time-series bucket span must be at least 1s, got 0s",
+		},
+		{
+			name:   "expiration below one second",
+			opts:   options.ModifyTimeSeries().SetExpireAfter(time.Millisecond),
+			errStr: "time-series expiration must be at least 1s, got 1ms",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := coll.ModifyTimeSeries(context.Background(), tc.opts)
+			assert.EqualError(t, err, tc.errStr)
+		})
+	}
+}
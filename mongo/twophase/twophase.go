@@ -0,0 +1,498 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package twophase coordinates writes that span several clusters, which cannot
+// be made atomic with a server transaction. It provides a best-effort
+// two-phase commit in which every participant first prepares its writes, and
+// then either commits them all or aborts them all:
+//
+//	coord, err := twophase.NewCoordinator(statusColl, map[string]twophase.Participant{
+//		"orders":  twophase.InsertParticipant{Collection: ordersColl},
+//		"billing": twophase.InsertParticipant{Collection: invoicesColl},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//
+//	id, err := coord.Run(ctx,
+//		twophase.Step{Participant: "orders", Payload: []any{order}},
+//		twophase.Step{Participant: "billing", Payload: []any{invoice}},
+//	)
+//
+// The progress of each transaction is recorded in a status collection, which
+// may be on any of the clusters. Once every participant is prepared, the
+// transaction is marked as committing, and from then on it is always
+// committed. If a participant cannot be prepared, the prepared participants
+// are aborted. A process that fails in the middle of a transaction leaves it
+// unfinished, so applications should periodically call Coordinator.Recover to
+// finish abandoned transactions.
+//
+// Writes are not isolated: readers can observe prepared writes before the
+// transaction commits, and committed writes of one participant before the
+// others. Participants that need isolation must hide prepared writes, like
+// InsertParticipant does with a marker field.
+package twophase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/errutil"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// State is the state of a transaction in the status collection.
+type State string
+
+// These constants are the states of a transaction.
+const (
+	// StatePreparing means the participants are being prepared. An abandoned
+	// transaction in this state is aborted by Recover.
+	StatePreparing State = "preparing"
+
+	// StateCommitting means every participant was prepared and the
+	// transaction is being committed. An abandoned transaction in this state
+	// is committed by Recover.
+	StateCommitting State = "committing"
+
+	// StateCommitted means every participant was committed.
+	StateCommitted State = "committed"
+
+	// StateAborting means a participant could not be prepared and the
+	// transaction is being aborted. An abandoned transaction in this state is
+	// aborted by Recover.
+	StateAborting State = "aborting"
+
+	// StateAborted means every participant was aborted.
+	StateAborted State = "aborted"
+)
+
+// Participant applies the writes of a step to one cluster. The payload is the
+// Step.Payload, as stored in the status collection.
+//
+// Commit and Abort must be idempotent, because Recover calls them again for
+// transactions whose outcome was not recorded. Abort is also called for the
+// step whose Prepare failed, so it must undo partially prepared writes.
+type Participant interface {
+	Prepare(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error
+	Commit(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error
+	Abort(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error
+}
+
+// Funcs is a Participant made of functions. A nil function does nothing, so a
+// participant that writes directly in Prepare and undoes the write with a
+// compensating action in Abort can leave Commit nil.
+type Funcs struct {
+	PrepareFunc func(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error
+	CommitFunc  func(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error
+	AbortFunc   func(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error
+}
+
+var _ Participant = Funcs{}
+
+// Prepare calls f.PrepareFunc if it is not nil.
+func (f Funcs) Prepare(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error {
+	if f.PrepareFunc == nil {
+		return nil
+	}
+	return f.PrepareFunc(ctx, txnID, payload)
+}
+
+// Commit calls f.CommitFunc if it is not nil.
+func (f Funcs) Commit(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error {
+	if f.CommitFunc == nil {
+		return nil
+	}
+	return f.CommitFunc(ctx, txnID, payload)
+}
+
+// Abort calls f.AbortFunc if it is not nil.
+func (f Funcs) Abort(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error {
+	if f.AbortFunc == nil {
+		return nil
+	}
+	return f.AbortFunc(ctx, txnID, payload)
+}
+
+// Step is a write of a transaction.
+type Step struct {
+	// Participant is the name of the participant that applies the step. It
+	// is required.
+	Participant string
+
+	// Payload describes the write and is passed to the participant. It is
+	// stored in the status collection so that Recover can finish the step,
+	// so it must be marshalable to BSON.
+	Payload any
+}
+
+// Coordinator runs transactions and records their progress in a status
+// collection. A Coordinator is safe for concurrent use.
+type Coordinator struct {
+	store        store
+	participants map[string]Participant
+}
+
+// NewCoordinator returns a Coordinator that records transactions in status
+// and applies steps with participants, keyed by name. Every process that runs
+// or recovers the same transactions must use the same participant names.
+func NewCoordinator(status *mongo.Collection, participants map[string]Participant) (*Coordinator, error) {
+	if status == nil {
+		return nil, errors.New("twophase: status collection is required")
+	}
+	return newCoordinator(collectionStore{coll: status}, participants)
+}
+
+func newCoordinator(st store, participants map[string]Participant) (*Coordinator, error) {
+	if len(participants) == 0 {
+		return nil, errors.New("twophase: at least one participant is required")
+	}
+	for name, p := range participants {
+		switch {
+		case name == "":
+			return nil, errors.New("twophase: participant name is required")
+		case p == nil:
+			return nil, fmt.Errorf("twophase: participant %q is nil", name)
+		}
+	}
+	return &Coordinator{store: st, participants: participants}, nil
+}
+
+// Run prepares every step in order, then commits them all, and returns the ID
+// of the transaction. If a step cannot be prepared, the steps that were
+// prepared, including the failed one, are aborted in reverse order and the
+// returned error is an *AbortedError.
+//
+// If the transaction is committing and a step cannot be committed, the error
+// is returned and the transaction is left in StateCommitting, so that Recover
+// commits the remaining steps later.
+func (c *Coordinator) Run(ctx context.Context, steps ...Step) (bson.ObjectID, error) {
+	if len(steps) == 0 {
+		return bson.NilObjectID, errors.New("twophase: at least one step is required")
+	}
+	for _, s := range steps {
+		if _, ok := c.participants[s.Participant]; !ok {
+			return bson.NilObjectID, fmt.Errorf("twophase: unknown participant %q", s.Participant)
+		}
+	}
+
+	txn, err := newTransaction(steps)
+	if err != nil {
+		return bson.NilObjectID, err
+	}
+	if err := c.store.insert(ctx, txn); err != nil {
+		return bson.NilObjectID, fmt.Errorf("twophase: error recording transaction: %w", err)
+	}
+
+	for i, s := range txn.Steps {
+		err := c.participants[s.Participant].Prepare(ctx, txn.ID, s.Payload)
+		if err != nil {
+			aborted := &AbortedError{TxnID: txn.ID, Step: i, Participant: s.Participant, Err: err}
+			aborted.AbortErr = c.abort(ctx, txn, i+1)
+			return txn.ID, aborted
+		}
+	}
+
+	// Recording the committing state is the commit point. If it fails, the
+	// outcome is decided by Recover.
+	if err := c.store.setState(ctx, txn.ID, StatePreparing, StateCommitting); err != nil {
+		return txn.ID, fmt.Errorf("twophase: error committing transaction %v: %w", txn.ID.Hex(), err)
+	}
+	txn.State = StateCommitting
+	return txn.ID, c.commit(ctx, txn)
+}
+
+// Recover finishes transactions that were last updated more than olderThan
+// ago and are not committed or aborted, which usually means the process
+// running them failed. Transactions that were preparing or aborting are
+// aborted, and transactions that were committing are committed. olderThan
+// should be longer than the longest expected transaction, so that running
+// transactions are not aborted.
+//
+// Recover returns the number of transactions it finished. It continues after
+// errors and returns them joined.
+func (c *Coordinator) Recover(ctx context.Context, olderThan time.Duration) (int, error) {
+	txns, err := c.store.unfinished(ctx, time.Now().Add(-olderThan))
+	if err != nil {
+		return 0, fmt.Errorf("twophase: error finding unfinished transactions: %w", err)
+	}
+
+	var n int
+	var errs []error
+	for _, txn := range txns {
+		var err error
+		if txn.State == StateCommitting {
+			err = c.commit(ctx, txn)
+		} else {
+			err = c.abort(ctx, txn, len(txn.Steps))
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		n++
+	}
+	return n, errutil.Join(errs...)
+}
+
+// commit commits the steps of txn that are not done and marks txn committed.
+func (c *Coordinator) commit(ctx context.Context, txn *transaction) error {
+	for i, s := range txn.Steps {
+		if s.Done {
+			continue
+		}
+		p, ok := c.participants[s.Participant]
+		if !ok {
+			return fmt.Errorf("twophase: transaction %v has unknown participant %q", txn.ID.Hex(), s.Participant)
+		}
+		if err := p.Commit(ctx, txn.ID, s.Payload); err != nil {
+			return fmt.Errorf("twophase: error committing step %d (%s) of transaction %v: %w",
+				i, s.Participant, txn.ID.Hex(), err)
+		}
+		if err := c.store.markDone(ctx, txn.ID, i); err != nil {
+			return fmt.Errorf("twophase: error recording step %d of transaction %v: %w", i, txn.ID.Hex(), err)
+		}
+	}
+	if err := c.store.setState(ctx, txn.ID, StateCommitting, StateCommitted); err != nil {
+		return fmt.Errorf("twophase: error recording commit of transaction %v: %w", txn.ID.Hex(), err)
+	}
+	return nil
+}
+
+// abort aborts the first n steps of txn in reverse order and marks txn
+// aborted. Every step is aborted even if some fail.
+func (c *Coordinator) abort(ctx context.Context, txn *transaction, n int) error {
+	if txn.State == StatePreparing {
+		if err := c.store.setState(ctx, txn.ID, StatePreparing, StateAborting); err != nil {
+			return fmt.Errorf("twophase: error aborting transaction %v: %w", txn.ID.Hex(), err)
+		}
+		txn.State = StateAborting
+	}
+
+	var errs []error
+	for i := n - 1; i >= 0; i-- {
+		s := txn.Steps[i]
+		if s.Done {
+			continue
+		}
+		p, ok := c.participants[s.Participant]
+		if !ok {
+			errs = append(errs, fmt.Errorf("twophase: transaction %v has unknown participant %q", txn.ID.Hex(), s.Participant))
+			continue
+		}
+		if err := p.Abort(ctx, txn.ID, s.Payload); err != nil {
+			errs = append(errs, fmt.Errorf("twophase: error aborting step %d (%s) of transaction %v: %w",
+				i, s.Participant, txn.ID.Hex(), err))
+			continue
+		}
+		if err := c.store.markDone(ctx, txn.ID, i); err != nil {
+			errs = append(errs, fmt.Errorf("twophase: error recording step %d of transaction %v: %w", i, txn.ID.Hex(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return errutil.Join(errs...)
+	}
+	if err := c.store.setState(ctx, txn.ID, StateAborting, StateAborted); err != nil {
+		return fmt.Errorf("twophase: error recording abort of transaction %v: %w", txn.ID.Hex(), err)
+	}
+	return nil
+}
+
+// AbortedError is returned by Coordinator.Run when a step cannot be prepared
+// and the transaction is aborted.
+type AbortedError struct {
+	// TxnID is the ID of the transaction.
+	TxnID bson.ObjectID
+
+	// Step and Participant identify the step that could not be prepared.
+	Step        int
+	Participant string
+
+	// Err is the error returned by Prepare.
+	Err error
+
+	// AbortErr is the error aborting the prepared steps, or nil if every
+	// step was aborted. If it is not nil, Recover retries the abort.
+	AbortErr error
+}
+
+// Error implements the error interface.
+func (e *AbortedError) Error() string {
+	msg := fmt.Sprintf("twophase: transaction %v aborted: error preparing step %d (%s): %v",
+		e.TxnID.Hex(), e.Step, e.Participant, e.Err)
+	if e.AbortErr != nil {
+		msg += fmt.Sprintf("; abort incomplete: %v", e.AbortErr)
+	}
+	return msg
+}
+
+// Unwrap returns the error returned by Prepare.
+func (e *AbortedError) Unwrap() error {
+	return e.Err
+}
+
+// transaction is a document in the status collection.
+type transaction struct {
+	ID        bson.ObjectID `bson:"_id"`
+	State     State         `bson:"state"`
+	Steps     []stepStatus  `bson:"steps"`
+	UpdatedAt time.Time     `bson:"updatedAt"`
+}
+
+// stepStatus is the status of a Step in the status collection. Done is set
+// when the step is committed or aborted.
+type stepStatus struct {
+	Participant string        `bson:"participant"`
+	Payload     bson.RawValue `bson:"payload"`
+	Done        bool          `bson:"done"`
+}
+
+func newTransaction(steps []Step) (*transaction, error) {
+	txn := &transaction{
+		ID:        bson.NewObjectID(),
+		State:     StatePreparing,
+		Steps:     make([]stepStatus, 0, len(steps)),
+		UpdatedAt: time.Now(),
+	}
+	for i, s := range steps {
+		typ, data, err := bson.MarshalValue(s.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("twophase: error marshaling payload of step %d: %w", i, err)
+		}
+		txn.Steps = append(txn.Steps, stepStatus{
+			Participant: s.Participant,
+			Payload:     bson.RawValue{Type: typ, Value: data},
+		})
+	}
+	return txn, nil
+}
+
+// store records transactions.
+type store interface {
+	insert(ctx context.Context, txn *transaction) error
+	setState(ctx context.Context, id bson.ObjectID, from, to State) error
+	markDone(ctx context.Context, id bson.ObjectID, step int) error
+	unfinished(ctx context.Context, updatedBefore time.Time) ([]*transaction, error)
+}
+
+// errStateChanged is returned by store.setState if the transaction is not in
+// the expected state, e.g. because another process recovered it.
+var errStateChanged = errors.New("transaction state changed concurrently")
+
+// collectionStore is a store backed by a collection.
+type collectionStore struct {
+	coll *mongo.Collection
+}
+
+func (s collectionStore) insert(ctx context.Context, txn *transaction) error {
+	_, err := s.coll.InsertOne(ctx, txn)
+	return err
+}
+
+func (s collectionStore) setState(ctx context.Context, id bson.ObjectID, from, to State) error {
+	res, err := s.coll.UpdateOne(ctx,
+		bson.D{{"_id", id}, {"state", from}},
+		bson.D{{"$set", bson.D{{"state", to}, {"updatedAt", time.Now()}}}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errStateChanged
+	}
+	return nil
+}
+
+func (s collectionStore) markDone(ctx context.Context, id bson.ObjectID, step int) error {
+	_, err := s.coll.UpdateOne(ctx,
+		bson.D{{"_id", id}},
+		bson.D{{"$set", bson.D{{fmt.Sprintf("steps.%d.done", step), true}, {"updatedAt", time.Now()}}}})
+	return err
+}
+
+func (s collectionStore) unfinished(ctx context.Context, updatedBefore time.Time) ([]*transaction, error) {
+	cursor, err := s.coll.Find(ctx, bson.D{
+		{"state", bson.D{{"$in", bson.A{StatePreparing, StateCommitting, StateAborting}}}},
+		{"updatedAt", bson.D{{"$lt", updatedBefore}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txns []*transaction
+	if err := cursor.All(ctx, &txns); err != nil {
+		return nil, err
+	}
+	return txns, nil
+}
+
+// InsertParticipant is a Participant that inserts documents into a collection.
+// The payload of its steps is an array of documents. Prepare inserts the
+// documents with Field set to the transaction ID, Commit removes the field,
+// and Abort deletes the documents. Readers that must not see uncommitted
+// documents should filter out documents that have Field.
+type InsertParticipant struct {
+	// Collection is the collection to insert into. It is required.
+	Collection *mongo.Collection
+
+	// Field is the marker field of prepared documents. If empty,
+	// "_pendingTxn" is used.
+	Field string
+}
+
+var _ Participant = InsertParticipant{}
+
+func (p InsertParticipant) field() string {
+	if p.Field == "" {
+		return "_pendingTxn"
+	}
+	return p.Field
+}
+
+// Prepare inserts the documents in payload, marked with txnID.
+func (p InsertParticipant) Prepare(ctx context.Context, txnID bson.ObjectID, payload bson.RawValue) error {
+	arr, ok := payload.ArrayOK()
+	if !ok {
+		return fmt.Errorf("payload must be an array of documents, got %v", payload.Type)
+	}
+	values, err := arr.Values()
+	if err != nil {
+		return err
+	}
+
+	docs := make([]any, 0, len(values))
+	for i, v := range values {
+		doc, ok := v.DocumentOK()
+		if !ok {
+			return fmt.Errorf("payload element %d must be a document, got %v", i, v.Type)
+		}
+		var d bson.D
+		if err := bson.Unmarshal(doc, &d); err != nil {
+			return err
+		}
+		docs = append(docs, append(d, bson.E{Key: p.field(), Value: txnID}))
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+	_, err = p.Collection.InsertMany(ctx, docs)
+	return err
+}
+
+// Commit removes the marker field from the documents of txnID.
+func (p InsertParticipant) Commit(ctx context.Context, txnID bson.ObjectID, _ bson.RawValue) error {
+	_, err := p.Collection.UpdateMany(ctx,
+		bson.D{{p.field(), txnID}},
+		bson.D{{"$unset", bson.D{{p.field(), ""}}}})
+	return err
+}
+
+// Abort deletes the documents of txnID.
+func (p InsertParticipant) Abort(ctx context.Context, txnID bson.ObjectID, _ bson.RawValue) error {
+	_, err := p.Collection.DeleteMany(ctx, bson.D{{p.field(), txnID}})
+	return err
+}
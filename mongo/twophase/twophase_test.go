@@ -0,0 +1,249 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package twophase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type memStore struct {
+	mu   sync.Mutex
+	txns map[bson.ObjectID]*transaction
+}
+
+func newMemStore() *memStore {
+	return &memStore{txns: make(map[bson.ObjectID]*transaction)}
+}
+
+func (s *memStore) insert(_ context.Context, txn *transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cp := *txn
+	cp.Steps = append([]stepStatus(nil), txn.Steps...)
+	s.txns[txn.ID] = &cp
+	return nil
+}
+
+func (s *memStore) setState(_ context.Context, id bson.ObjectID, from, to State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txn := s.txns[id]
+	if txn == nil || txn.State != from {
+		return errStateChanged
+	}
+	txn.State = to
+	return nil
+}
+
+func (s *memStore) markDone(_ context.Context, id bson.ObjectID, step int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.txns[id].Steps[step].Done = true
+	return nil
+}
+
+func (s *memStore) unfinished(_ context.Context, updatedBefore time.Time) ([]*transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var txns []*transaction
+	for _, txn := range s.txns {
+		switch txn.State {
+		case StatePreparing, StateCommitting, StateAborting:
+			if txn.UpdatedAt.Before(updatedBefore) {
+				cp := *txn
+				cp.Steps = append([]stepStatus(nil), txn.Steps...)
+				txns = append(txns, &cp)
+			}
+		}
+	}
+	return txns, nil
+}
+
+func (s *memStore) state(id bson.ObjectID) State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.txns[id].State
+}
+
+// recorder is a Participant that records its calls and fails the configured
+// ones.
+type recorder struct {
+	name  string
+	calls *[]string
+	fail  map[string]error
+}
+
+func (r recorder) call(method string, payload bson.RawValue) error {
+	*r.calls = append(*r.calls, r.name+"."+method+":"+payload.StringValue())
+	return r.fail[method]
+}
+
+func (r recorder) Prepare(_ context.Context, _ bson.ObjectID, payload bson.RawValue) error {
+	return r.call("prepare", payload)
+}
+
+func (r recorder) Commit(_ context.Context, _ bson.ObjectID, payload bson.RawValue) error {
+	return r.call("commit", payload)
+}
+
+func (r recorder) Abort(_ context.Context, _ bson.ObjectID, payload bson.RawValue) error {
+	return r.call("abort", payload)
+}
+
+func newTestCoordinator(t *testing.T, calls *[]string, fail map[string]map[string]error) (*Coordinator, *memStore) {
+	t.Helper()
+
+	st := newMemStore()
+	c, err := newCoordinator(st, map[string]Participant{
+		"a": recorder{name: "a", calls: calls, fail: fail["a"]},
+		"b": recorder{name: "b", calls: calls, fail: fail["b"]},
+	})
+	require.NoError(t, err, "newCoordinator error")
+	return c, st
+}
+
+func TestNewCoordinator(t *testing.T) {
+	_, err := NewCoordinator(nil, map[string]Participant{"a": Funcs{}})
+	assert.EqualError(t, err, "twophase: status collection is required")
+
+	_, err = newCoordinator(newMemStore(), nil)
+	assert.EqualError(t, err, "twophase: at least one participant is required")
+
+	_, err = newCoordinator(newMemStore(), map[string]Participant{"": Funcs{}})
+	assert.EqualError(t, err, "twophase: participant name is required")
+
+	_, err = newCoordinator(newMemStore(), map[string]Participant{"a": nil})
+	assert.EqualError(t, err, `twophase: participant "a" is nil`)
+}
+
+func TestCoordinatorRun(t *testing.T) {
+	ctx := context.Background()
+	steps := []Step{{Participant: "a", Payload: "x"}, {Participant: "b", Payload: "y"}}
+
+	t.Run("commit", func(t *testing.T) {
+		var calls []string
+		c, st := newTestCoordinator(t, &calls, nil)
+
+		id, err := c.Run(ctx, steps...)
+		require.NoError(t, err, "Run error")
+		assert.Equal(t, StateCommitted, st.state(id))
+		assert.Equal(t, []string{"a.prepare:x", "b.prepare:y", "a.commit:x", "b.commit:y"}, calls)
+	})
+	t.Run("prepare error aborts", func(t *testing.T) {
+		var calls []string
+		prepareErr := errors.New("prepare failed")
+		c, st := newTestCoordinator(t, &calls, map[string]map[string]error{"b": {"prepare": prepareErr}})
+
+		id, err := c.Run(ctx, steps...)
+		var aborted *AbortedError
+		require.True(t, errors.As(err, &aborted), "expected AbortedError, got %v", err)
+		assert.Equal(t, id, aborted.TxnID)
+		assert.Equal(t, 1, aborted.Step)
+		assert.Equal(t, "b", aborted.Participant)
+		assert.ErrorIs(t, err, prepareErr)
+		assert.NoError(t, aborted.AbortErr)
+		assert.Equal(t, StateAborted, st.state(id))
+		assert.Equal(t, []string{"a.prepare:x", "b.prepare:y", "b.abort:y", "a.abort:x"}, calls)
+	})
+	t.Run("abort error leaves aborting", func(t *testing.T) {
+		var calls []string
+		c, st := newTestCoordinator(t, &calls, map[string]map[string]error{
+			"a": {"abort": errors.New("abort failed")},
+			"b": {"prepare": errors.New("prepare failed")},
+		})
+
+		id, err := c.Run(ctx, steps...)
+		var aborted *AbortedError
+		require.True(t, errors.As(err, &aborted), "expected AbortedError, got %v", err)
+		assert.Error(t, aborted.AbortErr)
+		assert.Equal(t, StateAborting, st.state(id))
+	})
+	t.Run("commit error leaves committing", func(t *testing.T) {
+		var calls []string
+		c, st := newTestCoordinator(t, &calls, map[string]map[string]error{"b": {"commit": errors.New("commit failed")}})
+
+		id, err := c.Run(ctx, steps...)
+		assert.ErrorContains(t, err, "error committing step 1 (b)")
+		assert.Equal(t, StateCommitting, st.state(id))
+	})
+	t.Run("no steps", func(t *testing.T) {
+		c, _ := newTestCoordinator(t, new([]string), nil)
+
+		_, err := c.Run(ctx)
+		assert.EqualError(t, err, "twophase: at least one step is required")
+	})
+	t.Run("unknown participant", func(t *testing.T) {
+		c, st := newTestCoordinator(t, new([]string), nil)
+
+		_, err := c.Run(ctx, Step{Participant: "c"})
+		assert.EqualError(t, err, `twophase: unknown participant "c"`)
+		assert.Len(t, st.txns, 0)
+	})
+}
+
+func TestCoordinatorRecover(t *testing.T) {
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+
+	payload := func(s string) bson.RawValue {
+		typ, data, err := bson.MarshalValue(s)
+		require.NoError(t, err, "MarshalValue error")
+		return bson.RawValue{Type: typ, Value: data}
+	}
+	stored := func(state State, aDone bool) *transaction {
+		return &transaction{
+			ID:    bson.NewObjectID(),
+			State: state,
+			Steps: []stepStatus{
+				{Participant: "a", Payload: payload("x"), Done: aDone},
+				{Participant: "b", Payload: payload("y")},
+			},
+			UpdatedAt: past,
+		}
+	}
+
+	var calls []string
+	c, st := newTestCoordinator(t, &calls, nil)
+
+	committing := stored(StateCommitting, true)
+	preparing := stored(StatePreparing, false)
+	aborting := stored(StateAborting, true)
+	recent := stored(StatePreparing, false)
+	recent.UpdatedAt = time.Now()
+	for _, txn := range []*transaction{committing, preparing, aborting, recent} {
+		require.NoError(t, st.insert(ctx, txn), "insert error")
+	}
+
+	n, err := c.Recover(ctx, time.Minute)
+	require.NoError(t, err, "Recover error")
+	assert.Equal(t, 3, n)
+	assert.Equal(t, StateCommitted, st.state(committing.ID))
+	assert.Equal(t, StateAborted, st.state(preparing.ID))
+	assert.Equal(t, StateAborted, st.state(aborting.ID))
+	assert.Equal(t, StatePreparing, st.state(recent.ID))
+	assert.ElementsMatch(t, []string{"b.commit:y", "b.abort:y", "a.abort:x", "b.abort:y"}, calls)
+}
+
+func TestFuncs(t *testing.T) {
+	var f Funcs
+	assert.NoError(t, f.Prepare(context.Background(), bson.NilObjectID, bson.RawValue{}))
+	assert.NoError(t, f.Commit(context.Background(), bson.NilObjectID, bson.RawValue{}))
+	assert.NoError(t, f.Abort(context.Background(), bson.NilObjectID, bson.RawValue{}))
+}
@@ -0,0 +1,277 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// documentValidationFailureCode is the code of the DocumentValidationFailure
+// server error.
+const documentValidationFailureCode = 121
+
+// DocumentValidationFailure describes why a document was rejected by the
+// validator of a collection, as reported by servers 5.0 and later in the
+// errInfo of a DocumentValidationFailure write error.
+type DocumentValidationFailure struct {
+	// Index is the index of the write that failed, like WriteError.Index.
+	Index int
+
+	// FailingDocumentID is the _id of the rejected document.
+	FailingDocumentID bson.RawValue
+
+	// Rule is the top-level rule of the validator that the document did not
+	// satisfy, such as a $jsonSchema or a query operator.
+	Rule ValidationRuleFailure
+}
+
+// ValidationRuleFailure describes a validator rule that a document did not
+// satisfy. Rules that combine other rules, such as $jsonSchema, $and or
+// properties, report the nested rules that were not satisfied in Rules and
+// Properties.
+type ValidationRuleFailure struct {
+	// Operator is the name of the operator or schema keyword of the rule,
+	// such as "$jsonSchema", "$eq", "bsonType" or "required".
+	Operator string
+
+	// SpecifiedAs is the rule as specified in the validator.
+	SpecifiedAs bson.Raw
+
+	// Reason describes why the rule was not satisfied.
+	Reason string
+
+	// ConsideredValue is the value that did not satisfy the rule, if the
+	// server reported it.
+	ConsideredValue bson.RawValue
+
+	// ConsideredType is the BSON type of ConsideredValue, if the server
+	// reported it.
+	ConsideredType string
+
+	// ItemIndex is the index of the array element that did not satisfy the
+	// rule of an items keyword, or nil.
+	ItemIndex *int
+
+	// MissingProperties are the properties that a required keyword lists and
+	// that the document does not have.
+	MissingProperties []string
+
+	// AdditionalProperties are the properties that an additionalProperties
+	// keyword does not allow and that the document has.
+	AdditionalProperties []string
+
+	// Properties are the properties that did not satisfy the schemas of a
+	// properties or patternProperties keyword.
+	Properties []PropertyValidationFailure
+
+	// Rules are the nested rules that were not satisfied.
+	Rules []ValidationRuleFailure
+
+	// Raw is the failure as reported by the server.
+	Raw bson.Raw
+}
+
+// PropertyValidationFailure describes a property of a document that did not
+// satisfy its schema.
+type PropertyValidationFailure struct {
+	// Name is the name of the property.
+	Name string
+
+	// Description is the description of the property in the schema, if any.
+	Description string
+
+	// Rules are the rules of the schema of the property that were not
+	// satisfied.
+	Rules []ValidationRuleFailure
+}
+
+// ValidationViolation is a rule that a document did not satisfy and that has
+// no nested rule, together with the field it applies to.
+type ValidationViolation struct {
+	// Field is the dotted path of the field that the rule applies to, such as
+	// "address.zip" or "tags.2". It is empty for rules that apply to the
+	// whole document, such as the query operators of a validator.
+	Field string
+
+	// Rule is the rule that was not satisfied.
+	Rule ValidationRuleFailure
+}
+
+// ValidationFailure parses the details of a DocumentValidationFailure write
+// error. It returns nil if we is not a DocumentValidationFailure error or if
+// the server did not report the details, which only servers 5.0 and later do.
+func (we WriteError) ValidationFailure() *DocumentValidationFailure {
+	if we.Code != documentValidationFailureCode {
+		return nil
+	}
+	details, ok := we.Details.Lookup("details").DocumentOK()
+	if !ok {
+		return nil
+	}
+	return &DocumentValidationFailure{
+		Index:             we.Index,
+		FailingDocumentID: we.Details.Lookup("failingDocumentId"),
+		Rule:              parseValidationRuleFailure(details),
+	}
+}
+
+// ValidationFailures returns the parsed details of the write errors of mwe that
+// are DocumentValidationFailure errors, as returned by
+// WriteError.ValidationFailure.
+func (mwe WriteException) ValidationFailures() []*DocumentValidationFailure {
+	var failures []*DocumentValidationFailure
+	for _, we := range mwe.WriteErrors {
+		if f := we.ValidationFailure(); f != nil {
+			failures = append(failures, f)
+		}
+	}
+	return failures
+}
+
+// Violations returns the rules that the document did not satisfy and that
+// have no nested rule, in the order reported by the server.
+func (f *DocumentValidationFailure) Violations() []ValidationViolation {
+	return appendViolations(nil, "", f.Rule)
+}
+
+func appendViolations(dst []ValidationViolation, field string, rule ValidationRuleFailure) []ValidationViolation {
+	if rule.ItemIndex != nil {
+		field = joinField(field, strconv.Itoa(*rule.ItemIndex))
+	}
+	if len(rule.Rules) == 0 && len(rule.Properties) == 0 {
+		return append(dst, ValidationViolation{Field: field, Rule: rule})
+	}
+	for _, nested := range rule.Rules {
+		dst = appendViolations(dst, field, nested)
+	}
+	for _, prop := range rule.Properties {
+		for _, nested := range prop.Rules {
+			dst = appendViolations(dst, joinField(field, prop.Name), nested)
+		}
+	}
+	return dst
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// parseValidationRuleFailure parses a rule failure reported by the server.
+// Unknown fields are ignored, so that failures reported by newer servers can
+// still be inspected through Raw.
+func parseValidationRuleFailure(doc bson.Raw) ValidationRuleFailure {
+	rule := ValidationRuleFailure{Raw: doc}
+	elems, err := doc.Elements()
+	if err != nil {
+		return rule
+	}
+	for _, elem := range elems {
+		val := elem.Value()
+		switch elem.Key() {
+		case "operatorName":
+			rule.Operator, _ = val.StringValueOK()
+		case "specifiedAs":
+			rule.SpecifiedAs, _ = val.DocumentOK()
+		case "reason":
+			rule.Reason, _ = val.StringValueOK()
+		case "consideredValue":
+			rule.ConsideredValue = val
+		case "consideredType":
+			rule.ConsideredType, _ = val.StringValueOK()
+		case "itemIndex":
+			if i, ok := val.AsInt64OK(); ok {
+				idx := int(i)
+				rule.ItemIndex = &idx
+			}
+		case "missingProperties":
+			rule.MissingProperties = stringValues(val)
+		case "additionalProperties":
+			rule.AdditionalProperties = stringValues(val)
+		case "propertiesNotSatisfied":
+			for _, prop := range documentValues(val) {
+				rule.Properties = append(rule.Properties, parsePropertyValidationFailure(prop))
+			}
+		case "schemaRulesNotSatisfied", "details":
+			// details is an array of rules for keywords such as items, and a
+			// single rule for keywords such as not.
+			if nested, ok := val.DocumentOK(); ok {
+				rule.Rules = append(rule.Rules, parseValidationRuleFailure(nested))
+				break
+			}
+			for _, nested := range documentValues(val) {
+				rule.Rules = append(rule.Rules, parseValidationRuleFailure(nested))
+			}
+		case "clausesNotSatisfied", "schemasNotSatisfied":
+			// Each clause of an $and, $or or $nor, and each schema of an allOf,
+			// anyOf or oneOf, is reported with its index and its details.
+			for _, clause := range documentValues(val) {
+				details := clause.Lookup("details")
+				if nested, ok := details.DocumentOK(); ok {
+					rule.Rules = append(rule.Rules, parseValidationRuleFailure(nested))
+					continue
+				}
+				for _, nested := range documentValues(details) {
+					rule.Rules = append(rule.Rules, parseValidationRuleFailure(nested))
+				}
+			}
+		}
+	}
+	return rule
+}
+
+func parsePropertyValidationFailure(doc bson.Raw) PropertyValidationFailure {
+	prop := PropertyValidationFailure{}
+	prop.Name, _ = doc.Lookup("propertyName").StringValueOK()
+	prop.Description, _ = doc.Lookup("description").StringValueOK()
+	for _, nested := range documentValues(doc.Lookup("details")) {
+		prop.Rules = append(prop.Rules, parseValidationRuleFailure(nested))
+	}
+	return prop
+}
+
+// documentValues returns the documents in val if it is an array.
+func documentValues(val bson.RawValue) []bson.Raw {
+	arr, ok := val.ArrayOK()
+	if !ok {
+		return nil
+	}
+	vals, err := arr.Values()
+	if err != nil {
+		return nil
+	}
+	docs := make([]bson.Raw, 0, len(vals))
+	for _, v := range vals {
+		if doc, ok := v.DocumentOK(); ok {
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
+
+// stringValues returns the strings in val if it is an array.
+func stringValues(val bson.RawValue) []string {
+	arr, ok := val.ArrayOK()
+	if !ok {
+		return nil
+	}
+	vals, err := arr.Values()
+	if err != nil {
+		return nil
+	}
+	strs := make([]string, 0, len(vals))
+	for _, v := range vals {
+		if s, ok := v.StringValueOK(); ok {
+			strs = append(strs, s)
+		}
+	}
+	return strs
+}
@@ -0,0 +1,155 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func mustMarshalRaw(t *testing.T, doc any) bson.Raw {
+	t.Helper()
+
+	b, err := bson.Marshal(doc)
+	require.NoError(t, err)
+	return b
+}
+
+func TestWriteErrorValidationFailure(t *testing.T) {
+	// The errInfo of a document rejected by a $jsonSchema validator, as
+	// reported by the server.
+	schemaErrInfo := mustMarshalRaw(t, bson.D{
+		{"failingDocumentId", int32(7)},
+		{"details", bson.D{
+			{"operatorName", "$jsonSchema"},
+			{"schemaRulesNotSatisfied", bson.A{
+				bson.D{
+					{"operatorName", "properties"},
+					{"propertiesNotSatisfied", bson.A{
+						bson.D{
+							{"propertyName", "name"},
+							{"description", "must be a string"},
+							{"details", bson.A{
+								bson.D{
+									{"operatorName", "bsonType"},
+									{"specifiedAs", bson.D{{"bsonType", "string"}}},
+									{"reason", "type did not match"},
+									{"consideredValue", int32(42)},
+									{"consideredType", "int"},
+								},
+							}},
+						},
+						bson.D{
+							{"propertyName", "tags"},
+							{"details", bson.A{
+								bson.D{
+									{"operatorName", "items"},
+									{"reason", "At least one item did not match the sub-schema"},
+									{"itemIndex", int32(1)},
+									{"details", bson.A{
+										bson.D{
+											{"operatorName", "bsonType"},
+											{"reason", "type did not match"},
+										},
+									}},
+								},
+							}},
+						},
+					}},
+				},
+				bson.D{
+					{"operatorName", "required"},
+					{"specifiedAs", bson.D{{"required", bson.A{"name", "email"}}}},
+					{"missingProperties", bson.A{"email"}},
+				},
+			}},
+		}},
+	})
+	// The errInfo of a document rejected by a validator made of query
+	// operators.
+	queryErrInfo := mustMarshalRaw(t, bson.D{
+		{"failingDocumentId", int32(8)},
+		{"details", bson.D{
+			{"operatorName", "$and"},
+			{"clausesNotSatisfied", bson.A{
+				bson.D{
+					{"index", int32(0)},
+					{"details", bson.D{
+						{"operatorName", "$eq"},
+						{"specifiedAs", bson.D{{"status", "active"}}},
+						{"reason", "comparison failed"},
+						{"consideredValue", "inactive"},
+					}},
+				},
+			}},
+		}},
+	})
+
+	t.Run("json schema", func(t *testing.T) {
+		we := WriteError{Index: 3, Code: 121, Message: "Document failed validation", Details: schemaErrInfo}
+		f := we.ValidationFailure()
+		require.NotNil(t, f, "expected a validation failure")
+
+		assert.Equal(t, 3, f.Index)
+		assert.Equal(t, int32(7), f.FailingDocumentID.Int32())
+		assert.Equal(t, "$jsonSchema", f.Rule.Operator)
+		require.Len(t, f.Rule.Rules, 2)
+		require.Len(t, f.Rule.Rules[0].Properties, 2)
+		assert.Equal(t, "must be a string", f.Rule.Rules[0].Properties[0].Description)
+		assert.Equal(t, []string{"email"}, f.Rule.Rules[1].MissingProperties)
+
+		violations := f.Violations()
+		require.Len(t, violations, 3)
+
+		assert.Equal(t, "name", violations[0].Field)
+		assert.Equal(t, "bsonType", violations[0].Rule.Operator)
+		assert.Equal(t, "type did not match", violations[0].Rule.Reason)
+		assert.Equal(t, int32(42), violations[0].Rule.ConsideredValue.Int32())
+		assert.Equal(t, "int", violations[0].Rule.ConsideredType)
+		assert.Equal(t, "string", violations[0].Rule.SpecifiedAs.Lookup("bsonType").StringValue())
+
+		assert.Equal(t, "tags.1", violations[1].Field)
+		assert.Equal(t, "bsonType", violations[1].Rule.Operator)
+
+		assert.Equal(t, "", violations[2].Field)
+		assert.Equal(t, "required", violations[2].Rule.Operator)
+		assert.Equal(t, []string{"email"}, violations[2].Rule.MissingProperties)
+	})
+	t.Run("query operators", func(t *testing.T) {
+		we := WriteError{Code: 121, Details: queryErrInfo}
+		f := we.ValidationFailure()
+		require.NotNil(t, f, "expected a validation failure")
+
+		violations := f.Violations()
+		require.Len(t, violations, 1)
+		assert.Equal(t, "", violations[0].Field)
+		assert.Equal(t, "$eq", violations[0].Rule.Operator)
+		assert.Equal(t, "inactive", violations[0].Rule.ConsideredValue.StringValue())
+	})
+	t.Run("not a validation failure", func(t *testing.T) {
+		we := WriteError{Code: 11000, Details: schemaErrInfo}
+		assert.Nil(t, we.ValidationFailure())
+	})
+	t.Run("no details", func(t *testing.T) {
+		// Servers before 5.0 do not report the details.
+		we := WriteError{Code: 121, Message: "Document failed validation"}
+		assert.Nil(t, we.ValidationFailure())
+	})
+	t.Run("write exception", func(t *testing.T) {
+		we := WriteException{WriteErrors: WriteErrors{
+			{Index: 0, Code: 11000},
+			{Index: 1, Code: 121, Details: queryErrInfo},
+		}}
+		failures := we.ValidationFailures()
+		require.Len(t, failures, 1)
+		assert.Equal(t, 1, failures[0].Index)
+		assert.Equal(t, int32(8), failures[0].FailingDocumentID.Int32())
+	})
+}
@@ -0,0 +1,252 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// VectorSearchIndexType is the search index type of Atlas Vector Search indexes.
+const VectorSearchIndexType = "vectorSearch"
+
+// searchIndexPollInterval is the time SearchIndexView.WaitForQueryable waits
+// between listSearchIndexes commands.
+const searchIndexPollInterval = 5 * time.Second
+
+// Search index statuses reported by listSearchIndexes.
+const (
+	searchIndexStatusReady  = "READY"
+	searchIndexStatusFailed = "FAILED"
+)
+
+// ErrSearchIndexNotFound is returned by SearchIndexView.WaitForQueryable if the search index does not exist.
+var ErrSearchIndexNotFound = errors.New("search index not found")
+
+// VectorSearchFieldType is the type of a field in a vector search index definition.
+type VectorSearchFieldType string
+
+// These constants specify the supported VectorSearchFieldType values.
+const (
+	// VectorSearchFieldVector indexes a field containing vector embeddings.
+	VectorSearchFieldVector VectorSearchFieldType = "vector"
+
+	// VectorSearchFieldFilter indexes a field used to pre-filter the documents
+	// of a $vectorSearch query.
+	VectorSearchFieldFilter VectorSearchFieldType = "filter"
+)
+
+// VectorSimilarity is the function used to compare vectors in a vector search index.
+type VectorSimilarity string
+
+// These constants specify the supported VectorSimilarity values.
+const (
+	VectorSimilarityEuclidean  VectorSimilarity = "euclidean"
+	VectorSimilarityCosine     VectorSimilarity = "cosine"
+	VectorSimilarityDotProduct VectorSimilarity = "dotProduct"
+)
+
+// VectorQuantization is the type of automatic quantization applied to the
+// vectors in a vector search index.
+type VectorQuantization string
+
+// These constants specify the supported VectorQuantization values.
+const (
+	VectorQuantizationNone   VectorQuantization = "none"
+	VectorQuantizationScalar VectorQuantization = "scalar"
+	VectorQuantizationBinary VectorQuantization = "binary"
+)
+
+// VectorSearchField is a field in a vector search index definition.
+//
+// See https://www.mongodb.com/docs/atlas/atlas-vector-search/vector-search-type/ for reference.
+type VectorSearchField struct {
+	// The type of the field. It must be VectorSearchFieldVector or
+	// VectorSearchFieldFilter.
+	Type VectorSearchFieldType `bson:"type"`
+
+	// The name of the field to index. It cannot be empty.
+	Path string `bson:"path"`
+
+	// The number of dimensions of the vectors in the field. It is required for
+	// vector fields and must not be set for filter fields.
+	NumDimensions int `bson:"numDimensions,omitempty"`
+
+	// The function used to compare vectors. It is required for vector fields
+	// and must not be set for filter fields.
+	Similarity VectorSimilarity `bson:"similarity,omitempty"`
+
+	// The type of automatic quantization applied to the vectors. It can only
+	// be set for vector fields. The default is "", which means that the
+	// server default of no quantization is used.
+	Quantization VectorQuantization `bson:"quantization,omitempty"`
+}
+
+// VectorSearchIndexDefinition is the definition of an Atlas Vector Search
+// index. It can be used as the Definition of a SearchIndexModel with the
+// search index type set to VectorSearchIndexType, or passed to
+// SearchIndexView.CreateVectorSearchIndex.
+type VectorSearchIndexDefinition struct {
+	// The fields to index. There must be at least one vector field.
+	Fields []VectorSearchField `bson:"fields"`
+}
+
+// Validate returns an error if the definition is not a valid vector search
+// index definition.
+func (vsid VectorSearchIndexDefinition) Validate() error {
+	var vectors int
+	for i, field := range vsid.Fields {
+		if field.Path == "" {
+			return fmt.Errorf("vector search index field %d: path cannot be empty", i)
+		}
+
+		switch field.Type {
+		case VectorSearchFieldVector:
+			vectors++
+			if field.NumDimensions <= 0 {
+				return fmt.Errorf("vector search index field %q: numDimensions must be positive", field.Path)
+			}
+			if field.Similarity == "" {
+				return fmt.Errorf("vector search index field %q: similarity must be set", field.Path)
+			}
+		case VectorSearchFieldFilter:
+			if field.NumDimensions != 0 || field.Similarity != "" || field.Quantization != "" {
+				return fmt.Errorf("vector search index field %q: filter fields cannot set "+
+					"numDimensions, similarity, or quantization", field.Path)
+			}
+		default:
+			return fmt.Errorf("vector search index field %q: invalid type %q", field.Path, field.Type)
+		}
+	}
+
+	if vectors == 0 {
+		return errors.New("vector search index definition must contain a vector field")
+	}
+	return nil
+}
+
+// SearchIndexSpecification represents a search index returned by listSearchIndexes.
+type SearchIndexSpecification struct {
+	// The search index name.
+	Name string `bson:"name"`
+
+	// The search index type, e.g. "search" or "vectorSearch".
+	Type string `bson:"type"`
+
+	// The status of the search index, e.g. "PENDING", "BUILDING", "READY", or "FAILED".
+	Status string `bson:"status"`
+
+	// Whether the search index can be used in queries. An index that is being
+	// rebuilt after an update remains queryable with its previous definition.
+	Queryable bool `bson:"queryable"`
+
+	// The most recent definition of the search index.
+	LatestDefinition bson.Raw `bson:"latestDefinition"`
+}
+
+// CreateVectorSearchIndex executes a createSearchIndexes command to create an Atlas Vector Search index with the
+// given name and definition on the collection. The definition is validated before the command is run.
+//
+// This is an asynchronous operation. SearchIndexView.WaitForQueryable can be used to wait until the index can be used
+// in queries.
+func (siv SearchIndexView) CreateVectorSearchIndex(
+	ctx context.Context,
+	name string,
+	definition VectorSearchIndexDefinition,
+	opts ...options.Lister[options.CreateSearchIndexesOptions],
+) (string, error) {
+	if err := definition.Validate(); err != nil {
+		return "", err
+	}
+
+	model := SearchIndexModel{
+		Definition: definition,
+		Options:    options.SearchIndexes().SetName(name).SetType(VectorSearchIndexType),
+	}
+	return siv.CreateOne(ctx, model, opts...)
+}
+
+// UpdateVectorSearchIndex executes an updateSearchIndex command to replace the definition of the Atlas Vector Search
+// index with the given name. The definition is validated before the command is run.
+//
+// This is an asynchronous operation. SearchIndexView.WaitForQueryable can be used to wait until the index is
+// queryable with the new definition.
+func (siv SearchIndexView) UpdateVectorSearchIndex(
+	ctx context.Context,
+	name string,
+	definition VectorSearchIndexDefinition,
+	opts ...options.Lister[options.UpdateSearchIndexOptions],
+) error {
+	if err := definition.Validate(); err != nil {
+		return err
+	}
+
+	return siv.UpdateOne(ctx, name, definition, opts...)
+}
+
+// WaitForQueryable runs listSearchIndexes repeatedly until the search index with the given name is queryable with
+// its latest definition, and returns its specification. After a search index is created or updated, this happens
+// once its status is "READY".
+//
+// If the index build fails, an error containing the index status is returned. If the index does not exist, an error
+// wrapping ErrSearchIndexNotFound is returned. Otherwise, WaitForQueryable blocks until ctx expires, so ctx should
+// have a deadline.
+func (siv SearchIndexView) WaitForQueryable(ctx context.Context, name string) (*SearchIndexSpecification, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for {
+		spec, err := siv.searchIndexSpecification(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case spec.Queryable && spec.Status == searchIndexStatusReady:
+			return spec, nil
+		case spec.Status == searchIndexStatusFailed:
+			return nil, fmt.Errorf("search index %q has status %q", name, spec.Status)
+		}
+
+		timer := time.NewTimer(searchIndexPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// searchIndexSpecification returns the specification of the search index with
+// the given name.
+func (siv SearchIndexView) searchIndexSpecification(ctx context.Context, name string) (*SearchIndexSpecification, error) {
+	cursor, err := siv.List(ctx, options.SearchIndexes().SetName(name))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if !cursor.Next(ctx) {
+		if err := cursor.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %q", ErrSearchIndexNotFound, name)
+	}
+
+	var spec SearchIndexSpecification
+	if err := cursor.Decode(&spec); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestVectorSearchIndexDefinition(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		def := VectorSearchIndexDefinition{
+			Fields: []VectorSearchField{
+				{
+					Type:          VectorSearchFieldVector,
+					Path:          "embedding",
+					NumDimensions: 1536,
+					Similarity:    VectorSimilarityCosine,
+					Quantization:  VectorQuantizationScalar,
+				},
+				{Type: VectorSearchFieldFilter, Path: "genre"},
+			},
+		}
+
+		got, err := bson.Marshal(def)
+		require.NoError(t, err, "Marshal error")
+
+		want, err := bson.Marshal(bson.D{{"fields", bson.A{
+			bson.D{
+				{"type", "vector"},
+				{"path", "embedding"},
+				{"numDimensions", int32(1536)},
+				{"similarity", "cosine"},
+				{"quantization", "scalar"},
+			},
+			bson.D{{"type", "filter"}, {"path", "genre"}},
+		}}})
+		require.NoError(t, err, "Marshal error")
+
+		assert.Equal(t, bson.Raw(want), bson.Raw(got), "unexpected definition document")
+	})
+	t.Run("validate", func(t *testing.T) {
+		vector := VectorSearchField{
+			Type:          VectorSearchFieldVector,
+			Path:          "embedding",
+			NumDimensions: 3,
+			Similarity:    VectorSimilarityEuclidean,
+		}
+
+		testCases := []struct {
+			name    string
+			fields  []VectorSearchField
+			wantErr bool
+		}{
+			{"vector field", []VectorSearchField{vector}, false},
+			{"vector and filter fields", []VectorSearchField{vector, {Type: VectorSearchFieldFilter, Path: "a"}}, false},
+			{"no fields", nil, true},
+			{"only filter fields", []VectorSearchField{{Type: VectorSearchFieldFilter, Path: "a"}}, true},
+			{"empty path", []VectorSearchField{{Type: VectorSearchFieldVector, NumDimensions: 3, Similarity: VectorSimilarityCosine}}, true},
+			{"missing dimensions", []VectorSearchField{{Type: VectorSearchFieldVector, Path: "a", Similarity: VectorSimilarityCosine}}, true},
+			{"missing similarity", []VectorSearchField{{Type: VectorSearchFieldVector, Path: "a", NumDimensions: 3}}, true},
+			{"filter with similarity", []VectorSearchField{vector, {Type: VectorSearchFieldFilter, Path: "a", Similarity: VectorSimilarityCosine}}, true},
+			{"invalid type", []VectorSearchField{vector, {Type: "text", Path: "a"}}, true},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := VectorSearchIndexDefinition{Fields: tc.fields}.Validate()
+				if tc.wantErr {
+					assert.Error(t, err, "expected a validation error")
+				} else {
+					assert.NoError(t, err, "Validate error")
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+)
+
+type pinnedConnectionKey struct{}
+
+// pinnedConnectionScope is the connection that the operations of client are
+// pinned to by WithConnection.
+type pinnedConnectionScope struct {
+	client     *Client
+	deployment driver.ConnectionDeployment
+}
+
+// WithConnection checks out a connection to the primary, or to a mongos for
+// sharded clusters, and calls fn with a copy of ctx that runs every operation
+// of the Client on that connection. This is needed by workflows that depend on
+// connection state, such as settings applied with a command for the lifetime
+// of the connection, or that must send follow-up commands to the same mongos
+// as a previous command. The connection is returned to the pool when fn
+// returns, and the error returned by fn is returned.
+//
+// The operations run with the context passed to fn are not retried on another
+// connection, and they must not be run concurrently: an operation that is
+// started while another one is using the connection fails with
+// driver.ErrConnectionInUse. Cursors created by fn use the connection for
+// getMore commands. When fn returns, the cursors that are still open are
+// killed on the server, and iterating them further fails with
+// driver.ErrConnectionDeploymentClosed. Operations of other Clients run with
+// the context are not affected. If ctx is already pinned to a connection of
+// the Client, fn is called with ctx.
+func (c *Client) WithConnection(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if fn == nil {
+		return errors.New("fn must not be nil")
+	}
+	if scope, ok := ctx.Value(pinnedConnectionKey{}).(pinnedConnectionScope); ok && scope.client == c {
+		return fn(ctx)
+	}
+
+	deployment, err := c.checkOutConnection(ctx, readpref.Primary())
+	if err != nil {
+		return err
+	}
+	defer deployment.Close(ctx)
+
+	return fn(context.WithValue(ctx, pinnedConnectionKey{}, pinnedConnectionScope{
+		client:     c,
+		deployment: deployment,
+	}))
+}
+
+// pinnedDeployment returns the deployment that ctx pins the operations of c
+// to, and false if ctx does not pin them.
+func (c *Client) pinnedDeployment(ctx context.Context) (driver.ConnectionDeployment, bool) {
+	scope, ok := ctx.Value(pinnedConnectionKey{}).(pinnedConnectionScope)
+	if !ok || scope.client != c {
+		return driver.ConnectionDeployment{}, false
+	}
+	return scope.deployment, true
+}
+
+// deploymentFor returns the deployment that the operations of c run on: the
+// deployment that ctx pins them to, if any, and the deployment of c otherwise.
+func (c *Client) deploymentFor(ctx context.Context) driver.Deployment {
+	if ctx == nil {
+		return c.deployment
+	}
+	if deployment, ok := c.pinnedDeployment(ctx); ok {
+		return deployment
+	}
+	return c.deployment
+}
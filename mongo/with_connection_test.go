@@ -0,0 +1,56 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+)
+
+func TestClientWithConnection(t *testing.T) {
+	t.Run("nil fn", func(t *testing.T) {
+		client := setupClient()
+		err := client.WithConnection(context.Background(), nil)
+		assert.EqualError(t, err, "fn must not be nil")
+	})
+	t.Run("pinned deployment is scoped to the client", func(t *testing.T) {
+		client := setupClient()
+		other := setupClient()
+
+		deployment := driver.ConnectionDeployment{TopologyKind: description.TopologyKindSharded}
+		ctx := context.WithValue(context.Background(), pinnedConnectionKey{}, pinnedConnectionScope{
+			client:     client,
+			deployment: deployment,
+		})
+
+		got, ok := client.pinnedDeployment(ctx)
+		assert.True(t, ok, "expected the context to pin the client")
+		assert.Equal(t, deployment, got)
+
+		_, ok = other.pinnedDeployment(ctx)
+		assert.False(t, ok, "expected the context not to pin another client")
+		_, ok = client.pinnedDeployment(context.Background())
+		assert.False(t, ok, "expected an empty context not to pin the client")
+	})
+	t.Run("nested calls reuse the connection", func(t *testing.T) {
+		client := setupClient()
+		ctx := context.WithValue(context.Background(), pinnedConnectionKey{}, pinnedConnectionScope{client: client})
+
+		var called bool
+		err := client.WithConnection(ctx, func(inner context.Context) error {
+			called = true
+			assert.Equal(t, ctx, inner, "expected the pinned context to be reused")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.True(t, called, "expected fn to be called")
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/integtest"
+	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -696,3 +697,64 @@ func compareVersions(v1 string, v2 string) int {
 
 	return 0
 }
+
+func TestTransactionRetrier(t *testing.T) {
+	t.Parallel()
+
+	errTransient := CommandError{Name: "test Error", Labels: []string{driver.TransientTransactionError}}
+
+	t.Run("max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		var retries []options.TransactionRetry
+		args, err := mongoutil.NewOptions[options.TransactionOptions](options.Transaction().SetMaxAttempts(3).SetOnRetry(func(r options.TransactionRetry) {
+			retries = append(retries, r)
+		}))
+		require.NoError(t, err)
+		r := newTransactionRetrier(args)
+
+		assert.True(t, r.next(context.Background(), errTransient, false), "expected attempt 2 to be allowed")
+		assert.True(t, r.next(context.Background(), errTransient, true), "expected attempt 3 to be allowed")
+		assert.False(t, r.next(context.Background(), errTransient, false), "expected attempt 4 to be rejected")
+
+		require.Len(t, retries, 2)
+		assert.Equal(t, options.TransactionRetry{Attempt: 2, Err: errTransient}, retries[0])
+		assert.Equal(t, options.TransactionRetry{Attempt: 3, Commit: true, Err: errTransient}, retries[1])
+	})
+	t.Run("backoff", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := mongoutil.NewOptions[options.TransactionOptions](options.Transaction().SetRetryBackoff(10*time.Millisecond, 50*time.Millisecond))
+		require.NoError(t, err)
+		r := newTransactionRetrier(args)
+
+		assert.Equal(t, 10*time.Millisecond, r.backoffFor(2))
+		assert.Equal(t, 20*time.Millisecond, r.backoffFor(3))
+		assert.Equal(t, 40*time.Millisecond, r.backoffFor(4))
+		assert.Equal(t, 50*time.Millisecond, r.backoffFor(5))
+		assert.Equal(t, 50*time.Millisecond, r.backoffFor(100))
+	})
+	t.Run("backoff respects context", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := mongoutil.NewOptions[options.TransactionOptions](options.Transaction().SetRetryBackoff(time.Hour, time.Hour))
+		require.NoError(t, err)
+		r := newTransactionRetrier(args)
+		r.deadline = time.Now().Add(24 * time.Hour)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.False(t, r.next(ctx, errTransient, false), "expected retry to be rejected because the context is canceled")
+	})
+	t.Run("retry timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := mongoutil.NewOptions[options.TransactionOptions](options.Transaction().SetRetryTimeout(0))
+		require.NoError(t, err)
+		assert.True(t, newTransactionRetrier(args).expired(), "expected retry timeout to have elapsed")
+
+		args, err = mongoutil.NewOptions[options.TransactionOptions](options.Transaction())
+		require.NoError(t, err)
+		assert.False(t, newTransactionRetrier(args).expired(), "expected default retry timeout not to have elapsed")
+	})
+}
@@ -0,0 +1,314 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// ZoneKeyRange is a range of shard key values of a collection that is
+// assigned to a zone. The range includes Min and excludes Max.
+type ZoneKeyRange struct {
+	// Namespace is the sharded collection in "database.collection" form.
+	Namespace string `bson:"ns"`
+
+	// Min is the inclusive lower bound of the range.
+	Min bson.Raw `bson:"min"`
+
+	// Max is the exclusive upper bound of the range.
+	Max bson.Raw `bson:"max"`
+
+	// Zone is the name of the zone the range is assigned to.
+	Zone string `bson:"tag"`
+}
+
+// Zone is a zone of a sharded cluster, as returned by Client.ListZones.
+type Zone struct {
+	// Name is the name of the zone.
+	Name string
+
+	// Shards are the IDs of the shards assigned to the zone, in sorted order.
+	Shards []string
+
+	// Ranges are the shard key ranges assigned to the zone, sorted by
+	// namespace.
+	Ranges []ZoneKeyRange
+}
+
+// AddShardToZone assigns the shard with the given ID to a zone by running the
+// addShardToZone admin command. The zone is created if it does not exist.
+//
+// For more information about the command, see
+// https://www.mongodb.com/docs/manual/reference/command/addShardToZone/.
+func (c *Client) AddShardToZone(ctx context.Context, shard, zone string) error {
+	if shard == "" || zone == "" {
+		return errors.New("shard and zone names are required")
+	}
+	return c.Database("admin").RunCommand(ctx, bson.D{{"addShardToZone", shard}, {"zone", zone}}).Err()
+}
+
+// RemoveShardFromZone removes the shard with the given ID from a zone by
+// running the removeShardFromZone admin command.
+//
+// For more information about the command, see
+// https://www.mongodb.com/docs/manual/reference/command/removeShardFromZone/.
+func (c *Client) RemoveShardFromZone(ctx context.Context, shard, zone string) error {
+	if shard == "" || zone == "" {
+		return errors.New("shard and zone names are required")
+	}
+	return c.Database("admin").RunCommand(ctx, bson.D{{"removeShardFromZone", shard}, {"zone", zone}}).Err()
+}
+
+// UpdateZoneKeyRange assigns the range of shard key values from min
+// (inclusive) to max (exclusive) of the collection ns to a zone by running the
+// updateZoneKeyRange admin command. If zone is empty, the range is removed
+// from the zone it is assigned to instead.
+//
+// The min and max parameters must be documents with the fields of the shard
+// key, in the same order, and min must be less than max. The ordering is
+// checked before the command is sent for values of the following types:
+// MinKey, MaxKey, null, numbers other than Decimal128, strings, ObjectIDs,
+// booleans, dates, and timestamps. Ranges with values of other types are
+// validated by the server.
+//
+// For more information about the command, see
+// https://www.mongodb.com/docs/manual/reference/command/updateZoneKeyRange/.
+func (c *Client) UpdateZoneKeyRange(ctx context.Context, ns string, min, max any, zone string) error {
+	if ns == "" {
+		return errors.New("namespace is required")
+	}
+
+	minDoc, err := marshal(min, c.bsonOpts, c.registry)
+	if err != nil {
+		return err
+	}
+	maxDoc, err := marshal(max, c.bsonOpts, c.registry)
+	if err != nil {
+		return err
+	}
+	if err := validateZoneKeyRange(minDoc, maxDoc); err != nil {
+		return err
+	}
+
+	cmd := bson.D{
+		{"updateZoneKeyRange", ns},
+		{"min", bson.Raw(minDoc)},
+		{"max", bson.Raw(maxDoc)},
+	}
+	if zone == "" {
+		cmd = append(cmd, bson.E{"zone", nil})
+	} else {
+		cmd = append(cmd, bson.E{"zone", zone})
+	}
+	return c.Database("admin").RunCommand(ctx, cmd).Err()
+}
+
+// ListZones returns the zones of the sharded cluster, sorted by name, with the
+// shards and shard key ranges assigned to each. It reads the shards and tags
+// collections of the config database.
+func (c *Client) ListZones(ctx context.Context) ([]Zone, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	config := c.Database("config")
+	cursor, err := config.Collection("shards").Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var shards []struct {
+		ID   string   `bson:"_id"`
+		Tags []string `bson:"tags"`
+	}
+	if err := cursor.All(ctx, &shards); err != nil {
+		return nil, err
+	}
+
+	cursor, err = config.Collection("tags").Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var ranges []ZoneKeyRange
+	if err := cursor.All(ctx, &ranges); err != nil {
+		return nil, err
+	}
+
+	zones := make(map[string]*Zone)
+	zone := func(name string) *Zone {
+		z, ok := zones[name]
+		if !ok {
+			z = &Zone{Name: name}
+			zones[name] = z
+		}
+		return z
+	}
+	for _, shard := range shards {
+		for _, tag := range shard.Tags {
+			z := zone(tag)
+			z.Shards = append(z.Shards, shard.ID)
+		}
+	}
+	for _, r := range ranges {
+		z := zone(r.Zone)
+		z.Ranges = append(z.Ranges, r)
+	}
+
+	list := make([]Zone, 0, len(zones))
+	for _, z := range zones {
+		sort.Strings(z.Shards)
+		sort.SliceStable(z.Ranges, func(i, j int) bool { return z.Ranges[i].Namespace < z.Ranges[j].Namespace })
+		list = append(list, *z)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// validateZoneKeyRange returns an error if min and max do not have the same
+// fields or if min is not less than max.
+func validateZoneKeyRange(min, max bsoncore.Document) error {
+	minElems, err := min.Elements()
+	if err != nil {
+		return err
+	}
+	maxElems, err := max.Elements()
+	if err != nil {
+		return err
+	}
+
+	if len(minElems) == 0 {
+		return errors.New("zone key range bounds must not be empty")
+	}
+	if len(minElems) != len(maxElems) {
+		return fmt.Errorf("zone key range bounds %v and %v must have the same fields", min, max)
+	}
+	for i := range minElems {
+		if minElems[i].Key() != maxElems[i].Key() {
+			return fmt.Errorf("zone key range bounds %v and %v must have the same fields", min, max)
+		}
+	}
+
+	for i := range minElems {
+		cmp, ok := compareShardKeyValues(minElems[i].Value(), maxElems[i].Value())
+		if !ok {
+			// The server compares the remaining values.
+			return nil
+		}
+		if cmp < 0 {
+			return nil
+		}
+		if cmp > 0 {
+			break
+		}
+	}
+	return fmt.Errorf("zone key range min %v must be less than max %v", min, max)
+}
+
+// shardKeyTypeOrder returns the position of the type of v in the BSON
+// comparison order, and false if values of the type are not compared by
+// compareShardKeyValues.
+func shardKeyTypeOrder(v bsoncore.Value) (int, bool) {
+	switch v.Type {
+	case bsoncore.TypeMinKey:
+		return 0, true
+	case bsoncore.TypeNull:
+		return 1, true
+	case bsoncore.TypeInt32, bsoncore.TypeInt64, bsoncore.TypeDouble:
+		return 2, true
+	case bsoncore.TypeString:
+		return 3, true
+	case bsoncore.TypeObjectID:
+		return 4, true
+	case bsoncore.TypeBoolean:
+		return 5, true
+	case bsoncore.TypeDateTime:
+		return 6, true
+	case bsoncore.TypeTimestamp:
+		return 7, true
+	case bsoncore.TypeMaxKey:
+		return 8, true
+	}
+	return 0, false
+}
+
+// compareShardKeyValues returns -1, 0, or 1 if a is less than, equal to, or
+// greater than b in the BSON comparison order. It returns false if a or b has
+// a type that is not supported.
+func compareShardKeyValues(a, b bsoncore.Value) (int, bool) {
+	aOrder, aOK := shardKeyTypeOrder(a)
+	bOrder, bOK := shardKeyTypeOrder(b)
+	if !aOK || !bOK {
+		return 0, false
+	}
+	if aOrder != bOrder {
+		return compareInts(int64(aOrder), int64(bOrder)), true
+	}
+
+	switch a.Type {
+	case bsoncore.TypeInt32, bsoncore.TypeInt64, bsoncore.TypeDouble:
+		if a.Type != bsoncore.TypeDouble && b.Type != bsoncore.TypeDouble {
+			return compareInts(a.AsInt64(), b.AsInt64()), true
+		}
+		af, bf := shardKeyFloat(a), shardKeyFloat(b)
+		switch {
+		case af < bf:
+			return -1, true
+		case af > bf:
+			return 1, true
+		}
+		return 0, true
+	case bsoncore.TypeString:
+		return bytes.Compare([]byte(a.StringValue()), []byte(b.StringValue())), true
+	case bsoncore.TypeObjectID:
+		aID, bID := a.ObjectID(), b.ObjectID()
+		return bytes.Compare(aID[:], bID[:]), true
+	case bsoncore.TypeBoolean:
+		return compareInts(boolToInt64(a.Boolean()), boolToInt64(b.Boolean())), true
+	case bsoncore.TypeDateTime:
+		return compareInts(a.DateTime(), b.DateTime()), true
+	case bsoncore.TypeTimestamp:
+		at, ai := a.Timestamp()
+		bt, bi := b.Timestamp()
+		if at != bt {
+			return compareInts(int64(at), int64(bt)), true
+		}
+		return compareInts(int64(ai), int64(bi)), true
+	}
+	// MinKey, MaxKey, and null are equal to themselves.
+	return 0, true
+}
+
+// shardKeyFloat returns the numeric value v as a float64.
+func shardKeyFloat(v bsoncore.Value) float64 {
+	if v.Type == bsoncore.TypeDouble {
+		return v.Double()
+	}
+	return float64(v.AsInt64())
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,100 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestValidateZoneKeyRange(t *testing.T) {
+	t.Parallel()
+
+	oid1, oid2 := bson.NewObjectIDFromTimestamp(time.Unix(1, 0)), bson.NewObjectIDFromTimestamp(time.Unix(2, 0))
+	testCases := []struct {
+		name string
+		min  bson.D
+		max  bson.D
+		err  string
+	}{
+		{"ints", bson.D{{"x", 1}}, bson.D{{"x", 2}}, ""},
+		{"mixed numbers", bson.D{{"x", int64(1)}}, bson.D{{"x", 1.5}}, ""},
+		{"min key to max key", bson.D{{"x", bson.MinKey{}}}, bson.D{{"x", bson.MaxKey{}}}, ""},
+		{"number before string", bson.D{{"x", 100}}, bson.D{{"x", "a"}}, ""},
+		{"strings", bson.D{{"x", "a"}}, bson.D{{"x", "b"}}, ""},
+		{"object IDs", bson.D{{"x", oid1}}, bson.D{{"x", oid2}}, ""},
+		{"compound", bson.D{{"x", 1}, {"y", 5}}, bson.D{{"x", 1}, {"y", 6}}, ""},
+		{"compound decided by first field", bson.D{{"x", 1}, {"y", 9}}, bson.D{{"x", 2}, {"y", 0}}, ""},
+		{"unsupported type", bson.D{{"x", bson.D{{"a", 2}}}}, bson.D{{"x", bson.D{{"a", 1}}}}, ""},
+		{
+			"reversed",
+			bson.D{{"x", 2}}, bson.D{{"x", 1}},
+			`zone key range min {"x": {"$numberInt":"2"}} must be less than max {"x": {"$numberInt":"1"}}`,
+		},
+		{
+			"equal",
+			bson.D{{"x", "a"}}, bson.D{{"x", "a"}},
+			`zone key range min {"x": "a"} must be less than max {"x": "a"}`,
+		},
+		{
+			"string before number",
+			bson.D{{"x", "a"}}, bson.D{{"x", 100}},
+			`zone key range min {"x": "a"} must be less than max {"x": {"$numberInt":"100"}}`,
+		},
+		{
+			"different fields",
+			bson.D{{"x", 1}}, bson.D{{"y", 2}},
+			`zone key range bounds {"x": {"$numberInt":"1"}} and {"y": {"$numberInt":"2"}} must have the same fields`,
+		},
+		{
+			"different field counts",
+			bson.D{{"x", 1}}, bson.D{{"x", 2}, {"y", 2}},
+			`zone key range bounds {"x": {"$numberInt":"1"}} and {"x": {"$numberInt":"2"},"y": {"$numberInt":"2"}} must have the same fields`,
+		},
+		{"empty", bson.D{}, bson.D{}, "zone key range bounds must not be empty"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			min, err := bson.Marshal(tc.min)
+			require.NoError(t, err)
+			max, err := bson.Marshal(tc.max)
+			require.NoError(t, err)
+
+			err = validateZoneKeyRange(min, max)
+			if tc.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestZoneArguments(t *testing.T) {
+	client := setupClient()
+
+	err := client.AddShardToZone(context.Background(), "", "zone")
+	assert.EqualError(t, err, "shard and zone names are required")
+
+	err = client.RemoveShardFromZone(context.Background(), "shard", "")
+	assert.EqualError(t, err, "shard and zone names are required")
+
+	err = client.UpdateZoneKeyRange(context.Background(), "", bson.D{{"x", 1}}, bson.D{{"x", 2}}, "zone")
+	assert.EqualError(t, err, "namespace is required")
+
+	err = client.UpdateZoneKeyRange(context.Background(), "db.coll", bson.D{{"x", 2}}, bson.D{{"x", 1}}, "zone")
+	assert.ErrorContains(t, err, "must be less than max")
+}
@@ -0,0 +1,83 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package krb5 provides a pure Go implementation of the GSSAPI (Kerberos)
+// authentication mechanism. It does not require cgo or the system Kerberos
+// libraries, so it can be used for statically linked or cross-compiled
+// binaries on any platform. It is a separate module so that applications that
+// do not use it do not depend on its Kerberos library.
+//
+// Importing the package registers the implementation for the "GSSAPI"
+// mechanism, replacing the implementation enabled by the "gssapi" build tag,
+// if any:
+//
+//	import _ "go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth/krb5"
+//
+// The Kerberos configuration is read from the file named by the KRB5_CONFIG
+// environment variable (default "/etc/krb5.conf"). Connections authenticate
+// with the Credential password if one is set, otherwise with the keytab named
+// by KRB5_CLIENT_KTNAME, otherwise with the credential cache named by
+// KRB5CCNAME. Service tickets must use AES or newer encryption types.
+package krb5
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth"
+)
+
+const sourceExternal = "$external"
+
+func init() {
+	auth.RegisterAuthenticatorFactory(auth.GSSAPI, newAuthenticator)
+}
+
+func newAuthenticator(cred *auth.Cred, _ *http.Client) (auth.Authenticator, error) {
+	if cred.Source != "" && cred.Source != sourceExternal {
+		return nil, errors.New("GSSAPI source must be empty or $external")
+	}
+
+	return &authenticator{
+		username:    cred.Username,
+		password:    cred.Password,
+		passwordSet: cred.PasswordSet,
+		props:       cred.Props,
+	}, nil
+}
+
+// authenticator uses the GSSAPI mechanism over SASL to authenticate a
+// connection.
+type authenticator struct {
+	username    string
+	password    string
+	passwordSet bool
+	props       map[string]string
+}
+
+// Auth authenticates the connection.
+func (a *authenticator) Auth(ctx context.Context, cfg *driver.AuthConfig) error {
+	target := cfg.Connection.Description().Addr.String()
+	hostname, _, err := net.SplitHostPort(target)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint (%s) specified: %w", target, err)
+	}
+
+	client, err := newSaslClient(hostname, a.username, a.password, a.passwordSet, a.props)
+	if err != nil {
+		return fmt.Errorf("error creating gssapi: %w", err)
+	}
+	return auth.ConductSaslConversation(ctx, cfg, sourceExternal, client)
+}
+
+// Reauth reauthenticates the connection.
+func (a *authenticator) Reauth(context.Context, *driver.AuthConfig) error {
+	return errors.New("GSSAPI does not support reauthentication")
+}
@@ -0,0 +1,268 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package krb5
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	gokrb5gssapi "github.com/jcmturner/gokrb5/v8/gssapi"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"github.com/jcmturner/gokrb5/v8/types"
+)
+
+const (
+	defaultKrb5ConfigPath = "/etc/krb5.conf"
+
+	// wrapTokenHeaderLen is the length of an RFC 4121 wrap token header.
+	wrapTokenHeaderLen = 16
+)
+
+// newSaslClient creates a new saslClient backed by a pure Go Kerberos implementation. The target parameter
+// should be a hostname with no port.
+//
+// The Kerberos configuration is read from the file named by the KRB5_CONFIG environment variable,
+// or /etc/krb5.conf if it is not set. Credentials are obtained, in order of preference, from the
+// provided password, from the keytab named by the KRB5_CLIENT_KTNAME environment variable, or from
+// the credential cache named by the KRB5CCNAME environment variable (or the default
+// /tmp/krb5cc_<uid> cache).
+func newSaslClient(target, username, password string, passwordSet bool, props map[string]string) (*saslClient, error) {
+	var err error
+	serviceName := "mongodb"
+	serviceRealm := ""
+	canonicalizeHostName := false
+	var serviceHostSet bool
+
+	for key, value := range props {
+		switch strings.ToUpper(key) {
+		case "CANONICALIZE_HOST_NAME":
+			canonicalizeHostName, err = strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a boolean (true, false, 0, 1) but got '%s'", key, value)
+			}
+		case "SERVICE_REALM":
+			serviceRealm = value
+		case "SERVICE_NAME":
+			serviceName = value
+		case "SERVICE_HOST":
+			serviceHostSet = true
+			target = value
+		default:
+			return nil, fmt.Errorf("unknown mechanism property %s", key)
+		}
+	}
+
+	if canonicalizeHostName {
+		// Should not canonicalize the SERVICE_HOST
+		if serviceHostSet {
+			return nil, fmt.Errorf("CANONICALIZE_HOST_NAME and SERVICE_HOST cannot both be specified")
+		}
+
+		names, err := net.LookupAddr(target)
+		if err != nil || len(names) == 0 {
+			return nil, fmt.Errorf("unable to canonicalize hostname: %s", err)
+		}
+		target = strings.TrimSuffix(names[0], ".")
+	}
+
+	return &saslClient{
+		serviceName:  serviceName,
+		serviceHost:  target,
+		serviceRealm: serviceRealm,
+		username:     username,
+		password:     password,
+		passwordSet:  passwordSet,
+	}, nil
+}
+
+// saslClient is a GSSAPI SASL client implemented with github.com/jcmturner/gokrb5. It does not
+// require cgo or system Kerberos libraries.
+//
+// The client does not request mutual authentication, so the security context is established after
+// the initial AP-REQ token is sent. Only RFC 4121 tokens are supported, which requires the service
+// ticket to use an AES or newer encryption type.
+type saslClient struct {
+	serviceName  string
+	serviceHost  string
+	serviceRealm string
+	username     string
+	password     string
+	passwordSet  bool
+
+	// state
+	krbClient  *client.Client
+	sessionKey types.EncryptionKey
+	done       bool
+}
+
+// Close destroys the underlying Kerberos client.
+func (sc *saslClient) Close() {
+	if sc.krbClient != nil {
+		sc.krbClient.Destroy()
+	}
+}
+
+// Start requests a service ticket and returns the initial GSSAPI token containing the AP-REQ.
+func (sc *saslClient) Start() (string, []byte, error) {
+	const mechName = "GSSAPI"
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return mechName, nil, fmt.Errorf("unable to initialize client: %w", err)
+	}
+
+	sc.krbClient, err = sc.newKrbClient(cfg)
+	if err != nil {
+		return mechName, nil, fmt.Errorf("unable to initialize client: %w", err)
+	}
+
+	spn := sc.serviceName + "/" + sc.serviceHost
+	if sc.serviceRealm != "" {
+		spn += "@" + sc.serviceRealm
+	}
+	tkt, sessionKey, err := sc.krbClient.GetServiceTicket(spn)
+	if err != nil {
+		return mechName, nil, fmt.Errorf("unable to acquire service ticket for %s: %w", spn, err)
+	}
+	sc.sessionKey = sessionKey
+
+	token, err := spnego.NewKRB5TokenAPREQ(sc.krbClient, tkt, sessionKey, []int{gokrb5gssapi.ContextFlagInteg}, nil)
+	if err != nil {
+		return mechName, nil, fmt.Errorf("unable to create AP-REQ: %w", err)
+	}
+	payload, err := token.Marshal()
+	if err != nil {
+		return mechName, nil, fmt.Errorf("unable to marshal AP-REQ: %w", err)
+	}
+
+	return mechName, payload, nil
+}
+
+// Next processes the server's challenge. An empty challenge is answered with an empty response;
+// the first non-empty challenge is the server's security layer offer, which is answered with a
+// wrapped message selecting no security layer and carrying the authorization identity.
+func (sc *saslClient) Next(_ context.Context, challenge []byte) ([]byte, error) {
+	if len(challenge) == 0 {
+		return nil, nil
+	}
+
+	var offer gokrb5gssapi.WrapToken
+	if err := offer.Unmarshal(unrotate(challenge), true); err != nil {
+		return nil, fmt.Errorf("unable to unwrap security layer offer: %w", err)
+	}
+	if offer.Flags&0x02 != 0 {
+		return nil, errors.New("unable to unwrap security layer offer: sealed tokens are not supported")
+	}
+	if ok, err := offer.Verify(sc.sessionKey, keyusage.GSSAPI_ACCEPTOR_SEAL); !ok {
+		return nil, fmt.Errorf("unable to verify security layer offer: %w", err)
+	}
+	if len(offer.Payload) != 4 {
+		return nil, fmt.Errorf("unexpected security layer offer length %d", len(offer.Payload))
+	}
+
+	msg := append([]byte{1, 0, 0, 0}, []byte(sc.username)...)
+	reply, err := gokrb5gssapi.NewInitiatorWrapToken(msg, sc.sessionKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wrap authz: %w", err)
+	}
+	sc.done = true
+
+	return reply.Marshal()
+}
+
+// Completed returns true when the conversation is finished.
+func (sc *saslClient) Completed() bool {
+	return sc.done
+}
+
+func (sc *saslClient) newKrbClient(cfg *config.Config) (*client.Client, error) {
+	user, realm := splitPrincipal(sc.username, cfg.LibDefaults.DefaultRealm)
+	settings := client.DisablePAFXFAST(true)
+
+	if sc.username != "" && sc.passwordSet {
+		cl := client.NewWithPassword(user, realm, sc.password, cfg, settings)
+		return cl, cl.Login()
+	}
+
+	if path := os.Getenv("KRB5_CLIENT_KTNAME"); path != "" && sc.username != "" {
+		kt, err := keytab.Load(strings.TrimPrefix(path, "FILE:"))
+		if err != nil {
+			return nil, fmt.Errorf("error loading keytab %q: %w", path, err)
+		}
+		cl := client.NewWithKeytab(user, realm, kt, cfg, settings)
+		return cl, cl.Login()
+	}
+
+	path := os.Getenv("KRB5CCNAME")
+	if path == "" {
+		path = fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid())
+	}
+	ccache, err := credentials.LoadCCache(strings.TrimPrefix(path, "FILE:"))
+	if err != nil {
+		return nil, fmt.Errorf("error loading credential cache %q: %w", path, err)
+	}
+	cl, err := client.NewFromCCache(ccache, cfg, settings)
+	if err != nil {
+		return nil, err
+	}
+	if sc.username == "" {
+		sc.username = ccache.GetClientPrincipalName().PrincipalNameString() + "@" + ccache.GetClientRealm()
+	}
+	return cl, nil
+}
+
+func loadConfig() (*config.Config, error) {
+	path := os.Getenv("KRB5_CONFIG")
+	if path == "" {
+		path = defaultKrb5ConfigPath
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("error loading Kerberos configuration %q: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// splitPrincipal splits a "user@REALM" principal into its user and realm parts, using
+// defaultRealm if the principal has no realm.
+func splitPrincipal(principal, defaultRealm string) (string, string) {
+	if i := strings.LastIndex(principal, "@"); i >= 0 {
+		return principal[:i], principal[i+1:]
+	}
+	return principal, defaultRealm
+}
+
+// unrotate undoes the right rotation applied to the data following an RFC 4121 wrap token
+// header, as described in RFC 4121 section 4.2.5, and returns the token with an RRC of zero.
+func unrotate(token []byte) []byte {
+	if len(token) < wrapTokenHeaderLen {
+		return token
+	}
+	rrc := int(token[6])<<8 | int(token[7])
+	data := token[wrapTokenHeaderLen:]
+	if rrc == 0 || len(data) == 0 {
+		return token
+	}
+	rrc %= len(data)
+
+	out := make([]byte, len(token))
+	copy(out, token[:wrapTokenHeaderLen])
+	out[6], out[7] = 0, 0
+	copy(out[wrapTokenHeaderLen:], data[rrc:])
+	copy(out[wrapTokenHeaderLen+len(data)-rrc:], data[:rrc])
+	return out
+}
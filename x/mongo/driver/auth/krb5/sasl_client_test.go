@@ -0,0 +1,64 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package krb5
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewServicePrincipal(t *testing.T) {
+	sc, err := newSaslClient("db.example.com", "user@EXAMPLE.COM", "", false, map[string]string{
+		"SERVICE_NAME":  "mongo",
+		"SERVICE_REALM": "OTHER.COM",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sc.serviceName != "mongo" || sc.serviceHost != "db.example.com" || sc.serviceRealm != "OTHER.COM" {
+		t.Fatalf("unexpected service principal %s/%s@%s", sc.serviceName, sc.serviceHost, sc.serviceRealm)
+	}
+
+	_, err = newSaslClient("db.example.com", "", "", false, map[string]string{"BOGUS": "x"})
+	if err == nil {
+		t.Fatalf("expected error for unknown mechanism property")
+	}
+}
+
+func TestSplitPrincipal(t *testing.T) {
+	testCases := []struct {
+		principal, user, realm string
+	}{
+		{"user@EXAMPLE.COM", "user", "EXAMPLE.COM"},
+		{"user", "user", "DEFAULT.COM"},
+		{"a@b@EXAMPLE.COM", "a@b", "EXAMPLE.COM"},
+	}
+	for _, tc := range testCases {
+		user, realm := splitPrincipal(tc.principal, "DEFAULT.COM")
+		if user != tc.user || realm != tc.realm {
+			t.Errorf("splitPrincipal(%q) = %q, %q; want %q, %q", tc.principal, user, realm, tc.user, tc.realm)
+		}
+	}
+}
+
+func TestUnrotate(t *testing.T) {
+	header := []byte{0x05, 0x04, 0x01, 0xff, 0x00, 0x02, 0x00, 0x00, 0, 0, 0, 0, 0, 0, 0, 0}
+	data := []byte{1, 2, 3, 4, 5}
+
+	// Rotating right by 2 moves the last two bytes to the front.
+	rotated := append(append([]byte{}, header...), 4, 5, 1, 2, 3)
+	rotated[7] = 2
+
+	got := unrotate(rotated)
+	want := append(append([]byte{}, header...), data...)
+	if !bytes.Equal(got, want) {
+		t.Fatalf("unrotate() = %v, want %v", got, want)
+	}
+	if !bytes.Equal(unrotate(want), want) {
+		t.Fatalf("unrotate() modified a token with an RRC of zero")
+	}
+}
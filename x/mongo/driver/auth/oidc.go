@@ -65,6 +65,14 @@ const (
 	// Contexts with a shorter timeout are unaffected.
 	machineCallbackTimeout = time.Minute
 	humanCallbackTimeout   = 5 * time.Minute
+
+	// maxTokenRefreshWindow is the longest time before a cached access token's
+	// reported expiry that the authenticator stops using it and proactively
+	// requests a new one. Tokens are refreshed when a tenth of their lifetime
+	// remains, up to this window, so that short-lived tokens are used for most
+	// of their lifetime while tokens are not handed out if they would expire
+	// mid-handshake or shortly after a connection is established.
+	maxTokenRefreshWindow = 5 * time.Minute
 )
 
 var defaultAllowedHosts = []*regexp.Regexp{
@@ -108,9 +116,13 @@ type OIDCAuthenticator struct {
 	userName     string
 	httpClient   *http.Client
 	accessToken  string
+	refreshAt    *time.Time
 	refreshToken *string
 	idpInfo      *IDPInfo
 	tokenGenID   uint64
+
+	// now returns the current time. It can be overridden for testing.
+	now func() time.Time
 }
 
 // SetAccessToken allows for manually setting the access token for the OIDCAuthenticator, this is
@@ -119,6 +131,49 @@ func (oa *OIDCAuthenticator) SetAccessToken(accessToken string) {
 	oa.mu.Lock()
 	defer oa.mu.Unlock()
 	oa.accessToken = accessToken
+	oa.refreshAt = nil
+}
+
+// timeNow returns the current time.
+func (oa *OIDCAuthenticator) timeNow() time.Time {
+	if oa.now != nil {
+		return oa.now()
+	}
+	return time.Now()
+}
+
+// refreshTime returns the time at which a token that expires at expiresAt is
+// refreshed, which is a tenth of its remaining lifetime or
+// maxTokenRefreshWindow before expiresAt, whichever is shorter. It returns nil
+// if expiresAt is nil.
+func (oa *OIDCAuthenticator) refreshTime(expiresAt *time.Time) *time.Time {
+	if expiresAt == nil {
+		return nil
+	}
+	window := expiresAt.Sub(oa.timeNow()) / 10
+	if window > maxTokenRefreshWindow {
+		window = maxTokenRefreshWindow
+	}
+	if window < 0 {
+		window = 0
+	}
+	refreshAt := expiresAt.Add(-window)
+	return &refreshAt
+}
+
+// cachedAccessTokenLocked returns the cached access token if it exists and is
+// not due to be refreshed. Otherwise, it clears the cached token and returns an
+// empty string. The caller must hold oa.mu.
+func (oa *OIDCAuthenticator) cachedAccessTokenLocked() string {
+	if oa.accessToken == "" {
+		return ""
+	}
+	if oa.refreshAt != nil && !oa.timeNow().Before(*oa.refreshAt) {
+		oa.accessToken = ""
+		oa.refreshAt = nil
+		return ""
+	}
+	return oa.accessToken
 }
 
 func newOIDCAuthenticator(cred *Cred, httpClient *http.Client) (Authenticator, error) {
@@ -404,8 +459,8 @@ func (oa *OIDCAuthenticator) getAccessToken(
 	oa.mu.Lock()
 	defer oa.mu.Unlock()
 
-	if oa.accessToken != "" {
-		return oa.accessToken, nil
+	if accessToken := oa.cachedAccessTokenLocked(); accessToken != "" {
+		return accessToken, nil
 	}
 
 	// Attempt to refresh the access token if a refresh token is available.
@@ -413,6 +468,7 @@ func (oa *OIDCAuthenticator) getAccessToken(
 		cred, err := callback(ctx, args)
 		if err == nil && cred != nil {
 			oa.accessToken = cred.AccessToken
+			oa.refreshAt = oa.refreshTime(cred.ExpiresAt)
 			oa.tokenGenID++
 			conn.SetOIDCTokenGenID(oa.tokenGenID)
 			oa.refreshToken = cred.RefreshToken
@@ -433,6 +489,7 @@ func (oa *OIDCAuthenticator) getAccessToken(
 	}
 
 	oa.accessToken = cred.AccessToken
+	oa.refreshAt = oa.refreshTime(cred.ExpiresAt)
 	oa.tokenGenID++
 	conn.SetOIDCTokenGenID(oa.tokenGenID)
 	oa.refreshToken = cred.RefreshToken
@@ -457,6 +514,7 @@ func (oa *OIDCAuthenticator) invalidateAccessToken(conn *mnet.Connection) {
 	// invalidate the cached accessToken.
 	if tokenGenID == 0 || tokenGenID >= oa.tokenGenID {
 		oa.accessToken = ""
+		oa.refreshAt = nil
 		conn.SetOIDCTokenGenID(0)
 	}
 }
@@ -478,7 +536,7 @@ func (oa *OIDCAuthenticator) Auth(ctx context.Context, cfg *driver.AuthConfig) e
 	conn := cfg.Connection
 
 	oa.mu.Lock()
-	cachedAccessToken := oa.accessToken
+	cachedAccessToken := oa.cachedAccessTokenLocked()
 	cachedRefreshToken := oa.refreshToken
 	cachedIDPInfo := oa.idpInfo
 	oa.mu.Unlock()
@@ -583,7 +641,7 @@ func (oa *OIDCAuthenticator) doAuthMachine(ctx context.Context, cfg *driver.Auth
 func (oa *OIDCAuthenticator) CreateSpeculativeConversation() (SpeculativeConversation, error) {
 	oa.mu.Lock()
 	defer oa.mu.Unlock()
-	accessToken := oa.accessToken
+	accessToken := oa.cachedAccessTokenLocked()
 	if accessToken == "" {
 		return nil, nil // Skip speculative auth.
 	}
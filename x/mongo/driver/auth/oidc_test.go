@@ -7,10 +7,18 @@
 package auth
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/ptrutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
 )
 
 func TestCreatePatternsForGlobs(t *testing.T) {
@@ -42,3 +50,87 @@ func TestCreatePatternsForGlobs(t *testing.T) {
 		)
 	})
 }
+
+func TestOIDCAccessTokenRefresh(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newConn := func() *mnet.Connection {
+		return mnet.NewConnection(&drivertest.ChannelConn{
+			Desc: description.Server{Addr: address.Address("localhost:27017")},
+		})
+	}
+
+	testCases := []struct {
+		name      string
+		expiresAt *time.Time
+		advance   time.Duration
+		wantCalls int
+	}{
+		{
+			name:      "token without expiry is reused",
+			expiresAt: nil,
+			advance:   time.Hour,
+			wantCalls: 1,
+		},
+		{
+			name:      "token far from expiry is reused",
+			expiresAt: ptrutil.Ptr(now.Add(time.Hour)),
+			advance:   time.Minute,
+			wantCalls: 1,
+		},
+		{
+			name:      "token within refresh window is replaced",
+			expiresAt: ptrutil.Ptr(now.Add(time.Hour)),
+			advance:   time.Hour - maxTokenRefreshWindow,
+			wantCalls: 2,
+		},
+		{
+			name:      "short-lived token is reused for most of its lifetime",
+			expiresAt: ptrutil.Ptr(now.Add(10 * time.Minute)),
+			advance:   8 * time.Minute,
+			wantCalls: 1,
+		},
+		{
+			name:      "short-lived token is replaced in the last tenth of its lifetime",
+			expiresAt: ptrutil.Ptr(now.Add(10 * time.Minute)),
+			advance:   9 * time.Minute,
+			wantCalls: 2,
+		},
+		{
+			name:      "expired token is replaced",
+			expiresAt: ptrutil.Ptr(now.Add(-time.Minute)),
+			advance:   0,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			clock := now
+			calls := 0
+			oa := &OIDCAuthenticator{
+				now: func() time.Time { return clock },
+			}
+			cb := func(context.Context, *OIDCArgs) (*OIDCCredential, error) {
+				calls++
+				return &OIDCCredential{
+					AccessToken: fmt.Sprintf("token%d", calls),
+					ExpiresAt:   tc.expiresAt,
+				}, nil
+			}
+
+			tok, err := oa.getAccessToken(context.Background(), newConn(), &OIDCArgs{Version: apiVersion}, cb)
+			assert.NoError(t, err)
+			assert.Equal(t, "token1", tok)
+
+			clock = clock.Add(tc.advance)
+
+			tok, err = oa.getAccessToken(context.Background(), newConn(), &OIDCArgs{Version: apiVersion}, cb)
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("token%d", tc.wantCalls), tok)
+			assert.Equal(t, tc.wantCalls, calls)
+		})
+	}
+}
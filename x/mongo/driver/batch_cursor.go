@@ -56,6 +56,18 @@ type BatchCursor struct {
 	// is set, it will be used as the "maxTimeMS" field on getMore commands.
 	maxAwaitTime *time.Duration
 
+	// timeout is applied to each getMore, and deadline to all getMores,
+	// according to the cursor's timeout mode.
+	timeout  *time.Duration
+	deadline time.Time
+
+	// exhaust is true if getMore commands allow the server to stream batches
+	// over a connection pinned to the cursor.
+	exhaust bool
+
+	// interceptors are applied to the getMore and killCursors commands.
+	interceptors []CommandInterceptor
+
 	// legacy server (< 3.2) fields
 	limit       int32
 	numReturned int32 // number of docs returned by server
@@ -174,6 +186,22 @@ type CursorOptions struct {
 	// MaxAwaitTime is only valid for tailable awaitData cursors. If this option
 	// is set, it will be used as the "maxTimeMS" field on getMore commands.
 	MaxAwaitTime *time.Duration
+
+	// Timeout, if set, is applied to each getMore command that does not already
+	// have a deadline from its context.
+	Timeout *time.Duration
+
+	// Deadline, if non-zero, bounds every getMore command run by the cursor.
+	Deadline time.Time
+
+	// Exhaust, if true, pins the cursor to a connection on the first getMore
+	// and allows the server to stream the remaining batches over it without a
+	// getMore round trip for each batch.
+	Exhaust bool
+
+	// CommandInterceptors are applied to the getMore and killCursors commands
+	// run by the cursor.
+	CommandInterceptors []CommandInterceptor
 }
 
 // SetMaxAwaitTime will set the maxTimeMS value on getMore commands for
@@ -210,6 +238,10 @@ func NewBatchCursor(
 		serverAPI:            opts.ServerAPI,
 		serverDescription:    cr.Desc,
 		encoderFn:            opts.MarshalValueEncoderFn,
+		timeout:              opts.Timeout,
+		deadline:             opts.Deadline,
+		exhaust:              opts.Exhaust,
+		interceptors:         opts.CommandInterceptors,
 	}
 
 	if firstBatch != nil {
@@ -218,6 +250,10 @@ func NewBatchCursor(
 
 	bc.currentBatch = firstBatch
 
+	if cd, ok := cr.Server.(ConnectionDeployment); ok {
+		cd.trackCursor(bc)
+	}
+
 	return bc, nil
 }
 
@@ -282,19 +318,87 @@ func (bc *BatchCursor) Close(ctx context.Context) error {
 		ctx = context.Background()
 	}
 
+	// A connection that the server is streaming batches on cannot be used to
+	// kill the cursor, so it is closed instead, which also kills the cursor on
+	// the server.
+	var connErr error
+	if bc.streaming() {
+		connErr = bc.expireConnection()
+		bc.id = 0
+	}
+
 	err := bc.KillCursor(ctx)
 	bc.id = 0
 
 	bc.currentBatch.List = nil
 	bc.currentBatch.Reset()
 
-	connErr := bc.unpinConnection()
+	if unpinErr := bc.unpinConnection(); connErr == nil {
+		connErr = unpinErr
+	}
 	if err == nil {
 		err = connErr
 	}
 	return err
 }
 
+// streaming reports whether the server is streaming batches on the pinned
+// connection, in which case the next batch must be read without sending a
+// getMore.
+func (bc *BatchCursor) streaming() bool {
+	return bc.connection != nil && bc.connection.Streamer != nil && bc.connection.CurrentlyStreaming()
+}
+
+// pinExhaustConnection checks out a connection to the cursor's server and pins
+// the cursor to it, so that the server can stream batches over it. If the
+// cursor is already pinned, such as in load balanced mode, that connection is
+// used. If the connection cannot stream, exhaust is disabled and getMore
+// commands are run normally.
+func (bc *BatchCursor) pinExhaustConnection(ctx context.Context) error {
+	if bc.connection != nil {
+		if bc.connection.Streamer == nil {
+			bc.exhaust = false
+		}
+		return nil
+	}
+
+	ep, ok := bc.server.(ErrorProcessor)
+	if !ok {
+		bc.exhaust = false
+		return nil
+	}
+	conn, err := bc.server.Connection(ctx)
+	if err != nil {
+		return err
+	}
+	if conn.Streamer == nil || conn.Pinner == nil {
+		bc.exhaust = false
+		return conn.Close()
+	}
+	if err := conn.PinToCursor(); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("error pinning connection to exhaust cursor: %w", err)
+	}
+	bc.connection = conn
+	bc.errorProcessor = ep
+	return nil
+}
+
+// expireConnection closes the connection pinned to the cursor instead of
+// returning it to the pool.
+func (bc *BatchCursor) expireConnection() error {
+	conn := bc.connection
+	bc.connection = nil
+
+	if exp, ok := conn.ReadWriteCloser.(Expirable); ok {
+		return exp.Expire()
+	}
+	if conn.Pinner != nil {
+		_ = conn.UnpinFromCursor()
+	}
+	return conn.Close()
+}
+
 func (bc *BatchCursor) unpinConnection() error {
 	if bc.connection == nil || bc.connection.Pinner == nil {
 		return nil
@@ -309,11 +413,39 @@ func (bc *BatchCursor) unpinConnection() error {
 	return err
 }
 
+// invalidate ends the cursor without killing its server cursor, so that
+// subsequent calls to Next return false and Err returns err.
+func (bc *BatchCursor) invalidate(err error) {
+	if bc.id == 0 {
+		return
+	}
+	bc.id = 0
+	bc.err = err
+	_ = bc.unpinConnection()
+}
+
 // Server returns the server for this cursor.
 func (bc *BatchCursor) Server() Server {
 	return bc.server
 }
 
+// ServerDescription returns the description of the server the cursor was
+// established on. All getMore and killCursors commands for the cursor are sent
+// to that server.
+func (bc *BatchCursor) ServerDescription() description.Server {
+	return bc.serverDescription
+}
+
+// PinnedConnection returns the connection the cursor is pinned to, or nil if
+// the cursor is not pinned. Cursors are only pinned to a connection when the
+// deployment is behind a load balancer.
+func (bc *BatchCursor) PinnedConnection() mnet.Describer {
+	if bc.connection == nil {
+		return nil
+	}
+	return bc.connection
+}
+
 func (bc *BatchCursor) clearBatch() {
 	bc.currentBatch.List = bc.currentBatch.List[:0]
 }
@@ -338,6 +470,8 @@ func (bc *BatchCursor) KillCursor(ctx context.Context) error {
 		CommandMonitor: bc.cmdMonitor,
 		ServerAPI:      bc.serverAPI,
 
+		CommandInterceptors: bc.interceptors,
+
 		// No read preference is passed to the killCursor command,
 		// resulting in the default read preference: "primaryPreferred".
 		// Since this could be confusing, and there is no requirement
@@ -379,7 +513,20 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 		return
 	}
 
-	bc.err = Operation{
+	if !bc.deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, bc.deadline)
+		defer cancel()
+	}
+
+	if bc.exhaust {
+		if err := bc.pinExhaustConnection(ctx); err != nil {
+			bc.err = err
+			return
+		}
+	}
+
+	op := Operation{
 		CommandFn: func(dst []byte, _ description.SelectedServer) ([]byte, error) {
 			// If maxAwaitTime > remaining timeoutMS - minRoundTripTime, then use
 			// send remaining TimeoutMS - minRoundTripTime allowing the server an
@@ -471,6 +618,9 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 		CommandMonitor: bc.cmdMonitor,
 		Crypt:          bc.crypt,
 		ServerAPI:      bc.serverAPI,
+		Timeout:        bc.timeout,
+
+		CommandInterceptors: bc.interceptors,
 
 		// Omit the automatically-calculated maxTimeMS because setting maxTimeMS
 		// on a non-awaitData cursor causes a server error. For awaitData
@@ -483,7 +633,22 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 		// Since this could be confusing, and there is no requirement
 		// to use a read preference here, we omit it.
 		omitReadPreference: true,
-	}.Execute(ctx)
+
+		ExhaustAllowed: bc.exhaust,
+	}
+	if bc.streaming() {
+		bc.err = op.ExecuteExhaust(ctx, bc.connection)
+	} else {
+		bc.err = op.Execute(ctx)
+	}
+
+	// If reading a streamed batch fails, the rest of the stream cannot be
+	// read, so the connection is closed, which also ends the server-side
+	// cursor.
+	if bc.err != nil && bc.streaming() {
+		_ = bc.expireConnection()
+		bc.id = 0
+	}
 
 	// Once the cursor has been drained, we can unpin the connection if one is currently pinned.
 	if bc.id == 0 {
@@ -537,9 +702,14 @@ func (bc *BatchCursor) SetComment(comment any) {
 
 func (bc *BatchCursor) getOperationDeployment() Deployment {
 	if bc.connection != nil {
+		kind := description.TopologyKindSingle
+		if driverutil.IsServerLoadBalanced(bc.serverDescription) {
+			kind = description.TopologyKindLoadBalanced
+		}
 		return &loadBalancedCursorDeployment{
 			errorProcessor: bc.errorProcessor,
 			conn:           bc.connection,
+			kind:           kind,
 		}
 	}
 	return SingleServerDeployment{bc.server}
@@ -552,11 +722,12 @@ func (bc *BatchCursor) MaxAwaitTime() *time.Duration {
 }
 
 // loadBalancedCursorDeployment is used as a Deployment for getMore and killCursors commands when pinning to a
-// connection in load balanced mode. This type also functions as an ErrorProcessor to ensure that SDAM errors are
-// handled for these commands in this mode.
+// connection in load balanced mode or for an exhaust cursor. This type also functions as an ErrorProcessor to ensure
+// that SDAM errors are handled for these commands in this mode.
 type loadBalancedCursorDeployment struct {
 	errorProcessor ErrorProcessor
 	conn           *mnet.Connection
+	kind           description.TopologyKind
 }
 
 var _ Deployment = (*loadBalancedCursorDeployment)(nil)
@@ -568,7 +739,7 @@ func (lbcd *loadBalancedCursorDeployment) SelectServer(context.Context, descript
 }
 
 func (lbcd *loadBalancedCursorDeployment) Kind() description.TopologyKind {
-	return description.TopologyKindLoadBalanced
+	return lbcd.kind
 }
 
 func (lbcd *loadBalancedCursorDeployment) Connection(context.Context) (*mnet.Connection, error) {
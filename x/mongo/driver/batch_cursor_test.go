@@ -7,9 +7,16 @@
 package driver
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/csot"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
 )
 
 func TestBatchCursor(t *testing.T) {
@@ -90,3 +97,131 @@ func TestBatchCursor(t *testing.T) {
 		}
 	})
 }
+
+func TestBatchCursorExhaust(t *testing.T) {
+	t.Parallel()
+
+	getMoreReply := func(id int64, x int32, moreToCome bool) []byte {
+		doc := bsoncore.NewDocumentBuilder().
+			AppendInt32("ok", 1).
+			AppendDocument("cursor", bsoncore.NewDocumentBuilder().
+				AppendInt64("id", id).
+				AppendString("ns", "db.coll").
+				AppendArray("nextBatch", bsoncore.NewArrayBuilder().
+					AppendDocument(bsoncore.NewDocumentBuilder().AppendInt32("x", x).Build()).
+					Build()).
+				Build()).
+			Build()
+		return createExhaustServerResponse(doc, moreToCome)
+	}
+
+	newCursor := func(t *testing.T, conn *exhaustConnection) *BatchCursor {
+		t.Helper()
+
+		cr := CursorResponse{
+			Server:     exhaustServer{mockServer{conn: mnet.NewConnection(conn), rttMonitor: &csot.ZeroRTTMonitor{}}},
+			Desc:       conn.rDesc,
+			Database:   "db",
+			Collection: "coll",
+			ID:         1,
+			FirstBatch: &bsoncore.Iterator{},
+		}
+		bc, err := NewBatchCursor(cr, nil, nil, CursorOptions{Exhaust: true})
+		require.NoError(t, err, "NewBatchCursor error")
+
+		// Consume the empty first batch.
+		assert.False(t, bc.Next(context.Background()), "expected first batch to be empty")
+		return bc
+	}
+
+	t.Run("streams batches on a pinned connection", func(t *testing.T) {
+		t.Parallel()
+
+		conn := newExhaustConnection(
+			getMoreReply(1, 1, true),
+			getMoreReply(1, 2, true),
+			getMoreReply(0, 3, false),
+		)
+		bc := newCursor(t, conn)
+
+		for want := int32(1); want <= 3; want++ {
+			require.True(t, bc.Next(context.Background()), "Next error: %v", bc.Err())
+			if want < 3 {
+				assert.True(t, conn.pinned, "expected connection to be pinned while streaming")
+			}
+
+			doc, err := bc.Batch().Next()
+			require.NoError(t, err, "error reading batch")
+			assert.Equal(t, want, doc.Document().Lookup("x").Int32())
+		}
+
+		assert.Equal(t, 1, conn.writes, "expected a single getMore to be sent")
+		assertExhaustAllowedSet(t, conn.pWriteWM, true)
+		assert.False(t, conn.pinned, "expected connection to be unpinned after the cursor is exhausted")
+		assert.False(t, conn.expired, "expected connection to be returned to the pool")
+		assert.Equal(t, int64(0), bc.ID())
+	})
+
+	t.Run("Close expires a streaming connection", func(t *testing.T) {
+		t.Parallel()
+
+		conn := newExhaustConnection(getMoreReply(1, 1, true))
+		bc := newCursor(t, conn)
+
+		require.True(t, bc.Next(context.Background()), "Next error: %v", bc.Err())
+		require.NoError(t, bc.Close(context.Background()), "Close error")
+
+		assert.Equal(t, 1, conn.writes, "expected no killCursors to be sent on the streaming connection")
+		assert.True(t, conn.expired, "expected streaming connection to be expired")
+	})
+}
+
+// exhaustServer is a Server that can be used to establish an exhaust cursor.
+type exhaustServer struct {
+	mockServer
+}
+
+func (exhaustServer) ProcessError(error, mnet.Describer) ProcessErrorResult { return NoChange }
+
+// exhaustConnection is a pinnable connection that returns the given responses
+// in order, whether or not a request was written.
+type exhaustConnection struct {
+	*mockConnection
+
+	responses [][]byte
+	writes    int
+	pinned    bool
+	expired   bool
+}
+
+func newExhaustConnection(responses ...[]byte) *exhaustConnection {
+	return &exhaustConnection{
+		mockConnection: &mockConnection{
+			rDesc: description.Server{
+				WireVersion: &description.VersionRange{Max: 21},
+			},
+		},
+		responses: responses,
+	}
+}
+
+func (c *exhaustConnection) Write(ctx context.Context, wm []byte) error {
+	c.writes++
+	return c.mockConnection.Write(ctx, wm)
+}
+
+func (c *exhaustConnection) Read(context.Context) ([]byte, error) {
+	if len(c.responses) == 0 {
+		return nil, errors.New("no more responses")
+	}
+	wm := c.responses[0]
+	c.responses = c.responses[1:]
+	return wm, nil
+}
+
+func (c *exhaustConnection) PinToCursor() error          { c.pinned = true; return nil }
+func (c *exhaustConnection) PinToTransaction() error     { return nil }
+func (c *exhaustConnection) UnpinFromCursor() error      { c.pinned = false; return nil }
+func (c *exhaustConnection) UnpinFromTransaction() error { return nil }
+func (c *exhaustConnection) Expire() error               { c.expired = true; return nil }
+func (c *exhaustConnection) Alive() bool                 { return !c.expired }
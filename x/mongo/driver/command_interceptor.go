@@ -0,0 +1,72 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package driver
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// CommandInterceptor observes and modifies the commands sent by operations and
+// the replies to them.
+type CommandInterceptor struct {
+	// Command is called with each command document before it is sent, after
+	// the driver has added fields such as lsid and $db. It returns the document
+	// to send, or nil to send cmd unchanged. If Command returns an error, the
+	// command is not sent and the operation fails with the error.
+	Command func(ctx context.Context, database string, cmd bsoncore.Document) (bsoncore.Document, error)
+
+	// Reply is called with the reply to each command and the error of the
+	// command, if any, before the reply is processed by the operation. The
+	// reply is nil if no reply was received. If Reply returns an error, the
+	// operation fails with it instead.
+	Reply func(ctx context.Context, name, database string, reply bsoncore.Document, err error) error
+}
+
+// interceptCommand passes cmd through the Command functions of interceptors
+// and returns the document to send.
+func interceptCommand(
+	ctx context.Context,
+	interceptors []CommandInterceptor,
+	database string,
+	cmd bsoncore.Document,
+) (bsoncore.Document, error) {
+	for _, interceptor := range interceptors {
+		if interceptor.Command == nil {
+			continue
+		}
+		res, err := interceptor.Command(ctx, database, cmd)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			cmd = res
+		}
+	}
+	return cmd, nil
+}
+
+// interceptReply passes reply and err through the Reply functions of
+// interceptors and returns the error the operation fails with.
+func interceptReply(
+	ctx context.Context,
+	interceptors []CommandInterceptor,
+	name, database string,
+	reply bsoncore.Document,
+	err error,
+) error {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		if interceptors[i].Reply == nil {
+			continue
+		}
+		if replyErr := interceptors[i].Reply(ctx, name, database, reply, err); replyErr != nil {
+			err = replyErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,173 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/csot"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/wiremessage"
+)
+
+// sentCommand returns the command document of the OP_MSG wire message wm.
+func sentCommand(t *testing.T, wm []byte) bsoncore.Document {
+	t.Helper()
+
+	_, _, _, _, wm, ok := wiremessage.ReadHeader(wm)
+	require.True(t, ok, "could not read header")
+	_, wm, ok = wiremessage.ReadMsgFlags(wm)
+	require.True(t, ok, "could not read flags")
+	_, wm, ok = wiremessage.ReadMsgSectionType(wm)
+	require.True(t, ok, "could not read section type")
+	doc, _, ok := wiremessage.ReadMsgSectionSingleDocument(wm)
+	require.True(t, ok, "could not read command document")
+	return doc
+}
+
+func TestCommandInterceptors(t *testing.T) {
+	reply := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "ok", 1))
+
+	newOperation := func() (Operation, *mockConnection) {
+		conn := &mockConnection{
+			rDesc: description.Server{
+				WireVersion: &description.VersionRange{Max: 6},
+			},
+			rReadWM: createExhaustServerResponse(reply, false),
+		}
+		op := Operation{
+			CommandFn: func(dst []byte, _ description.SelectedServer) ([]byte, error) {
+				return bsoncore.AppendInt32Element(dst, "ping", 1), nil
+			},
+			Database:   "admin",
+			Deployment: SingleConnectionDeployment{C: mnet.NewConnection(conn)},
+		}
+		return op, conn
+	}
+
+	t.Run("commands are modified in order", func(t *testing.T) {
+		var calls []string
+		tag := func(name string) CommandInterceptor {
+			return CommandInterceptor{
+				Command: func(_ context.Context, database string, cmd bsoncore.Document) (bsoncore.Document, error) {
+					calls = append(calls, name)
+					assert.Equal(t, "admin", database)
+
+					idx, doc := bsoncore.AppendDocumentStart(nil)
+					elems, err := cmd.Elements()
+					require.NoError(t, err)
+					for _, elem := range elems {
+						doc = append(doc, elem...)
+					}
+					doc = bsoncore.AppendStringElement(doc, name, "set")
+					return bsoncore.AppendDocumentEnd(doc, idx)
+				},
+			}
+		}
+		observer := CommandInterceptor{
+			Command: func(_ context.Context, _ string, cmd bsoncore.Document) (bsoncore.Document, error) {
+				calls = append(calls, "observer")
+				return nil, nil
+			},
+		}
+
+		op, conn := newOperation()
+		op.CommandInterceptors = []CommandInterceptor{tag("a"), observer, tag("b")}
+		err := op.Execute(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "observer", "b"}, calls)
+		cmd := sentCommand(t, conn.pWriteWM)
+		assert.Equal(t, "ping", cmd.Index(0).Key())
+		assert.Equal(t, "admin", cmd.Lookup("$db").StringValue())
+		assert.Equal(t, "set", cmd.Lookup("a").StringValue())
+		assert.Equal(t, "set", cmd.Lookup("b").StringValue())
+	})
+	t.Run("command error prevents sending", func(t *testing.T) {
+		denied := errors.New("denied")
+		interceptor := CommandInterceptor{
+			Command: func(context.Context, string, bsoncore.Document) (bsoncore.Document, error) {
+				return nil, denied
+			},
+		}
+
+		op, conn := newOperation()
+		op.CommandInterceptors = []CommandInterceptor{interceptor}
+		err := op.Execute(context.Background())
+		assert.ErrorIs(t, err, denied)
+		assert.Nil(t, conn.pWriteWM, "expected no command to be sent")
+	})
+	t.Run("replies are inspected in reverse order", func(t *testing.T) {
+		var calls []string
+		inspect := func(name string, ret error) CommandInterceptor {
+			return CommandInterceptor{
+				Reply: func(_ context.Context, cmdName, database string, res bsoncore.Document, err error) error {
+					calls = append(calls, name)
+					assert.Equal(t, "ping", cmdName)
+					assert.Equal(t, "admin", database)
+					assert.Equal(t, reply, []byte(res))
+					return ret
+				},
+			}
+		}
+
+		rejected := errors.New("rejected")
+		op, _ := newOperation()
+		op.CommandInterceptors = []CommandInterceptor{
+			inspect("a", nil),
+			inspect("b", rejected),
+		}
+		err := op.Execute(context.Background())
+		assert.ErrorIs(t, err, rejected)
+		assert.Equal(t, []string{"b", "a"}, calls)
+	})
+	t.Run("cursor commands are intercepted", func(t *testing.T) {
+		getMoreReply := bsoncore.NewDocumentBuilder().
+			AppendInt32("ok", 1).
+			AppendDocument("cursor", bsoncore.NewDocumentBuilder().
+				AppendInt64("id", 1).
+				AppendString("ns", "db.coll").
+				AppendArray("nextBatch", bsoncore.NewArrayBuilder().Build()).
+				Build()).
+			Build()
+		conn := newExhaustConnection(
+			createExhaustServerResponse(getMoreReply, false),
+			createExhaustServerResponse(reply, false),
+		)
+
+		var commands []string
+		interceptor := CommandInterceptor{
+			Command: func(_ context.Context, _ string, cmd bsoncore.Document) (bsoncore.Document, error) {
+				commands = append(commands, cmd.Index(0).Key())
+				return nil, nil
+			},
+		}
+		cr := CursorResponse{
+			Server:     exhaustServer{mockServer{conn: mnet.NewConnection(conn), rttMonitor: &csot.ZeroRTTMonitor{}}},
+			Desc:       conn.rDesc,
+			Database:   "db",
+			Collection: "coll",
+			ID:         1,
+			FirstBatch: &bsoncore.Iterator{},
+		}
+		bc, err := NewBatchCursor(cr, nil, nil, CursorOptions{CommandInterceptors: []CommandInterceptor{interceptor}})
+		require.NoError(t, err, "NewBatchCursor error")
+
+		assert.False(t, bc.Next(context.Background()), "expected first batch to be empty")
+		assert.False(t, bc.Next(context.Background()), "expected getMore batch to be empty")
+		require.NoError(t, bc.Err(), "getMore error")
+		require.NoError(t, bc.Close(context.Background()), "Close error")
+
+		assert.Equal(t, []string{"getMore", "killCursors"}, commands)
+	})
+}
@@ -73,6 +73,32 @@ type VersionRange struct {
 	Max int32
 }
 
+// RTTStats contains statistics about the round-trip times measured by the
+// monitor of a server, in addition to the moving average in AverageRTT.
+type RTTStats struct {
+	// Samples are the most recent round-trip times, oldest first.
+	Samples []time.Duration
+
+	// P90 is the 90th percentile of Samples.
+	P90 time.Duration
+
+	// Histogram counts all round-trip times measured since the server was
+	// last marked unknown.
+	Histogram []RTTBucket
+}
+
+// RTTBucket is a bucket of an RTT histogram.
+type RTTBucket struct {
+	// UpperBound is the inclusive upper bound of the round-trip times counted
+	// in the bucket. The lower bound is the UpperBound of the previous bucket.
+	// The last bucket has no upper bound and its UpperBound is the maximum
+	// time.Duration.
+	UpperBound time.Duration
+
+	// Count is the number of round-trip times in the bucket.
+	Count int64
+}
+
 // Server contains information about a node in a cluster. This is created from
 // hello command responses. If the value of the Kind field is LoadBalancer, only
 // the Addr and Kind fields will be set. All other fields will be set to the
@@ -101,6 +127,7 @@ type Server struct {
 	Passive               bool
 	Primary               address.Address
 	ReadOnly              bool
+	RTTStats              RTTStats
 	ServiceID             *bson.ObjectID // Only set for servers that are deployed behind a load balancer.
 	SessionTimeoutMinutes *int64
 	SetName               string
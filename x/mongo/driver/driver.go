@@ -15,6 +15,8 @@ package driver
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/internal/csot"
@@ -174,6 +176,13 @@ type ErrorProcessor interface {
 	ProcessError(err error, desc mnet.Describer) ProcessErrorResult
 }
 
+// OperationObserver implementations can observe the outcome of the commands run on them. If this
+// type is implemented by a Server, then Operation.Execute will call its ObserveOperation method
+// after each round trip with the command name, the error, if any, and the round trip duration.
+type OperationObserver interface {
+	ObserveOperation(cmdName string, err error, duration time.Duration)
+}
+
 // HandshakeInformation contains information extracted from a MongoDB connection handshake. This is a helper type that
 // augments description.Server by also tracking server connection ID and authentication-related fields. We use this type
 // rather than adding authentication-related fields to description.Server to avoid retaining sensitive information in a
@@ -250,6 +259,279 @@ func (scd SingleConnectionDeployment) RTTMonitor() RTTMonitor {
 	return &csot.ZeroRTTMonitor{}
 }
 
+// ErrConnectionInUse is returned by ConnectionDeployment.Connection if another operation is using the connection.
+var ErrConnectionInUse = errors.New("the connection is in use by another operation")
+
+// ErrConnectionDeploymentClosed is returned by the operations and cursors run on a ConnectionDeployment after it has
+// been closed.
+var ErrConnectionDeploymentClosed = errors.New("the connection deployment is closed")
+
+// ConnectionDeployment is an implementation of Deployment that runs every operation on the same Connection, which
+// must have been checked out from Server. Unlike SingleConnectionDeployment, it processes errors with Server if Server
+// is an ErrorProcessor, so it can be used for application operations.
+//
+// A ConnectionDeployment created with NewConnectionDeployment lets one operation use the connection at a time and
+// tracks the cursors created on it, which Close kills and invalidates. The Connections returned from the Connection
+// method are not closed by operations, so the caller must call Close when it is done.
+type ConnectionDeployment struct {
+	Server       Server
+	Conn         *mnet.Connection
+	TopologyKind description.TopologyKind
+
+	lease *connectionLease
+}
+
+var _ Deployment = ConnectionDeployment{}
+var _ Server = ConnectionDeployment{}
+var _ ErrorProcessor = ConnectionDeployment{}
+
+// NewConnectionDeployment creates a ConnectionDeployment that runs every operation on conn, which must have been
+// checked out from server.
+func NewConnectionDeployment(server Server, conn *mnet.Connection, kind description.TopologyKind) ConnectionDeployment {
+	return ConnectionDeployment{
+		Server:       server,
+		Conn:         conn,
+		TopologyKind: kind,
+		lease:        &connectionLease{},
+	}
+}
+
+// SelectServer implements the Deployment interface. This method does not use the
+// description.SelectedServer provided and instead returns itself.
+func (cd ConnectionDeployment) SelectServer(context.Context, description.ServerSelector) (Server, error) {
+	return cd, nil
+}
+
+// GetServerSelectionTimeout returns zero as a server selection timeout is not
+// applicable for connection deployments.
+func (ConnectionDeployment) GetServerSelectionTimeout() time.Duration {
+	return 0
+}
+
+// Kind implements the Deployment interface. It returns the kind of the topology that Server belongs to.
+func (cd ConnectionDeployment) Kind() description.TopologyKind {
+	return cd.TopologyKind
+}
+
+// Connection implements the Server interface. It returns the embedded connection, wrapped so that closing it does
+// not return it to its pool. If the deployment was created with NewConnectionDeployment, it returns
+// ErrConnectionInUse if the connection has been returned to another operation that has not closed it yet, and
+// ErrConnectionDeploymentClosed if the deployment is closed.
+func (cd ConnectionDeployment) Connection(context.Context) (*mnet.Connection, error) {
+	if cd.lease == nil {
+		conn := *cd.Conn
+		conn.ReadWriteCloser = nonClosingConnection{cd.Conn.ReadWriteCloser}
+		return &conn, nil
+	}
+	if err := cd.lease.acquire(); err != nil {
+		return nil, err
+	}
+
+	lc := &leasedConnection{conn: cd.Conn, lease: cd.lease}
+	conn := &mnet.Connection{
+		ReadWriteCloser: lc,
+		Describer:       cd.Conn.Describer,
+		Pinner:          lc,
+	}
+	if cd.Conn.Compressor != nil {
+		conn.Compressor = lc
+	}
+	// The connection is not streamable: a streaming response would leave it
+	// unusable by the other operations of the deployment.
+	return conn, nil
+}
+
+// RTTMonitor implements the Server interface.
+func (cd ConnectionDeployment) RTTMonitor() RTTMonitor {
+	return cd.Server.RTTMonitor()
+}
+
+// ProcessError implements the ErrorProcessor interface.
+func (cd ConnectionDeployment) ProcessError(err error, desc mnet.Describer) ProcessErrorResult {
+	if ep, ok := cd.Server.(ErrorProcessor); ok {
+		return ep.ProcessError(err, desc)
+	}
+	return NoChange
+}
+
+// Close kills the server cursors of the open cursors created on the deployment and invalidates them, so that
+// iterating them further fails with ErrConnectionDeploymentClosed, and then closes Conn. Operations run on the
+// deployment after it is closed fail with ErrConnectionDeploymentClosed.
+func (cd ConnectionDeployment) Close(ctx context.Context) error {
+	if cd.lease != nil {
+		cd.lease.mu.Lock()
+		cursors := cd.lease.cursors
+		cd.lease.cursors = nil
+		cd.lease.mu.Unlock()
+
+		for _, bc := range cursors {
+			// The server cursor is left to time out if it cannot be killed.
+			_ = bc.KillCursor(ctx)
+			bc.invalidate(ErrConnectionDeploymentClosed)
+		}
+
+		cd.lease.mu.Lock()
+		cd.lease.closed = true
+		cd.lease.mu.Unlock()
+	}
+	return cd.Conn.Close()
+}
+
+// trackCursor records that bc was created on the deployment so that Close can
+// invalidate it.
+func (cd ConnectionDeployment) trackCursor(bc *BatchCursor) {
+	if cd.lease == nil || bc.id == 0 {
+		return
+	}
+
+	cd.lease.mu.Lock()
+	defer cd.lease.mu.Unlock()
+
+	cd.lease.cursors = append(cd.lease.cursors, bc)
+}
+
+// connectionLease guards the connection of a ConnectionDeployment so that it is
+// used by one operation at a time.
+type connectionLease struct {
+	mu      sync.Mutex
+	inUse   bool
+	closed  bool
+	cursors []*BatchCursor
+}
+
+func (l *connectionLease) acquire() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return ErrConnectionDeploymentClosed
+	}
+	if l.inUse {
+		return ErrConnectionInUse
+	}
+	l.inUse = true
+	return nil
+}
+
+func (l *connectionLease) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inUse = false
+}
+
+func (l *connectionLease) isClosed() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.closed
+}
+
+// leasedConnection is a connection returned by ConnectionDeployment.Connection.
+// Closing it releases the lease of the deployment instead of returning the
+// connection to its pool, unless a cursor or transaction is pinned to it, in
+// which case the lease is released when the last one is unpinned. Pinning does
+// not change the reference count of the underlying connection, whose lifetime
+// is owned by the deployment.
+type leasedConnection struct {
+	conn  *mnet.Connection
+	lease *connectionLease
+
+	mu       sync.Mutex
+	pins     int
+	released bool
+}
+
+var _ mnet.ReadWriteCloser = (*leasedConnection)(nil)
+var _ mnet.Compressor = (*leasedConnection)(nil)
+var _ mnet.Pinner = (*leasedConnection)(nil)
+
+// Read implements the mnet.ReadWriteCloser interface.
+func (lc *leasedConnection) Read(ctx context.Context) ([]byte, error) {
+	if lc.lease.isClosed() {
+		return nil, ErrConnectionDeploymentClosed
+	}
+	return lc.conn.Read(ctx)
+}
+
+// Write implements the mnet.ReadWriteCloser interface.
+func (lc *leasedConnection) Write(ctx context.Context, wm []byte) error {
+	if lc.lease.isClosed() {
+		return ErrConnectionDeploymentClosed
+	}
+	return lc.conn.Write(ctx, wm)
+}
+
+// Close implements the mnet.ReadWriteCloser interface.
+func (lc *leasedConnection) Close() error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if lc.pins > 0 || lc.released {
+		return nil
+	}
+	lc.released = true
+	lc.lease.release()
+	return nil
+}
+
+// CompressWireMessage implements the mnet.Compressor interface.
+func (lc *leasedConnection) CompressWireMessage(src, dst []byte) ([]byte, error) {
+	return lc.conn.CompressWireMessage(src, dst)
+}
+
+// PinToCursor implements the mnet.Pinner interface.
+func (lc *leasedConnection) PinToCursor() error {
+	return lc.pin()
+}
+
+// PinToTransaction implements the mnet.Pinner interface.
+func (lc *leasedConnection) PinToTransaction() error {
+	return lc.pin()
+}
+
+// UnpinFromCursor implements the mnet.Pinner interface.
+func (lc *leasedConnection) UnpinFromCursor() error {
+	return lc.unpin()
+}
+
+// UnpinFromTransaction implements the mnet.Pinner interface.
+func (lc *leasedConnection) UnpinFromTransaction() error {
+	return lc.unpin()
+}
+
+func (lc *leasedConnection) pin() error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if lc.released {
+		return errors.New("cannot pin a closed connection")
+	}
+	lc.pins++
+	return nil
+}
+
+func (lc *leasedConnection) unpin() error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if lc.pins == 0 {
+		return errors.New("no pinned cursors or transactions to unpin from")
+	}
+	lc.pins--
+	return nil
+}
+
+// nonClosingConnection is a connection whose Close method does nothing.
+type nonClosingConnection struct {
+	mnet.ReadWriteCloser
+}
+
+// Close implements the mnet.ReadWriteCloser interface. It does nothing.
+func (nonClosingConnection) Close() error {
+	return nil
+}
+
 // TODO(GODRIVER-617): We can likely use 1 type for both the Type and the RetryMode by using 2 bits for the mode and 1
 // TODO bit for the type. Although in the practical sense, we might not want to do that since the type of retryability
 // TODO is tied to the operation itself and isn't going change, e.g. and insert operation will always be a write,
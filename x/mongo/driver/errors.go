@@ -118,6 +118,11 @@ type WriteCommandError struct {
 	WriteErrors       WriteErrors
 	Labels            []string
 	Raw               bsoncore.Document
+
+	// Responses are the server responses of the batches of the operation that
+	// failed with a write error, in order. They are not copied, so WriteErrors
+	// and Raw reference the same memory.
+	Responses []bsoncore.Document
 }
 
 // UnsupportedStorageEngine returns whether or not the WriteCommandError comes from a retryable write being attempted
@@ -477,8 +482,7 @@ func ExtractErrorFromServerResponse(doc bsoncore.Document) error {
 					we.Message = msg
 				}
 				if info, exists := doc.Lookup("errInfo").DocumentOK(); exists {
-					we.Details = make([]byte, len(info))
-					copy(we.Details, info)
+					we.Details = info
 				}
 				we.Raw = doc
 				wcError.WriteErrors = append(wcError.WriteErrors, we)
@@ -500,8 +504,7 @@ func ExtractErrorFromServerResponse(doc bsoncore.Document) error {
 				wcError.WriteConcernError.Message = msg
 			}
 			if info, exists := doc.Lookup("errInfo").DocumentOK(); exists {
-				wcError.WriteConcernError.Details = make([]byte, len(info))
-				copy(wcError.WriteConcernError.Details, info)
+				wcError.WriteConcernError.Details = info
 			}
 			if errLabels, exists := doc.Lookup("errorLabels").ArrayOK(); exists {
 				vals, err := errLabels.Values()
@@ -0,0 +1,16 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package driver
+
+// MemoryAccountant tracks the memory held by operations. Acquire is called
+// with the size of a buffer when an operation starts holding it and Release
+// is called with the same size when the operation no longer holds it.
+// Implementations must be safe for concurrent use and must not block.
+type MemoryAccountant interface {
+	Acquire(n int)
+	Release(n int)
+}
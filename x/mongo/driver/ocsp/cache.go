@@ -8,6 +8,7 @@ package ocsp
 
 import (
 	"crypto"
+	"fmt"
 	"sync"
 	"time"
 
@@ -119,3 +120,85 @@ func createCacheKey(request *ocsp.Request) cacheKey {
 		SerialNumber:   request.SerialNumber.String(),
 	}
 }
+
+// Store is a key-value store for OCSP responses. It can be used to share an OCSP cache between clients or processes,
+// for example by backing it with a shared file or an external key-value service. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	// Get returns the response stored for key, or false if there is none.
+	Get(key string) (*ResponseDetails, bool)
+
+	// Put stores the response for key, replacing any existing response.
+	Put(key string, response *ResponseDetails)
+
+	// Delete removes the response stored for key, if any.
+	Delete(key string)
+}
+
+// StoreCache is an implementation of ocsp.Cache backed by a Store.
+//
+// StoreCache applies the same rules as ConcurrentCache, but does not lock the Store between reading and writing an
+// entry. If multiple processes update the same entry concurrently, the last write wins.
+type StoreCache struct {
+	store Store
+}
+
+var _ Cache = (*StoreCache)(nil)
+
+// NewStoreCache creates an OCSP cache backed by store.
+func NewStoreCache(store Store) *StoreCache {
+	return &StoreCache{store: store}
+}
+
+// Update updates the stored entry for the provided request according to the rules described in
+// ConcurrentCache.Update and returns the most up-to-date response corresponding to the request.
+func (c *StoreCache) Update(request *ocsp.Request, response *ResponseDetails) *ResponseDetails {
+	unknown := response.Status == ocsp.Unknown
+	hasUpdateTime := !response.NextUpdate.IsZero()
+	key := CacheKey(request)
+
+	current, ok := c.store.Get(key)
+	switch {
+	case !ok || current == nil:
+		if !unknown && hasUpdateTime {
+			c.store.Put(key, response)
+		}
+		return response
+	case unknown:
+		return current
+	case !hasUpdateTime:
+		c.store.Delete(key)
+		return response
+	case response.NextUpdate.After(current.NextUpdate):
+		c.store.Put(key, response)
+		return response
+	}
+	return current
+}
+
+// Get returns the stored response for the request, or nil if there is no stored response. If the stored response
+// has expired, it will be removed from the store and nil will be returned.
+func (c *StoreCache) Get(request *ocsp.Request) *ResponseDetails {
+	key := CacheKey(request)
+
+	response, ok := c.store.Get(key)
+	if !ok || response == nil {
+		return nil
+	}
+
+	if time.Now().UTC().Before(response.NextUpdate) {
+		return response
+	}
+	c.store.Delete(key)
+	return nil
+}
+
+// CacheKey returns a string that uniquely identifies the certificate an OCSP request is for. It is used as the key
+// for entries in a Store.
+func CacheKey(request *ocsp.Request) string {
+	return fmt.Sprintf("%d:%x:%x:%s",
+		request.HashAlgorithm,
+		request.IssuerNameHash,
+		request.IssuerKeyHash,
+		request.SerialNumber.String())
+}
@@ -190,3 +190,66 @@ func TestCache(t *testing.T) {
 func futureTime(minutes int) time.Time {
 	return time.Now().Add(time.Duration(minutes) * time.Minute).UTC()
 }
+
+type mapStore map[string]*ResponseDetails
+
+func (s mapStore) Get(key string) (*ResponseDetails, bool) {
+	res, ok := s[key]
+	return res, ok
+}
+
+func (s mapStore) Put(key string, res *ResponseDetails) { s[key] = res }
+
+func (s mapStore) Delete(key string) { delete(s, key) }
+
+func TestStoreCache(t *testing.T) {
+	testRequest := &ocsp.Request{
+		HashAlgorithm:  crypto.SHA1,
+		IssuerNameHash: []byte("issuerNameHash"),
+		IssuerKeyHash:  []byte("issuerKeyHash"),
+	}
+	testRequestKey := CacheKey(testRequest)
+
+	t.Run("update", func(t *testing.T) {
+		store := mapStore{}
+		cache := NewStoreCache(store)
+
+		good := &ResponseDetails{Status: ocsp.Good, NextUpdate: futureTime(10)}
+		res := cache.Update(testRequest, good)
+		assert.Equal(t, good, res, "expected Update to return %v, got %v", good, res)
+		assert.Equal(t, good, store[testRequestKey], "expected store to contain %v, got %v", good, store[testRequestKey])
+
+		unknown := &ResponseDetails{Status: ocsp.Unknown, NextUpdate: futureTime(20)}
+		res = cache.Update(testRequest, unknown)
+		assert.Equal(t, good, res, "expected Update to return %v, got %v", good, res)
+
+		later := &ResponseDetails{Status: ocsp.Revoked, NextUpdate: futureTime(20)}
+		res = cache.Update(testRequest, later)
+		assert.Equal(t, later, res, "expected Update to return %v, got %v", later, res)
+		assert.Equal(t, later, store[testRequestKey], "expected store to contain %v, got %v", later, store[testRequestKey])
+
+		noUpdate := &ResponseDetails{Status: ocsp.Good}
+		res = cache.Update(testRequest, noUpdate)
+		assert.Equal(t, noUpdate, res, "expected Update to return %v, got %v", noUpdate, res)
+		_, ok := store[testRequestKey]
+		assert.False(t, ok, "expected store to contain no entry")
+	})
+	t.Run("get", func(t *testing.T) {
+		store := mapStore{}
+		cache := NewStoreCache(store)
+
+		res := cache.Get(testRequest)
+		assert.Nil(t, res, "expected Get to return nil, got %v", res)
+
+		valid := &ResponseDetails{Status: ocsp.Good, NextUpdate: futureTime(10)}
+		store[testRequestKey] = valid
+		res = cache.Get(testRequest)
+		assert.Equal(t, valid, res, "expected Get to return %v, got %v", valid, res)
+
+		store[testRequestKey] = &ResponseDetails{Status: ocsp.Good, NextUpdate: futureTime(-10)}
+		res = cache.Get(testRequest)
+		assert.Nil(t, res, "expected Get to return nil, got %v", res)
+		_, ok := store[testRequestKey]
+		assert.False(t, ok, "expected expired entry to be deleted")
+	})
+}
@@ -23,6 +23,7 @@ type config struct {
 	ocspRequest             *ocsp.Request
 	ocspRequestBytes        []byte
 	httpClient              *http.Client
+	hardFail                bool
 }
 
 func newConfig(certChain []*x509.Certificate, opts *VerifyOptions) (config, error) {
@@ -30,6 +31,7 @@ func newConfig(certChain []*x509.Certificate, opts *VerifyOptions) (config, erro
 		cache:                   opts.Cache,
 		disableEndpointChecking: opts.DisableEndpointChecking,
 		httpClient:              opts.HTTPClient,
+		hardFail:                opts.HardFail,
 	}
 
 	if cfg.httpClient == nil {
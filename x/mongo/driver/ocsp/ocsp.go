@@ -97,7 +97,10 @@ func Verify(ctx context.Context, connState tls.ConnectionState, opts *VerifyOpti
 	}
 	if res == nil {
 		// If no response was parsed from the staple and responders, the status of the certificate is unknown, so don't
-		// error.
+		// error unless hard-fail semantics were requested.
+		if ocspCfg.hardFail {
+			return newOCSPError(errors.New("unable to determine certificate revocation status"))
+		}
 		return nil
 	}
 
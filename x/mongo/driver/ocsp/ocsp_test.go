@@ -11,7 +11,13 @@ package ocsp
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
 	"net"
 	"testing"
 	"time"
@@ -71,3 +77,32 @@ func TestContactResponders(t *testing.T) {
 		assert.True(t, duration <= 5*time.Second, "expected duration to be <= 5s, but was %v", duration)
 	})
 }
+
+func TestVerifyFailureMode(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err, "GenerateKey error: %v", err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err, "CreateCertificate error: %v", err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err, "ParseCertificate error: %v", err)
+
+	// The certificate has no stapled response and lists no OCSP responders, so its status cannot be determined.
+	connState := tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+
+	t.Run("soft fail", func(t *testing.T) {
+		err := Verify(context.Background(), connState, &VerifyOptions{Cache: NewCache()})
+		assert.Nil(t, err, "Verify error: %v", err)
+	})
+	t.Run("hard fail", func(t *testing.T) {
+		err := Verify(context.Background(), connState, &VerifyOptions{Cache: NewCache(), HardFail: true})
+		assert.NotNil(t, err, "expected Verify error, got nil")
+		assert.Equal(t, "OCSP verification failed: unable to determine certificate revocation status", err.Error())
+	})
+}
@@ -13,4 +13,9 @@ type VerifyOptions struct {
 	Cache                   Cache
 	DisableEndpointChecking bool
 	HTTPClient              *http.Client
+
+	// HardFail specifies whether verification should fail if the certificate status cannot be determined from a
+	// stapled, cached, or responder-provided OCSP response. By default, verification soft-fails and the connection
+	// is allowed to continue.
+	HardFail bool
 }
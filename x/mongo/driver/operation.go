@@ -132,6 +132,7 @@ type finishedInformation struct {
 	serviceID          *bson.ObjectID
 	serverAddress      address.Address
 	duration           time.Duration
+	finishedAt         time.Time
 }
 
 // success returns true if there was no command error or the command error is a
@@ -350,10 +351,26 @@ type Operation struct {
 	// of the operation do not contain a maxTimeMS field.
 	OmitMaxTimeMS bool
 
+	// ExhaustAllowed sets the exhaustAllowed flag on the OP_MSG sent to the server, which allows the server to
+	// respond with the moreToCome flag and stream further responses without additional requests. The responses
+	// must then be read with ExecuteExhaust. It has no effect if the connection does not implement mnet.Streamer.
+	ExhaustAllowed bool
+
 	// Authenticator is the authenticator to use for this operation when a reauthentication is
 	// required.
 	Authenticator Authenticator
 
+	// RetryPolicy customizes the retries of this operation. If nil, the default retry behavior is used.
+	RetryPolicy *RetryPolicy
+
+	// CommandInterceptors observe and modify the commands sent by this operation and the replies to them. The
+	// Command functions are called in order, each with the document returned by the previous one, and the Reply
+	// functions are called in reverse order.
+	CommandInterceptors []CommandInterceptor
+
+	// MemoryAccountant, if set, is notified of the wire message buffers held by this operation.
+	MemoryAccountant MemoryAccountant
+
 	// omitReadPreference is a boolean that indicates whether to omit the
 	// read preference from the command. This omition includes the case
 	// where a default read preference is used when the operation
@@ -511,6 +528,7 @@ func (op Operation) getServerAndConnection(
 			return nil, nil, fmt.Errorf("error incrementing connection reference count when starting a transaction: %w", err)
 		}
 		op.Client.PinnedConnection = conn
+		op.Client.PinnedTime = time.Now()
 	}
 
 	return server, conn, nil
@@ -558,6 +576,7 @@ func (op Operation) Execute(ctx context.Context) error {
 		}
 	}
 
+	policy := op.RetryPolicy
 	var retries int
 	if op.RetryMode != nil {
 		switch op.Type {
@@ -567,14 +586,14 @@ func (op Operation) Execute(ctx context.Context) error {
 			}
 			switch *op.RetryMode {
 			case RetryOnce, RetryOncePerCommand:
-				retries = 1
+				retries = policy.maxRetries()
 			case RetryContext:
 				retries = -1
 			}
 		case Read:
 			switch *op.RetryMode {
 			case RetryOnce, RetryOncePerCommand:
-				retries = 1
+				retries = policy.maxRetries()
 			case RetryContext:
 				retries = -1
 			}
@@ -595,6 +614,8 @@ func (op Operation) Execute(ctx context.Context) error {
 	var prevIndefiniteErr error
 	retrySupported := false
 	first := true
+	retryCount := 0
+	backoffPending := false
 	currIndex := 0
 
 	// deprioritizedServers are a running list of servers that should be
@@ -606,6 +627,8 @@ func (op Operation) Execute(ctx context.Context) error {
 	// retry loop variables to request a new server and a new connection for the next attempt.
 	resetForRetry := func(err error) {
 		retries--
+		retryCount++
+		backoffPending = true
 		prevErr = err
 
 		// Set the previous indefinite error to be returned in any case where a retryable write error does not have a
@@ -665,6 +688,15 @@ func (op Operation) Execute(ctx context.Context) error {
 			return prevErr
 		}
 
+		// Wait for the backoff of the retry policy before retrying. If the
+		// context is done first, return the error from the previous try.
+		if backoffPending {
+			backoffPending = false
+			if err := policy.wait(ctx, retryCount); err != nil {
+				return prevErr
+			}
+		}
+
 		requestID := wiremessage.NextRequestID()
 
 		// If the server or connection are nil, try to select a new server and get a new connection.
@@ -808,6 +840,7 @@ func (op Operation) Execute(ctx context.Context) error {
 			}
 		}
 
+		var roundTripped bool
 		if err == nil {
 			// roundtrip using either the full roundTripper or a special one for when the moreToCome
 			// flag is set
@@ -815,7 +848,16 @@ func (op Operation) Execute(ctx context.Context) error {
 			if moreToCome {
 				roundTrip = op.moreToComeRoundTrip
 			}
+			// The wire message buffer is held for the duration of the round
+			// trip, so report it to the memory accountant, if any.
+			if op.MemoryAccountant != nil {
+				op.MemoryAccountant.Acquire(len(*wm))
+			}
 			res, err = roundTrip(ctx, conn, *wm)
+			if op.MemoryAccountant != nil {
+				op.MemoryAccountant.Release(len(*wm))
+			}
+			roundTripped = true
 
 			if ep, ok := srvr.(ErrorProcessor); ok {
 				_ = ep.ProcessError(err, conn)
@@ -824,10 +866,21 @@ func (op Operation) Execute(ctx context.Context) error {
 
 		finishedInfo.response = res
 		finishedInfo.cmdErr = err
-		finishedInfo.duration = time.Since(startedTime)
+		finishedInfo.finishedAt = time.Now()
+		finishedInfo.duration = finishedInfo.finishedAt.Sub(startedTime)
+
+		if oo, ok := srvr.(OperationObserver); ok && roundTripped {
+			oo.ObserveOperation(startedInfo.cmdName, err, finishedInfo.duration)
+		}
 
 		op.publishFinishedEvent(ctx, finishedInfo)
 
+		// Interceptors inspect the reply after it is published, so that command
+		// monitors observe the reply sent by the server.
+		if len(op.CommandInterceptors) > 0 && roundTripped {
+			err = interceptReply(ctx, op.CommandInterceptors, startedInfo.cmdName, op.Database, res, err)
+		}
+
 		// prevIndefiniteErrorIsSet is "true" if the "err" variable has been set to the "prevIndefiniteErr" in
 		// a case in the switch statement below.
 		var prevIndefiniteErrIsSet bool
@@ -853,9 +906,9 @@ func (op Operation) Execute(ctx context.Context) error {
 			}
 
 			// If retries are supported for the current operation on the first server description,
-			// the error is considered retryable, and there are retries remaining (negative retries
-			// means retry indefinitely), then retry the operation.
-			if retrySupported && retryEnabled && retryableErr && retries != 0 {
+			// the error is considered retryable by the retry policy, and there are retries remaining
+			// (negative retries means retry indefinitely), then retry the operation.
+			if retrySupported && retryEnabled && policy.classify(tt, op.Type == Write, retryableErr) && retries != 0 {
 				if op.Client != nil && op.Client.Committing {
 					// Apply majority write concern for retries
 					op.Client.UpdateCommitTransactionWriteConcern()
@@ -887,6 +940,8 @@ func (op Operation) Execute(ctx context.Context) error {
 				_ = op.ProcessResponseFn(ctx, res, info)
 			}
 
+			operationErr.Responses = append(operationErr.Responses, tt.Raw)
+
 			// If batching is enabled and either ordered is the default (which is true) or
 			// explicitly set to true and we have write errors, return the errors.
 			if op.Batches != nil && len(tt.WriteErrors) > 0 {
@@ -896,6 +951,7 @@ func (op Operation) Execute(ctx context.Context) error {
 					}
 				}
 				if isOrdered := op.Batches.IsOrdered(); isOrdered == nil || *isOrdered {
+					tt.Responses = operationErr.Responses
 					return tt
 				}
 			}
@@ -973,9 +1029,9 @@ func (op Operation) Execute(ctx context.Context) error {
 			}
 
 			// If retries are supported for the current operation on the first server description,
-			// the error is considered retryable, and there are retries remaining (negative retries
-			// means retry indefinitely), then retry the operation.
-			if retrySupported && retryEnabled && retryableErr && retries != 0 {
+			// the error is considered retryable by the retry policy, and there are retries remaining
+			// (negative retries means retry indefinitely), then retry the operation.
+			if retrySupported && retryEnabled && policy.classify(tt, op.Type == Write, retryableErr) && retries != 0 {
 				if op.Client != nil && op.Client.Committing {
 					// Apply majority write concern for retries
 					op.Client.UpdateCommitTransactionWriteConcern()
@@ -1057,7 +1113,7 @@ func (op Operation) Execute(ctx context.Context) error {
 				// Reset the retries number for RetryOncePerCommand unless context is a Timeout context, in
 				// which case retries should remain as -1 (as many times as possible).
 				if *op.RetryMode == RetryOncePerCommand && !csot.IsTimeoutContext(ctx) {
-					retries = 1
+					retries = policy.maxRetries()
 				}
 			}
 			currIndex += startedInfo.processedBatches
@@ -1311,9 +1367,9 @@ func (op Operation) createMsgWireMessage(
 	cmdFn func([]byte, description.SelectedServer) ([]byte, error),
 ) ([]byte, []byte, error) {
 	var flags wiremessage.MsgFlag
-	// Set the ExhaustAllowed flag if the connection supports streaming. This will tell the server that it can
-	// respond with the MoreToCome flag and then stream responses over this connection.
-	if streamer := conn.Streamer; streamer != nil && streamer.SupportsStreaming() {
+	// Set the ExhaustAllowed flag if the connection supports streaming or the operation allows it. This will tell the
+	// server that it can respond with the MoreToCome flag and then stream responses over this connection.
+	if streamer := conn.Streamer; streamer != nil && (op.ExhaustAllowed || streamer.SupportsStreaming()) {
 		flags = wiremessage.ExhaustAllowed
 	}
 	dst = wiremessage.AppendMsgFlags(dst, flags)
@@ -1363,6 +1419,14 @@ func (op Operation) createMsgWireMessage(
 
 	dst, _ = bsoncore.AppendDocumentEnd(dst, idx)
 
+	if len(op.CommandInterceptors) > 0 {
+		cmd, err := interceptCommand(ctx, op.CommandInterceptors, op.Database, dst[idx:])
+		if err != nil {
+			return dst, nil, err
+		}
+		dst = append(dst[:idx], cmd...)
+	}
+
 	return dst, dst[idx:], nil
 }
 
@@ -2137,6 +2201,7 @@ func (op Operation) publishStartedEvent(ctx context.Context, info startedInforma
 			ConnectionID:       info.connID,
 			ServerConnectionID: info.serverConnID,
 			ServiceID:          info.serviceID,
+			Time:               time.Now(),
 		}
 		op.CommandMonitor.Started(ctx, started)
 	}
@@ -2217,6 +2282,10 @@ func (op Operation) publishFinishedEvent(ctx context.Context, info finishedInfor
 		Duration:           info.duration,
 		ServerConnectionID: info.serverConnID,
 		ServiceID:          info.serviceID,
+		Time:               info.finishedAt,
+	}
+	if !info.redacted {
+		finished.OperationTime, finished.ClusterTime = replyTimes(info.response)
 	}
 
 	if info.success() {
@@ -2236,6 +2305,22 @@ func (op Operation) publishFinishedEvent(ctx context.Context, info finishedInfor
 	op.CommandMonitor.Failed(ctx, failedEvent)
 }
 
+// replyTimes returns the operationTime and $clusterTime of a server reply, if
+// present. The returned values are copies and remain valid after the reply is
+// released.
+func replyTimes(reply bsoncore.Document) (*bson.Timestamp, bson.Raw) {
+	var operationTime *bson.Timestamp
+	if t, i, ok := reply.Lookup("operationTime").TimestampOK(); ok {
+		operationTime = &bson.Timestamp{T: t, I: i}
+	}
+
+	var clusterTime bson.Raw
+	if doc, ok := reply.Lookup("$clusterTime").DocumentOK(); ok {
+		clusterTime = bson.Raw(append([]byte(nil), doc...))
+	}
+	return operationTime, clusterTime
+}
+
 // sessionsSupported returns true of the given server version indicates that it supports sessions.
 func sessionsSupported(wireVersion *description.VersionRange) bool {
 	return wireVersion != nil
@@ -34,6 +34,10 @@ type AbortTransaction struct {
 	writeConcern  *writeconcern.WriteConcern
 	retry         *driver.RetryMode
 	serverAPI     *driver.ServerAPIOptions
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewAbortTransaction constructs and returns a new AbortTransaction.
@@ -67,6 +71,10 @@ func (at *AbortTransaction) Execute(ctx context.Context) error {
 		ServerAPI:         at.serverAPI,
 		Name:              driverutil.AbortTransactionOp,
 		Authenticator:     at.authenticator,
+
+		RetryPolicy:         at.retryPolicy,
+		CommandInterceptors: at.interceptors,
+		MemoryAccountant:    at.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -210,3 +218,33 @@ func (at *AbortTransaction) Authenticator(authenticator driver.Authenticator) *A
 	at.authenticator = authenticator
 	return at
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (at *AbortTransaction) RetryPolicy(policy *driver.RetryPolicy) *AbortTransaction {
+	if at == nil {
+		at = new(AbortTransaction)
+	}
+
+	at.retryPolicy = policy
+	return at
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (at *AbortTransaction) CommandInterceptors(interceptors []driver.CommandInterceptor) *AbortTransaction {
+	if at == nil {
+		at = new(AbortTransaction)
+	}
+
+	at.interceptors = interceptors
+	return at
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (at *AbortTransaction) MemoryAccountant(accountant driver.MemoryAccountant) *AbortTransaction {
+	if at == nil {
+		at = new(AbortTransaction)
+	}
+
+	at.memoryAccountant = accountant
+	return at
+}
@@ -53,6 +53,10 @@ type Aggregate struct {
 	rawData                  *bool
 
 	result driver.CursorResponse
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewAggregate constructs and returns a new Aggregate.
@@ -117,6 +121,10 @@ func (a *Aggregate) Execute(ctx context.Context) error {
 		Name:                           driverutil.AggregateOp,
 		Authenticator:                  a.authenticator,
 		OmitMaxTimeMS:                  a.omitMaxTimeMS,
+
+		RetryPolicy:         a.retryPolicy,
+		CommandInterceptors: a.interceptors,
+		MemoryAccountant:    a.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -426,6 +434,36 @@ func (a *Aggregate) Authenticator(authenticator driver.Authenticator) *Aggregate
 	return a
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (a *Aggregate) RetryPolicy(policy *driver.RetryPolicy) *Aggregate {
+	if a == nil {
+		a = new(Aggregate)
+	}
+
+	a.retryPolicy = policy
+	return a
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (a *Aggregate) CommandInterceptors(interceptors []driver.CommandInterceptor) *Aggregate {
+	if a == nil {
+		a = new(Aggregate)
+	}
+
+	a.interceptors = interceptors
+	return a
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (a *Aggregate) MemoryAccountant(accountant driver.MemoryAccountant) *Aggregate {
+	if a == nil {
+		a = new(Aggregate)
+	}
+
+	a.memoryAccountant = accountant
+	return a
+}
+
 // OmitMaxTimeMS omits the automatically-calculated "maxTimeMS" from the
 // command.
 func (a *Aggregate) OmitMaxTimeMS(omit bool) *Aggregate {
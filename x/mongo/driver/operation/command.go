@@ -39,6 +39,11 @@ type Command struct {
 	cursorOpts     driver.CursorOptions
 	timeout        *time.Duration
 	logger         *logger.Logger
+	retry          *driver.RetryMode
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewCommand constructs and returns a new Command. Once the operation is executed, the result may only be accessed via
@@ -113,6 +118,12 @@ func (c *Command) Execute(ctx context.Context) error {
 		Timeout:        c.timeout,
 		Logger:         c.logger,
 		Authenticator:  c.authenticator,
+		Type:           driver.Read,
+		RetryMode:      c.retry,
+
+		RetryPolicy:         c.retryPolicy,
+		CommandInterceptors: c.interceptors,
+		MemoryAccountant:    c.memoryAccountant,
 	}.Execute(ctx)
 }
 
@@ -235,3 +246,44 @@ func (c *Command) Authenticator(authenticator driver.Authenticator) *Command {
 	c.authenticator = authenticator
 	return c
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (c *Command) RetryPolicy(policy *driver.RetryPolicy) *Command {
+	if c == nil {
+		c = new(Command)
+	}
+
+	c.retryPolicy = policy
+	return c
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (c *Command) CommandInterceptors(interceptors []driver.CommandInterceptor) *Command {
+	if c == nil {
+		c = new(Command)
+	}
+
+	c.interceptors = interceptors
+	return c
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (c *Command) MemoryAccountant(accountant driver.MemoryAccountant) *Command {
+	if c == nil {
+		c = new(Command)
+	}
+
+	c.memoryAccountant = accountant
+	return c
+}
+
+// Retry enables retryable reads for this operation. A command should only be retried if running
+// it more than once has the same effect as running it once.
+func (c *Command) Retry(retry driver.RetryMode) *Command {
+	if c == nil {
+		c = new(Command)
+	}
+
+	c.retry = &retry
+	return c
+}
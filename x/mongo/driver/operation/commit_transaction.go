@@ -33,6 +33,10 @@ type CommitTransaction struct {
 	writeConcern  *writeconcern.WriteConcern
 	retry         *driver.RetryMode
 	serverAPI     *driver.ServerAPIOptions
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewCommitTransaction constructs and returns a new CommitTransaction.
@@ -66,6 +70,10 @@ func (ct *CommitTransaction) Execute(ctx context.Context) error {
 		ServerAPI:         ct.serverAPI,
 		Name:              driverutil.CommitTransactionOp,
 		Authenticator:     ct.authenticator,
+
+		RetryPolicy:         ct.retryPolicy,
+		CommandInterceptors: ct.interceptors,
+		MemoryAccountant:    ct.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -199,3 +207,33 @@ func (ct *CommitTransaction) Authenticator(authenticator driver.Authenticator) *
 	ct.authenticator = authenticator
 	return ct
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (ct *CommitTransaction) RetryPolicy(policy *driver.RetryPolicy) *CommitTransaction {
+	if ct == nil {
+		ct = new(CommitTransaction)
+	}
+
+	ct.retryPolicy = policy
+	return ct
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (ct *CommitTransaction) CommandInterceptors(interceptors []driver.CommandInterceptor) *CommitTransaction {
+	if ct == nil {
+		ct = new(CommitTransaction)
+	}
+
+	ct.interceptors = interceptors
+	return ct
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (ct *CommitTransaction) MemoryAccountant(accountant driver.MemoryAccountant) *CommitTransaction {
+	if ct == nil {
+		ct = new(CommitTransaction)
+	}
+
+	ct.memoryAccountant = accountant
+	return ct
+}
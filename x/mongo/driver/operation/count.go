@@ -42,6 +42,10 @@ type Count struct {
 	serverAPI      *driver.ServerAPIOptions
 	timeout        *time.Duration
 	rawData        *bool
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // CountResult represents a count result returned by the server.
@@ -128,6 +132,10 @@ func (c *Count) Execute(ctx context.Context) error {
 		Timeout:           c.timeout,
 		Name:              driverutil.CountOp,
 		Authenticator:     c.authenticator,
+
+		RetryPolicy:         c.retryPolicy,
+		CommandInterceptors: c.interceptors,
+		MemoryAccountant:    c.memoryAccountant,
 	}.Execute(ctx)
 
 	// Swallow error if NamespaceNotFound(26) is returned from aggregate on non-existent namespace
@@ -316,6 +324,36 @@ func (c *Count) Authenticator(authenticator driver.Authenticator) *Count {
 	return c
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (c *Count) RetryPolicy(policy *driver.RetryPolicy) *Count {
+	if c == nil {
+		c = new(Count)
+	}
+
+	c.retryPolicy = policy
+	return c
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (c *Count) CommandInterceptors(interceptors []driver.CommandInterceptor) *Count {
+	if c == nil {
+		c = new(Count)
+	}
+
+	c.interceptors = interceptors
+	return c
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (c *Count) MemoryAccountant(accountant driver.MemoryAccountant) *Count {
+	if c == nil {
+		c = new(Count)
+	}
+
+	c.memoryAccountant = accountant
+	return c
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (c *Count) RawData(rawData bool) *Count {
 	if c == nil {
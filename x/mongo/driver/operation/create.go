@@ -48,6 +48,10 @@ type Create struct {
 	timeSeries                   bsoncore.Document
 	encryptedFields              bsoncore.Document
 	clusteredIndex               bsoncore.Document
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewCreate constructs and returns a new Create.
@@ -80,6 +84,10 @@ func (c *Create) Execute(ctx context.Context) error {
 		WriteConcern:      c.writeConcern,
 		ServerAPI:         c.serverAPI,
 		Authenticator:     c.authenticator,
+
+		RetryPolicy:         c.retryPolicy,
+		CommandInterceptors: c.interceptors,
+		MemoryAccountant:    c.memoryAccountant,
 	}.Execute(ctx)
 }
 
@@ -412,3 +420,33 @@ func (c *Create) Authenticator(authenticator driver.Authenticator) *Create {
 	c.authenticator = authenticator
 	return c
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (c *Create) RetryPolicy(policy *driver.RetryPolicy) *Create {
+	if c == nil {
+		c = new(Create)
+	}
+
+	c.retryPolicy = policy
+	return c
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (c *Create) CommandInterceptors(interceptors []driver.CommandInterceptor) *Create {
+	if c == nil {
+		c = new(Create)
+	}
+
+	c.interceptors = interceptors
+	return c
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (c *Create) MemoryAccountant(accountant driver.MemoryAccountant) *Create {
+	if c == nil {
+		c = new(Create)
+	}
+
+	c.memoryAccountant = accountant
+	return c
+}
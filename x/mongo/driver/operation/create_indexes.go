@@ -39,6 +39,10 @@ type CreateIndexes struct {
 	serverAPI     *driver.ServerAPIOptions
 	timeout       *time.Duration
 	rawData       *bool
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // CreateIndexesResult represents a createIndexes result returned by the server.
@@ -119,6 +123,10 @@ func (ci *CreateIndexes) Execute(ctx context.Context) error {
 		Timeout:           ci.timeout,
 		Name:              driverutil.CreateIndexesOp,
 		Authenticator:     ci.authenticator,
+
+		RetryPolicy:         ci.retryPolicy,
+		CommandInterceptors: ci.interceptors,
+		MemoryAccountant:    ci.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -283,6 +291,36 @@ func (ci *CreateIndexes) Authenticator(authenticator driver.Authenticator) *Crea
 	return ci
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (ci *CreateIndexes) RetryPolicy(policy *driver.RetryPolicy) *CreateIndexes {
+	if ci == nil {
+		ci = new(CreateIndexes)
+	}
+
+	ci.retryPolicy = policy
+	return ci
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (ci *CreateIndexes) CommandInterceptors(interceptors []driver.CommandInterceptor) *CreateIndexes {
+	if ci == nil {
+		ci = new(CreateIndexes)
+	}
+
+	ci.interceptors = interceptors
+	return ci
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (ci *CreateIndexes) MemoryAccountant(accountant driver.MemoryAccountant) *CreateIndexes {
+	if ci == nil {
+		ci = new(CreateIndexes)
+	}
+
+	ci.memoryAccountant = accountant
+	return ci
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (ci *CreateIndexes) RawData(rawData bool) *CreateIndexes {
 	if ci == nil {
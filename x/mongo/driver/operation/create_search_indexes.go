@@ -35,6 +35,10 @@ type CreateSearchIndexes struct {
 	result        CreateSearchIndexesResult
 	serverAPI     *driver.ServerAPIOptions
 	timeout       *time.Duration
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // CreateSearchIndexResult represents a single search index result in CreateSearchIndexesResult.
@@ -118,6 +122,10 @@ func (csi *CreateSearchIndexes) Execute(ctx context.Context) error {
 		ServerAPI:         csi.serverAPI,
 		Timeout:           csi.timeout,
 		Authenticator:     csi.authenticator,
+
+		RetryPolicy:         csi.retryPolicy,
+		CommandInterceptors: csi.interceptors,
+		MemoryAccountant:    csi.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -249,3 +257,33 @@ func (csi *CreateSearchIndexes) Authenticator(authenticator driver.Authenticator
 	csi.authenticator = authenticator
 	return csi
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (csi *CreateSearchIndexes) RetryPolicy(policy *driver.RetryPolicy) *CreateSearchIndexes {
+	if csi == nil {
+		csi = new(CreateSearchIndexes)
+	}
+
+	csi.retryPolicy = policy
+	return csi
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (csi *CreateSearchIndexes) CommandInterceptors(interceptors []driver.CommandInterceptor) *CreateSearchIndexes {
+	if csi == nil {
+		csi = new(CreateSearchIndexes)
+	}
+
+	csi.interceptors = interceptors
+	return csi
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (csi *CreateSearchIndexes) MemoryAccountant(accountant driver.MemoryAccountant) *CreateSearchIndexes {
+	if csi == nil {
+		csi = new(CreateSearchIndexes)
+	}
+
+	csi.memoryAccountant = accountant
+	return csi
+}
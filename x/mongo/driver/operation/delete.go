@@ -45,6 +45,10 @@ type Delete struct {
 	timeout       *time.Duration
 	rawData       *bool
 	logger        *logger.Logger
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // DeleteResult represents a delete result returned by the server.
@@ -117,6 +121,10 @@ func (d *Delete) Execute(ctx context.Context) error {
 		Logger:            d.logger,
 		Name:              driverutil.DeleteOp,
 		Authenticator:     d.authenticator,
+
+		RetryPolicy:         d.retryPolicy,
+		CommandInterceptors: d.interceptors,
+		MemoryAccountant:    d.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -343,6 +351,36 @@ func (d *Delete) Authenticator(authenticator driver.Authenticator) *Delete {
 	return d
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (d *Delete) RetryPolicy(policy *driver.RetryPolicy) *Delete {
+	if d == nil {
+		d = new(Delete)
+	}
+
+	d.retryPolicy = policy
+	return d
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (d *Delete) CommandInterceptors(interceptors []driver.CommandInterceptor) *Delete {
+	if d == nil {
+		d = new(Delete)
+	}
+
+	d.interceptors = interceptors
+	return d
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (d *Delete) MemoryAccountant(accountant driver.MemoryAccountant) *Delete {
+	if d == nil {
+		d = new(Delete)
+	}
+
+	d.memoryAccountant = accountant
+	return d
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (d *Delete) RawData(rawData bool) *Delete {
 	if d == nil {
@@ -44,6 +44,10 @@ type Distinct struct {
 	serverAPI      *driver.ServerAPIOptions
 	timeout        *time.Duration
 	rawData        *bool
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // DistinctResult represents a distinct result returned by the server.
@@ -107,6 +111,10 @@ func (d *Distinct) Execute(ctx context.Context) error {
 		Timeout:           d.timeout,
 		Name:              driverutil.DistinctOp,
 		Authenticator:     d.authenticator,
+
+		RetryPolicy:         d.retryPolicy,
+		CommandInterceptors: d.interceptors,
+		MemoryAccountant:    d.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -329,6 +337,36 @@ func (d *Distinct) Authenticator(authenticator driver.Authenticator) *Distinct {
 	return d
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (d *Distinct) RetryPolicy(policy *driver.RetryPolicy) *Distinct {
+	if d == nil {
+		d = new(Distinct)
+	}
+
+	d.retryPolicy = policy
+	return d
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (d *Distinct) CommandInterceptors(interceptors []driver.CommandInterceptor) *Distinct {
+	if d == nil {
+		d = new(Distinct)
+	}
+
+	d.interceptors = interceptors
+	return d
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (d *Distinct) MemoryAccountant(accountant driver.MemoryAccountant) *Distinct {
+	if d == nil {
+		d = new(Distinct)
+	}
+
+	d.memoryAccountant = accountant
+	return d
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (d *Distinct) RawData(rawData bool) *Distinct {
 	if d == nil {
@@ -36,6 +36,10 @@ type DropCollection struct {
 	result        DropCollectionResult
 	serverAPI     *driver.ServerAPIOptions
 	timeout       *time.Duration
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // DropCollectionResult represents a dropCollection result returned by the server.
@@ -106,6 +110,10 @@ func (dc *DropCollection) Execute(ctx context.Context) error {
 		Timeout:           dc.timeout,
 		Name:              driverutil.DropOp,
 		Authenticator:     dc.authenticator,
+
+		RetryPolicy:         dc.retryPolicy,
+		CommandInterceptors: dc.interceptors,
+		MemoryAccountant:    dc.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -234,3 +242,33 @@ func (dc *DropCollection) Authenticator(authenticator driver.Authenticator) *Dro
 	dc.authenticator = authenticator
 	return dc
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (dc *DropCollection) RetryPolicy(policy *driver.RetryPolicy) *DropCollection {
+	if dc == nil {
+		dc = new(DropCollection)
+	}
+
+	dc.retryPolicy = policy
+	return dc
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (dc *DropCollection) CommandInterceptors(interceptors []driver.CommandInterceptor) *DropCollection {
+	if dc == nil {
+		dc = new(DropCollection)
+	}
+
+	dc.interceptors = interceptors
+	return dc
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (dc *DropCollection) MemoryAccountant(accountant driver.MemoryAccountant) *DropCollection {
+	if dc == nil {
+		dc = new(DropCollection)
+	}
+
+	dc.memoryAccountant = accountant
+	return dc
+}
@@ -31,6 +31,10 @@ type DropDatabase struct {
 	selector      description.ServerSelector
 	writeConcern  *writeconcern.WriteConcern
 	serverAPI     *driver.ServerAPIOptions
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewDropDatabase constructs and returns a new DropDatabase.
@@ -57,6 +61,10 @@ func (dd *DropDatabase) Execute(ctx context.Context) error {
 		ServerAPI:      dd.serverAPI,
 		Name:           driverutil.DropDatabaseOp,
 		Authenticator:  dd.authenticator,
+
+		RetryPolicy:         dd.retryPolicy,
+		CommandInterceptors: dd.interceptors,
+		MemoryAccountant:    dd.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -166,3 +174,33 @@ func (dd *DropDatabase) Authenticator(authenticator driver.Authenticator) *DropD
 	dd.authenticator = authenticator
 	return dd
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (dd *DropDatabase) RetryPolicy(policy *driver.RetryPolicy) *DropDatabase {
+	if dd == nil {
+		dd = new(DropDatabase)
+	}
+
+	dd.retryPolicy = policy
+	return dd
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (dd *DropDatabase) CommandInterceptors(interceptors []driver.CommandInterceptor) *DropDatabase {
+	if dd == nil {
+		dd = new(DropDatabase)
+	}
+
+	dd.interceptors = interceptors
+	return dd
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (dd *DropDatabase) MemoryAccountant(accountant driver.MemoryAccountant) *DropDatabase {
+	if dd == nil {
+		dd = new(DropDatabase)
+	}
+
+	dd.memoryAccountant = accountant
+	return dd
+}
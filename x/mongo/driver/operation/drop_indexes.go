@@ -38,6 +38,10 @@ type DropIndexes struct {
 	serverAPI     *driver.ServerAPIOptions
 	timeout       *time.Duration
 	rawData       *bool
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // DropIndexesResult represents a dropIndexes result returned by the server.
@@ -101,6 +105,10 @@ func (di *DropIndexes) Execute(ctx context.Context) error {
 		Timeout:           di.timeout,
 		Name:              driverutil.DropIndexesOp,
 		Authenticator:     di.authenticator,
+
+		RetryPolicy:         di.retryPolicy,
+		CommandInterceptors: di.interceptors,
+		MemoryAccountant:    di.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -254,6 +262,36 @@ func (di *DropIndexes) Authenticator(authenticator driver.Authenticator) *DropIn
 	return di
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (di *DropIndexes) RetryPolicy(policy *driver.RetryPolicy) *DropIndexes {
+	if di == nil {
+		di = new(DropIndexes)
+	}
+
+	di.retryPolicy = policy
+	return di
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (di *DropIndexes) CommandInterceptors(interceptors []driver.CommandInterceptor) *DropIndexes {
+	if di == nil {
+		di = new(DropIndexes)
+	}
+
+	di.interceptors = interceptors
+	return di
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (di *DropIndexes) MemoryAccountant(accountant driver.MemoryAccountant) *DropIndexes {
+	if di == nil {
+		di = new(DropIndexes)
+	}
+
+	di.memoryAccountant = accountant
+	return di
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (di *DropIndexes) RawData(rawData bool) *DropIndexes {
 	if di == nil {
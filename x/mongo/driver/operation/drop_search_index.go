@@ -34,6 +34,10 @@ type DropSearchIndex struct {
 	result        DropSearchIndexResult
 	serverAPI     *driver.ServerAPIOptions
 	timeout       *time.Duration
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // DropSearchIndexResult represents a dropSearchIndex result returned by the server.
@@ -94,6 +98,10 @@ func (dsi *DropSearchIndex) Execute(ctx context.Context) error {
 		ServerAPI:         dsi.serverAPI,
 		Timeout:           dsi.timeout,
 		Authenticator:     dsi.authenticator,
+
+		RetryPolicy:         dsi.retryPolicy,
+		CommandInterceptors: dsi.interceptors,
+		MemoryAccountant:    dsi.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -223,3 +231,33 @@ func (dsi *DropSearchIndex) Authenticator(authenticator driver.Authenticator) *D
 	dsi.authenticator = authenticator
 	return dsi
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (dsi *DropSearchIndex) RetryPolicy(policy *driver.RetryPolicy) *DropSearchIndex {
+	if dsi == nil {
+		dsi = new(DropSearchIndex)
+	}
+
+	dsi.retryPolicy = policy
+	return dsi
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (dsi *DropSearchIndex) CommandInterceptors(interceptors []driver.CommandInterceptor) *DropSearchIndex {
+	if dsi == nil {
+		dsi = new(DropSearchIndex)
+	}
+
+	dsi.interceptors = interceptors
+	return dsi
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (dsi *DropSearchIndex) MemoryAccountant(accountant driver.MemoryAccountant) *DropSearchIndex {
+	if dsi == nil {
+		dsi = new(DropSearchIndex)
+	}
+
+	dsi.memoryAccountant = accountant
+	return dsi
+}
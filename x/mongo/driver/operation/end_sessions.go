@@ -30,6 +30,10 @@ type EndSessions struct {
 	deployment    driver.Deployment
 	selector      description.ServerSelector
 	serverAPI     *driver.ServerAPIOptions
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewEndSessions constructs and returns a new EndSessions.
@@ -62,6 +66,10 @@ func (es *EndSessions) Execute(ctx context.Context) error {
 		ServerAPI:         es.serverAPI,
 		Name:              driverutil.EndSessionsOp,
 		Authenticator:     es.authenticator,
+
+		RetryPolicy:         es.retryPolicy,
+		CommandInterceptors: es.interceptors,
+		MemoryAccountant:    es.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -172,3 +180,33 @@ func (es *EndSessions) Authenticator(authenticator driver.Authenticator) *EndSes
 	es.authenticator = authenticator
 	return es
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (es *EndSessions) RetryPolicy(policy *driver.RetryPolicy) *EndSessions {
+	if es == nil {
+		es = new(EndSessions)
+	}
+
+	es.retryPolicy = policy
+	return es
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (es *EndSessions) CommandInterceptors(interceptors []driver.CommandInterceptor) *EndSessions {
+	if es == nil {
+		es = new(EndSessions)
+	}
+
+	es.interceptors = interceptors
+	return es
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (es *EndSessions) MemoryAccountant(accountant driver.MemoryAccountant) *EndSessions {
+	if es == nil {
+		es = new(EndSessions)
+	}
+
+	es.memoryAccountant = accountant
+	return es
+}
@@ -64,6 +64,10 @@ type Find struct {
 	rawData             *bool
 	logger              *logger.Logger
 	omitMaxTimeMS       bool
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewFind constructs and returns a new Find.
@@ -115,6 +119,10 @@ func (f *Find) Execute(ctx context.Context) error {
 		Name:              driverutil.FindOp,
 		Authenticator:     f.authenticator,
 		OmitMaxTimeMS:     f.omitMaxTimeMS,
+
+		RetryPolicy:         f.retryPolicy,
+		CommandInterceptors: f.interceptors,
+		MemoryAccountant:    f.memoryAccountant,
 	}.Execute(ctx)
 }
 
@@ -570,6 +578,36 @@ func (f *Find) Authenticator(authenticator driver.Authenticator) *Find {
 	return f
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (f *Find) RetryPolicy(policy *driver.RetryPolicy) *Find {
+	if f == nil {
+		f = new(Find)
+	}
+
+	f.retryPolicy = policy
+	return f
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (f *Find) CommandInterceptors(interceptors []driver.CommandInterceptor) *Find {
+	if f == nil {
+		f = new(Find)
+	}
+
+	f.interceptors = interceptors
+	return f
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (f *Find) MemoryAccountant(accountant driver.MemoryAccountant) *Find {
+	if f == nil {
+		f = new(Find)
+	}
+
+	f.memoryAccountant = accountant
+	return f
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (f *Find) RawData(rawData bool) *Find {
 	if f == nil {
@@ -53,6 +53,10 @@ type FindAndModify struct {
 	rawData                  *bool
 
 	result FindAndModifyResult
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // LastErrorObject represents information about updates and upserts returned by the server.
@@ -145,6 +149,10 @@ func (fam *FindAndModify) Execute(ctx context.Context) error {
 		Timeout:        fam.timeout,
 		Name:           driverutil.FindAndModifyOp,
 		Authenticator:  fam.authenticator,
+
+		RetryPolicy:         fam.retryPolicy,
+		CommandInterceptors: fam.interceptors,
+		MemoryAccountant:    fam.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -482,6 +490,36 @@ func (fam *FindAndModify) Authenticator(authenticator driver.Authenticator) *Fin
 	return fam
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (fam *FindAndModify) RetryPolicy(policy *driver.RetryPolicy) *FindAndModify {
+	if fam == nil {
+		fam = new(FindAndModify)
+	}
+
+	fam.retryPolicy = policy
+	return fam
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (fam *FindAndModify) CommandInterceptors(interceptors []driver.CommandInterceptor) *FindAndModify {
+	if fam == nil {
+		fam = new(FindAndModify)
+	}
+
+	fam.interceptors = interceptors
+	return fam
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (fam *FindAndModify) MemoryAccountant(accountant driver.MemoryAccountant) *FindAndModify {
+	if fam == nil {
+		fam = new(FindAndModify)
+	}
+
+	fam.memoryAccountant = accountant
+	return fam
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (fam *FindAndModify) RawData(rawData bool) *FindAndModify {
 	if fam == nil {
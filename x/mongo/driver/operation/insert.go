@@ -44,6 +44,10 @@ type Insert struct {
 	timeout                  *time.Duration
 	rawData                  *bool
 	logger                   *logger.Logger
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // InsertResult represents an insert result returned by the server.
@@ -116,6 +120,10 @@ func (i *Insert) Execute(ctx context.Context) error {
 		Logger:            i.logger,
 		Name:              driverutil.InsertOp,
 		Authenticator:     i.authenticator,
+
+		RetryPolicy:         i.retryPolicy,
+		CommandInterceptors: i.interceptors,
+		MemoryAccountant:    i.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -324,6 +332,36 @@ func (i *Insert) Authenticator(authenticator driver.Authenticator) *Insert {
 	return i
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (i *Insert) RetryPolicy(policy *driver.RetryPolicy) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.retryPolicy = policy
+	return i
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (i *Insert) CommandInterceptors(interceptors []driver.CommandInterceptor) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.interceptors = interceptors
+	return i
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (i *Insert) MemoryAccountant(accountant driver.MemoryAccountant) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.memoryAccountant = accountant
+	return i
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (i *Insert) RawData(rawData bool) *Insert {
 	if i == nil {
@@ -40,6 +40,10 @@ type ListCollections struct {
 	serverAPI             *driver.ServerAPIOptions
 	timeout               *time.Duration
 	rawData               *bool
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewListCollections constructs and returns a new ListCollections.
@@ -89,6 +93,10 @@ func (lc *ListCollections) Execute(ctx context.Context) error {
 		Timeout:           lc.timeout,
 		Name:              driverutil.ListCollectionsOp,
 		Authenticator:     lc.authenticator,
+
+		RetryPolicy:         lc.retryPolicy,
+		CommandInterceptors: lc.interceptors,
+		MemoryAccountant:    lc.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -281,6 +289,36 @@ func (lc *ListCollections) Authenticator(authenticator driver.Authenticator) *Li
 	return lc
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (lc *ListCollections) RetryPolicy(policy *driver.RetryPolicy) *ListCollections {
+	if lc == nil {
+		lc = new(ListCollections)
+	}
+
+	lc.retryPolicy = policy
+	return lc
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (lc *ListCollections) CommandInterceptors(interceptors []driver.CommandInterceptor) *ListCollections {
+	if lc == nil {
+		lc = new(ListCollections)
+	}
+
+	lc.interceptors = interceptors
+	return lc
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (lc *ListCollections) MemoryAccountant(accountant driver.MemoryAccountant) *ListCollections {
+	if lc == nil {
+		lc = new(ListCollections)
+	}
+
+	lc.memoryAccountant = accountant
+	return lc
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (lc *ListCollections) RawData(rawData bool) *ListCollections {
 	if lc == nil {
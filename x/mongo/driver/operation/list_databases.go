@@ -41,6 +41,10 @@ type ListDatabases struct {
 	timeout             *time.Duration
 
 	result ListDatabasesResult
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // ListDatabasesResult represents a listDatabases result returned by the server.
@@ -167,6 +171,10 @@ func (ld *ListDatabases) Execute(ctx context.Context) error {
 		Timeout:        ld.timeout,
 		Name:           driverutil.ListDatabasesOp,
 		Authenticator:  ld.authenticator,
+
+		RetryPolicy:         ld.retryPolicy,
+		CommandInterceptors: ld.interceptors,
+		MemoryAccountant:    ld.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -339,3 +347,33 @@ func (ld *ListDatabases) Authenticator(authenticator driver.Authenticator) *List
 	ld.authenticator = authenticator
 	return ld
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (ld *ListDatabases) RetryPolicy(policy *driver.RetryPolicy) *ListDatabases {
+	if ld == nil {
+		ld = new(ListDatabases)
+	}
+
+	ld.retryPolicy = policy
+	return ld
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (ld *ListDatabases) CommandInterceptors(interceptors []driver.CommandInterceptor) *ListDatabases {
+	if ld == nil {
+		ld = new(ListDatabases)
+	}
+
+	ld.interceptors = interceptors
+	return ld
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (ld *ListDatabases) MemoryAccountant(accountant driver.MemoryAccountant) *ListDatabases {
+	if ld == nil {
+		ld = new(ListDatabases)
+	}
+
+	ld.memoryAccountant = accountant
+	return ld
+}
@@ -37,6 +37,10 @@ type ListIndexes struct {
 	rawData       *bool
 
 	result driver.CursorResponse
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // NewListIndexes constructs and returns a new ListIndexes.
@@ -88,6 +92,10 @@ func (li *ListIndexes) Execute(ctx context.Context) error {
 		Timeout:        li.timeout,
 		Name:           driverutil.ListIndexesOp,
 		Authenticator:  li.authenticator,
+
+		RetryPolicy:         li.retryPolicy,
+		CommandInterceptors: li.interceptors,
+		MemoryAccountant:    li.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -240,6 +248,36 @@ func (li *ListIndexes) Authenticator(authenticator driver.Authenticator) *ListIn
 	return li
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (li *ListIndexes) RetryPolicy(policy *driver.RetryPolicy) *ListIndexes {
+	if li == nil {
+		li = new(ListIndexes)
+	}
+
+	li.retryPolicy = policy
+	return li
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (li *ListIndexes) CommandInterceptors(interceptors []driver.CommandInterceptor) *ListIndexes {
+	if li == nil {
+		li = new(ListIndexes)
+	}
+
+	li.interceptors = interceptors
+	return li
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (li *ListIndexes) MemoryAccountant(accountant driver.MemoryAccountant) *ListIndexes {
+	if li == nil {
+		li = new(ListIndexes)
+	}
+
+	li.memoryAccountant = accountant
+	return li
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (li *ListIndexes) RawData(rawData bool) *ListIndexes {
 	if li == nil {
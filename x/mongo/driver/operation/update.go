@@ -48,6 +48,10 @@ type Update struct {
 	timeout                  *time.Duration
 	rawData                  *bool
 	logger                   *logger.Logger
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // Upsert contains the information for an upsert in an Update operation.
@@ -169,6 +173,10 @@ func (u *Update) Execute(ctx context.Context) error {
 		Logger:            u.logger,
 		Name:              driverutil.UpdateOp,
 		Authenticator:     u.authenticator,
+
+		RetryPolicy:         u.retryPolicy,
+		CommandInterceptors: u.interceptors,
+		MemoryAccountant:    u.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -428,6 +436,36 @@ func (u *Update) Authenticator(authenticator driver.Authenticator) *Update {
 	return u
 }
 
+// RetryPolicy sets the retry policy to use for this operation.
+func (u *Update) RetryPolicy(policy *driver.RetryPolicy) *Update {
+	if u == nil {
+		u = new(Update)
+	}
+
+	u.retryPolicy = policy
+	return u
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (u *Update) CommandInterceptors(interceptors []driver.CommandInterceptor) *Update {
+	if u == nil {
+		u = new(Update)
+	}
+
+	u.interceptors = interceptors
+	return u
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (u *Update) MemoryAccountant(accountant driver.MemoryAccountant) *Update {
+	if u == nil {
+		u = new(Update)
+	}
+
+	u.memoryAccountant = accountant
+	return u
+}
+
 // RawData sets the rawData to access timeseries data in the compressed format.
 func (u *Update) RawData(rawData bool) *Update {
 	if u == nil {
@@ -35,6 +35,10 @@ type UpdateSearchIndex struct {
 	result        UpdateSearchIndexResult
 	serverAPI     *driver.ServerAPIOptions
 	timeout       *time.Duration
+
+	retryPolicy      *driver.RetryPolicy
+	interceptors     []driver.CommandInterceptor
+	memoryAccountant driver.MemoryAccountant
 }
 
 // UpdateSearchIndexResult represents a single index in the updateSearchIndexResult result.
@@ -96,6 +100,10 @@ func (usi *UpdateSearchIndex) Execute(ctx context.Context) error {
 		ServerAPI:         usi.serverAPI,
 		Timeout:           usi.timeout,
 		Authenticator:     usi.authenticator,
+
+		RetryPolicy:         usi.retryPolicy,
+		CommandInterceptors: usi.interceptors,
+		MemoryAccountant:    usi.memoryAccountant,
 	}.Execute(ctx)
 
 }
@@ -236,3 +244,33 @@ func (usi *UpdateSearchIndex) Authenticator(authenticator driver.Authenticator)
 	usi.authenticator = authenticator
 	return usi
 }
+
+// RetryPolicy sets the retry policy to use for this operation.
+func (usi *UpdateSearchIndex) RetryPolicy(policy *driver.RetryPolicy) *UpdateSearchIndex {
+	if usi == nil {
+		usi = new(UpdateSearchIndex)
+	}
+
+	usi.retryPolicy = policy
+	return usi
+}
+
+// CommandInterceptors sets the command interceptors to use for this operation.
+func (usi *UpdateSearchIndex) CommandInterceptors(interceptors []driver.CommandInterceptor) *UpdateSearchIndex {
+	if usi == nil {
+		usi = new(UpdateSearchIndex)
+	}
+
+	usi.interceptors = interceptors
+	return usi
+}
+
+// MemoryAccountant sets the memory accountant to use for this operation.
+func (usi *UpdateSearchIndex) MemoryAccountant(accountant driver.MemoryAccountant) *UpdateSearchIndex {
+	if usi == nil {
+		usi = new(UpdateSearchIndex)
+	}
+
+	usi.memoryAccountant = accountant
+	return usi
+}
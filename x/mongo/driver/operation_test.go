@@ -16,6 +16,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/csot"
 	"go.mongodb.org/mongo-driver/v2/internal/handshake"
@@ -860,6 +861,160 @@ func TestRetry(t *testing.T) {
 			time.Now().After(deadline),
 			"expected operation to complete only after the context deadline is exceeded")
 	})
+	t.Run("retry policy sets max retries and backoff", func(t *testing.T) {
+		d := new(mockDeployment)
+		ms := new(mockRetryServer)
+		d.returns.server = ms
+
+		var backoffs []int
+		policy := &RetryPolicy{
+			MaxRetries: 3,
+			Backoff: func(retry int) time.Duration {
+				backoffs = append(backoffs, retry)
+				return time.Millisecond
+			},
+		}
+
+		retry := RetryOnce
+		err := Operation{
+			CommandFn:   func([]byte, description.SelectedServer) ([]byte, error) { return nil, nil },
+			Deployment:  d,
+			Database:    "testing",
+			RetryMode:   &retry,
+			Type:        Read,
+			RetryPolicy: policy,
+		}.Execute(context.Background())
+		assert.NotNil(t, err, "expected an error from Execute()")
+		assert.Equal(t, 4, ms.numCallsToConnection, "expected the initial attempt and 3 retries")
+		assert.Equal(t, []int{1, 2, 3}, backoffs)
+	})
+	t.Run("backoff is interrupted by context", func(t *testing.T) {
+		d := new(mockDeployment)
+		ms := new(mockRetryServer)
+		d.returns.server = ms
+
+		ctx, cancel := context.WithCancel(context.Background())
+		policy := &RetryPolicy{
+			Backoff: func(int) time.Duration {
+				cancel()
+				return time.Hour
+			},
+		}
+
+		retry := RetryOnce
+		err := Operation{
+			CommandFn:   func([]byte, description.SelectedServer) ([]byte, error) { return nil, nil },
+			Deployment:  d,
+			Database:    "testing",
+			RetryMode:   &retry,
+			Type:        Read,
+			RetryPolicy: policy,
+		}.Execute(ctx)
+		var rerr retryableError
+		assert.True(t, errors.As(err, &rerr), "expected the error from the first attempt, got %v", err)
+		assert.Equal(t, 1, ms.numCallsToConnection, "expected no retry")
+	})
+}
+
+func TestConnectionDeployment(t *testing.T) {
+	closeErr := errors.New("closed")
+	cd := ConnectionDeployment{
+		Server: mockServer{rttMonitor: &csot.ZeroRTTMonitor{}},
+		Conn:   mnet.NewConnection(&mockConnection{rCloseErr: closeErr}),
+	}
+
+	conn, err := cd.Connection(context.Background())
+	require.NoError(t, err)
+	assert.NoError(t, conn.Close(), "expected closing the returned connection to do nothing")
+	assert.ErrorIs(t, cd.Conn.Close(), closeErr, "expected the embedded connection to be closed")
+}
+
+func TestNewConnectionDeployment(t *testing.T) {
+	newDeployment := func() ConnectionDeployment {
+		return NewConnectionDeployment(
+			mockServer{rttMonitor: &csot.ZeroRTTMonitor{}},
+			mnet.NewConnection(&mockConnection{}),
+			description.TopologyKindSharded,
+		)
+	}
+
+	t.Run("one operation at a time", func(t *testing.T) {
+		cd := newDeployment()
+
+		conn, err := cd.Connection(context.Background())
+		require.NoError(t, err)
+		assert.Nil(t, conn.Streamer, "expected the connection not to be streamable")
+		assert.Nil(t, conn.Compressor, "expected no compressor for a connection without one")
+
+		_, err = cd.Connection(context.Background())
+		assert.ErrorIs(t, err, ErrConnectionInUse)
+
+		require.NoError(t, conn.Close())
+		require.NoError(t, conn.Close())
+		conn, err = cd.Connection(context.Background())
+		require.NoError(t, err, "expected closing the connection to release it")
+		require.NoError(t, conn.Close())
+	})
+	t.Run("pinned connections are released when unpinned", func(t *testing.T) {
+		cd := newDeployment()
+
+		conn, err := cd.Connection(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, conn.PinToCursor())
+		require.NoError(t, conn.Close())
+
+		_, err = cd.Connection(context.Background())
+		assert.ErrorIs(t, err, ErrConnectionInUse)
+
+		require.NoError(t, conn.UnpinFromCursor())
+		assert.Error(t, conn.UnpinFromCursor(), "expected unpinning an unpinned connection to fail")
+		require.NoError(t, conn.Close())
+		_, err = cd.Connection(context.Background())
+		assert.NoError(t, err)
+	})
+	t.Run("close invalidates cursors", func(t *testing.T) {
+		cd := newDeployment()
+
+		bc, err := NewBatchCursor(CursorResponse{
+			Server:     cd,
+			ID:         1,
+			FirstBatch: &bsoncore.Iterator{},
+		}, nil, nil, CursorOptions{})
+		require.NoError(t, err)
+		assert.Len(t, cd.lease.cursors, 1, "expected the cursor to be tracked")
+
+		conn, err := cd.Connection(context.Background())
+		require.NoError(t, err)
+
+		require.NoError(t, cd.Close(context.Background()))
+		assert.False(t, bc.Next(context.Background()), "expected the first batch to be empty")
+		assert.False(t, bc.Next(context.Background()), "expected no more batches")
+		assert.ErrorIs(t, bc.Err(), ErrConnectionDeploymentClosed)
+
+		assert.ErrorIs(t, conn.Write(context.Background(), nil), ErrConnectionDeploymentClosed)
+		_, err = cd.Connection(context.Background())
+		assert.ErrorIs(t, err, ErrConnectionDeploymentClosed)
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	var nilPolicy *RetryPolicy
+	assert.True(t, nilPolicy.classify(errors.New("x"), false, true), "expected default classification")
+	assert.False(t, nilPolicy.classify(errors.New("x"), false, false), "expected default classification")
+	assert.Equal(t, 1, nilPolicy.maxRetries())
+	assert.Nil(t, nilPolicy.wait(context.Background(), 1))
+
+	policy := &RetryPolicy{
+		Classify: func(err error, write bool, retryable bool) bool {
+			return retryable || (write && err.Error() == "proxy reset")
+		},
+		MaxRetries: 5,
+	}
+	assert.True(t, policy.classify(errors.New("proxy reset"), true, false), "expected write to be retried")
+	assert.False(t, policy.classify(errors.New("proxy reset"), false, false), "expected read not to be retried")
+	assert.Equal(t, 5, policy.maxRetries())
 }
 
 func TestDecodeOpReply(t *testing.T) {
@@ -1091,3 +1246,44 @@ func BenchmarkRedactStartedInformationCmd(b *testing.B) {
 		})
 	}
 }
+
+func TestPublishFinishedEventTimes(t *testing.T) {
+	t.Parallel()
+
+	clusterTime := bsoncore.NewDocumentBuilder().
+		AppendTimestamp("clusterTime", 42, 1).
+		Build()
+	reply := bsoncore.NewDocumentBuilder().
+		AppendDouble("ok", 1).
+		AppendTimestamp("operationTime", 42, 1).
+		AppendDocument("$clusterTime", clusterTime).
+		Build()
+	finishedAt := time.Now()
+
+	var got *event.CommandSucceededEvent
+	op := Operation{
+		CommandMonitor: &event.CommandMonitor{
+			Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+				got = evt
+			},
+		},
+	}
+
+	op.publishFinishedEvent(context.Background(), finishedInformation{
+		cmdName:    "find",
+		response:   reply,
+		finishedAt: finishedAt,
+	})
+	require.NotNil(t, got, "expected a CommandSucceededEvent")
+	assert.Equal(t, finishedAt, got.Time)
+	assert.Equal(t, &bson.Timestamp{T: 42, I: 1}, got.OperationTime)
+	assert.Equal(t, bson.Raw(clusterTime), got.ClusterTime)
+
+	op.publishFinishedEvent(context.Background(), finishedInformation{
+		cmdName:  "saslStart",
+		response: reply,
+		redacted: true,
+	})
+	assert.Nil(t, got.OperationTime)
+	assert.Nil(t, got.ClusterTime)
+}
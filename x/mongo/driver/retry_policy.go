@@ -0,0 +1,65 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+// RetryPolicy customizes the retries of operations whose RetryMode enables
+// retrying. It does not enable retries for other operations.
+type RetryPolicy struct {
+	// Classify reports whether err should be retried. write is true for write
+	// operations, and retryable is whether the driver would retry err by
+	// default. If Classify is nil, the default is used.
+	Classify func(err error, write bool, retryable bool) bool
+
+	// MaxRetries is the maximum number of retries of each command. If zero,
+	// commands are retried once. It is ignored by operations that retry until
+	// their context is done, such as operations with a timeout.
+	MaxRetries int
+
+	// Backoff returns the delay before the given retry, starting at 1. If
+	// Backoff is nil, retries are immediate.
+	Backoff func(retry int) time.Duration
+}
+
+func (p *RetryPolicy) classify(err error, write bool, retryable bool) bool {
+	if p == nil || p.Classify == nil {
+		return retryable
+	}
+	return p.Classify(err, write, retryable)
+}
+
+func (p *RetryPolicy) maxRetries() int {
+	if p == nil || p.MaxRetries <= 0 {
+		return 1
+	}
+	return p.MaxRetries
+}
+
+// wait blocks for the backoff before the given retry. It returns the error of
+// ctx if ctx is done first.
+func (p *RetryPolicy) wait(ctx context.Context, retry int) error {
+	if p == nil || p.Backoff == nil {
+		return nil
+	}
+	d := p.Backoff(retry)
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
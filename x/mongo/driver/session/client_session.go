@@ -8,6 +8,7 @@ package session
 
 import (
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -120,6 +121,10 @@ type Client struct {
 	RecoveryToken    bson.Raw
 	PinnedConnection LoadBalancedTransactionConnection
 	SnapshotTime     *bson.Timestamp
+
+	// PinnedTime is the time at which PinnedServerAddr or PinnedConnection was
+	// set, or the zero time if the session is not pinned.
+	PinnedTime time.Time
 }
 
 func getClusterTime(clusterTime bson.Raw) (uint32, uint32) {
@@ -204,6 +209,13 @@ func NewClientSession(pool *Pool, clientID uuid.UUID, opts ...*ClientOptions) (*
 	if c.Consistent && c.Snapshot {
 		return nil, errors.New("causal consistency and snapshot cannot both be set for a session")
 	}
+	if mergedOpts.SnapshotTime != nil {
+		if !c.Snapshot {
+			return nil, errors.New("snapshot time cannot be set unless snapshot is enabled for a session")
+		}
+		snapshotTime := *mergedOpts.SnapshotTime
+		c.SnapshotTime = &snapshotTime
+	}
 
 	if err := c.SetServer(); err != nil {
 		return nil, err
@@ -216,6 +228,9 @@ func NewClientSession(pool *Pool, clientID uuid.UUID, opts ...*ClientOptions) (*
 func (c *Client) SetServer() error {
 	var err error
 	c.Server, err = c.pool.GetSession()
+	if err == nil && c.IsImplicit {
+		atomic.AddInt64(&c.pool.implicitCheckedOut, 1)
+	}
 	return err
 }
 
@@ -304,6 +319,7 @@ func (c *Client) ClearPinnedResources() error {
 	}
 
 	c.PinnedServerAddr = nil
+	c.PinnedTime = time.Time{}
 	if c.PinnedConnection != nil {
 		if err := c.PinnedConnection.UnpinFromTransaction(); err != nil {
 			return err
@@ -330,6 +346,7 @@ func (c *Client) unpinConnection() error {
 		err = closeErr
 	}
 	c.PinnedConnection = nil
+	c.PinnedTime = time.Time{}
 	return err
 }
 
@@ -345,6 +362,9 @@ func (c *Client) EndSession() {
 	// happen here indicate that something went wrong with the connection state,
 	// like it wasn't marked as pinned or attempted to return to the wrong pool.
 	_ = c.unpinConnection()
+	if c.Server != nil && c.IsImplicit {
+		atomic.AddInt64(&c.pool.implicitCheckedOut, -1)
+	}
 	c.pool.ReturnSession(c.Server)
 }
 
@@ -406,9 +426,7 @@ func (c *Client) StartTransaction(opts *TransactionOptions) error {
 		c.CurrentRp = c.transactionRp
 	}
 
-	if c.CurrentWc == nil {
-		c.CurrentWc = c.transactionWc
-	}
+	c.CurrentWc = c.TransactionWriteConcern(opts)
 
 	if !c.CurrentWc.Acknowledged() {
 		_ = c.clearTransactionOpts()
@@ -419,6 +437,16 @@ func (c *Client) StartTransaction(opts *TransactionOptions) error {
 	return c.ClearPinnedResources()
 }
 
+// TransactionWriteConcern returns the write concern of a transaction started
+// with opts: the write concern of opts, if set, or the default transaction
+// write concern of the session otherwise.
+func (c *Client) TransactionWriteConcern(opts *TransactionOptions) *writeconcern.WriteConcern {
+	if opts != nil && opts.WriteConcern != nil {
+		return opts.WriteConcern
+	}
+	return c.transactionWc
+}
+
 // CheckCommitTransaction checks to see if allowed to commit transaction and returns
 // an error if not allowed.
 func (c *Client) CheckCommitTransaction() error {
@@ -511,6 +539,7 @@ func (c *Client) ApplyCommand(desc description.Server) error {
 		// If this is in a transaction and the server is a mongos, pin it
 		if desc.Kind == description.ServerKindMongos {
 			c.PinnedServerAddr = &desc.Addr
+			c.PinnedTime = time.Now()
 		}
 	} else if c.TransactionState == Committed || c.TransactionState == Aborted {
 		c.TransactionState = None
@@ -284,6 +284,19 @@ func TestClientSession(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("snapshot time", func(t *testing.T) {
+		snapshot := true
+		snapshotTime := bson.Timestamp{T: 42, I: 1}
+
+		id, _ := uuid.New()
+		sess, err := NewClientSession(&Pool{}, id, &ClientOptions{Snapshot: &snapshot, SnapshotTime: &snapshotTime})
+		require.Nil(t, err, "unexpected NewClientSession error %v", err)
+		require.Equal(t, &snapshotTime, sess.SnapshotTime, "expected SnapshotTime to be set")
+
+		_, err = NewClientSession(&Pool{}, id, &ClientOptions{SnapshotTime: &snapshotTime})
+		require.NotNil(t, err, "expected error setting SnapshotTime without Snapshot")
+	})
 }
 
 func TestImplicitClientSession(t *testing.T) {
@@ -28,11 +28,42 @@ type topologyDescription struct {
 	timeoutMinutes *int64
 }
 
+// PoolStats contains counters describing the state of a Pool.
+type PoolStats struct {
+	// Idle is the number of server sessions in the pool that are available for
+	// reuse.
+	Idle int
+
+	// CheckedOut is the number of server sessions in use by client sessions.
+	// ImplicitCheckedOut is the subset of those in use by implicit sessions,
+	// which the driver ends automatically when an operation or cursor
+	// completes; a steadily growing count may indicate cursors that are never
+	// closed. The remaining sessions are used by explicit sessions, which
+	// must be ended by the application.
+	CheckedOut         int64
+	ImplicitCheckedOut int64
+
+	// Created is the total number of server sessions created by the pool.
+	Created int64
+
+	// Expired is the total number of server sessions discarded because they
+	// were about to time out on the server, and Dirty the total number
+	// discarded because a network error occurred while they were in use.
+	Expired int64
+	Dirty   int64
+}
+
 // Pool is a pool of server sessions that can be reused.
 type Pool struct {
 	// number of sessions checked out of pool (accessed atomically)
 	checkedOut int64
 
+	// counters reported by Stats (accessed atomically)
+	implicitCheckedOut int64
+	created            int64
+	expired            int64
+	dirty              int64
+
 	descChan       <-chan description.Topology
 	head           *Node
 	tail           *Node
@@ -47,6 +78,7 @@ func (p *Pool) createServerSession() (*Server, error) {
 	}
 
 	atomic.AddInt64(&p.checkedOut, 1)
+	atomic.AddInt64(&p.created, 1)
 	return s, nil
 }
 
@@ -86,6 +118,7 @@ func (p *Pool) GetSession() (*Server, error) {
 	for p.head != nil {
 		// pull session from head of queue and return if it is valid for at least 1 more minute
 		if p.head.expired(p.latestTopology) {
+			atomic.AddInt64(&p.expired, 1)
 			p.head = p.head.next
 			continue
 		}
@@ -125,19 +158,25 @@ func (p *Pool) ReturnSession(ss *Server) {
 	// check sessions at end of queue for expired
 	// stop checking after hitting the first valid session
 	for p.tail != nil && p.tail.expired(p.latestTopology) {
+		atomic.AddInt64(&p.expired, 1)
 		if p.tail.prev != nil {
 			p.tail.prev.next = nil
 		}
 		p.tail = p.tail.prev
 	}
+	if p.tail == nil {
+		p.head = nil
+	}
 
 	// session expired
 	if ss.expired(p.latestTopology) {
+		atomic.AddInt64(&p.expired, 1)
 		return
 	}
 
 	// session is dirty
 	if ss.Dirty {
+		atomic.AddInt64(&p.dirty, 1)
 		return
 	}
 
@@ -190,3 +229,22 @@ func (p *Pool) String() string {
 func (p *Pool) CheckedOut() int64 {
 	return atomic.LoadInt64(&p.checkedOut)
 }
+
+// Stats returns the current counters of the pool.
+func (p *Pool) Stats() PoolStats {
+	p.mutex.Lock()
+	idle := 0
+	for node := p.head; node != nil; node = node.next {
+		idle++
+	}
+	p.mutex.Unlock()
+
+	return PoolStats{
+		Idle:               idle,
+		CheckedOut:         atomic.LoadInt64(&p.checkedOut),
+		ImplicitCheckedOut: atomic.LoadInt64(&p.implicitCheckedOut),
+		Created:            atomic.LoadInt64(&p.created),
+		Expired:            atomic.LoadInt64(&p.expired),
+		Dirty:              atomic.LoadInt64(&p.dirty),
+	}
+}
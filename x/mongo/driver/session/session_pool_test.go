@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/uuid"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
 )
 
@@ -69,4 +70,31 @@ func TestSessionPool(t *testing.T) {
 		assert.False(t, bytes.Equal(sess.SessionID, firstID), "first expired session was not removed")
 		assert.False(t, bytes.Equal(sess.SessionID, secondID), "second expired session was not removed")
 	})
+	t.Run("TestStats", func(t *testing.T) {
+		descChan := make(chan description.Topology)
+		p := NewPool(descChan)
+		p.latestTopology = topologyDescription{
+			timeoutMinutes: int64ToPtr(30),
+		}
+
+		id, _ := uuid.New()
+		implicit := NewImplicitClientSession(p, id)
+		assert.Nil(t, implicit.SetServer(), "SetServer error")
+		explicit, err := NewClientSession(p, id)
+		assert.Nil(t, err, "NewClientSession error: %v", err)
+		dirty, err := NewClientSession(p, id)
+		assert.Nil(t, err, "NewClientSession error: %v", err)
+
+		assert.Equal(t, PoolStats{CheckedOut: 3, ImplicitCheckedOut: 1, Created: 3}, p.Stats())
+
+		implicit.EndSession()
+		dirty.Server.MarkDirty()
+		dirty.EndSession()
+		assert.Equal(t, PoolStats{Idle: 1, CheckedOut: 1, Created: 3, Dirty: 1}, p.Stats())
+
+		// Sessions returned when the session timeout is unknown are expired.
+		p.latestTopology = topologyDescription{}
+		explicit.EndSession()
+		assert.Equal(t, PoolStats{Created: 3, Expired: 2, Dirty: 1}, p.Stats())
+	})
 }
@@ -0,0 +1,219 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+)
+
+const (
+	defaultBreakerWindow        = 10 * time.Second
+	defaultBreakerMinOperations = 20
+	defaultBreakerFailureRate   = 0.5
+	defaultBreakerCooldown      = 30 * time.Second
+)
+
+// circuitBreaker tracks the error rate and latency of the operations run on
+// each server and trips servers that exceed the configured thresholds.
+type circuitBreaker struct {
+	window           time.Duration
+	minOperations    int
+	failureRate      float64
+	latencyThreshold time.Duration
+	cooldown         time.Duration
+	quarantine       bool
+
+	now       func() time.Time
+	onTripped func(*event.ServerTrippedEvent)
+	onRestore func(*event.ServerRestoredEvent)
+
+	mu      sync.Mutex
+	servers map[address.Address]*breakerState
+}
+
+// breakerState is the state of a single server. The counters cover the
+// window that started at windowStart.
+type breakerState struct {
+	windowStart  time.Time
+	operations   int
+	failures     int
+	latencyOps   int
+	latencyTotal time.Duration
+	trippedUntil time.Time
+}
+
+func newCircuitBreaker(opts *options.CircuitBreakerOptions) *circuitBreaker {
+	cb := &circuitBreaker{
+		window:        defaultBreakerWindow,
+		minOperations: defaultBreakerMinOperations,
+		failureRate:   defaultBreakerFailureRate,
+		cooldown:      defaultBreakerCooldown,
+		now:           time.Now,
+		servers:       make(map[address.Address]*breakerState),
+	}
+	if opts.Window != nil {
+		cb.window = *opts.Window
+	}
+	if opts.MinOperations != nil {
+		cb.minOperations = *opts.MinOperations
+	}
+	if opts.FailureRateThreshold != nil {
+		cb.failureRate = *opts.FailureRateThreshold
+	}
+	if opts.LatencyThreshold != nil {
+		cb.latencyThreshold = *opts.LatencyThreshold
+	}
+	if opts.Cooldown != nil {
+		cb.cooldown = *opts.Cooldown
+	}
+	if opts.Quarantine != nil {
+		cb.quarantine = *opts.Quarantine
+	}
+	return cb
+}
+
+// isBreakerFailure returns true if err indicates that the server is unhealthy.
+// Errors caused by the application canceling the operation are not failures.
+func isBreakerFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var de driver.Error
+	return errors.As(err, &de) && de.RetryableRead()
+}
+
+// record records the outcome of a command run on the server at addr.
+func (cb *circuitBreaker) record(addr address.Address, cmdName string, err error, duration time.Duration) {
+	var tripped *event.ServerTrippedEvent
+	var restored bool
+
+	cb.mu.Lock()
+	now := cb.now()
+	st := cb.state(addr, now)
+	restored = cb.restore(st, now)
+	if now.Before(st.trippedUntil) {
+		// Operations that were started before the server was tripped do not
+		// count towards the next window.
+		cb.mu.Unlock()
+		return
+	}
+	if now.Sub(st.windowStart) >= cb.window {
+		*st = breakerState{windowStart: now}
+	}
+
+	st.operations++
+	if isBreakerFailure(err) {
+		st.failures++
+	}
+	if cmdName != "getMore" {
+		st.latencyOps++
+		st.latencyTotal += duration
+	}
+
+	if st.operations >= cb.minOperations {
+		var avgLatency time.Duration
+		if st.latencyOps > 0 {
+			avgLatency = st.latencyTotal / time.Duration(st.latencyOps)
+		}
+		failureRate := float64(st.failures) / float64(st.operations)
+		if failureRate >= cb.failureRate || (cb.latencyThreshold > 0 && avgLatency >= cb.latencyThreshold) {
+			tripped = &event.ServerTrippedEvent{
+				Address:        addr,
+				Operations:     st.operations,
+				Failures:       st.failures,
+				AverageLatency: avgLatency,
+				Until:          now.Add(cb.cooldown),
+			}
+			*st = breakerState{trippedUntil: tripped.Until}
+		}
+	}
+	cb.mu.Unlock()
+
+	if restored && cb.onRestore != nil {
+		cb.onRestore(&event.ServerRestoredEvent{Address: addr})
+	}
+	if tripped != nil && cb.onTripped != nil {
+		cb.onTripped(tripped)
+	}
+}
+
+// state returns the state of the server at addr. It must be called with mu
+// held.
+func (cb *circuitBreaker) state(addr address.Address, now time.Time) *breakerState {
+	st, ok := cb.servers[addr]
+	if !ok {
+		st = &breakerState{windowStart: now}
+		cb.servers[addr] = st
+	}
+	return st
+}
+
+// restore resets st and returns true if st is tripped and its cooldown has
+// expired. It must be called with mu held.
+func (cb *circuitBreaker) restore(st *breakerState, now time.Time) bool {
+	if st.trippedUntil.IsZero() || now.Before(st.trippedUntil) {
+		return false
+	}
+	*st = breakerState{windowStart: now}
+	return true
+}
+
+// filter removes tripped servers from candidates. If no candidate is healthy,
+// candidates are returned as-is unless tripped servers are quarantined.
+func (cb *circuitBreaker) filter(candidates []description.Server) []description.Server {
+	if cb == nil || len(candidates) == 0 {
+		return candidates
+	}
+
+	var restored []address.Address
+	healthy := make([]description.Server, 0, len(candidates))
+
+	cb.mu.Lock()
+	now := cb.now()
+	for _, candidate := range candidates {
+		st, ok := cb.servers[candidate.Addr]
+		if ok && cb.restore(st, now) {
+			restored = append(restored, candidate.Addr)
+		}
+		if !ok || st.trippedUntil.IsZero() {
+			healthy = append(healthy, candidate)
+		}
+	}
+	cb.mu.Unlock()
+
+	if cb.onRestore != nil {
+		for _, addr := range restored {
+			cb.onRestore(&event.ServerRestoredEvent{Address: addr})
+		}
+	}
+
+	if len(healthy) == 0 && !cb.quarantine {
+		return candidates
+	}
+	return healthy
+}
+
+// remove forgets the state of the server at addr.
+func (cb *circuitBreaker) remove(addr address.Address) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	delete(cb.servers, addr)
+}
@@ -0,0 +1,167 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+)
+
+// testBreaker is a circuitBreaker with a manual clock that records the events
+// it publishes.
+type testBreaker struct {
+	*circuitBreaker
+
+	clock    time.Time
+	tripped  []*event.ServerTrippedEvent
+	restored []*event.ServerRestoredEvent
+}
+
+func newTestBreaker(opts *options.CircuitBreakerOptions) *testBreaker {
+	tb := &testBreaker{
+		circuitBreaker: newCircuitBreaker(opts),
+		clock:          time.Now(),
+	}
+	tb.now = func() time.Time { return tb.clock }
+	tb.onTripped = func(evt *event.ServerTrippedEvent) { tb.tripped = append(tb.tripped, evt) }
+	tb.onRestore = func(evt *event.ServerRestoredEvent) { tb.restored = append(tb.restored, evt) }
+	return tb
+}
+
+func (tb *testBreaker) recordN(addr address.Address, n int, err error, d time.Duration) {
+	for i := 0; i < n; i++ {
+		tb.record(addr, "find", err, d)
+	}
+}
+
+func addrs(servers []description.Server) []address.Address {
+	var as []address.Address
+	for _, s := range servers {
+		as = append(as, s.Addr)
+	}
+	return as
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	const a, b = address.Address("a:27017"), address.Address("b:27017")
+	candidates := []description.Server{{Addr: a}, {Addr: b}}
+	networkErr := driver.Error{Labels: []string{driver.NetworkError}}
+
+	t.Run("trips on failure rate", func(t *testing.T) {
+		tb := newTestBreaker(options.CircuitBreaker().SetMinOperations(4).SetCooldown(time.Minute))
+
+		tb.recordN(a, 2, nil, time.Millisecond)
+		tb.recordN(a, 1, networkErr, time.Millisecond)
+		assert.Len(t, tb.tripped, 0)
+
+		tb.recordN(a, 1, networkErr, time.Millisecond)
+		require.Len(t, tb.tripped, 1)
+		assert.Equal(t, a, tb.tripped[0].Address)
+		assert.Equal(t, 4, tb.tripped[0].Operations)
+		assert.Equal(t, 2, tb.tripped[0].Failures)
+		assert.Equal(t, tb.clock.Add(time.Minute), tb.tripped[0].Until)
+
+		assert.Equal(t, []address.Address{b}, addrs(tb.filter(candidates)))
+	})
+	t.Run("trips on latency", func(t *testing.T) {
+		tb := newTestBreaker(options.CircuitBreaker().SetMinOperations(2).SetLatencyThreshold(100 * time.Millisecond))
+
+		tb.recordN(a, 2, nil, 150*time.Millisecond)
+		require.Len(t, tb.tripped, 1)
+		assert.Equal(t, 150*time.Millisecond, tb.tripped[0].AverageLatency)
+		assert.Equal(t, 0, tb.tripped[0].Failures)
+	})
+	t.Run("ignores getMore latency", func(t *testing.T) {
+		tb := newTestBreaker(options.CircuitBreaker().SetMinOperations(2).SetLatencyThreshold(100 * time.Millisecond))
+
+		tb.record(a, "getMore", nil, time.Minute)
+		tb.record(a, "find", nil, time.Millisecond)
+		assert.Len(t, tb.tripped, 0)
+	})
+	t.Run("ignores non-server errors", func(t *testing.T) {
+		tb := newTestBreaker(options.CircuitBreaker().SetMinOperations(2))
+
+		tb.recordN(a, 1, driver.Error{Code: 11000}, time.Millisecond)
+		tb.recordN(a, 1, driver.Error{Labels: []string{driver.NetworkError}, Wrapped: context.Canceled}, time.Millisecond)
+		tb.recordN(a, 1, errors.New("decode error"), time.Millisecond)
+		assert.Len(t, tb.tripped, 0)
+	})
+	t.Run("window resets counts", func(t *testing.T) {
+		tb := newTestBreaker(options.CircuitBreaker().SetMinOperations(2).SetWindow(time.Second))
+
+		tb.recordN(a, 1, networkErr, time.Millisecond)
+		tb.clock = tb.clock.Add(2 * time.Second)
+		tb.recordN(a, 1, nil, time.Millisecond)
+		assert.Len(t, tb.tripped, 0)
+	})
+	t.Run("restores after cooldown", func(t *testing.T) {
+		tb := newTestBreaker(options.CircuitBreaker().SetMinOperations(1).SetCooldown(time.Minute))
+
+		tb.recordN(a, 1, networkErr, time.Millisecond)
+		require.Len(t, tb.tripped, 1)
+
+		// Operations that finish while the server is tripped are not recorded.
+		tb.recordN(a, 1, networkErr, time.Millisecond)
+		assert.Len(t, tb.tripped, 1)
+
+		tb.clock = tb.clock.Add(time.Minute)
+		assert.Equal(t, []address.Address{a, b}, addrs(tb.filter(candidates)))
+		require.Len(t, tb.restored, 1)
+		assert.Equal(t, a, tb.restored[0].Address)
+	})
+	t.Run("deprioritizes when all tripped", func(t *testing.T) {
+		tb := newTestBreaker(options.CircuitBreaker().SetMinOperations(1))
+
+		tb.recordN(a, 1, networkErr, time.Millisecond)
+		tb.recordN(b, 1, networkErr, time.Millisecond)
+		assert.Equal(t, []address.Address{a, b}, addrs(tb.filter(candidates)))
+	})
+	t.Run("quarantines when all tripped", func(t *testing.T) {
+		tb := newTestBreaker(options.CircuitBreaker().SetMinOperations(1).SetQuarantine(true))
+
+		tb.recordN(a, 1, networkErr, time.Millisecond)
+		tb.recordN(b, 1, networkErr, time.Millisecond)
+		assert.Len(t, tb.filter(candidates), 0)
+	})
+	t.Run("nil breaker", func(t *testing.T) {
+		var cb *circuitBreaker
+		assert.Equal(t, candidates, cb.filter(candidates))
+		cb.remove(a)
+	})
+}
+
+func TestTopologyCircuitBreaker(t *testing.T) {
+	var tripped []*event.ServerTrippedEvent
+	cfg, err := NewConfig(options.Client().
+		SetHosts([]string{"a:27017"}).
+		SetCircuitBreaker(options.CircuitBreaker().SetMinOperations(1)).
+		SetServerMonitor(&event.ServerMonitor{
+			ServerTripped: func(evt *event.ServerTrippedEvent) { tripped = append(tripped, evt) },
+		}), nil)
+	require.NoError(t, err, "NewConfig error")
+
+	topo, err := New(cfg)
+	require.NoError(t, err, "New error")
+	require.NotNil(t, topo.breaker, "expected circuit breaker to be configured")
+
+	ss := &SelectedServer{Server: &Server{address: "a:27017"}, breaker: topo.breaker}
+	ss.ObserveOperation("find", driver.Error{Labels: []string{driver.NetworkError}}, time.Millisecond)
+
+	require.Len(t, tripped, 1)
+	assert.Equal(t, topo.id, tripped[0].TopologyID)
+	assert.Equal(t, address.Address("a:27017"), tripped[0].Address)
+}
@@ -52,6 +52,10 @@ type connection struct {
 	// - suggested layout: https://go101.org/article/memory-layout.html
 	state int64
 
+	// pinned is 1 if the connection is pinned to a cursor or transaction and 0 otherwise. It must be accessed using
+	// the atomic package.
+	pinned int32
+
 	id                   string
 	nc                   net.Conn // When nil, the connection is closed.
 	addr                 address.Address
@@ -224,6 +228,10 @@ func (c *connection) connect(ctx context.Context) (err error) {
 			Cache:                   c.config.ocspCache,
 			DisableEndpointChecking: c.config.disableOCSPEndpointCheck,
 			HTTPClient:              c.config.httpClient,
+			HardFail:                c.config.ocspHardFail,
+		}
+		if c.config.ocspHTTPClient != nil {
+			ocspOpts.HTTPClient = c.config.ocspHTTPClient
 		}
 		tlsNc, err := configureTLS(ctx, c.config.tlsConnectionSource, c.nc, c.addr, tlsConfig, ocspOpts)
 
@@ -314,6 +322,19 @@ func (c *connection) wait() {
 	}
 }
 
+// established returns true if the connection has finished connecting, after which its description can be read.
+func (c *connection) established() bool {
+	if c.connectDone == nil {
+		return true
+	}
+	select {
+	case <-c.connectDone:
+		return true
+	default:
+		return false
+	}
+}
+
 func (c *connection) closeConnectContext() {
 	if c.connectListener != nil {
 		c.connectListener.StopListening()
@@ -373,6 +394,7 @@ func (c *connection) writeWireMessage(ctx context.Context, wm []byte) error {
 		}
 	}
 
+	c.publishWireMessage(ctx, wm, true)
 	return nil
 }
 
@@ -422,6 +444,7 @@ func (c *connection) readWireMessage(ctx context.Context) ([]byte, error) {
 		}
 	}
 
+	c.publishWireMessage(ctx, dst, false)
 	return dst, nil
 }
 
@@ -643,6 +666,7 @@ var _ mnet.ReadWriteCloser = (*Connection)(nil)
 var _ mnet.Describer = (*Connection)(nil)
 var _ mnet.Compressor = (*Connection)(nil)
 var _ mnet.Pinner = (*Connection)(nil)
+var _ mnet.Streamer = (*Connection)(nil)
 var _ driver.Expirable = (*Connection)(nil)
 
 // WriteWireMessage handles writing a wire message to the underlying connection.
@@ -699,6 +723,32 @@ func (c *Connection) CompressWireMessage(src, dst []byte) ([]byte, error) {
 	return bsoncore.UpdateLength(dst, idx, int32(len(dst[idx:]))), nil
 }
 
+// SetStreaming records whether the server is streaming responses on this connection because the last response had
+// the moreToCome flag set.
+func (c *Connection) SetStreaming(streaming bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.connection != nil {
+		c.connection.setStreaming(streaming)
+	}
+}
+
+// CurrentlyStreaming returns whether the server is streaming responses on this connection, in which case the next
+// response must be read without sending a request and the connection must not be used for other operations.
+func (c *Connection) CurrentlyStreaming() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.connection != nil && c.connection.getCurrentlyStreaming()
+}
+
+// SupportsStreaming returns whether every operation on this connection allows the server to stream responses. It is
+// false for pooled connections, which only allow streaming for operations that set Operation.ExhaustAllowed.
+func (c *Connection) SupportsStreaming() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.connection != nil && c.connection.canStream
+}
+
 // Description returns the server description of the server this connection is connected to.
 func (c *Connection) Description() description.Server {
 	c.mu.RLock()
@@ -818,7 +868,12 @@ func (c *Connection) pin(reason string, updatePoolFn, cleanupPoolFn func()) erro
 	// in the pool.
 	if c.refCount == 0 {
 		updatePoolFn()
-		c.cleanupPoolFn = cleanupPoolFn
+		conn := c.connection
+		atomic.StoreInt32(&conn.pinned, 1)
+		c.cleanupPoolFn = func() {
+			atomic.StoreInt32(&conn.pinned, 0)
+			cleanupPoolFn()
+		}
 	}
 	c.refCount++
 	return nil
@@ -52,12 +52,15 @@ type connectionConfig struct {
 	handshaker               Handshaker
 	idleTimeout              time.Duration
 	cmdMonitor               *event.CommandMonitor
+	wireMonitor              *event.WireMonitor
 	tlsConfig                *tls.Config
 	httpClient               *http.Client
 	compressors              []string
 	zlibLevel                *int
 	zstdLevel                *int
 	ocspCache                ocsp.Cache
+	ocspHTTPClient           *http.Client
+	ocspHardFail             bool
 	disableOCSPEndpointCheck bool
 	tlsConnectionSource      tlsConnectionSource
 	loadBalanced             bool
@@ -146,6 +149,13 @@ func WithMonitor(fn func(*event.CommandMonitor) *event.CommandMonitor) Connectio
 	}
 }
 
+// WithWireMonitor configures a monitor for the raw wire messages sent and received on the connection.
+func WithWireMonitor(fn func(*event.WireMonitor) *event.WireMonitor) ConnectionOption {
+	return func(c *connectionConfig) {
+		c.wireMonitor = fn(c.wireMonitor)
+	}
+}
+
 // WithZlibLevel sets the zLib compression level.
 func WithZlibLevel(fn func(*int) *int) ConnectionOption {
 	return func(c *connectionConfig) {
@@ -167,6 +177,22 @@ func WithOCSPCache(fn func(ocsp.Cache) ocsp.Cache) ConnectionOption {
 	}
 }
 
+// WithOCSPHTTPClient specifies the HTTP client to use for requests to OCSP responders. If it is not set, the HTTP
+// client configured with WithHTTPClient is used.
+func WithOCSPHTTPClient(fn func(*http.Client) *http.Client) ConnectionOption {
+	return func(c *connectionConfig) {
+		c.ocspHTTPClient = fn(c.ocspHTTPClient)
+	}
+}
+
+// WithOCSPHardFail specifies whether OCSP verification should fail the connection if the status of the server's
+// certificate cannot be determined. By default, the connection is allowed to continue.
+func WithOCSPHardFail(fn func(bool) bool) ConnectionOption {
+	return func(c *connectionConfig) {
+		c.ocspHardFail = fn(c.ocspHardFail)
+	}
+}
+
 // WithDisableOCSPEndpointCheck specifies whether or the driver should perform non-stapled OCSP verification. If set
 // to true, the driver will only check stapled responses and will continue the connection without reaching out to
 // OCSP responders.
@@ -176,6 +176,14 @@ func connectionPerished(conn *connection) (reason, bool) {
 			loggerConn: logger.ReasonConnClosedError,
 			event:      event.ReasonError,
 		}, true
+	case conn.getCurrentlyStreaming():
+		// A connection that the server is still streaming responses on, such
+		// as one used by an exhaust cursor that was not closed, cannot be used
+		// for another operation.
+		return reason{
+			loggerConn: logger.ReasonConnClosedError,
+			event:      event.ReasonError,
+		}, true
 	case conn.idleTimeoutExpired():
 		return reason{
 			loggerConn: logger.ReasonConnClosedIdle,
@@ -267,6 +275,7 @@ func newPool(config poolConfig, connOpts ...ConnectionOption) *pool {
 	if pool.monitor != nil {
 		pool.monitor.Event(&event.PoolEvent{
 			Type: event.ConnectionPoolCreated,
+			Time: time.Now(),
 			PoolOptions: &event.MonitorPoolOptions{
 				MaxPoolSize: config.MaxPoolSize,
 				MinPoolSize: config.MinPoolSize,
@@ -310,6 +319,7 @@ func (p *pool) ready() error {
 	if p.monitor != nil {
 		p.monitor.Event(&event.PoolEvent{
 			Type:    event.ConnectionPoolReady,
+			Time:    time.Now(),
 			Address: p.address.String(),
 		})
 	}
@@ -421,6 +431,7 @@ func (p *pool) close(ctx context.Context) {
 	if p.monitor != nil {
 		p.monitor.Event(&event.PoolEvent{
 			Type:    event.ConnectionPoolClosed,
+			Time:    time.Now(),
 			Address: p.address.String(),
 		})
 	}
@@ -468,6 +479,7 @@ func (p *pool) checkOut(ctx context.Context) (conn *connection, err error) {
 	if p.monitor != nil {
 		p.monitor.Event(&event.PoolEvent{
 			Type:    event.ConnectionCheckOutStarted,
+			Time:    time.Now(),
 			Address: p.address.String(),
 		})
 	}
@@ -496,6 +508,7 @@ func (p *pool) checkOut(ctx context.Context) (conn *connection, err error) {
 		if p.monitor != nil {
 			p.monitor.Event(&event.PoolEvent{
 				Type:     event.ConnectionCheckOutFailed,
+				Time:     time.Now(),
 				Address:  p.address.String(),
 				Duration: duration,
 				Reason:   event.ReasonPoolClosed,
@@ -531,6 +544,7 @@ func (p *pool) checkOut(ctx context.Context) (conn *connection, err error) {
 		if p.monitor != nil {
 			p.monitor.Event(&event.PoolEvent{
 				Type:     event.ConnectionCheckOutFailed,
+				Time:     time.Now(),
 				Address:  p.address.String(),
 				Reason:   event.ReasonConnectionErrored,
 				Duration: duration,
@@ -577,6 +591,7 @@ func (p *pool) checkOut(ctx context.Context) (conn *connection, err error) {
 			if p.monitor != nil {
 				p.monitor.Event(&event.PoolEvent{
 					Type:     event.ConnectionCheckOutFailed,
+					Time:     time.Now(),
 					Address:  p.address.String(),
 					Duration: duration,
 					Reason:   event.ReasonConnectionErrored,
@@ -599,6 +614,7 @@ func (p *pool) checkOut(ctx context.Context) (conn *connection, err error) {
 		if p.monitor != nil {
 			p.monitor.Event(&event.PoolEvent{
 				Type:         event.ConnectionCheckedOut,
+				Time:         time.Now(),
 				Address:      p.address.String(),
 				ConnectionID: w.conn.driverConnectionID,
 				Duration:     duration,
@@ -632,6 +648,7 @@ func (p *pool) checkOut(ctx context.Context) (conn *connection, err error) {
 			if p.monitor != nil {
 				p.monitor.Event(&event.PoolEvent{
 					Type:     event.ConnectionCheckOutFailed,
+					Time:     time.Now(),
 					Address:  p.address.String(),
 					Duration: duration,
 					Reason:   event.ReasonConnectionErrored,
@@ -655,6 +672,7 @@ func (p *pool) checkOut(ctx context.Context) (conn *connection, err error) {
 		if p.monitor != nil {
 			p.monitor.Event(&event.PoolEvent{
 				Type:         event.ConnectionCheckedOut,
+				Time:         time.Now(),
 				Address:      p.address.String(),
 				ConnectionID: w.conn.driverConnectionID,
 				Duration:     duration,
@@ -677,6 +695,7 @@ func (p *pool) checkOut(ctx context.Context) (conn *connection, err error) {
 		if p.monitor != nil {
 			p.monitor.Event(&event.PoolEvent{
 				Type:     event.ConnectionCheckOutFailed,
+				Time:     time.Now(),
 				Address:  p.address.String(),
 				Duration: duration,
 				Reason:   event.ReasonTimedOut,
@@ -771,6 +790,7 @@ func (p *pool) removeConnection(conn *connection, reason reason, err error) erro
 	if p.monitor != nil {
 		p.monitor.Event(&event.PoolEvent{
 			Type:         event.ConnectionClosed,
+			Time:         time.Now(),
 			Address:      p.address.String(),
 			ConnectionID: conn.driverConnectionID,
 			Reason:       reason.event,
@@ -880,6 +900,7 @@ func (p *pool) checkIn(conn *connection) error {
 	if p.monitor != nil {
 		p.monitor.Event(&event.PoolEvent{
 			Type:         event.ConnectionCheckedIn,
+			Time:         time.Now(),
 			ConnectionID: conn.driverConnectionID,
 			Address:      conn.addr.String(),
 		})
@@ -1029,6 +1050,7 @@ func (p *pool) clearImpl(err error, serviceID *bson.ObjectID, interruptAllConnec
 	if sendEvent && p.monitor != nil {
 		event := &event.PoolEvent{
 			Type:         event.ConnectionPoolCleared,
+			Time:         time.Now(),
 			Address:      p.address.String(),
 			ServiceID:    serviceID,
 			Interruption: interruptAllConnections,
@@ -1139,6 +1161,93 @@ func (p *pool) queueForNewConn(w *wantConn) {
 	p.createConnectionsCond.Signal()
 }
 
+// PoolStats contains statistics about the connection pool of a server.
+type PoolStats struct {
+	// Open is the number of open connections, including connections that are
+	// being established.
+	Open int
+
+	// Idle is the number of connections that are available to be checked out.
+	Idle int
+
+	// MaxSize is the maximum number of connections in the pool. Zero means
+	// there is no limit.
+	MaxSize uint64
+
+	// Services contains the statistics of the connections to each service
+	// behind a load balancer, keyed by service ID. Connections that are still
+	// being established are not included because their service is not known
+	// yet. It is nil if the deployment is not load balanced.
+	Services map[bson.ObjectID]ServicePoolStats
+}
+
+// ServicePoolStats contains statistics about the connections to a single
+// service behind a load balancer.
+type ServicePoolStats struct {
+	// Open is the number of established connections to the service.
+	Open int
+
+	// Idle is the number of connections to the service that are available to
+	// be checked out.
+	Idle int
+
+	// Pinned is the number of connections to the service that are pinned to a
+	// cursor or transaction.
+	Pinned int
+}
+
+// InUse returns the number of connections that are checked out or being
+// established.
+func (ps PoolStats) InUse() int {
+	return ps.Open - ps.Idle
+}
+
+func (p *pool) stats() PoolStats {
+	stats := PoolStats{
+		Open:    p.totalConnectionCount(),
+		Idle:    p.availableConnectionCount(),
+		MaxSize: p.maxSize,
+	}
+	if p.loadBalanced {
+		stats.Services = p.serviceStats()
+	}
+	return stats
+}
+
+// serviceStats returns the statistics of the established connections to each
+// service behind a load balancer.
+func (p *pool) serviceStats() map[bson.ObjectID]ServicePoolStats {
+	services := make(map[bson.ObjectID]ServicePoolStats)
+
+	p.createConnectionsCond.L.Lock()
+	for _, conn := range p.conns {
+		if !conn.established() || conn.desc.ServiceID == nil {
+			continue
+		}
+		s := services[*conn.desc.ServiceID]
+		s.Open++
+		if atomic.LoadInt32(&conn.pinned) == 1 {
+			s.Pinned++
+		}
+		services[*conn.desc.ServiceID] = s
+	}
+	p.createConnectionsCond.L.Unlock()
+
+	p.idleMu.Lock()
+	for _, conn := range p.idleConns {
+		if conn.desc.ServiceID == nil {
+			continue
+		}
+		if s, ok := services[*conn.desc.ServiceID]; ok {
+			s.Idle++
+			services[*conn.desc.ServiceID] = s
+		}
+	}
+	p.idleMu.Unlock()
+
+	return services
+}
+
 func (p *pool) totalConnectionCount() int {
 	p.createConnectionsCond.L.Lock()
 	defer p.createConnectionsCond.L.Unlock()
@@ -1214,6 +1323,7 @@ func (p *pool) createConnections(ctx context.Context, wg *sync.WaitGroup) {
 		if p.monitor != nil {
 			p.monitor.Event(&event.PoolEvent{
 				Type:         event.ConnectionCreated,
+				Time:         time.Now(),
 				Address:      p.address.String(),
 				ConnectionID: conn.driverConnectionID,
 			})
@@ -1276,6 +1386,7 @@ func (p *pool) createConnections(ctx context.Context, wg *sync.WaitGroup) {
 		if p.monitor != nil {
 			p.monitor.Event(&event.PoolEvent{
 				Type:         event.ConnectionReady,
+				Time:         time.Now(),
 				Address:      p.address.String(),
 				ConnectionID: conn.driverConnectionID,
 				Duration:     duration,
@@ -15,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/csot"
@@ -22,6 +23,8 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo/address"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/operation"
 )
 
@@ -1608,3 +1611,67 @@ func TestPool_Error(t *testing.T) {
 		p.close(context.Background())
 	})
 }
+
+func TestPool_ServiceStats(t *testing.T) {
+	t.Parallel()
+
+	serviceA, serviceB := bson.NewObjectID(), bson.NewObjectID()
+	services := []bson.ObjectID{serviceA, serviceA, serviceB}
+
+	var mu sync.Mutex
+	handshaker := &testHandshaker{
+		getHandshakeInformation: func(context.Context, address.Address, *mnet.Connection) (driver.HandshakeInformation, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			serviceID := services[0]
+			services = services[1:]
+			return driver.HandshakeInformation{
+				Description: description.Server{ServiceID: &serviceID},
+			}, nil
+		},
+	}
+
+	addr := bootstrapConnections(t, 3, func(net.Conn) {})
+	p := newPool(
+		poolConfig{
+			Address:        address.Address(addr.String()),
+			ConnectTimeout: defaultConnectionTimeout,
+			LoadBalanced:   true,
+		},
+		WithHandshaker(func(Handshaker) Handshaker { return handshaker }),
+		WithConnectionLoadBalanced(func(bool) bool { return true }),
+	)
+	err := p.ready()
+	require.NoError(t, err, "ready error")
+	defer p.close(context.Background())
+
+	conns := make([]*connection, 0, 3)
+	for i := 0; i < 3; i++ {
+		conn, err := p.checkOut(context.Background())
+		require.NoError(t, err, "checkOut error")
+		conns = append(conns, conn)
+	}
+
+	// Pin the first connection to service A and return the second one to the
+	// pool.
+	pinned := &Connection{connection: conns[0]}
+	err = pinned.PinToCursor()
+	require.NoError(t, err, "PinToCursor error")
+	err = p.checkIn(conns[1])
+	require.NoError(t, err, "checkIn error")
+
+	want := map[bson.ObjectID]ServicePoolStats{
+		serviceA: {Open: 2, Idle: 1, Pinned: 1},
+		serviceB: {Open: 1},
+	}
+	assert.Equal(t, want, p.stats().Services)
+
+	err = pinned.UnpinFromCursor()
+	require.NoError(t, err, "UnpinFromCursor error")
+	err = pinned.Close()
+	require.NoError(t, err, "Close error")
+
+	want[serviceA] = ServicePoolStats{Open: 2, Idle: 2}
+	assert.Equal(t, want, p.stats().Services)
+}
@@ -10,10 +10,13 @@ import (
 	"container/list"
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/operation"
 )
@@ -22,8 +25,24 @@ const (
 	rttAlphaValue             = 0.2
 	minRTTSamplesForMovingMin = 2
 	maxRTTSamplesForMovingMin = 10
+	maxRTTSamplesForStats     = 50
 )
 
+// rttHistogramBounds are the upper bounds of the buckets of the RTT histogram, excluding the last bucket, which has
+// no upper bound.
+var rttHistogramBounds = []time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	20 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	200 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+}
+
 type rttConfig struct {
 	// The minimum interval between RTT measurements. The actual interval may be greater if running
 	// the operation takes longer than the interval.
@@ -36,7 +55,7 @@ type rttConfig struct {
 }
 
 type rttMonitor struct {
-	mu sync.RWMutex // mu guards samples, offset, minRTT, averageRTT, and averageRTTSet
+	mu sync.RWMutex // mu guards samples, histogram, minRTT, averageRTT, and averageRTTSet
 
 	// connMu guards connecting and disconnecting. This is necessary since
 	// disconnecting will await the cancellation of a started connection. The
@@ -49,6 +68,8 @@ type rttMonitor struct {
 	stddevRTT              time.Duration
 	stddevSum              float64
 	callsToAppendMovingMin int
+	samples                []time.Duration // samples holds the last maxRTTSamplesForStats samples, oldest first.
+	histogram              []int64         // histogram holds a count for each of rttHistogramBounds and one more.
 
 	closeWg  sync.WaitGroup
 	cfg      *rttConfig
@@ -70,6 +91,7 @@ func newRTTMonitor(cfg *rttConfig) *rttMonitor {
 		ctx:       ctx,
 		cancelFn:  cancel,
 		movingMin: list.New(),
+		histogram: make([]int64, len(rttHistogramBounds)+1),
 	}
 }
 
@@ -193,6 +215,8 @@ func (r *rttMonitor) reset() {
 	r.averageRTTSet = false
 	r.stddevSum = 0
 	r.callsToAppendMovingMin = 0
+	r.samples = nil
+	r.histogram = make([]int64, len(rttHistogramBounds)+1)
 }
 
 // appendMovingMin will append the RTT to the movingMin list which tracks a
@@ -250,6 +274,24 @@ func (r *rttMonitor) stddev() time.Duration {
 	return stddev
 }
 
+// appendStatsSample records the RTT in the recent samples and the histogram.
+func (r *rttMonitor) appendStatsSample(rtt time.Duration) {
+	if rtt < 0 {
+		return
+	}
+
+	if len(r.samples) == maxRTTSamplesForStats {
+		copy(r.samples, r.samples[1:])
+		r.samples = r.samples[:len(r.samples)-1]
+	}
+	r.samples = append(r.samples, rtt)
+
+	bucket := sort.Search(len(rttHistogramBounds), func(i int) bool {
+		return rtt <= rttHistogramBounds[i]
+	})
+	r.histogram[bucket]++
+}
+
 func (r *rttMonitor) addSample(rtt time.Duration) {
 	// Lock for the duration of this method. We're doing compuationally inexpensive work very infrequently, so lock
 	// contention isn't expected.
@@ -259,6 +301,7 @@ func (r *rttMonitor) addSample(rtt time.Duration) {
 	r.appendMovingMin(rtt)
 	r.minRTT = r.min()
 	r.stddevRTT = r.stddev()
+	r.appendStatsSample(rtt)
 
 	if !r.averageRTTSet {
 		r.averageRTT = rtt
@@ -285,6 +328,32 @@ func (r *rttMonitor) Min() time.Duration {
 	return r.minRTT
 }
 
+// RTTStats returns the recent samples, their 90th percentile, and the histogram of all samples since the monitor was
+// last reset.
+func (r *rttMonitor) RTTStats() description.RTTStats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stats := description.RTTStats{
+		Samples:   append([]time.Duration(nil), r.samples...),
+		Histogram: make([]description.RTTBucket, len(r.histogram)),
+	}
+	if len(stats.Samples) > 0 {
+		sorted := append([]time.Duration(nil), stats.Samples...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		// Use the nearest-rank method so that the percentile is always one of the samples.
+		stats.P90 = sorted[int(math.Ceil(0.9*float64(len(sorted))))-1]
+	}
+	for i, count := range r.histogram {
+		upperBound := time.Duration(math.MaxInt64)
+		if i < len(rttHistogramBounds) {
+			upperBound = rttHistogramBounds[i]
+		}
+		stats.Histogram[i] = description.RTTBucket{UpperBound: upperBound, Count: count}
+	}
+	return stats
+}
+
 // Stats returns stringified stats of the current state of the monitor.
 func (r *rttMonitor) Stats() string {
 	r.mu.RLock()
@@ -11,6 +11,7 @@ import (
 	"container/list"
 	"context"
 	"io"
+	"math"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -484,3 +485,85 @@ func TestRTTMonitor_stddev(t *testing.T) {
 		})
 	}
 }
+
+func TestRTTMonitor_RTTStats(t *testing.T) {
+	t.Parallel()
+
+	newMonitor := func() *rttMonitor {
+		return newRTTMonitor(&rttConfig{interval: time.Second})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		stats := newMonitor().RTTStats()
+		assert.Len(t, stats.Samples, 0)
+		assert.Equal(t, time.Duration(0), stats.P90)
+		require.Len(t, stats.Histogram, len(rttHistogramBounds)+1)
+		for _, bucket := range stats.Histogram {
+			assert.Equal(t, int64(0), bucket.Count)
+		}
+	})
+	t.Run("percentile and histogram", func(t *testing.T) {
+		t.Parallel()
+
+		rtt := newMonitor()
+		for _, sample := range makeArithmeticSamples(1, 10) {
+			rtt.addSample(sample)
+		}
+		rtt.addSample(2 * time.Second)
+
+		stats := rtt.RTTStats()
+		assert.Len(t, stats.Samples, 11)
+		assert.Equal(t, 2*time.Second, stats.Samples[10])
+		assert.Equal(t, 10*time.Millisecond, stats.P90)
+
+		counts := make(map[time.Duration]int64)
+		for _, bucket := range stats.Histogram {
+			counts[bucket.UpperBound] = bucket.Count
+		}
+		want := map[time.Duration]int64{
+			time.Millisecond:             1,
+			2 * time.Millisecond:         1,
+			5 * time.Millisecond:         3,
+			10 * time.Millisecond:        5,
+			20 * time.Millisecond:        0,
+			50 * time.Millisecond:        0,
+			100 * time.Millisecond:       0,
+			200 * time.Millisecond:       0,
+			500 * time.Millisecond:       0,
+			time.Second:                  0,
+			time.Duration(math.MaxInt64): 1,
+		}
+		assert.Equal(t, want, counts)
+	})
+	t.Run("keeps recent samples", func(t *testing.T) {
+		t.Parallel()
+
+		rtt := newMonitor()
+		samples := makeArithmeticSamples(1, maxRTTSamplesForStats+5)
+		for _, sample := range samples {
+			rtt.addSample(sample)
+		}
+
+		stats := rtt.RTTStats()
+		assert.Equal(t, samples[5:], stats.Samples)
+
+		var total int64
+		for _, bucket := range stats.Histogram {
+			total += bucket.Count
+		}
+		assert.Equal(t, int64(len(samples)), total, "expected histogram to count all samples")
+	})
+	t.Run("reset", func(t *testing.T) {
+		t.Parallel()
+
+		rtt := newMonitor()
+		rtt.addSample(time.Millisecond)
+		rtt.reset()
+
+		stats := rtt.RTTStats()
+		assert.Len(t, stats.Samples, 0)
+		assert.Equal(t, int64(0), stats.Histogram[0].Count)
+	})
+}
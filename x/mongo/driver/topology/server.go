@@ -89,6 +89,19 @@ type SelectedServer struct {
 	*Server
 
 	Kind description.TopologyKind
+
+	breaker *circuitBreaker
+}
+
+var _ driver.OperationObserver = (*SelectedServer)(nil)
+
+// ObserveOperation records the outcome of a command run on the server with the circuit breaker
+// of the topology, if any.
+func (ss *SelectedServer) ObserveOperation(cmdName string, err error, duration time.Duration) {
+	if ss.breaker == nil {
+		return
+	}
+	ss.breaker.record(ss.Server.address, cmdName, err, duration)
 }
 
 // Description returns a description of the server as of the last heartbeat.
@@ -1026,6 +1039,7 @@ func (s *Server) check(ctx context.Context) (description.Server, error) {
 		desc := *descPtr
 		desc.AverageRTT = s.rttMonitor.EWMA()
 		desc.AverageRTTSet = true
+		desc.RTTStats = s.rttMonitor.RTTStats()
 		desc.HeartbeatInterval = s.cfg.heartbeatInterval
 
 		return desc, nil
@@ -1071,6 +1085,11 @@ func (s *Server) OperationCount() int64 {
 	return atomic.LoadInt64(&s.operationCount)
 }
 
+// PoolStats returns statistics about the connection pool of this server.
+func (s *Server) PoolStats() PoolStats {
+	return s.pool.stats()
+}
+
 // String implements the Stringer interface.
 func (s *Server) String() string {
 	desc := s.Description()
@@ -1167,6 +1186,9 @@ func (s *Server) publishServerHeartbeatSucceededEvent(connectionID string,
 		ConnectionID: connectionID,
 		Awaited:      await,
 	}
+	if s != nil && s.rttMonitor != nil {
+		serverHeartbeatSucceeded.RTTStats = newEventRTTStats(s.rttMonitor.RTTStats())
+	}
 
 	if s != nil && s.cfg.serverMonitor != nil && s.cfg.serverMonitor.ServerHeartbeatSucceeded != nil {
 		s.cfg.serverMonitor.ServerHeartbeatSucceeded(serverHeartbeatSucceeded)
@@ -35,6 +35,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
 	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/internal/randutil"
+	"go.mongodb.org/mongo-driver/v2/internal/replay"
 	"go.mongodb.org/mongo-driver/v2/mongo/address"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
@@ -114,6 +115,8 @@ type Topology struct {
 	serversClosed bool
 	servers       map[address.Address]*Server
 
+	breaker *circuitBreaker
+
 	id bson.ObjectID
 }
 
@@ -144,6 +147,11 @@ func New(cfg *Config) (*Topology, error) {
 		id:                bson.NewObjectID(),
 	}
 	t.desc.Store(description.Topology{})
+	if cfg.CircuitBreaker != nil {
+		t.breaker = newCircuitBreaker(cfg.CircuitBreaker)
+		t.breaker.onTripped = t.publishServerTrippedEvent
+		t.breaker.onRestore = t.publishServerRestoredEvent
+	}
 	t.updateCallback = func(desc description.Server) description.Server {
 		return t.apply(context.Background(), desc)
 	}
@@ -424,6 +432,25 @@ func (t *Topology) Description() description.Topology {
 	return td
 }
 
+// EventDescription returns the current description of the topology in the
+// form used by monitoring events.
+func (t *Topology) EventDescription() event.TopologyDescription {
+	return newEventServerTopology(t.Description())
+}
+
+// PoolStats returns the connection pool statistics of each server in the
+// topology, keyed by server address.
+func (t *Topology) PoolStats() map[string]PoolStats {
+	t.serversLock.Lock()
+	defer t.serversLock.Unlock()
+
+	stats := make(map[string]PoolStats, len(t.servers))
+	for addr, s := range t.servers {
+		stats[addr.String()] = s.PoolStats()
+	}
+	return stats
+}
+
 // Kind returns the topology kind of this Topology.
 func (t *Topology) Kind() description.TopologyKind { return t.Description().Kind }
 
@@ -625,8 +652,14 @@ func (t *Topology) SelectServer(ctx context.Context, ss description.ServerSelect
 
 		// Of the two randomly selected suitable servers, pick the one with fewer in-use connections.
 		// We use in-use connections as an analog for in-progress operations because they are almost
-		// always the same value for a given server.
-		if server1.OperationCount() < server2.OperationCount() {
+		// always the same value for a given server. The choice is recorded so that it can be replayed.
+		choice := replay.Intn(replay.ServerSelection, 2, func(int) int {
+			if server1.OperationCount() < server2.OperationCount() {
+				return 0
+			}
+			return 1
+		})
+		if choice == 0 {
 			if mustLogServerSelection(t, logger.LevelDebug) {
 				logServerSelectionSucceeded(ctx, t, ss, server1)
 			}
@@ -647,7 +680,7 @@ func (t *Topology) SelectServer(ctx context.Context, ss description.ServerSelect
 // provided, pick2 will panic.
 func pick2(ds []description.Server) (description.Server, description.Server) {
 	// Select a random index from the input slice and keep the server description from that index.
-	idx := random.Intn(len(ds))
+	idx := replay.Intn(replay.ServerSelection, len(ds), random.Intn)
 	s1 := ds[idx]
 
 	// Swap the selected index to the end and reslice to remove it so we don't pick the same server
@@ -656,7 +689,7 @@ func pick2(ds []description.Server) (description.Server, description.Server) {
 	ds = ds[:len(ds)-1]
 
 	// Select another random index from the input slice and return both selected server descriptions.
-	return s1, ds[random.Intn(len(ds))]
+	return s1, ds[replay.Intn(replay.ServerSelection, len(ds), random.Intn)]
 }
 
 // FindServer will attempt to find a server that fits the given server description.
@@ -674,8 +707,9 @@ func (t *Topology) FindServer(selected description.Server) (*SelectedServer, err
 
 	desc := t.Description()
 	return &SelectedServer{
-		Server: server,
-		Kind:   desc.Kind,
+		Server:  server,
+		Kind:    desc.Kind,
+		breaker: t.breaker,
 	}, nil
 }
 
@@ -745,7 +779,7 @@ func (t *Topology) selectServerFromDescription(
 	if err != nil {
 		return nil, ServerSelectionError{Wrapped: err, Desc: desc}
 	}
-	return suitable, nil
+	return t.breaker.filter(suitable), nil
 }
 
 func (t *Topology) pollSRVRecords(hosts string) {
@@ -860,6 +894,7 @@ func (t *Topology) processSRVResults(parsedHosts []string) bool {
 			_ = s.Disconnect(cancelCtx)
 		}()
 		delete(t.servers, addr)
+		t.breaker.remove(addr)
 		t.fsm.removeServerByAddr(addr)
 		t.publishServerClosedEvent(s.address)
 	}
@@ -942,6 +977,7 @@ func (t *Topology) apply(ctx context.Context, desc description.Server) descripti
 				_ = s.Disconnect(cancelCtx)
 			}()
 			delete(t.servers, removed.Addr)
+			t.breaker.remove(removed.Addr)
 			t.publishServerClosedEvent(s.address)
 		}
 	}
@@ -1037,6 +1073,24 @@ func (t *Topology) publishServerClosedEvent(addr address.Address) {
 	}
 }
 
+// publishes a ServerTrippedEvent to indicate the circuit breaker has tripped a server
+func (t *Topology) publishServerTrippedEvent(evt *event.ServerTrippedEvent) {
+	evt.TopologyID = t.id
+
+	if t.cfg.ServerMonitor != nil && t.cfg.ServerMonitor.ServerTripped != nil {
+		t.cfg.ServerMonitor.ServerTripped(evt)
+	}
+}
+
+// publishes a ServerRestoredEvent to indicate the cooldown of a tripped server has expired
+func (t *Topology) publishServerRestoredEvent(evt *event.ServerRestoredEvent) {
+	evt.TopologyID = t.id
+
+	if t.cfg.ServerMonitor != nil && t.cfg.ServerMonitor.ServerRestored != nil {
+		t.cfg.ServerMonitor.ServerRestored(evt)
+	}
+}
+
 // publishes a TopologyDescriptionChangedEvent to indicate the topology description has changed
 func (t *Topology) publishTopologyDescriptionChangedEvent(prev description.Topology, current description.Topology) {
 	topologyDescriptionChanged := &event.TopologyDescriptionChangedEvent{
@@ -1100,6 +1154,7 @@ func newEventServerDescription(srv description.Server) event.ServerDescription {
 	evtSrv := event.ServerDescription{
 		Addr:                  srv.Addr,
 		Arbiters:              srv.Arbiters,
+		AverageRTT:            srv.AverageRTT,
 		Compression:           srv.Compression,
 		CanonicalAddr:         srv.CanonicalAddr,
 		ElectionID:            srv.ElectionID,
@@ -1107,6 +1162,8 @@ func newEventServerDescription(srv description.Server) event.ServerDescription {
 		HelloOK:               srv.HelloOK,
 		Hosts:                 srv.Hosts,
 		Kind:                  srv.Kind.String(),
+		LastError:             srv.LastError,
+		LastUpdateTime:        srv.LastUpdateTime,
 		LastWriteTime:         srv.LastWriteTime,
 		MaxBatchCount:         srv.MaxBatchCount,
 		MaxDocumentSize:       srv.MaxDocumentSize,
@@ -1116,6 +1173,7 @@ func newEventServerDescription(srv description.Server) event.ServerDescription {
 		Passives:              srv.Passives,
 		Primary:               srv.Primary,
 		ReadOnly:              srv.ReadOnly,
+		RTTStats:              newEventRTTStats(srv.RTTStats),
 		ServiceID:             srv.ServiceID,
 		SessionTimeoutMinutes: srv.SessionTimeoutMinutes,
 		SetName:               srv.SetName,
@@ -1136,6 +1194,20 @@ func newEventServerDescription(srv description.Server) event.ServerDescription {
 	return evtSrv
 }
 
+func newEventRTTStats(stats description.RTTStats) event.RTTStats {
+	evtStats := event.RTTStats{
+		Samples: stats.Samples,
+		P90:     stats.P90,
+	}
+	if stats.Histogram != nil {
+		evtStats.Histogram = make([]event.RTTBucket, len(stats.Histogram))
+		for idx, bucket := range stats.Histogram {
+			evtStats.Histogram[idx] = event.RTTBucket{UpperBound: bucket.UpperBound, Count: bucket.Count}
+		}
+	}
+	return evtStats
+}
+
 func newEventServerTopology(topo description.Topology) event.TopologyDescription {
 	evtSrvs := make([]event.ServerDescription, len(topo.Servers))
 	for idx, srv := range topo.Servers {
@@ -23,6 +23,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/ocsp"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/operation"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/session"
+	xocsp "golang.org/x/crypto/ocsp"
 )
 
 const defaultServerSelectionTimeout = 30 * time.Second
@@ -42,6 +43,7 @@ type Config struct {
 	SRVMaxHosts            int
 	SRVServiceName         string
 	LoadBalanced           bool
+	CircuitBreaker         *options.CircuitBreakerOptions
 	logger                 *logger.Logger
 }
 
@@ -307,6 +309,18 @@ func NewConfigFromOptionsWithAuthenticator(opts *options.ClientOptions, clock *s
 			func(Dialer) Dialer { return opts.Dialer },
 		))
 	}
+	// UnixSocketHosts
+	if len(opts.UnixSocketHosts) > 0 {
+		connOpts = append(connOpts, WithDialer(
+			func(d Dialer) Dialer { return newUnixSocketDialer(d, opts.UnixSocketHosts) },
+		))
+	}
+	// Transport
+	if opts.Transport != nil {
+		connOpts = append(connOpts, WithDialer(
+			func(Dialer) Dialer { return transportDialer{transport: opts.Transport} },
+		))
+	}
 	// Direct
 	if opts.Direct != nil && *opts.Direct {
 		cfgp.Mode = SingleMode
@@ -364,6 +378,12 @@ func NewConfigFromOptionsWithAuthenticator(opts *options.ClientOptions, clock *s
 			func(*event.CommandMonitor) *event.CommandMonitor { return opts.Monitor },
 		))
 	}
+	// WireMonitor
+	if opts.WireMonitor != nil {
+		connOpts = append(connOpts, WithWireMonitor(
+			func(*event.WireMonitor) *event.WireMonitor { return opts.WireMonitor },
+		))
+	}
 	// ServerMonitor
 	if opts.ServerMonitor != nil {
 		serverOpts = append(
@@ -372,6 +392,10 @@ func NewConfigFromOptionsWithAuthenticator(opts *options.ClientOptions, clock *s
 		)
 		cfgp.ServerMonitor = opts.ServerMonitor
 	}
+	// CircuitBreaker
+	if opts.CircuitBreaker != nil {
+		cfgp.CircuitBreaker = opts.CircuitBreaker
+	}
 	// ReplicaSet
 	if opts.ReplicaSet != nil {
 		cfgp.ReplicaSetName = *opts.ReplicaSet
@@ -403,12 +427,32 @@ func NewConfigFromOptionsWithAuthenticator(opts *options.ClientOptions, clock *s
 	}
 
 	// OCSP cache
-	ocspCache := ocsp.NewCache()
+	var ocspCache ocsp.Cache = ocsp.NewCache()
+	if opts.OCSPCache != nil {
+		ocspCache = ocsp.NewStoreCache(ocspStore{cache: opts.OCSPCache})
+	}
 	connOpts = append(
 		connOpts,
 		WithOCSPCache(func(ocsp.Cache) ocsp.Cache { return ocspCache }),
 	)
 
+	// OCSP HTTP client
+	if opts.OCSPHTTPClient != nil {
+		connOpts = append(connOpts, WithOCSPHTTPClient(
+			func(*http.Client) *http.Client {
+				return opts.OCSPHTTPClient
+			},
+		))
+	}
+
+	// OCSP failure mode
+	if opts.OCSPFailureMode != nil {
+		connOpts = append(
+			connOpts,
+			WithOCSPHardFail(func(bool) bool { return *opts.OCSPFailureMode == options.OCSPFailureModeHard }),
+		)
+	}
+
 	// Disable communication with external OCSP responders.
 	if opts.DisableOCSPEndpointCheck != nil {
 		connOpts = append(
@@ -453,3 +497,33 @@ func NewConfigFromOptionsWithAuthenticator(opts *options.ClientOptions, clock *s
 
 	return cfgp, nil
 }
+
+// ocspStore adapts an options.OCSPCache to an ocsp.Store.
+type ocspStore struct {
+	cache options.OCSPCache
+}
+
+var _ ocsp.Store = ocspStore{}
+
+func (s ocspStore) Get(key string) (*ocsp.ResponseDetails, bool) {
+	res, ok := s.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	status := xocsp.Good
+	if res.Revoked {
+		status = xocsp.Revoked
+	}
+	return &ocsp.ResponseDetails{Status: status, NextUpdate: res.NextUpdate}, true
+}
+
+func (s ocspStore) Put(key string, res *ocsp.ResponseDetails) {
+	s.cache.Put(key, options.OCSPResponse{
+		Revoked:    res.Status == xocsp.Revoked,
+		NextUpdate: res.NextUpdate,
+	})
+}
+
+func (s ocspStore) Delete(key string) {
+	s.cache.Delete(key)
+}
@@ -9,6 +9,7 @@ package topology
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"reflect"
 	"testing"
@@ -21,7 +22,9 @@ import (
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/ocsp"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/xoptions"
+	xocsp "golang.org/x/crypto/ocsp"
 )
 
 func TestDirectConnectionFromConnString(t *testing.T) {
@@ -181,6 +184,69 @@ func TestTopologyNewConfig(t *testing.T) {
 		assert.Nil(t, err, "error constructing topology config: %v", err)
 		assert.Equal(t, []string{"localhost:27018"}, cfg.SeedList)
 	})
+	t.Run("OCSP options", func(t *testing.T) {
+		httpClient := &http.Client{}
+		cache := mapOCSPCache{}
+		opts := options.Client().
+			SetOCSPHTTPClient(httpClient).
+			SetOCSPCache(cache).
+			SetOCSPFailureMode(options.OCSPFailureModeHard)
+
+		cfg, err := NewConfig(opts, nil)
+		assert.Nil(t, err, "error constructing topology config: %v", err)
+
+		srvrCfg := newServerConfig(defaultConnectionTimeout, cfg.ServerOpts...)
+		connCfg := newConnectionConfig(srvrCfg.connectionOpts...)
+		assert.Equal(t, httpClient, connCfg.ocspHTTPClient)
+		assert.True(t, connCfg.ocspHardFail, "expected OCSP hard fail to be enabled")
+
+		store, ok := connCfg.ocspCache.(*ocsp.StoreCache)
+		require.True(t, ok, "expected OCSP cache of type %T, got %T", &ocsp.StoreCache{}, connCfg.ocspCache)
+		assert.NotNil(t, store, "expected OCSP cache to be set")
+	})
+	t.Run("default OCSP options", func(t *testing.T) {
+		cfg, err := NewConfig(options.Client(), nil)
+		assert.Nil(t, err, "error constructing topology config: %v", err)
+
+		srvrCfg := newServerConfig(defaultConnectionTimeout, cfg.ServerOpts...)
+		connCfg := newConnectionConfig(srvrCfg.connectionOpts...)
+		assert.Nil(t, connCfg.ocspHTTPClient, "expected no OCSP HTTP client")
+		assert.False(t, connCfg.ocspHardFail, "expected OCSP hard fail to be disabled")
+
+		_, ok := connCfg.ocspCache.(*ocsp.ConcurrentCache)
+		assert.True(t, ok, "expected OCSP cache of type %T, got %T", &ocsp.ConcurrentCache{}, connCfg.ocspCache)
+	})
+}
+
+type mapOCSPCache map[string]options.OCSPResponse
+
+func (c mapOCSPCache) Get(key string) (options.OCSPResponse, bool) {
+	res, ok := c[key]
+	return res, ok
+}
+
+func (c mapOCSPCache) Put(key string, res options.OCSPResponse) { c[key] = res }
+
+func (c mapOCSPCache) Delete(key string) { delete(c, key) }
+
+func TestOCSPStore(t *testing.T) {
+	t.Parallel()
+
+	next := time.Now().Add(time.Hour)
+	cache := mapOCSPCache{}
+	store := ocspStore{cache: cache}
+
+	store.Put("revoked", &ocsp.ResponseDetails{Status: xocsp.Revoked, NextUpdate: next})
+	assert.Equal(t, options.OCSPResponse{Revoked: true, NextUpdate: next}, cache["revoked"])
+
+	store.Put("good", &ocsp.ResponseDetails{Status: xocsp.Good, NextUpdate: next})
+	res, ok := store.Get("good")
+	require.True(t, ok, "expected stored response")
+	assert.Equal(t, &ocsp.ResponseDetails{Status: xocsp.Good, NextUpdate: next}, res)
+
+	store.Delete("good")
+	_, ok = store.Get("good")
+	assert.False(t, ok, "expected response to be deleted")
 }
 
 // Test that convertOIDCArgs exhaustively copies all fields of a driver.OIDCArgs
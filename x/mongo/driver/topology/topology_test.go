@@ -9,6 +9,7 @@ package topology
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"path"
@@ -24,6 +25,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/address"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/tag"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
 )
 
@@ -1040,3 +1042,32 @@ func BenchmarkSelectServerFromDescription(b *testing.B) {
 		})
 	}
 }
+
+func TestTopologyEventDescription(t *testing.T) {
+	topo, err := New(nil)
+	require.NoError(t, err, "New error")
+
+	lastErr := errors.New("connection refused")
+	tags := tag.NewTagSetFromMap(map[string]string{"dc": "east"})
+	servers := []description.Server{
+		{
+			Addr:        "a:27017",
+			Kind:        description.ServerKindRSPrimary,
+			AverageRTT:  5 * time.Millisecond,
+			Tags:        tags,
+			WireVersion: &description.VersionRange{Min: 0, Max: 21},
+		},
+		{Addr: "b:27017", LastError: lastErr},
+	}
+	topo.desc.Store(description.Topology{Kind: description.TopologyKindReplicaSetWithPrimary, Servers: servers})
+
+	desc := topo.EventDescription()
+	assert.Equal(t, "ReplicaSetWithPrimary", desc.Kind)
+	require.Len(t, desc.Servers, 2)
+	assert.Equal(t, "RSPrimary", desc.Servers[0].Kind)
+	assert.Equal(t, 5*time.Millisecond, desc.Servers[0].AverageRTT)
+	assert.Equal(t, tags, desc.Servers[0].Tags)
+	assert.Equal(t, int32(21), desc.Servers[0].MaxWireVersion)
+	assert.Equal(t, "Unknown", desc.Servers[1].Kind)
+	assert.Equal(t, lastErr, desc.Servers[1].LastError)
+}
@@ -0,0 +1,194 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// transportDialer is a Dialer that creates connections with an
+// options.Transport.
+type transportDialer struct {
+	transport options.Transport
+}
+
+var _ Dialer = transportDialer{}
+
+// DialContext implements the Dialer interface. The network is ignored because
+// the transport determines how to reach the server at address.
+func (td transportDialer) DialContext(ctx context.Context, _, address string) (net.Conn, error) {
+	conn, err := td.transport.Dial(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return newTransportNetConn(conn, address), nil
+}
+
+// transportAddr is the net.Addr of a connection created by a Transport.
+type transportAddr string
+
+func (transportAddr) Network() string   { return "transport" }
+func (ta transportAddr) String() string { return string(ta) }
+
+// transportNetConn adapts an options.TransportConn, which transfers whole wire
+// messages, to the byte stream that connection reads and writes. Deadlines are
+// passed to the TransportConn as context deadlines.
+type transportNetConn struct {
+	conn options.TransportConn
+	addr transportAddr
+
+	// ctx is canceled by Close to stop pending reads and writes.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu            sync.Mutex
+	readDeadline  time.Time
+	writeDeadline time.Time
+
+	// rbuf is the unread remainder of the last message read.
+	rbuf []byte
+
+	// wbuf holds the start of a message whose remaining bytes have not been
+	// written yet.
+	wbuf []byte
+}
+
+var _ net.Conn = (*transportNetConn)(nil)
+
+func newTransportNetConn(conn options.TransportConn, address string) *transportNetConn {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &transportNetConn{
+		conn:   conn,
+		addr:   transportAddr(address),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// deadlineContext returns a context that is canceled when the connection is
+// closed or deadline passes.
+func (tc *transportNetConn) deadlineContext(deadline time.Time) (context.Context, context.CancelFunc) {
+	if deadline.IsZero() {
+		return context.WithCancel(tc.ctx)
+	}
+	return context.WithDeadline(tc.ctx, deadline)
+}
+
+// convertErr converts an error returned by the TransportConn to the error a
+// net.Conn would return, so that timeouts are handled like socket timeouts.
+func (tc *transportNetConn) convertErr(err error) error {
+	switch {
+	case tc.ctx.Err() != nil:
+		return net.ErrClosed
+	case errors.Is(err, context.DeadlineExceeded):
+		return os.ErrDeadlineExceeded
+	}
+	return err
+}
+
+// Read reads from the current message, reading the next message from the
+// TransportConn if the current one has been consumed.
+func (tc *transportNetConn) Read(p []byte) (int, error) {
+	if len(tc.rbuf) == 0 {
+		tc.mu.Lock()
+		deadline := tc.readDeadline
+		tc.mu.Unlock()
+
+		ctx, cancel := tc.deadlineContext(deadline)
+		defer cancel()
+
+		msg, err := tc.conn.ReadMessage(ctx)
+		if err != nil {
+			return 0, tc.convertErr(err)
+		}
+		tc.rbuf = msg
+	}
+
+	n := copy(p, tc.rbuf)
+	tc.rbuf = tc.rbuf[n:]
+	return n, nil
+}
+
+// Write buffers p until it contains complete messages and writes each message
+// to the TransportConn.
+func (tc *transportNetConn) Write(p []byte) (int, error) {
+	buf := p
+	if len(tc.wbuf) > 0 {
+		buf = append(tc.wbuf, p...)
+	}
+
+	tc.mu.Lock()
+	deadline := tc.writeDeadline
+	tc.mu.Unlock()
+
+	for len(buf) >= 4 {
+		size := int(int32(binary.LittleEndian.Uint32(buf)))
+		if size < 4 {
+			tc.wbuf = nil
+			return 0, fmt.Errorf("malformed message length: %d", size)
+		}
+		if len(buf) < size {
+			break
+		}
+
+		ctx, cancel := tc.deadlineContext(deadline)
+		err := tc.conn.WriteMessage(ctx, buf[:size])
+		cancel()
+		if err != nil {
+			tc.wbuf = nil
+			return 0, tc.convertErr(err)
+		}
+		buf = buf[size:]
+	}
+
+	// Copy the start of an incomplete message because p belongs to the caller.
+	tc.wbuf = append([]byte(nil), buf...)
+	return len(p), nil
+}
+
+// Close closes the TransportConn and stops pending reads and writes.
+func (tc *transportNetConn) Close() error {
+	tc.cancel()
+	return tc.conn.Close()
+}
+
+func (tc *transportNetConn) LocalAddr() net.Addr  { return tc.addr }
+func (tc *transportNetConn) RemoteAddr() net.Addr { return tc.addr }
+
+func (tc *transportNetConn) SetDeadline(t time.Time) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.readDeadline = t
+	tc.writeDeadline = t
+	return nil
+}
+
+func (tc *transportNetConn) SetReadDeadline(t time.Time) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.readDeadline = t
+	return nil
+}
+
+func (tc *transportNetConn) SetWriteDeadline(t time.Time) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.writeDeadline = t
+	return nil
+}
@@ -0,0 +1,209 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// chanTransportConn is an options.TransportConn that sends written messages on
+// written and reads messages from toRead.
+type chanTransportConn struct {
+	written chan []byte
+	toRead  chan []byte
+	closed  chan struct{}
+}
+
+var _ options.TransportConn = (*chanTransportConn)(nil)
+
+func newChanTransportConn() *chanTransportConn {
+	return &chanTransportConn{
+		written: make(chan []byte, 10),
+		toRead:  make(chan []byte, 10),
+		closed:  make(chan struct{}),
+	}
+}
+
+func (c *chanTransportConn) WriteMessage(_ context.Context, wm []byte) error {
+	c.written <- append([]byte(nil), wm...)
+	return nil
+}
+
+func (c *chanTransportConn) ReadMessage(ctx context.Context) ([]byte, error) {
+	select {
+	case msg := <-c.toRead:
+		return msg, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *chanTransportConn) Close() error {
+	close(c.closed)
+	return nil
+}
+
+type testTransport struct {
+	conn *chanTransportConn
+	addr string
+}
+
+func (tt *testTransport) Dial(_ context.Context, address string) (options.TransportConn, error) {
+	tt.addr = address
+	return tt.conn, nil
+}
+
+// testMessage returns a message of size bytes that starts with its length.
+func testMessage(size int, fill byte) []byte {
+	msg := make([]byte, size)
+	for i := range msg {
+		msg[i] = fill
+	}
+	binary.LittleEndian.PutUint32(msg, uint32(size))
+	return msg
+}
+
+func TestTransportNetConn(t *testing.T) {
+	t.Run("dialer", func(t *testing.T) {
+		tt := &testTransport{conn: newChanTransportConn()}
+		nc, err := transportDialer{transport: tt}.DialContext(context.Background(), "tcp", "localhost:27017")
+		require.NoError(t, err, "DialContext error")
+
+		assert.Equal(t, "localhost:27017", tt.addr, "expected Dial to be called with the address")
+		assert.Equal(t, "localhost:27017", nc.RemoteAddr().String(), "unexpected remote address")
+		assert.Equal(t, "transport", nc.RemoteAddr().Network(), "unexpected network")
+	})
+	t.Run("write", func(t *testing.T) {
+		first := testMessage(16, 1)
+		second := testMessage(20, 2)
+
+		testCases := []struct {
+			name   string
+			writes [][]byte
+		}{
+			{"one write per message", [][]byte{first, second}},
+			{"messages in one write", [][]byte{append(append([]byte{}, first...), second...)}},
+			{"split header", [][]byte{first[:2], first[2:], second}},
+			{"split body", [][]byte{first[:10], append(append([]byte{}, first[10:]...), second[:5]...), second[5:]}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				tconn := newChanTransportConn()
+				nc := newTransportNetConn(tconn, "localhost:27017")
+
+				for _, p := range tc.writes {
+					n, err := nc.Write(p)
+					require.NoError(t, err, "Write error")
+					assert.Equal(t, len(p), n, "expected Write to return the length of p")
+				}
+
+				require.Len(t, tconn.written, 2, "expected two messages to be written")
+				assert.Equal(t, first, <-tconn.written, "unexpected first message")
+				assert.Equal(t, second, <-tconn.written, "unexpected second message")
+			})
+		}
+	})
+	t.Run("write malformed message", func(t *testing.T) {
+		nc := newTransportNetConn(newChanTransportConn(), "localhost:27017")
+
+		_, err := nc.Write([]byte{1, 0, 0, 0, 0})
+		assert.Error(t, err, "expected an error for a message length smaller than the header")
+	})
+	t.Run("read", func(t *testing.T) {
+		tconn := newChanTransportConn()
+		nc := newTransportNetConn(tconn, "localhost:27017")
+
+		first := testMessage(16, 1)
+		second := testMessage(20, 2)
+		tconn.toRead <- first
+		tconn.toRead <- second
+
+		// Reads do not span messages, so the first message is read in two
+		// parts before the second is started.
+		buf := make([]byte, 10)
+		n, err := nc.Read(buf)
+		require.NoError(t, err, "Read error")
+		assert.Equal(t, first[:10], buf[:n], "unexpected start of first message")
+
+		n, err = nc.Read(buf)
+		require.NoError(t, err, "Read error")
+		assert.Equal(t, first[10:], buf[:n], "unexpected end of first message")
+
+		buf = make([]byte, 32)
+		n, err = nc.Read(buf)
+		require.NoError(t, err, "Read error")
+		assert.Equal(t, second, buf[:n], "unexpected second message")
+	})
+	t.Run("read deadline", func(t *testing.T) {
+		nc := newTransportNetConn(newChanTransportConn(), "localhost:27017")
+
+		err := nc.SetReadDeadline(time.Now().Add(10 * time.Millisecond))
+		require.NoError(t, err, "SetReadDeadline error")
+
+		_, err = nc.Read(make([]byte, 16))
+		var netErr net.Error
+		require.True(t, errors.As(err, &netErr), "expected a net.Error, got %v", err)
+		assert.True(t, netErr.Timeout(), "expected a timeout error, got %v", err)
+	})
+	t.Run("close unblocks read", func(t *testing.T) {
+		tconn := newChanTransportConn()
+		nc := newTransportNetConn(tconn, "localhost:27017")
+
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := nc.Read(make([]byte, 16))
+			errCh <- err
+		}()
+
+		err := nc.Close()
+		require.NoError(t, err, "Close error")
+
+		select {
+		case err := <-errCh:
+			assert.ErrorIs(t, err, net.ErrClosed, "expected net.ErrClosed")
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for Read to return after Close")
+		}
+		select {
+		case <-tconn.closed:
+		default:
+			t.Fatal("expected the TransportConn to be closed")
+		}
+	})
+	t.Run("connection", func(t *testing.T) {
+		tconn := newChanTransportConn()
+		tt := &testTransport{conn: tconn}
+		conn := newConnection(address.Address("localhost:27017"),
+			WithDialer(func(Dialer) Dialer { return transportDialer{transport: tt} }),
+			WithHandshaker(func(Handshaker) Handshaker { return nil }),
+		)
+		err := conn.connect(context.Background())
+		require.NoError(t, err, "connect error")
+		defer conn.close()
+
+		wm := testMessage(24, 3)
+		err = conn.writeWireMessage(context.Background(), wm)
+		require.NoError(t, err, "writeWireMessage error")
+		assert.Equal(t, wm, <-tconn.written, "unexpected message written")
+
+		want := testMessage(32, 4)
+		tconn.toRead <- want
+		got, err := conn.readWireMessage(context.Background())
+		require.NoError(t, err, "readWireMessage error")
+		assert.Equal(t, want, got, "unexpected message read")
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"net"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/address"
+)
+
+// unixSocketDialer is a Dialer that dials the Unix domain socket mapped to an
+// address instead of the address itself. Addresses that are not mapped are
+// dialed unchanged.
+type unixSocketDialer struct {
+	dialer  Dialer
+	sockets map[address.Address]string
+}
+
+var _ Dialer = unixSocketDialer{}
+
+// newUnixSocketDialer creates a unixSocketDialer that dials with dialer. The
+// keys of sockets are canonicalized so that they match server addresses.
+func newUnixSocketDialer(dialer Dialer, sockets map[string]string) unixSocketDialer {
+	if dialer == nil {
+		dialer = DefaultDialer
+	}
+
+	canonical := make(map[address.Address]string, len(sockets))
+	for host, path := range sockets {
+		canonical[address.Address(host).Canonicalize()] = path
+	}
+	return unixSocketDialer{dialer: dialer, sockets: canonical}
+}
+
+// DialContext implements the Dialer interface.
+func (ud unixSocketDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if path, ok := ud.sockets[address.Address(addr).Canonicalize()]; ok {
+		return ud.dialer.DialContext(ctx, "unix", path)
+	}
+	return ud.dialer.DialContext(ctx, network, addr)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type recordingDialer struct {
+	network, address string
+}
+
+func (rd *recordingDialer) DialContext(_ context.Context, network, address string) (net.Conn, error) {
+	rd.network, rd.address = network, address
+	return nil, nil
+}
+
+func TestUnixSocketDialer(t *testing.T) {
+	t.Run("mapped hosts", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			addr        string
+			wantNetwork string
+			wantAddress string
+		}{
+			{"mapped host", "db1.example.com:27017", "unix", "/var/run/mongodb/db1.sock"},
+			{"mapped host without port", "db2.example.com:27017", "unix", "/var/run/mongodb/db2.sock"},
+			{"host in different case", "DB1.example.com:27017", "unix", "/var/run/mongodb/db1.sock"},
+			{"unmapped port", "db1.example.com:27018", "tcp", "db1.example.com:27018"},
+			{"unmapped host", "db3.example.com:27017", "tcp", "db3.example.com:27017"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rd := &recordingDialer{}
+				ud := newUnixSocketDialer(rd, map[string]string{
+					"db1.example.com:27017": "/var/run/mongodb/db1.sock",
+					"db2.example.com":       "/var/run/mongodb/db2.sock",
+				})
+
+				_, err := ud.DialContext(context.Background(), "tcp", tc.addr)
+				require.NoError(t, err, "DialContext error")
+				assert.Equal(t, tc.wantNetwork, rd.network, "unexpected network")
+				assert.Equal(t, tc.wantAddress, rd.address, "unexpected address")
+			})
+		}
+	})
+	t.Run("dials socket", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mongodb.sock")
+		testDialSocket(t, path)
+	})
+	t.Run("dials abstract socket", func(t *testing.T) {
+		if runtime.GOOS != "linux" {
+			t.Skip("abstract Unix domain sockets are only supported on Linux")
+		}
+		testDialSocket(t, "@"+t.Name())
+	})
+}
+
+// testDialSocket checks that a unixSocketDialer with the default dialer
+// connects to a server listening on the socket at path.
+func testDialSocket(t *testing.T, path string) {
+	t.Helper()
+
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err, "Listen error")
+	defer l.Close()
+
+	accepted := make(chan struct{})
+	go func() {
+		defer close(accepted)
+		if conn, err := l.Accept(); err == nil {
+			_ = conn.Close()
+		}
+	}()
+
+	ud := newUnixSocketDialer(nil, map[string]string{"localhost:27017": path})
+	conn, err := ud.DialContext(context.Background(), "tcp", "localhost:27017")
+	require.NoError(t, err, "DialContext error")
+	defer conn.Close()
+
+	<-accepted
+	assert.Equal(t, "unix", conn.RemoteAddr().Network(), "expected a Unix domain socket connection")
+}
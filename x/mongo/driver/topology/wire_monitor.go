@@ -0,0 +1,93 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/wiremessage"
+)
+
+// publishWireMessage passes a copy of the wire message wm to the wire monitor
+// of the connection, if any. OP_COMPRESSED messages are decompressed first.
+func (c *connection) publishWireMessage(ctx context.Context, wm []byte, sent bool) {
+	if c.config == nil || c.config.wireMonitor == nil {
+		return
+	}
+	monitor := c.config.wireMonitor
+	publish := monitor.Received
+	if sent {
+		publish = monitor.Sent
+	}
+	if publish == nil {
+		return
+	}
+
+	_, requestID, responseTo, opcode, _, ok := wiremessage.ReadHeader(wm)
+	if !ok {
+		return
+	}
+	evt := &event.WireMessageEvent{
+		ConnectionID:       c.id,
+		ServerConnectionID: c.serverConnectionID,
+		RequestID:          requestID,
+		ResponseTo:         responseTo,
+		OpCode:             int32(opcode),
+		Time:               time.Now(),
+	}
+	if opcode == wiremessage.OpCompressed {
+		msg, origOpcode, err := decompressWireMessage(wm)
+		if err != nil {
+			return
+		}
+		evt.OpCode = int32(origOpcode)
+		evt.Compressed = true
+		evt.Message = msg
+	} else {
+		evt.Message = make([]byte, len(wm))
+		copy(evt.Message, wm)
+	}
+	publish(ctx, evt)
+}
+
+// decompressWireMessage returns the message compressed in the OP_COMPRESSED
+// wire message wm, with a header that has the original opcode.
+func decompressWireMessage(wm []byte) ([]byte, wiremessage.OpCode, error) {
+	_, requestID, responseTo, _, rem, ok := wiremessage.ReadHeader(wm)
+	if !ok {
+		return nil, 0, errors.New("malformed wire message: insufficient bytes")
+	}
+	opcode, rem, ok := wiremessage.ReadCompressedOriginalOpCode(rem)
+	if !ok {
+		return nil, 0, errors.New("malformed OP_COMPRESSED: missing original opcode")
+	}
+	uncompressedSize, rem, ok := wiremessage.ReadCompressedUncompressedSize(rem)
+	if !ok {
+		return nil, 0, errors.New("malformed OP_COMPRESSED: missing uncompressed size")
+	}
+	compressorID, rem, ok := wiremessage.ReadCompressedCompressorID(rem)
+	if !ok {
+		return nil, 0, errors.New("malformed OP_COMPRESSED: missing compressor ID")
+	}
+
+	uncompressed, err := driver.DecompressPayload(rem, driver.CompressionOpts{
+		Compressor:       compressorID,
+		UncompressedSize: uncompressedSize,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The header is 16 bytes long.
+	msg := wiremessage.AppendHeader(make([]byte, 0, 16+len(uncompressed)), int32(16+len(uncompressed)),
+		requestID, responseTo, opcode)
+	return append(msg, uncompressed...), opcode, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) MongoDB, Inc. 2025-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/wiremessage"
+)
+
+func TestConnectionWireMonitor(t *testing.T) {
+	doc := bsoncore.NewDocumentBuilder().AppendInt32("ping", 1).Build()
+	idx, msg := wiremessage.AppendHeaderStart(nil, 7, 3, wiremessage.OpMsg)
+	msg = wiremessage.AppendMsgFlags(msg, 0)
+	msg = wiremessage.AppendMsgSectionType(msg, wiremessage.SingleDocument)
+	msg = append(msg, doc...)
+	msg = bsoncore.UpdateLength(msg, idx, int32(len(msg)))
+
+	var sent, received []*event.WireMessageEvent
+	newConn := func(tnc *testNetConn) *connection {
+		conn := &connection{
+			id:    "localhost:27017[-1]",
+			nc:    tnc,
+			state: connConnected,
+			config: newConnectionConfig(WithWireMonitor(func(*event.WireMonitor) *event.WireMonitor {
+				return &event.WireMonitor{
+					Sent:     func(_ context.Context, evt *event.WireMessageEvent) { sent = append(sent, evt) },
+					Received: func(_ context.Context, evt *event.WireMessageEvent) { received = append(received, evt) },
+				}
+			})),
+		}
+		conn.cancellationListener = newTestCancellationListener(false)
+		return conn
+	}
+
+	t.Run("sent", func(t *testing.T) {
+		sent = nil
+		conn := newConn(&testNetConn{})
+		err := conn.writeWireMessage(context.Background(), msg)
+		require.NoError(t, err)
+
+		require.Len(t, sent, 1)
+		assert.Equal(t, "localhost:27017[-1]", sent[0].ConnectionID)
+		assert.Equal(t, int32(7), sent[0].RequestID)
+		assert.Equal(t, int32(3), sent[0].ResponseTo)
+		assert.Equal(t, int32(wiremessage.OpMsg), sent[0].OpCode)
+		assert.False(t, sent[0].Compressed, "expected an uncompressed message")
+		assert.Equal(t, msg, sent[0].Message)
+	})
+	t.Run("received compressed", func(t *testing.T) {
+		received = nil
+		_, _, _, _, body, ok := wiremessage.ReadHeader(msg)
+		require.True(t, ok, "could not read header")
+		compressed, err := driver.CompressPayload(body, driver.CompressionOpts{Compressor: wiremessage.CompressorSnappy})
+		require.NoError(t, err)
+
+		idx, wm := wiremessage.AppendHeaderStart(nil, 7, 3, wiremessage.OpCompressed)
+		wm = wiremessage.AppendCompressedOriginalOpCode(wm, wiremessage.OpMsg)
+		wm = wiremessage.AppendCompressedUncompressedSize(wm, int32(len(body)))
+		wm = wiremessage.AppendCompressedCompressorID(wm, wiremessage.CompressorSnappy)
+		wm = append(wm, compressed...)
+		wm = bsoncore.UpdateLength(wm, idx, int32(len(wm)))
+
+		conn := newConn(&testNetConn{buf: wm})
+		got, err := conn.readWireMessage(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, wm, got, "expected the compressed message to be returned")
+
+		require.Len(t, received, 1)
+		assert.True(t, received[0].Compressed, "expected a compressed message")
+		assert.Equal(t, int32(wiremessage.OpMsg), received[0].OpCode)
+		assert.Equal(t, msg, received[0].Message, "expected the decompressed message")
+	})
+	t.Run("no monitor", func(t *testing.T) {
+		conn := &connection{id: "foobar", nc: &testNetConn{}, state: connConnected, config: newConnectionConfig()}
+		conn.cancellationListener = newTestCancellationListener(false)
+		err := conn.writeWireMessage(context.Background(), msg)
+		assert.NoError(t, err)
+	})
+}